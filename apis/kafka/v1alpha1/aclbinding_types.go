@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ACLResourceType is a typed enum for the type of resources an ACL binding
+// applies to.
+// +kubebuilder:validation:Enum=TOPIC;GROUP;CLUSTER;TRANSACTIONAL_ID
+type ACLResourceType string
+
+// ACLPatternType is a typed enum for how the resource name of an ACL binding
+// is matched.
+// +kubebuilder:validation:Enum=LITERAL;PREFIXED
+type ACLPatternType string
+
+// ACLOperation is a typed enum for the operation an ACL binding applies to.
+// +kubebuilder:validation:Enum=ALL;READ;WRITE;CREATE;DELETE;ALTER;DESCRIBE;DESCRIBE_CONFIGS;ALTER_CONFIGS
+type ACLOperation string
+
+// ACLPermission is a typed enum for whether an ACL binding allows or denies
+// an operation.
+// +kubebuilder:validation:Enum=ALLOW;DENY
+type ACLPermission string
+
+// ACLBindingParameters are the configurable fields of an ACLBinding. ACL
+// bindings cannot be changed, so the binding is replaced if they change.
+type ACLBindingParameters struct {
+	// KafkaInstanceID is the ID of the Kafka instance the ACL binding belongs
+	// to.
+	// +kubebuilder:validation:Optional
+	KafkaInstanceID string `json:"kafkaInstanceID,omitempty"`
+
+	// KafkaInstanceIDRef references a KafkaInstance to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	KafkaInstanceIDRef *xpv1.Reference `json:"kafkaInstanceIDRef,omitempty"`
+
+	// KafkaInstanceIDSelector selects a reference to a KafkaInstance to
+	// retrieve its ID.
+	// +kubebuilder:validation:Optional
+	KafkaInstanceIDSelector *xpv1.Selector `json:"kafkaInstanceIDSelector,omitempty"`
+
+	// ResourceType is the type of the resources the ACL binding applies to.
+	ResourceType ACLResourceType `json:"resourceType"`
+
+	// ResourceName is the name of the resources the ACL binding applies to,
+	// or * for all resources of the type. The name of the cluster resource
+	// is kafka-cluster.
+	ResourceName string `json:"resourceName"`
+
+	// PatternType defines whether the resource name matches resources
+	// literally or as a prefix of their name.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=LITERAL
+	PatternType ACLPatternType `json:"patternType,omitempty"`
+
+	// Principal the ACL binding applies to, e.g. User:<client ID> for a
+	// service account, or User:* for all principals.
+	Principal string `json:"principal"`
+
+	// Operation the ACL binding applies to.
+	Operation ACLOperation `json:"operation"`
+
+	// Permission defines whether the operation is allowed or denied.
+	Permission ACLPermission `json:"permission"`
+}
+
+// ACLBindingObservation are the observable fields of an ACLBinding. They
+// describe the ACL binding that exists in the Kafka instance, which differs
+// from the desired one until it is replaced.
+type ACLBindingObservation struct {
+	// ResourceType is the type of the resources the ACL binding applies to.
+	ResourceType ACLResourceType `json:"resourceType,omitempty"`
+
+	// ResourceName is the name of the resources the ACL binding applies to.
+	ResourceName string `json:"resourceName,omitempty"`
+
+	// PatternType defines how the resource name matches resources.
+	PatternType ACLPatternType `json:"patternType,omitempty"`
+
+	// Principal the ACL binding applies to.
+	Principal string `json:"principal,omitempty"`
+
+	// Operation the ACL binding applies to.
+	Operation ACLOperation `json:"operation,omitempty"`
+
+	// Permission defines whether the operation is allowed or denied.
+	Permission ACLPermission `json:"permission,omitempty"`
+}
+
+// An ACLBindingSpec defines the desired state of an ACLBinding.
+type ACLBindingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ACLBindingParameters `json:"forProvider"`
+}
+
+// An ACLBindingStatus represents the observed state of an ACLBinding.
+type ACLBindingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ACLBindingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ACLBinding allows or denies a principal an operation on resources of a
+// Kafka instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PRINCIPAL",type="string",JSONPath=".spec.forProvider.principal"
+// +kubebuilder:printcolumn:name="OPERATION",type="string",JSONPath=".spec.forProvider.operation"
+// +kubebuilder:printcolumn:name="PERMISSION",type="string",JSONPath=".spec.forProvider.permission"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type ACLBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ACLBindingSpec   `json:"spec"`
+	Status ACLBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ACLBindingList contains a list of ACLBinding
+type ACLBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ACLBinding `json:"items"`
+}
+
+// ACLBinding type metadata.
+var (
+	ACLBindingKind             = reflect.TypeOf(ACLBinding{}).Name()
+	ACLBindingGroupKind        = schema.GroupKind{Group: Group, Kind: ACLBindingKind}.String()
+	ACLBindingKindAPIVersion   = ACLBindingKind + "." + SchemeGroupVersion.String()
+	ACLBindingGroupVersionKind = SchemeGroupVersion.WithKind(ACLBindingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ACLBinding{}, &ACLBindingList{})
+}
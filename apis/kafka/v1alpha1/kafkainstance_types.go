@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CloudProvider is a typed enum for the cloud provider of a Kafka instance.
+// +kubebuilder:validation:Enum=aws;gcp
+type CloudProvider string
+
+// Connection detail keys of a KafkaInstance.
+const (
+	ConnectionKeyBootstrapServerHost = "bootstrapServerHost"
+)
+
+// KafkaInstanceParameters are the configurable fields of a KafkaInstance. A
+// Kafka instance cannot be changed once it is created, so it is deleted and
+// created again if they change.
+type KafkaInstanceParameters struct {
+	// Name of the Kafka instance.
+	// +kubebuilder:validation:Pattern=^[a-z]([-a-z0-9]*[a-z0-9])?$
+	// +kubebuilder:validation:MaxLength=32
+	Name string `json:"name"`
+
+	// CloudProvider to which the Kafka instance is deployed.
+	CloudProvider CloudProvider `json:"cloudProvider"`
+
+	// Region defines the geographical region which hosts the Kafka instance,
+	// e.g. us-east-1.
+	Region string `json:"region"`
+
+	// MultiAZ defines if the Kafka instance is deployed to a cluster with
+	// multiple availability zones.
+	// +kubebuilder:default=true
+	MultiAZ bool `json:"multiAZ"`
+}
+
+// KafkaInstanceObservation are the observable fields of a KafkaInstance.
+type KafkaInstanceObservation struct {
+	// ID represents a unique identifier for the Kafka instance.
+	ID string `json:"id,omitempty"`
+
+	// HRef represents the API path of the Kafka instance in the Kafka
+	// management API.
+	HRef string `json:"href,omitempty"`
+
+	// Status defines the status of the Kafka instance, e.g. provisioning or
+	// ready.
+	Status string `json:"status,omitempty"`
+
+	// FailedReason indicates why the instance is in a failed state.
+	FailedReason string `json:"failedReason,omitempty"`
+
+	// BootstrapServerHost is the host Kafka clients bootstrap their
+	// connection to the instance with.
+	BootstrapServerHost string `json:"bootstrapServerHost,omitempty"`
+
+	// AdminAPIServerURL is the URL of the admin API of the instance, which
+	// manages its topics and ACL bindings.
+	AdminAPIServerURL string `json:"adminAPIServerURL,omitempty"`
+
+	// InstanceType defines the purchasing type of the Kafka instance.
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// Owner of the Kafka instance.
+	Owner string `json:"owner,omitempty"`
+
+	// Version represents the Kafka version.
+	Version string `json:"version,omitempty"`
+
+	// CreatedAt defines the timestamp at which the Kafka instance was
+	// created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt defines the timestamp at which the Kafka instance was last
+	// updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// A KafkaInstanceSpec defines the desired state of a KafkaInstance.
+type KafkaInstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KafkaInstanceParameters `json:"forProvider"`
+}
+
+// A KafkaInstanceStatus represents the observed state of a KafkaInstance.
+type KafkaInstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KafkaInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KafkaInstance represents a Red Hat OpenShift Streams for Apache Kafka
+// instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type KafkaInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaInstanceSpec   `json:"spec"`
+	Status KafkaInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KafkaInstanceList contains a list of KafkaInstance
+type KafkaInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaInstance `json:"items"`
+}
+
+// KafkaInstance type metadata.
+var (
+	KafkaInstanceKind             = reflect.TypeOf(KafkaInstance{}).Name()
+	KafkaInstanceGroupKind        = schema.GroupKind{Group: Group, Kind: KafkaInstanceKind}.String()
+	KafkaInstanceKindAPIVersion   = KafkaInstanceKind + "." + SchemeGroupVersion.String()
+	KafkaInstanceGroupVersionKind = SchemeGroupVersion.WithKind(KafkaInstanceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KafkaInstance{}, &KafkaInstanceList{})
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// KafkaInstanceID extracts the ID of a KafkaInstance. The external name of a
+// KafkaInstance is its name, not its ID.
+func KafkaInstanceID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		k, ok := mg.(*KafkaInstance)
+		if !ok {
+			return ""
+		}
+		return k.Status.AtProvider.ID
+	}
+}
+
+// resolveKafkaInstanceID resolves the ID of the KafkaInstance referenced or
+// selected by a resource of a Kafka instance.
+func resolveKafkaInstanceID(ctx context.Context, c client.Reader, mg resource.Managed, id *string, ref **xpv1.Reference, sel *xpv1.Selector) error {
+	rsp, err := reference.NewAPIResolver(c, mg).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: *id,
+		Extract:      KafkaInstanceID(),
+		Reference:    *ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &KafkaInstanceList{},
+			Managed: &KafkaInstance{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.kafkaInstanceID")
+	}
+	*id, *ref = rsp.ResolvedValue, rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this Topic.
+func (mg *Topic) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveKafkaInstanceID(ctx, c, mg, &p.KafkaInstanceID, &p.KafkaInstanceIDRef, p.KafkaInstanceIDSelector)
+}
+
+// ResolveReferences of this ACLBinding.
+func (mg *ACLBinding) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveKafkaInstanceID(ctx, c, mg, &p.KafkaInstanceID, &p.KafkaInstanceIDRef, p.KafkaInstanceIDSelector)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TopicParameters are the configurable fields of a Topic.
+type TopicParameters struct {
+	// KafkaInstanceID is the ID of the Kafka instance the topic belongs to.
+	// +kubebuilder:validation:Optional
+	KafkaInstanceID string `json:"kafkaInstanceID,omitempty"`
+
+	// KafkaInstanceIDRef references a KafkaInstance to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	KafkaInstanceIDRef *xpv1.Reference `json:"kafkaInstanceIDRef,omitempty"`
+
+	// KafkaInstanceIDSelector selects a reference to a KafkaInstance to
+	// retrieve its ID.
+	// +kubebuilder:validation:Optional
+	KafkaInstanceIDSelector *xpv1.Selector `json:"kafkaInstanceIDSelector,omitempty"`
+
+	// Name of the topic. It cannot be changed once the topic is created.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9._-]+$`
+	// +kubebuilder:validation:MaxLength=249
+	Name string `json:"name"`
+
+	// Partitions is the number of partitions of the topic. It can only be
+	// increased.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	Partitions int `json:"partitions,omitempty"`
+
+	// RetentionMs is how long messages are retained in the topic, in
+	// milliseconds, or -1 to retain them indefinitely. It takes precedence
+	// over retention.ms in Configs.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=-1
+	RetentionMs *int64 `json:"retentionMs,omitempty"`
+
+	// RetentionBytes is the maximum size of a partition of the topic before
+	// old messages are discarded, or -1 for no limit. It takes precedence
+	// over retention.bytes in Configs.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=-1
+	RetentionBytes *int64 `json:"retentionBytes,omitempty"`
+
+	// Configs are further configuration entries of the topic, e.g.
+	// cleanup.policy. Entries that are not set keep their default.
+	// +kubebuilder:validation:Optional
+	Configs map[string]string `json:"configs,omitempty"`
+}
+
+// TopicObservation are the observable fields of a Topic.
+type TopicObservation struct {
+	// Partitions is the number of partitions of the topic.
+	Partitions int `json:"partitions,omitempty"`
+}
+
+// A TopicSpec defines the desired state of a Topic.
+type TopicSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TopicParameters `json:"forProvider"`
+}
+
+// A TopicStatus represents the observed state of a Topic.
+type TopicStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TopicObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Topic is a topic of a Kafka instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PARTITIONS",type="integer",JSONPath=".status.atProvider.partitions"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type Topic struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TopicSpec   `json:"spec"`
+	Status TopicStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TopicList contains a list of Topic
+type TopicList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Topic `json:"items"`
+}
+
+// Topic type metadata.
+var (
+	TopicKind             = reflect.TypeOf(Topic{}).Name()
+	TopicGroupKind        = schema.GroupKind{Group: Group, Kind: TopicKind}.String()
+	TopicKindAPIVersion   = TopicKind + "." + SchemeGroupVersion.String()
+	TopicGroupVersionKind = SchemeGroupVersion.WithKind(TopicKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Topic{}, &TopicList{})
+}
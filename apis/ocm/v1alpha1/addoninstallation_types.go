@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AddOnInstallationParameters are the configurable fields of an
+// AddOnInstallation.
+type AddOnInstallationParameters struct {
+	// ClusterID is the ID of the cluster the add-on is installed on.
+	// +kubebuilder:validation:Optional
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// ClusterIDRef references a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIDRef,omitempty"`
+
+	// ClusterIDSelector selects a reference to a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIDSelector,omitempty"`
+
+	// AddOnID is the ID of the add-on, e.g. cluster-logging-operator. It
+	// cannot be changed.
+	AddOnID string `json:"addOnID"`
+
+	// Parameters of the add-on. Their values must match the type, validation
+	// and options the add-on defines for them. Parameters that are not set,
+	// or no longer set, have the defaults of the add-on.
+	// +kubebuilder:validation:Optional
+	Parameters []AddOnParameter `json:"parameters,omitempty"`
+}
+
+// An AddOnParameter configures an add-on installation.
+type AddOnParameter struct {
+	// ID of the parameter, as defined by the add-on.
+	ID string `json:"id"`
+
+	// Value of the parameter, e.g. "true" for a boolean parameter.
+	Value string `json:"value"`
+}
+
+// AddOnInstallationObservation are the observable fields of an
+// AddOnInstallation.
+type AddOnInstallationObservation struct {
+	// ID of the add-on installation.
+	ID string `json:"id,omitempty"`
+
+	// State of the add-on installation.
+	State string `json:"state,omitempty"`
+
+	// StateDescription explains the state of the add-on installation, e.g.
+	// why it failed.
+	StateDescription string `json:"stateDescription,omitempty"`
+
+	// Version of the installed add-on.
+	Version string `json:"version,omitempty"`
+}
+
+// An AddOnInstallationSpec defines the desired state of an
+// AddOnInstallation.
+type AddOnInstallationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AddOnInstallationParameters `json:"forProvider"`
+}
+
+// An AddOnInstallationStatus represents the observed state of an
+// AddOnInstallation.
+type AddOnInstallationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AddOnInstallationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AddOnInstallation is an add-on installed on an OCM cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type AddOnInstallation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AddOnInstallationSpec   `json:"spec"`
+	Status AddOnInstallationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AddOnInstallationList contains a list of AddOnInstallation
+type AddOnInstallationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AddOnInstallation `json:"items"`
+}
+
+// AddOnInstallation type metadata.
+var (
+	AddOnInstallationKind             = reflect.TypeOf(AddOnInstallation{}).Name()
+	AddOnInstallationGroupKind        = schema.GroupKind{Group: Group, Kind: AddOnInstallationKind}.String()
+	AddOnInstallationKindAPIVersion   = AddOnInstallationKind + "." + SchemeGroupVersion.String()
+	AddOnInstallationGroupVersionKind = SchemeGroupVersion.WithKind(AddOnInstallationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AddOnInstallation{}, &AddOnInstallationList{})
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Product is a typed enum for the OpenShift product of a cluster.
+// +kubebuilder:validation:Enum=osd;rosa
+type Product string
+
+// Products of clusters.
+const (
+	ProductOSD  Product = "osd"
+	ProductROSA Product = "rosa"
+)
+
+// CloudProvider is a typed enum for the cloud provider of a cluster.
+// +kubebuilder:validation:Enum=aws;gcp
+type CloudProvider string
+
+// Connection detail keys of a Cluster. The kubeconfig and admin credentials
+// are only published if OCM keeps them for the cluster.
+const (
+	ConnectionKeyAPIURL     = xpv1.ResourceCredentialsSecretEndpointKey
+	ConnectionKeyConsoleURL = "consoleURL"
+	ConnectionKeyKubeconfig = xpv1.ResourceCredentialsSecretKubeconfigKey
+	ConnectionKeyUsername   = xpv1.ResourceCredentialsSecretUserKey
+	ConnectionKeyPassword   = xpv1.ResourceCredentialsSecretPasswordKey
+)
+
+// ClusterParameters are the configurable fields of a Cluster. All fields but
+// the compute nodes cannot be changed once the cluster is created.
+type ClusterParameters struct {
+	// Name of the cluster.
+	// +kubebuilder:validation:Pattern=^[a-z]([-a-z0-9]*[a-z0-9])?$
+	// +kubebuilder:validation:MaxLength=15
+	Name string `json:"name"`
+
+	// Product of the cluster, either OpenShift Dedicated (osd) or Red Hat
+	// OpenShift Service on AWS (rosa).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=osd
+	Product Product `json:"product,omitempty"`
+
+	// CloudProvider to which the cluster is deployed.
+	CloudProvider CloudProvider `json:"cloudProvider"`
+
+	// Region of the cloud provider which hosts the cluster, e.g. us-east-1.
+	Region string `json:"region"`
+
+	// Version of OpenShift the cluster is installed with, e.g.
+	// openshift-v4.12.8. Defaults to the latest version.
+	// +kubebuilder:validation:Optional
+	Version string `json:"version,omitempty"`
+
+	// MultiAZ defines if the cluster spans multiple availability zones.
+	// +kubebuilder:validation:Optional
+	MultiAZ bool `json:"multiAZ,omitempty"`
+
+	// ComputeNodes is the number of compute nodes of the cluster. It must
+	// be a multiple of three for multi-AZ clusters. Defaults to the minimum
+	// of the product.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=2
+	ComputeNodes int `json:"computeNodes,omitempty"`
+
+	// ComputeMachineType is the instance type of the compute nodes, e.g.
+	// m5.xlarge.
+	// +kubebuilder:validation:Optional
+	ComputeMachineType string `json:"computeMachineType,omitempty"`
+
+	// Network configures the address ranges of the cluster.
+	// +kubebuilder:validation:Optional
+	Network *ClusterNetwork `json:"network,omitempty"`
+
+	// AWS is the AWS account the cluster is provisioned into. Setting it
+	// provisions a customer cloud subscription cluster, which ROSA
+	// clusters require.
+	// +kubebuilder:validation:Optional
+	AWS *ClusterAWS `json:"aws,omitempty"`
+}
+
+// A ClusterNetwork configures the address ranges of a cluster. OCM defaults
+// unset ranges.
+type ClusterNetwork struct {
+	// MachineCIDR is the address range of the nodes.
+	// +kubebuilder:validation:Optional
+	MachineCIDR string `json:"machineCIDR,omitempty"`
+
+	// ServiceCIDR is the address range of services.
+	// +kubebuilder:validation:Optional
+	ServiceCIDR string `json:"serviceCIDR,omitempty"`
+
+	// PodCIDR is the address range of pods.
+	// +kubebuilder:validation:Optional
+	PodCIDR string `json:"podCIDR,omitempty"`
+
+	// HostPrefix is the prefix length of the pod subnet of each node.
+	// +kubebuilder:validation:Optional
+	HostPrefix int `json:"hostPrefix,omitempty"`
+}
+
+// ClusterAWS is the AWS account a cluster is provisioned into.
+type ClusterAWS struct {
+	// AccountID of the AWS account.
+	AccountID string `json:"accountID"`
+
+	// AccessKeyIDSecretRef references the access key ID of an IAM user with
+	// the permissions to provision the cluster.
+	AccessKeyIDSecretRef xpv1.SecretKeySelector `json:"accessKeyIDSecretRef"`
+
+	// SecretAccessKeySecretRef references the secret access key of the IAM
+	// user.
+	SecretAccessKeySecretRef xpv1.SecretKeySelector `json:"secretAccessKeySecretRef"`
+}
+
+// ClusterObservation are the observable fields of a Cluster.
+type ClusterObservation struct {
+	// ID represents a unique identifier for the cluster in OCM.
+	ID string `json:"id,omitempty"`
+
+	// ExternalID is the identifier of the cluster within OpenShift.
+	ExternalID string `json:"externalID,omitempty"`
+
+	// State of the cluster, e.g. installing or ready.
+	State string `json:"state,omitempty"`
+
+	// Version of OpenShift the cluster runs.
+	Version string `json:"version,omitempty"`
+
+	// AvailableUpgrades are the versions the cluster can be upgraded to.
+	AvailableUpgrades []string `json:"availableUpgrades,omitempty"`
+
+	// ComputeNodes is the number of compute nodes of the cluster.
+	ComputeNodes int `json:"computeNodes,omitempty"`
+
+	// APIURL is the URL of the API server of the cluster.
+	APIURL string `json:"apiURL,omitempty"`
+
+	// ConsoleURL is the URL of the web console of the cluster.
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	// CreatedAt defines the timestamp at which the cluster was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// A ClusterSpec defines the desired state of a Cluster.
+type ClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterParameters `json:"forProvider"`
+}
+
+// A ClusterStatus represents the observed state of a Cluster.
+type ClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Cluster is an OpenShift Dedicated or ROSA cluster provisioned by the
+// OpenShift Cluster Manager.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.version"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSpec   `json:"spec"`
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Cluster
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
+
+// Cluster type metadata.
+var (
+	ClusterKind             = reflect.TypeOf(Cluster{}).Name()
+	ClusterGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterKind}.String()
+	ClusterKindAPIVersion   = ClusterKind + "." + SchemeGroupVersion.String()
+	ClusterGroupVersionKind = SchemeGroupVersion.WithKind(ClusterKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Cluster{}, &ClusterList{})
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ClusterGroup is a typed enum for the groups of cluster users with elevated
+// permissions.
+// +kubebuilder:validation:Enum=dedicated-admins;cluster-admins
+type ClusterGroup string
+
+// Groups of cluster users.
+const (
+	ClusterGroupDedicatedAdmins ClusterGroup = "dedicated-admins"
+	ClusterGroupClusterAdmins   ClusterGroup = "cluster-admins"
+)
+
+// ClusterGroupUserParameters are the configurable fields of a
+// ClusterGroupUser. None of them can be changed once the user was added to
+// the group.
+type ClusterGroupUserParameters struct {
+	// ClusterID is the ID of the cluster of the group.
+	// +kubebuilder:validation:Optional
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// ClusterIDRef references a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIDRef,omitempty"`
+
+	// ClusterIDSelector selects a reference to a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIDSelector,omitempty"`
+
+	// Group the user is added to. Members of cluster-admins have full
+	// access to the cluster, which must allow it.
+	Group ClusterGroup `json:"group"`
+
+	// User is the name the user logs in to the cluster with.
+	User string `json:"user"`
+}
+
+// A ClusterGroupUserSpec defines the desired state of a ClusterGroupUser.
+type ClusterGroupUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterGroupUserParameters `json:"forProvider"`
+}
+
+// A ClusterGroupUserStatus represents the observed state of a
+// ClusterGroupUser.
+type ClusterGroupUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterGroupUser is a member of a group of an OCM cluster, such as
+// dedicated-admins.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="GROUP",type="string",JSONPath=".spec.forProvider.group"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type ClusterGroupUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterGroupUserSpec   `json:"spec"`
+	Status ClusterGroupUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterGroupUserList contains a list of ClusterGroupUser
+type ClusterGroupUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterGroupUser `json:"items"`
+}
+
+// ClusterGroupUser type metadata.
+var (
+	ClusterGroupUserKind             = reflect.TypeOf(ClusterGroupUser{}).Name()
+	ClusterGroupUserGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterGroupUserKind}.String()
+	ClusterGroupUserKindAPIVersion   = ClusterGroupUserKind + "." + SchemeGroupVersion.String()
+	ClusterGroupUserGroupVersionKind = SchemeGroupVersion.WithKind(ClusterGroupUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ClusterGroupUser{}, &ClusterGroupUserList{})
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+)
+
+// MappingMethod is a typed enum for how identities of an identity provider
+// are mapped to users.
+// +kubebuilder:validation:Enum=claim;lookup;generate;add
+type MappingMethod string
+
+// IdentityProviderParameters are the configurable fields of an
+// IdentityProvider. Exactly one of openID, github, ldap and htpasswd must be
+// set.
+type IdentityProviderParameters struct {
+	// ClusterID is the ID of the cluster users log in to with the identity
+	// provider.
+	// +kubebuilder:validation:Optional
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// ClusterIDRef references a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIDRef,omitempty"`
+
+	// ClusterIDSelector selects a reference to a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIDSelector,omitempty"`
+
+	// Name of the identity provider, shown on the login page of the cluster.
+	Name string `json:"name"`
+
+	// MappingMethod defines how identities are mapped to users.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=claim
+	MappingMethod MappingMethod `json:"mappingMethod,omitempty"`
+
+	// OpenID authenticates users with an OpenID Connect provider.
+	// +kubebuilder:validation:Optional
+	OpenID *OpenIDIdentityProvider `json:"openID,omitempty"`
+
+	// GitHub authenticates users with GitHub or GitHub Enterprise.
+	// +kubebuilder:validation:Optional
+	GitHub *GitHubIdentityProvider `json:"github,omitempty"`
+
+	// LDAP authenticates users with an LDAP server.
+	// +kubebuilder:validation:Optional
+	LDAP *LDAPIdentityProvider `json:"ldap,omitempty"`
+
+	// HTPasswd authenticates a fixed list of users with passwords.
+	// +kubebuilder:validation:Optional
+	HTPasswd *HTPasswdIdentityProvider `json:"htpasswd,omitempty"`
+}
+
+// An OpenIDIdentityProvider authenticates users with an OpenID Connect
+// provider.
+type OpenIDIdentityProvider struct {
+	// ClientID registered with the provider.
+	ClientID string `json:"clientID"`
+
+	// ClientSecretSecretRef references the client secret registered with the
+	// provider.
+	ClientSecretSecretRef xpv1.SecretKeySelector `json:"clientSecretSecretRef"`
+
+	// Issuer URL of the provider.
+	Issuer string `json:"issuer"`
+
+	// CA is a PEM encoded bundle of certificates to verify the provider
+	// with.
+	// +kubebuilder:validation:Optional
+	CA string `json:"ca,omitempty"`
+
+	// ExtraScopes requested in addition to the openid scope.
+	// +kubebuilder:validation:Optional
+	ExtraScopes []string `json:"extraScopes,omitempty"`
+
+	// Claims map the claims of ID tokens to the identities of users.
+	// +kubebuilder:validation:Optional
+	Claims *OpenIDClaims `json:"claims,omitempty"`
+}
+
+// OpenIDClaims map the claims of ID tokens to the identities of users. The
+// first claim with a value is used.
+type OpenIDClaims struct {
+	// Email claims of the user.
+	// +kubebuilder:validation:Optional
+	Email []string `json:"email,omitempty"`
+
+	// Name claims of the user.
+	// +kubebuilder:validation:Optional
+	Name []string `json:"name,omitempty"`
+
+	// PreferredUsername claims of the user.
+	// +kubebuilder:validation:Optional
+	PreferredUsername []string `json:"preferredUsername,omitempty"`
+
+	// Groups claims of the user.
+	// +kubebuilder:validation:Optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// A GitHubIdentityProvider authenticates users with GitHub. Users can be
+// restricted to members of either organizations or teams.
+type GitHubIdentityProvider struct {
+	// ClientID of the GitHub OAuth application.
+	ClientID string `json:"clientID"`
+
+	// ClientSecretSecretRef references the client secret of the GitHub OAuth
+	// application.
+	ClientSecretSecretRef xpv1.SecretKeySelector `json:"clientSecretSecretRef"`
+
+	// Organizations users must be a member of.
+	// +kubebuilder:validation:Optional
+	Organizations []string `json:"organizations,omitempty"`
+
+	// Teams users must be a member of, in the form organization/team.
+	// +kubebuilder:validation:Optional
+	Teams []string `json:"teams,omitempty"`
+
+	// Hostname of a GitHub Enterprise instance.
+	// +kubebuilder:validation:Optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// CA is a PEM encoded bundle of certificates to verify the GitHub
+	// Enterprise instance with.
+	// +kubebuilder:validation:Optional
+	CA string `json:"ca,omitempty"`
+}
+
+// An LDAPIdentityProvider authenticates users with an LDAP server.
+type LDAPIdentityProvider struct {
+	// URL of the LDAP server, the base DN and the search filter, as
+	// specified by RFC 2255.
+	URL string `json:"url"`
+
+	// BindDN to bind to the LDAP server with during searches.
+	// +kubebuilder:validation:Optional
+	BindDN string `json:"bindDN,omitempty"`
+
+	// BindPasswordSecretRef references the password to bind to the LDAP
+	// server with during searches.
+	// +kubebuilder:validation:Optional
+	BindPasswordSecretRef *xpv1.SecretKeySelector `json:"bindPasswordSecretRef,omitempty"`
+
+	// Insecure connects to the LDAP server without TLS.
+	// +kubebuilder:validation:Optional
+	Insecure bool `json:"insecure,omitempty"`
+
+	// CA is a PEM encoded bundle of certificates to verify the LDAP server
+	// with.
+	// +kubebuilder:validation:Optional
+	CA string `json:"ca,omitempty"`
+
+	// Attributes map the attributes of LDAP entries to the identities of
+	// users.
+	// +kubebuilder:validation:Optional
+	Attributes *LDAPAttributes `json:"attributes,omitempty"`
+}
+
+// LDAPAttributes map the attributes of LDAP entries to the identities of
+// users. The first attribute with a value is used.
+type LDAPAttributes struct {
+	// ID attributes of the user.
+	// +kubebuilder:validation:Optional
+	ID []string `json:"id,omitempty"`
+
+	// Email attributes of the user.
+	// +kubebuilder:validation:Optional
+	Email []string `json:"email,omitempty"`
+
+	// Name attributes of the user.
+	// +kubebuilder:validation:Optional
+	Name []string `json:"name,omitempty"`
+
+	// PreferredUsername attributes of the user.
+	// +kubebuilder:validation:Optional
+	PreferredUsername []string `json:"preferredUsername,omitempty"`
+}
+
+// An HTPasswdIdentityProvider authenticates a fixed list of users with
+// passwords.
+type HTPasswdIdentityProvider struct {
+	// Users that can log in.
+	// +kubebuilder:validation:MinItems=1
+	Users []HTPasswdUser `json:"users"`
+}
+
+// An HTPasswdUser logs in with a username and password.
+type HTPasswdUser struct {
+	// Username of the user.
+	Username string `json:"username"`
+
+	// PasswordSecretRef references the password of the user.
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// IdentityProviderObservation are the observable fields of an
+// IdentityProvider.
+type IdentityProviderObservation struct {
+	// ID of the identity provider.
+	ID string `json:"id,omitempty"`
+
+	// Type of the identity provider.
+	Type string `json:"type,omitempty"`
+
+	apisv1alpha1.SecretObservation `json:",inline"`
+}
+
+// An IdentityProviderSpec defines the desired state of an IdentityProvider.
+type IdentityProviderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IdentityProviderParameters `json:"forProvider"`
+}
+
+// An IdentityProviderStatus represents the observed state of an
+// IdentityProvider.
+type IdentityProviderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IdentityProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IdentityProvider lets users log in to an OCM cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type IdentityProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IdentityProviderSpec   `json:"spec"`
+	Status IdentityProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IdentityProviderList contains a list of IdentityProvider
+type IdentityProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IdentityProvider `json:"items"`
+}
+
+// IdentityProvider type metadata.
+var (
+	IdentityProviderKind             = reflect.TypeOf(IdentityProvider{}).Name()
+	IdentityProviderGroupKind        = schema.GroupKind{Group: Group, Kind: IdentityProviderKind}.String()
+	IdentityProviderKindAPIVersion   = IdentityProviderKind + "." + SchemeGroupVersion.String()
+	IdentityProviderGroupVersionKind = SchemeGroupVersion.WithKind(IdentityProviderKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&IdentityProvider{}, &IdentityProviderList{})
+}
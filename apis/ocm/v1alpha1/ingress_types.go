@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Listening is a typed enum for the networks an ingress is reachable from.
+// +kubebuilder:validation:Enum=public;private
+type Listening string
+
+// Networks an ingress is reachable from.
+const (
+	ListeningPublic  Listening = "public"
+	ListeningPrivate Listening = "private"
+)
+
+// LoadBalancerType is a typed enum for the AWS load balancers of ingresses.
+// +kubebuilder:validation:Enum=classic;nlb
+type LoadBalancerType string
+
+// IngressParameters are the configurable fields of an Ingress.
+type IngressParameters struct {
+	// ClusterID is the ID of the cluster of the ingress.
+	// +kubebuilder:validation:Optional
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// ClusterIDRef references a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIDRef,omitempty"`
+
+	// ClusterIDSelector selects a reference to a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIDSelector,omitempty"`
+
+	// Default manages the default ingress of the cluster instead of an
+	// additional one. The default ingress is adopted rather than created, and
+	// is left in place when the Ingress is deleted. It cannot be changed.
+	// +kubebuilder:validation:Optional
+	Default bool `json:"default,omitempty"`
+
+	// Listening defines if the ingress is reachable from the internet or
+	// only from the network of the cluster.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=public
+	Listening Listening `json:"listening,omitempty"`
+
+	// LoadBalancerType of the ingress on AWS. Defaults to the type OCM
+	// chooses for the cluster.
+	// +kubebuilder:validation:Optional
+	LoadBalancerType LoadBalancerType `json:"loadBalancerType,omitempty"`
+
+	// RouteSelectors select the routes served by the ingress by their
+	// labels. The ingress serves all routes if none are set.
+	// +kubebuilder:validation:Optional
+	RouteSelectors map[string]string `json:"routeSelectors,omitempty"`
+
+	// ExcludedNamespaces whose routes are not served by the ingress.
+	// +kubebuilder:validation:Optional
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+}
+
+// IngressObservation are the observable fields of an Ingress.
+type IngressObservation struct {
+	// ID of the ingress.
+	ID string `json:"id,omitempty"`
+
+	// DNSName of the ingress, which routes are exposed under.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// LoadBalancerType of the ingress on AWS.
+	LoadBalancerType string `json:"loadBalancerType,omitempty"`
+}
+
+// An IngressSpec defines the desired state of an Ingress.
+type IngressSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IngressParameters `json:"forProvider"`
+}
+
+// An IngressStatus represents the observed state of an Ingress.
+type IngressStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IngressObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Ingress is the default or an additional ingress controller of an OCM
+// cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DNS-NAME",type="string",JSONPath=".status.atProvider.dnsName"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type Ingress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IngressSpec   `json:"spec"`
+	Status IngressStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IngressList contains a list of Ingress
+type IngressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Ingress `json:"items"`
+}
+
+// Ingress type metadata.
+var (
+	IngressKind             = reflect.TypeOf(Ingress{}).Name()
+	IngressGroupKind        = schema.GroupKind{Group: Group, Kind: IngressKind}.String()
+	IngressKindAPIVersion   = IngressKind + "." + SchemeGroupVersion.String()
+	IngressGroupVersionKind = SchemeGroupVersion.WithKind(IngressKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Ingress{}, &IngressList{})
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TaintEffect is a typed enum for the effect of a taint.
+// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+type TaintEffect string
+
+// MachinePoolParameters are the configurable fields of a MachinePool.
+type MachinePoolParameters struct {
+	// ClusterID is the ID of the cluster the machine pool belongs to.
+	// +kubebuilder:validation:Optional
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// ClusterIDRef references a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIDRef,omitempty"`
+
+	// ClusterIDSelector selects a reference to a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIDSelector,omitempty"`
+
+	// Name of the machine pool. It identifies the machine pool within its
+	// cluster and cannot be changed.
+	// +kubebuilder:validation:Pattern=^[a-z]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+
+	// InstanceType of the nodes, e.g. m5.xlarge. It cannot be changed.
+	InstanceType string `json:"instanceType"`
+
+	// Replicas is the number of nodes of the machine pool. Exactly one of
+	// replicas and autoscaling must be set.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int `json:"replicas,omitempty"`
+
+	// Autoscaling scales the machine pool between a minimum and maximum
+	// number of nodes.
+	// +kubebuilder:validation:Optional
+	Autoscaling *MachinePoolAutoscaling `json:"autoscaling,omitempty"`
+
+	// Labels added to the nodes.
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints added to the nodes.
+	// +kubebuilder:validation:Optional
+	Taints []Taint `json:"taints,omitempty"`
+
+	// AvailabilityZones the nodes are spread across. Defaults to the zones
+	// of the cluster. They cannot be changed.
+	// +kubebuilder:validation:Optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+}
+
+// MachinePoolAutoscaling bounds the number of nodes of an autoscaled machine
+// pool.
+type MachinePoolAutoscaling struct {
+	// MinReplicas is the minimum number of nodes.
+	// +kubebuilder:validation:Minimum=0
+	MinReplicas int `json:"minReplicas"`
+
+	// MaxReplicas is the maximum number of nodes.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int `json:"maxReplicas"`
+}
+
+// A Taint is added to the nodes of a machine pool.
+type Taint struct {
+	// Key of the taint.
+	Key string `json:"key"`
+
+	// Value of the taint.
+	// +kubebuilder:validation:Optional
+	Value string `json:"value,omitempty"`
+
+	// Effect of the taint on pods that do not tolerate it.
+	Effect TaintEffect `json:"effect"`
+}
+
+// MachinePoolObservation are the observable fields of a MachinePool.
+type MachinePoolObservation struct {
+	// ID of the machine pool.
+	ID string `json:"id,omitempty"`
+
+	// Replicas is the number of nodes of a machine pool that is not
+	// autoscaled.
+	Replicas *int `json:"replicas,omitempty"`
+
+	// AvailabilityZones the nodes are spread across.
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+}
+
+// A MachinePoolSpec defines the desired state of a MachinePool.
+type MachinePoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MachinePoolParameters `json:"forProvider"`
+}
+
+// A MachinePoolStatus represents the observed state of a MachinePool.
+type MachinePoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MachinePoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MachinePool is a set of compute nodes of an OCM cluster with the same
+// instance type.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type MachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MachinePoolSpec   `json:"spec"`
+	Status MachinePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MachinePoolList contains a list of MachinePool
+type MachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MachinePool `json:"items"`
+}
+
+// MachinePool type metadata.
+var (
+	MachinePoolKind             = reflect.TypeOf(MachinePool{}).Name()
+	MachinePoolGroupKind        = schema.GroupKind{Group: Group, Kind: MachinePoolKind}.String()
+	MachinePoolKindAPIVersion   = MachinePoolKind + "." + SchemeGroupVersion.String()
+	MachinePoolGroupVersionKind = SchemeGroupVersion.WithKind(MachinePoolKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MachinePool{}, &MachinePoolList{})
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// resolveClusterID resolves the ID of the Cluster referenced or selected by a
+// resource of a cluster.
+func resolveClusterID(ctx context.Context, c client.Reader, mg resource.Managed, id *string, ref **xpv1.Reference, sel *xpv1.Selector) error {
+	rsp, err := reference.NewAPIResolver(c, mg).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: *id,
+		Extract:      reference.ExternalName(),
+		Reference:    *ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &ClusterList{},
+			Managed: &Cluster{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.clusterID")
+	}
+	*id, *ref = rsp.ResolvedValue, rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this MachinePool.
+func (mg *MachinePool) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveClusterID(ctx, c, mg, &p.ClusterID, &p.ClusterIDRef, p.ClusterIDSelector)
+}
+
+// ResolveReferences of this IdentityProvider.
+func (mg *IdentityProvider) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveClusterID(ctx, c, mg, &p.ClusterID, &p.ClusterIDRef, p.ClusterIDSelector)
+}
+
+// ResolveReferences of this ClusterGroupUser.
+func (mg *ClusterGroupUser) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveClusterID(ctx, c, mg, &p.ClusterID, &p.ClusterIDRef, p.ClusterIDSelector)
+}
+
+// ResolveReferences of this AddOnInstallation.
+func (mg *AddOnInstallation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveClusterID(ctx, c, mg, &p.ClusterID, &p.ClusterIDRef, p.ClusterIDSelector)
+}
+
+// ResolveReferences of this UpgradePolicy.
+func (mg *UpgradePolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveClusterID(ctx, c, mg, &p.ClusterID, &p.ClusterIDRef, p.ClusterIDSelector)
+}
+
+// ResolveReferences of this Ingress.
+func (mg *Ingress) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveClusterID(ctx, c, mg, &p.ClusterID, &p.ClusterIDRef, p.ClusterIDSelector)
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ScheduleType is a typed enum for the schedule types of upgrade policies.
+// +kubebuilder:validation:Enum=manual;automatic
+type ScheduleType string
+
+// Schedule types of upgrade policies.
+const (
+	ScheduleTypeManual    ScheduleType = "manual"
+	ScheduleTypeAutomatic ScheduleType = "automatic"
+)
+
+// UpgradePolicyParameters are the configurable fields of an UpgradePolicy.
+type UpgradePolicyParameters struct {
+	// ClusterID is the ID of the cluster that is upgraded.
+	// +kubebuilder:validation:Optional
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// ClusterIDRef references a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIDRef,omitempty"`
+
+	// ClusterIDSelector selects a reference to a Cluster to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIDSelector,omitempty"`
+
+	// ScheduleType defines if the cluster is upgraded once to a version at
+	// the next run, or repeatedly to the latest patch version according to
+	// the schedule. It cannot be changed.
+	ScheduleType ScheduleType `json:"scheduleType"`
+
+	// Version the cluster is upgraded to by a manual upgrade policy, e.g.
+	// 4.12.9. It must be one of the available upgrades of the cluster and
+	// cannot be changed.
+	// +kubebuilder:validation:Optional
+	Version string `json:"version,omitempty"`
+
+	// NextRun is the time a manual upgrade policy upgrades the cluster. It
+	// can be changed until the upgrade started.
+	// +kubebuilder:validation:Optional
+	NextRun *metav1.Time `json:"nextRun,omitempty"`
+
+	// Schedule of an automatic upgrade policy in cron format, e.g.
+	// "0 2 * * 6" to upgrade every Saturday at 02:00 UTC.
+	// +kubebuilder:validation:Optional
+	Schedule string `json:"schedule,omitempty"`
+}
+
+// UpgradePolicyObservation are the observable fields of an UpgradePolicy.
+type UpgradePolicyObservation struct {
+	// ID of the upgrade policy.
+	ID string `json:"id,omitempty"`
+
+	// Version the next scheduled upgrade upgrades the cluster to.
+	Version string `json:"version,omitempty"`
+
+	// NextRun is the time of the next scheduled upgrade.
+	NextRun *metav1.Time `json:"nextRun,omitempty"`
+
+	// State of the next scheduled upgrade.
+	State string `json:"state,omitempty"`
+
+	// StateDescription explains the state of the next scheduled upgrade,
+	// e.g. why it failed.
+	StateDescription string `json:"stateDescription,omitempty"`
+}
+
+// An UpgradePolicySpec defines the desired state of an UpgradePolicy.
+type UpgradePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UpgradePolicyParameters `json:"forProvider"`
+}
+
+// An UpgradePolicyStatus represents the observed state of an UpgradePolicy.
+type UpgradePolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UpgradePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An UpgradePolicy schedules upgrades of an OCM cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="NEXT-RUN",type="string",JSONPath=".status.atProvider.nextRun"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type UpgradePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UpgradePolicySpec   `json:"spec"`
+	Status UpgradePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UpgradePolicyList contains a list of UpgradePolicy
+type UpgradePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UpgradePolicy `json:"items"`
+}
+
+// UpgradePolicy type metadata.
+var (
+	UpgradePolicyKind             = reflect.TypeOf(UpgradePolicy{}).Name()
+	UpgradePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: UpgradePolicyKind}.String()
+	UpgradePolicyKindAPIVersion   = UpgradePolicyKind + "." + SchemeGroupVersion.String()
+	UpgradePolicyGroupVersionKind = SchemeGroupVersion.WithKind(UpgradePolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&UpgradePolicy{}, &UpgradePolicyList{})
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OrganizationParameters are the configurable fields of an Organization.
+type OrganizationParameters struct {
+	// Name of the organization. It cannot be changed once the organization
+	// is created.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+([._-][a-z0-9]+)*$`
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// Email is the contact address of the organization. It must not be the
+	// address of a user of the registry.
+	// +kubebuilder:validation:Optional
+	Email string `json:"email,omitempty"`
+
+	// TagExpirationSeconds is how long tags removed from repositories of the
+	// organization can be restored. Defaults to the registry's default.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	TagExpirationSeconds *int `json:"tagExpirationSeconds,omitempty"`
+}
+
+// An OrganizationSpec defines the desired state of an Organization.
+type OrganizationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationParameters `json:"forProvider"`
+}
+
+// An OrganizationStatus represents the observed state of an Organization.
+type OrganizationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Organization is a Quay organization, which shares its repositories and
+// robot accounts among its members.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type Organization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSpec   `json:"spec"`
+	Status OrganizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationList contains a list of Organization
+type OrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Organization `json:"items"`
+}
+
+// Organization type metadata.
+var (
+	OrganizationKind             = reflect.TypeOf(Organization{}).Name()
+	OrganizationGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationKind}.String()
+	OrganizationKindAPIVersion   = OrganizationKind + "." + SchemeGroupVersion.String()
+	OrganizationGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Organization{}, &OrganizationList{})
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// resolveOrganization resolves the name of the Organization referenced or
+// selected by a resource of an organization.
+func resolveOrganization(ctx context.Context, c client.Reader, mg resource.Managed, name *string, ref **xpv1.Reference, sel *xpv1.Selector) error {
+	rsp, err := reference.NewAPIResolver(c, mg).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: *name,
+		Extract:      reference.ExternalName(),
+		Reference:    *ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.organization")
+	}
+	*name, *ref = rsp.ResolvedValue, rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this Repository.
+func (mg *Repository) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveOrganization(ctx, c, mg, &p.Organization, &p.OrganizationRef, p.OrganizationSelector)
+}
+
+// ResolveReferences of this RobotAccount.
+func (mg *RobotAccount) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveOrganization(ctx, c, mg, &p.Organization, &p.OrganizationRef, p.OrganizationSelector)
+}
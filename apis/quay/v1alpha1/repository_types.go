@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Visibility is a typed enum for who can pull images from a repository.
+// +kubebuilder:validation:Enum=public;private
+type Visibility string
+
+// Visibilities of repositories.
+const (
+	VisibilityPublic  Visibility = "public"
+	VisibilityPrivate Visibility = "private"
+)
+
+// GranteeKind is a typed enum for the kind of grantee of a repository
+// permission.
+// +kubebuilder:validation:Enum=user;team
+type GranteeKind string
+
+// Kinds of grantees. Robot accounts are granted permissions like users.
+const (
+	GranteeKindUser GranteeKind = "user"
+	GranteeKindTeam GranteeKind = "team"
+)
+
+// RepositoryRole is a typed enum for the role of a grantee on a repository.
+// +kubebuilder:validation:Enum=read;write;admin
+type RepositoryRole string
+
+// RepositoryParameters are the configurable fields of a Repository.
+type RepositoryParameters struct {
+	// Organization is the name of the organization the repository belongs
+	// to.
+	// +kubebuilder:validation:Optional
+	Organization string `json:"organization,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +kubebuilder:validation:Optional
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to
+	// retrieve its name.
+	// +kubebuilder:validation:Optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// Name of the repository. It cannot be changed once the repository is
+	// created.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+([._/-][a-z0-9]+)*$`
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// Visibility of the repository. Anyone can pull images from public
+	// repositories.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=private
+	Visibility Visibility `json:"visibility,omitempty"`
+
+	// Description of the repository, in Markdown.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// Permissions of users, robot accounts and teams on the repository.
+	// Permissions that are removed from the list are revoked. Permissions
+	// granted otherwise, e.g. to the creator of the repository, are left
+	// alone.
+	// +kubebuilder:validation:Optional
+	Permissions []RepositoryPermission `json:"permissions,omitempty"`
+}
+
+// A RepositoryPermission grants a role on a repository to a user, robot
+// account or team.
+type RepositoryPermission struct {
+	// Kind of the grantee. Robot accounts are users.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=user
+	Kind GranteeKind `json:"kind,omitempty"`
+
+	// Name of the user, robot account or team, e.g. myorg+deployer for the
+	// robot account deployer of the organization myorg.
+	Name string `json:"name"`
+
+	// Role granted on the repository.
+	Role RepositoryRole `json:"role"`
+}
+
+// RepositoryObservation are the observable fields of a Repository.
+type RepositoryObservation struct {
+	// Visibility of the repository.
+	Visibility Visibility `json:"visibility,omitempty"`
+
+	// Permissions are the observed permissions of the grantees that are, or
+	// were, listed in the permissions of the repository.
+	Permissions []RepositoryPermission `json:"permissions,omitempty"`
+}
+
+// A RepositorySpec defines the desired state of a Repository.
+type RepositorySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryParameters `json:"forProvider"`
+}
+
+// A RepositoryStatus represents the observed state of a Repository.
+type RepositoryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Repository is an image repository of a Quay organization.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VISIBILITY",type="string",JSONPath=".status.atProvider.visibility"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type Repository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositorySpec   `json:"spec"`
+	Status RepositoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryList contains a list of Repository
+type RepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Repository `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	RepositoryKind             = reflect.TypeOf(Repository{}).Name()
+	RepositoryGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryKind}.String()
+	RepositoryKindAPIVersion   = RepositoryKind + "." + SchemeGroupVersion.String()
+	RepositoryGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Repository{}, &RepositoryList{})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection detail keys of a RobotAccount. The docker config can be used as
+// the .dockerconfigjson of an image pull secret.
+const (
+	ConnectionKeyDockerConfigJSON = ".dockerconfigjson"
+	ConnectionKeyUsername         = xpv1.ResourceCredentialsSecretUserKey
+	ConnectionKeyPassword         = xpv1.ResourceCredentialsSecretPasswordKey
+)
+
+// RobotAccountParameters are the configurable fields of a RobotAccount. None
+// of them can be changed once the robot account is created.
+type RobotAccountParameters struct {
+	// Organization is the name of the organization the robot account belongs
+	// to.
+	// +kubebuilder:validation:Optional
+	Organization string `json:"organization,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +kubebuilder:validation:Optional
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to
+	// retrieve its name.
+	// +kubebuilder:validation:Optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// Name of the robot account within its organization.
+	// +kubebuilder:validation:Pattern=`^[a-z][a-z0-9_]{1,254}$`
+	Name string `json:"name"`
+
+	// Description of the robot account.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+}
+
+// RobotAccountObservation are the observable fields of a RobotAccount.
+type RobotAccountObservation struct {
+	// Username the robot account logs in with, e.g. myorg+deployer. It is
+	// also the name permissions are granted to the robot account with.
+	Username string `json:"username,omitempty"`
+}
+
+// A RobotAccountSpec defines the desired state of a RobotAccount.
+type RobotAccountSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RobotAccountParameters `json:"forProvider"`
+}
+
+// A RobotAccountStatus represents the observed state of a RobotAccount.
+type RobotAccountStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RobotAccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RobotAccount is a robot account of a Quay organization, which container
+// clients pull and push images with.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".status.atProvider.username"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type RobotAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RobotAccountSpec   `json:"spec"`
+	Status RobotAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RobotAccountList contains a list of RobotAccount
+type RobotAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RobotAccount `json:"items"`
+}
+
+// RobotAccount type metadata.
+var (
+	RobotAccountKind             = reflect.TypeOf(RobotAccount{}).Name()
+	RobotAccountGroupKind        = schema.GroupKind{Group: Group, Kind: RobotAccountKind}.String()
+	RobotAccountKindAPIVersion   = RobotAccountKind + "." + SchemeGroupVersion.String()
+	RobotAccountGroupVersionKind = SchemeGroupVersion.WithKind(RobotAccountKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RobotAccount{}, &RobotAccountList{})
+}
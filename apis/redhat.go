@@ -20,6 +20,10 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	iamv1alpha1 "github.com/stehessel/provider-redhat/apis/iam/v1alpha1"
+	kafkav1alpha1 "github.com/stehessel/provider-redhat/apis/kafka/v1alpha1"
+	ocmv1alpha1 "github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	quayv1alpha1 "github.com/stehessel/provider-redhat/apis/quay/v1alpha1"
 	rhacsv1alpha1 "github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
 	redhatv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
 )
@@ -29,6 +33,10 @@ func init() {
 	AddToSchemes = append(AddToSchemes,
 		redhatv1alpha1.SchemeBuilder.AddToScheme,
 		rhacsv1alpha1.SchemeBuilder.AddToScheme,
+		ocmv1alpha1.SchemeBuilder.AddToScheme,
+		iamv1alpha1.SchemeBuilder.AddToScheme,
+		kafkav1alpha1.SchemeBuilder.AddToScheme,
+		quayv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LabelSelectorOperator is a typed enum for the operator of a label
+// requirement.
+// +kubebuilder:validation:Enum=IN;NOT_IN;EXISTS;NOT_EXISTS
+type LabelSelectorOperator string
+
+// AccessScopeParameters are the configurable fields of an AccessScope.
+type AccessScopeParameters struct {
+	// Name of the access scope.
+	Name string `json:"name"`
+
+	// Description of the access scope.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// IncludedClusters are the names of the clusters included in the access
+	// scope.
+	// +kubebuilder:validation:Optional
+	IncludedClusters []string `json:"includedClusters,omitempty"`
+
+	// IncludedNamespaces are the namespaces included in the access scope.
+	// +kubebuilder:validation:Optional
+	IncludedNamespaces []IncludedNamespace `json:"includedNamespaces,omitempty"`
+
+	// ClusterLabelSelectors select the clusters included in the access scope.
+	// +kubebuilder:validation:Optional
+	ClusterLabelSelectors []LabelSelector `json:"clusterLabelSelectors,omitempty"`
+
+	// NamespaceLabelSelectors select the namespaces included in the access
+	// scope.
+	// +kubebuilder:validation:Optional
+	NamespaceLabelSelectors []LabelSelector `json:"namespaceLabelSelectors,omitempty"`
+
+	// CentralURL is the UI URL of the Central the access scope belongs to.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// An IncludedNamespace is a namespace of a cluster included in an access
+// scope.
+type IncludedNamespace struct {
+	// ClusterName of the namespace.
+	ClusterName string `json:"clusterName"`
+
+	// NamespaceName of the namespace.
+	NamespaceName string `json:"namespaceName"`
+}
+
+// A LabelSelector selects clusters or namespaces whose labels match all of
+// its requirements.
+type LabelSelector struct {
+	// Requirements of the label selector.
+	// +kubebuilder:validation:MinItems=1
+	Requirements []LabelRequirement `json:"requirements"`
+}
+
+// A LabelRequirement is a requirement of a label selector.
+type LabelRequirement struct {
+	// Key of the label.
+	Key string `json:"key"`
+
+	// Op is the operator applied to the label.
+	Op LabelSelectorOperator `json:"op"`
+
+	// Values of the label.
+	// +kubebuilder:validation:Optional
+	Values []string `json:"values,omitempty"`
+}
+
+// AccessScopeObservation are the observable fields of an AccessScope.
+type AccessScopeObservation struct {
+	// ID represents a unique identifier for the access scope.
+	ID string `json:"id,omitempty"`
+}
+
+// A AccessScopeSpec defines the desired state of a AccessScope.
+type AccessScopeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessScopeParameters `json:"forProvider"`
+}
+
+// A AccessScopeStatus represents the observed state of a AccessScope.
+type AccessScopeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessScopeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessScope represents the clusters and namespaces a role of an ACS Central is restricted to.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type AccessScope struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessScopeSpec   `json:"spec"`
+	Status AccessScopeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessScopeList contains a list of AccessScope
+type AccessScopeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessScope `json:"items"`
+}
+
+// AccessScope type metadata.
+var (
+	AccessScopeKind             = reflect.TypeOf(AccessScope{}).Name()
+	AccessScopeGroupKind        = schema.GroupKind{Group: Group, Kind: AccessScopeKind}.String()
+	AccessScopeKindAPIVersion   = AccessScopeKind + "." + SchemeGroupVersion.String()
+	AccessScopeGroupVersionKind = SchemeGroupVersion.WithKind(AccessScopeKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AccessScope{}, &AccessScopeList{})
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection detail keys of an APIToken, also used for the bootstrap
+// credentials of a CentralInstance.
+const (
+	ConnectionKeyToken    = "token"
+	ConnectionKeyEndpoint = "endpoint"
+)
+
+// APITokenParameters are the configurable fields of an APIToken.
+type APITokenParameters struct {
+	// Name of the API token.
+	Name string `json:"name"`
+
+	// Role granted to the API token, e.g. "Admin" or "Continuous Integration".
+	Role string `json:"role"`
+
+	// ExpiresAfter is how long a generated token is valid. The token does not
+	// expire if unset.
+	// +kubebuilder:validation:Optional
+	ExpiresAfter *metav1.Duration `json:"expiresAfter,omitempty"`
+
+	// RotateBefore is how long before its expiry a token is replaced by a new
+	// one. Tokens are only rotated if they expire.
+	// +kubebuilder:validation:Optional
+	RotateBefore *metav1.Duration `json:"rotateBefore,omitempty"`
+
+	// CentralURL is the UI URL of the Central the token is generated by.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// APITokenObservation are the observable fields of an APIToken.
+type APITokenObservation struct {
+	// ID represents a unique identifier for the API token.
+	ID string `json:"id,omitempty"`
+
+	// Name of the API token.
+	Name string `json:"name,omitempty"`
+
+	// Roles granted to the API token.
+	Roles []string `json:"roles,omitempty"`
+
+	// IssuedAt defines the timestamp at which the API token was issued.
+	IssuedAt *metav1.Time `json:"issuedAt,omitempty"`
+
+	// Expiration defines the timestamp at which the API token expires.
+	Expiration *metav1.Time `json:"expiration,omitempty"`
+}
+
+// An APITokenSpec defines the desired state of an APIToken.
+type APITokenSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       APITokenParameters `json:"forProvider"`
+}
+
+// An APITokenStatus represents the observed state of an APIToken.
+type APITokenStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          APITokenObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An APIToken represents an API token of an ACS Central.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="EXPIRATION",type="date",JSONPath=".status.atProvider.expiration"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type APIToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   APITokenSpec   `json:"spec"`
+	Status APITokenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// APITokenList contains a list of APIToken
+type APITokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIToken `json:"items"`
+}
+
+// APIToken type metadata.
+var (
+	APITokenKind             = reflect.TypeOf(APIToken{}).Name()
+	APITokenGroupKind        = schema.GroupKind{Group: Group, Kind: APITokenKind}.String()
+	APITokenKindAPIVersion   = APITokenKind + "." + SchemeGroupVersion.String()
+	APITokenGroupVersionKind = SchemeGroupVersion.WithKind(APITokenKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&APIToken{}, &APITokenList{})
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+)
+
+// OIDCMode is a typed enum for the OIDC callback mode.
+// +kubebuilder:validation:Enum=auto;post;query;fragment
+type OIDCMode string
+
+// AuthProviderParameters are the configurable fields of an AuthProvider.
+// Exactly one of OIDC and SAML must be set.
+type AuthProviderParameters struct {
+	// Name of the auth provider.
+	Name string `json:"name"`
+
+	// UIEndpoint is the host and port users log in to Central at. Defaults
+	// to the host of CentralURL.
+	// +kubebuilder:validation:Optional
+	UIEndpoint string `json:"uiEndpoint,omitempty"`
+
+	// Enabled auth providers can be used to log in.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled"`
+
+	// OIDC configures an OpenID Connect identity provider.
+	// +kubebuilder:validation:Optional
+	OIDC *OIDCConfig `json:"oidc,omitempty"`
+
+	// SAML configures a SAML 2.0 identity provider.
+	// +kubebuilder:validation:Optional
+	SAML *SAMLConfig `json:"saml,omitempty"`
+
+	// RequiredAttributes users must have to log in.
+	// +kubebuilder:validation:Optional
+	RequiredAttributes []RequiredAttribute `json:"requiredAttributes,omitempty"`
+
+	// ClaimMappings map claims of the identity provider to user attributes.
+	// +kubebuilder:validation:Optional
+	ClaimMappings map[string]string `json:"claimMappings,omitempty"`
+
+	// CentralURL is the UI URL of the Central the auth provider belongs to.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// An OIDCConfig configures an OpenID Connect identity provider.
+type OIDCConfig struct {
+	// Issuer of the identity provider.
+	Issuer string `json:"issuer"`
+
+	// ClientID of Central at the identity provider.
+	ClientID string `json:"clientID"`
+
+	// ClientSecretSecretRef references the client secret of Central at the
+	// identity provider. Public clients have no client secret.
+	// +kubebuilder:validation:Optional
+	ClientSecretSecretRef *xpv1.SecretKeySelector `json:"clientSecretSecretRef,omitempty"`
+
+	// Mode of the callback from the identity provider.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=auto
+	Mode OIDCMode `json:"mode,omitempty"`
+}
+
+// A SAMLConfig configures a SAML 2.0 identity provider. Either IDPMetadataURL
+// or IDPIssuer, IDPSSOURL and IDPCertPEM must be set.
+type SAMLConfig struct {
+	// SPIssuer is the issuer of Central as service provider.
+	SPIssuer string `json:"spIssuer"`
+
+	// IDPMetadataURL is the metadata URL of the identity provider.
+	// +kubebuilder:validation:Optional
+	IDPMetadataURL string `json:"idpMetadataURL,omitempty"`
+
+	// IDPIssuer is the issuer of the identity provider.
+	// +kubebuilder:validation:Optional
+	IDPIssuer string `json:"idpIssuer,omitempty"`
+
+	// IDPSSOURL is the single sign-on URL of the identity provider.
+	// +kubebuilder:validation:Optional
+	IDPSSOURL string `json:"idpSSOURL,omitempty"`
+
+	// IDPCertPEM is the PEM encoded certificate of the identity provider.
+	// +kubebuilder:validation:Optional
+	IDPCertPEM string `json:"idpCertPEM,omitempty"`
+
+	// IDPNameIDFormat is the name ID format requested from the identity
+	// provider.
+	// +kubebuilder:validation:Optional
+	IDPNameIDFormat string `json:"idpNameIDFormat,omitempty"`
+}
+
+// A RequiredAttribute is an attribute a user must have to log in.
+type RequiredAttribute struct {
+	// AttributeKey of the attribute.
+	AttributeKey string `json:"attributeKey"`
+
+	// AttributeValue the attribute must have.
+	AttributeValue string `json:"attributeValue"`
+}
+
+// AuthProviderObservation are the observable fields of an AuthProvider.
+type AuthProviderObservation struct {
+	// ID represents a unique identifier for the auth provider.
+	ID string `json:"id,omitempty"`
+
+	// Type of the auth provider.
+	Type string `json:"type,omitempty"`
+
+	// LoginURL of the auth provider.
+	LoginURL string `json:"loginURL,omitempty"`
+
+	// Validated indicates that a user has logged in with the auth provider.
+	Validated bool `json:"validated,omitempty"`
+
+	// Active indicates that the auth provider has been used.
+	Active bool `json:"active,omitempty"`
+
+	apisv1alpha1.SecretObservation `json:",inline"`
+}
+
+// A AuthProviderSpec defines the desired state of a AuthProvider.
+type AuthProviderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AuthProviderParameters `json:"forProvider"`
+}
+
+// A AuthProviderStatus represents the observed state of a AuthProvider.
+type AuthProviderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AuthProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AuthProvider represents an identity provider users log in to an ACS Central with.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type AuthProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AuthProviderSpec   `json:"spec"`
+	Status AuthProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuthProviderList contains a list of AuthProvider
+type AuthProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AuthProvider `json:"items"`
+}
+
+// AuthProvider type metadata.
+var (
+	AuthProviderKind             = reflect.TypeOf(AuthProvider{}).Name()
+	AuthProviderGroupKind        = schema.GroupKind{Group: Group, Kind: AuthProviderKind}.String()
+	AuthProviderKindAPIVersion   = AuthProviderKind + "." + SchemeGroupVersion.String()
+	AuthProviderGroupVersionKind = SchemeGroupVersion.WithKind(AuthProviderKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AuthProvider{}, &AuthProviderList{})
+}
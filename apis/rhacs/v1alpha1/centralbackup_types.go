@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+)
+
+// CentralBackupParameters are the configurable fields of a CentralBackup.
+type CentralBackupParameters struct {
+	// Name of the external backup integration.
+	Name string `json:"name"`
+
+	// Schedule further backups are written at, after the first one written
+	// once the integration is created.
+	Schedule Schedule `json:"schedule"`
+
+	// BackupsToKeep in the object store. Older backups are removed by
+	// Central.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	BackupsToKeep int32 `json:"backupsToKeep,omitempty"`
+
+	// S3 is the S3 compatible object store backups are written to.
+	S3 S3BackupStore `json:"s3"`
+
+	// CentralURL is the UI URL of the Central to back up.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// An S3BackupStore is a bucket of an S3 compatible object store, such as AWS
+// S3 or MinIO. Both Central and the provider access it with the referenced
+// credentials.
+type S3BackupStore struct {
+	// Bucket backups are written to.
+	Bucket string `json:"bucket"`
+
+	// Region of the bucket.
+	// +kubebuilder:validation:Optional
+	Region string `json:"region,omitempty"`
+
+	// Endpoint of the object store, e.g. https://minio.example.com:9000.
+	// Defaults to AWS S3.
+	// +kubebuilder:validation:Optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// ObjectPrefix backups are written below.
+	// +kubebuilder:validation:Optional
+	ObjectPrefix string `json:"objectPrefix,omitempty"`
+
+	// AccessKeyIDSecretRef references the access key ID of the object
+	// store.
+	AccessKeyIDSecretRef xpv1.SecretKeySelector `json:"accessKeyIDSecretRef"`
+
+	// SecretAccessKeySecretRef references the secret access key of the
+	// object store.
+	SecretAccessKeySecretRef xpv1.SecretKeySelector `json:"secretAccessKeySecretRef"`
+}
+
+// States of the backup the provider last had Central write.
+const (
+	// TriggerStateRunning means Central is writing the backup.
+	TriggerStateRunning = "Running"
+
+	// TriggerStateSucceeded means Central wrote the backup.
+	TriggerStateSucceeded = "Succeeded"
+
+	// TriggerStateFailed means Central did not write the backup, or the
+	// provider restarted while it did.
+	TriggerStateFailed = "Failed"
+)
+
+// CentralBackupObservation are the observable fields of a CentralBackup.
+type CentralBackupObservation struct {
+	// ID represents a unique identifier for the external backup integration.
+	ID string `json:"id,omitempty"`
+
+	apisv1alpha1.SecretObservation `json:",inline"`
+
+	// LastTriggerTime is the time the provider last had Central write a
+	// backup.
+	LastTriggerTime *metav1.Time `json:"lastTriggerTime,omitempty"`
+
+	// TriggerState is the state of the backup the provider last had Central
+	// write.
+	// +kubebuilder:validation:Enum=Running;Succeeded;Failed
+	TriggerState string `json:"triggerState,omitempty"`
+
+	// TriggerMessage explains why Central did not write the backup.
+	TriggerMessage string `json:"triggerMessage,omitempty"`
+
+	// ObjectKey of the latest backup in the object store.
+	ObjectKey string `json:"objectKey,omitempty"`
+
+	// Size of the latest backup in bytes.
+	Size int64 `json:"size,omitempty"`
+
+	// LastBackupTime is the time the latest backup was written.
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+}
+
+// A CentralBackupSpec defines the desired state of a CentralBackup.
+type CentralBackupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CentralBackupParameters `json:"forProvider"`
+}
+
+// A CentralBackupStatus represents the observed state of a CentralBackup.
+type CentralBackupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CentralBackupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CentralBackup backs up the database of an ACS Central to an object store.
+// It is ready once a backup has been written, and tracks the latest backup,
+// which CentralInstances can restore from.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="LAST-BACKUP",type="date",JSONPath=".status.atProvider.lastBackupTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type CentralBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CentralBackupSpec   `json:"spec"`
+	Status CentralBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CentralBackupList contains a list of CentralBackup
+type CentralBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CentralBackup `json:"items"`
+}
+
+// CentralBackup type metadata.
+var (
+	CentralBackupKind             = reflect.TypeOf(CentralBackup{}).Name()
+	CentralBackupGroupKind        = schema.GroupKind{Group: Group, Kind: CentralBackupKind}.String()
+	CentralBackupKindAPIVersion   = CentralBackupKind + "." + SchemeGroupVersion.String()
+	CentralBackupGroupVersionKind = SchemeGroupVersion.WithKind(CentralBackupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CentralBackup{}, &CentralBackupList{})
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CentralConfigurationParameters are the configurable fields of a
+// CentralConfiguration.
+type CentralConfigurationParameters struct {
+	// ConfigMapRefs reference config maps of declarative configuration in
+	// the format of ACS. Each key of a config map holds one or more YAML
+	// documents, each of which is an auth provider, role, permission set,
+	// access scope or notifier.
+	// +kubebuilder:validation:MinItems=1
+	ConfigMapRefs []ConfigMapReference `json:"configMapRefs"`
+
+	// CentralURL is the UI URL of the Central to configure.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// A ConfigMapReference is a reference to a config map in an arbitrary
+// namespace.
+type ConfigMapReference struct {
+	// Name of the config map.
+	Name string `json:"name"`
+
+	// Namespace of the config map.
+	Namespace string `json:"namespace"`
+}
+
+// A DeclarativeConfigItem is an item of declarative configuration pushed to
+// Central.
+type DeclarativeConfigItem struct {
+	// Type of the item, e.g. AUTH_PROVIDER or ROLE.
+	Type string `json:"type"`
+
+	// Name of the item.
+	Name string `json:"name"`
+
+	// ID Central assigned to the item. Roles are identified by their name.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Source of the item as namespace/name[key] of its config map.
+	Source string `json:"source"`
+
+	// Health of the item, either HEALTHY or UNHEALTHY. It is reported by
+	// Central where Central tracks the item, and reflects whether the item
+	// was pushed otherwise.
+	Health string `json:"health"`
+
+	// Message explains why the item is unhealthy.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CentralConfigurationObservation are the observable fields of a
+// CentralConfiguration.
+type CentralConfigurationObservation struct {
+	// ConfigHash is a hash of the declarative configuration last pushed to
+	// Central.
+	ConfigHash string `json:"configHash,omitempty"`
+
+	// Items of declarative configuration pushed to Central.
+	Items []DeclarativeConfigItem `json:"items,omitempty"`
+}
+
+// A CentralConfigurationSpec defines the desired state of a
+// CentralConfiguration.
+type CentralConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CentralConfigurationParameters `json:"forProvider"`
+}
+
+// A CentralConfigurationStatus represents the observed state of a
+// CentralConfiguration.
+type CentralConfigurationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CentralConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CentralConfiguration pushes a bundle of ACS declarative configuration to
+// a Central. It is ready once the bundle was pushed and all of its items are
+// healthy. Items removed from the bundle are removed from Central.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type CentralConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CentralConfigurationSpec   `json:"spec"`
+	Status CentralConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CentralConfigurationList contains a list of CentralConfiguration
+type CentralConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CentralConfiguration `json:"items"`
+}
+
+// CentralConfiguration type metadata.
+var (
+	CentralConfigurationKind             = reflect.TypeOf(CentralConfiguration{}).Name()
+	CentralConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: CentralConfigurationKind}.String()
+	CentralConfigurationKindAPIVersion   = CentralConfigurationKind + "." + SchemeGroupVersion.String()
+	CentralConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(CentralConfigurationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CentralConfiguration{}, &CentralConfigurationList{})
+}
@@ -25,6 +25,44 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// Annotations that override the poll intervals of an individual
+// CentralInstance. Their values are parsed as Go durations, e.g. "30s".
+const (
+	// AnnotationPollIntervalTransitional overrides how often a CentralInstance
+	// is polled while its central is accepted, preparing, provisioning or
+	// being deleted.
+	AnnotationPollIntervalTransitional = Group + "/poll-interval-transitional"
+
+	// AnnotationPollIntervalReady overrides how often a CentralInstance is
+	// polled once its central is ready.
+	AnnotationPollIntervalReady = Group + "/poll-interval-ready"
+)
+
+// Annotations that record the state of a CentralInstance's central which the
+// fleet-manager API does not return.
+const (
+	// AnnotationBootstrapToken records the ID of the admin API token issued
+	// once a central is ready. The token is published as connection detail,
+	// together with the UI URL of the central.
+	AnnotationBootstrapToken = Group + "/bootstrap-token"
+
+	// AnnotationRestoredBackup records the object key of the backup restored
+	// from the CentralBackup referenced by RestoreFrom.
+	AnnotationRestoredBackup = Group + "/restored-backup"
+)
+
+// Labels used to adopt centrals that are not managed by any CentralInstance.
+const (
+	// LabelAdoptUnmanagedCentrals opts a ProviderConfig into adoption when
+	// set to "true": a CentralInstance is created for every central of its
+	// organisation that is not yet managed by one.
+	LabelAdoptUnmanagedCentrals = Group + "/adopt-unmanaged-centrals"
+
+	// LabelAdopted marks CentralInstances that were created to adopt an
+	// existing central.
+	LabelAdopted = Group + "/adopted"
+)
+
 // CloudProvider is a typed enum for the cloud provider.
 // +kubebuilder:validation:Enum=aws
 type CloudProvider string
@@ -33,6 +71,12 @@ type CloudProvider string
 // +kubebuilder:validation:Enum=us-east-1
 type Region string
 
+// The cloud providers and regions centrals can be deployed to.
+const (
+	CloudProviderAWS CloudProvider = "aws"
+	RegionUSEast1    Region        = "us-east-1"
+)
+
 // CentralInstanceParameters are the configurable fields of a CentralInstance.
 type CentralInstanceParameters struct {
 	// CloudAccount to which Central is deployed.
@@ -52,6 +96,12 @@ type CentralInstanceParameters struct {
 
 	// Region defines the geographical region which hosts Central.
 	Region Region `json:"region"`
+
+	// RestoreFrom references a CentralBackup whose latest backup is restored
+	// once Central is ready, e.g. to migrate a Central to another region.
+	// The backup is restored only once.
+	// +kubebuilder:validation:Optional
+	RestoreFrom *xpv1.Reference `json:"restoreFrom,omitempty"`
 }
 
 // CentralInstanceObservation are the observable fields of a CentralInstance.
@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SelectorFieldName is a typed enum for the deployment fields selector rules
+// match.
+// +kubebuilder:validation:Enum=Cluster;Cluster Label;Namespace;Namespace Label;Deployment;Deployment Label
+type SelectorFieldName string
+
+// SelectorOperator is a typed enum for how the values of a selector rule are
+// combined.
+// +kubebuilder:validation:Enum=OR;AND
+type SelectorOperator string
+
+// MatchType is a typed enum for how a value of a selector rule is matched.
+// +kubebuilder:validation:Enum=EXACT;REGEX
+type MatchType string
+
+// CollectionParameters are the configurable fields of a Collection.
+type CollectionParameters struct {
+	// Name of the collection.
+	Name string `json:"name"`
+
+	// Description of the collection.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// ResourceSelectors select the deployments of the collection.
+	// +kubebuilder:validation:Optional
+	ResourceSelectors []ResourceSelector `json:"resourceSelectors,omitempty"`
+
+	// EmbeddedCollectionIDs are the IDs of collections whose deployments are
+	// part of the collection.
+	// +kubebuilder:validation:Optional
+	EmbeddedCollectionIDs []string `json:"embeddedCollectionIDs,omitempty"`
+
+	// EmbeddedCollectionIDRefs reference Collections to retrieve their IDs.
+	// +kubebuilder:validation:Optional
+	EmbeddedCollectionIDRefs []xpv1.Reference `json:"embeddedCollectionIDRefs,omitempty"`
+
+	// EmbeddedCollectionIDSelector selects references to Collections to
+	// retrieve their IDs.
+	// +kubebuilder:validation:Optional
+	EmbeddedCollectionIDSelector *xpv1.Selector `json:"embeddedCollectionIDSelector,omitempty"`
+
+	// CentralURL is the UI URL of the Central the collection belongs to.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// A ResourceSelector selects the deployments matching all of its rules.
+type ResourceSelector struct {
+	// Rules deployments must match.
+	Rules []SelectorRule `json:"rules"`
+}
+
+// A SelectorRule matches a field of deployments against values.
+type SelectorRule struct {
+	// FieldName of the field to match.
+	FieldName SelectorFieldName `json:"fieldName"`
+
+	// Operator combining the matches of the values.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=OR
+	Operator SelectorOperator `json:"operator,omitempty"`
+
+	// Values to match. Label values are given as key=value.
+	Values []RuleValue `json:"values"`
+}
+
+// A RuleValue is a value a SelectorRule matches.
+type RuleValue struct {
+	// Value to match.
+	Value string `json:"value"`
+
+	// MatchType of the value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=EXACT
+	MatchType MatchType `json:"matchType,omitempty"`
+}
+
+// CollectionObservation are the observable fields of a Collection.
+type CollectionObservation struct {
+	// ID represents a unique identifier for the collection.
+	ID string `json:"id,omitempty"`
+}
+
+// A CollectionSpec defines the desired state of a Collection.
+type CollectionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CollectionParameters `json:"forProvider"`
+}
+
+// A CollectionStatus represents the observed state of a Collection.
+type CollectionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CollectionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Collection represents a collection of deployments of an ACS Central.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type Collection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CollectionSpec   `json:"spec"`
+	Status CollectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CollectionList contains a list of Collection
+type CollectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Collection `json:"items"`
+}
+
+// Collection type metadata.
+var (
+	CollectionKind             = reflect.TypeOf(Collection{}).Name()
+	CollectionGroupKind        = schema.GroupKind{Group: Group, Kind: CollectionKind}.String()
+	CollectionKindAPIVersion   = CollectionKind + "." + SchemeGroupVersion.String()
+	CollectionGroupVersionKind = SchemeGroupVersion.WithKind(CollectionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Collection{}, &CollectionList{})
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+)
+
+// ImageIntegrationCategory is a typed enum for the purpose of an image
+// integration.
+// +kubebuilder:validation:Enum=REGISTRY;SCANNER
+type ImageIntegrationCategory string
+
+// ImageIntegrationParameters are the configurable fields of an
+// ImageIntegration. Exactly one of Docker, Quay, ECR and Google must be set.
+type ImageIntegrationParameters struct {
+	// Name of the image integration.
+	Name string `json:"name"`
+
+	// Categories of the image integration. Only Quay integrations can be
+	// scanners.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={REGISTRY}
+	Categories []ImageIntegrationCategory `json:"categories,omitempty"`
+
+	// SkipTestIntegration creates or updates the integration without testing
+	// it first.
+	// +kubebuilder:validation:Optional
+	SkipTestIntegration bool `json:"skipTestIntegration,omitempty"`
+
+	// Docker integrates a generic Docker registry.
+	// +kubebuilder:validation:Optional
+	Docker *DockerImageIntegration `json:"docker,omitempty"`
+
+	// Quay integrates a Quay registry or scanner.
+	// +kubebuilder:validation:Optional
+	Quay *QuayImageIntegration `json:"quay,omitempty"`
+
+	// ECR integrates an Amazon Elastic Container Registry.
+	// +kubebuilder:validation:Optional
+	ECR *ECRImageIntegration `json:"ecr,omitempty"`
+
+	// Google integrates a Google Container or Artifact Registry.
+	// +kubebuilder:validation:Optional
+	Google *GoogleImageIntegration `json:"google,omitempty"`
+
+	// CentralURL is the UI URL of the Central the image integration belongs
+	// to.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// A DockerImageIntegration integrates a generic Docker registry.
+type DockerImageIntegration struct {
+	// Endpoint of the registry.
+	Endpoint string `json:"endpoint"`
+
+	// Username to authenticate with.
+	// +kubebuilder:validation:Optional
+	Username string `json:"username,omitempty"`
+
+	// PasswordSecretRef references the password to authenticate with.
+	// +kubebuilder:validation:Optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// Insecure skips the verification of the TLS certificate of the
+	// registry.
+	// +kubebuilder:validation:Optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// A QuayImageIntegration integrates a Quay registry or scanner.
+type QuayImageIntegration struct {
+	// Endpoint of Quay.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=quay.io
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// OAuthTokenSecretRef references an OAuth token to authenticate with.
+	// It is required for scanner integrations.
+	// +kubebuilder:validation:Optional
+	OAuthTokenSecretRef *xpv1.SecretKeySelector `json:"oauthTokenSecretRef,omitempty"`
+
+	// RobotAccount authenticates with a robot account to pull images.
+	// +kubebuilder:validation:Optional
+	RobotAccount *QuayRobotAccount `json:"robotAccount,omitempty"`
+
+	// Insecure skips the verification of the TLS certificate of Quay.
+	// +kubebuilder:validation:Optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// A QuayRobotAccount authenticates with a Quay robot account.
+type QuayRobotAccount struct {
+	// Username of the robot account.
+	Username string `json:"username"`
+
+	// PasswordSecretRef references the token of the robot account.
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// An ECRImageIntegration integrates an Amazon Elastic Container Registry.
+type ECRImageIntegration struct {
+	// RegistryID is the ID of the AWS account of the registry.
+	RegistryID string `json:"registryID"`
+
+	// Region of the registry.
+	Region string `json:"region"`
+
+	// Endpoint of the registry. Defaults to the endpoint of the region.
+	// +kubebuilder:validation:Optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// UseIAM authenticates with the IAM role of Central instead of access
+	// keys.
+	// +kubebuilder:validation:Optional
+	UseIAM bool `json:"useIAM,omitempty"`
+
+	// AccessKeyIDSecretRef references the ID of the access key to
+	// authenticate with.
+	// +kubebuilder:validation:Optional
+	AccessKeyIDSecretRef *xpv1.SecretKeySelector `json:"accessKeyIDSecretRef,omitempty"`
+
+	// SecretAccessKeySecretRef references the secret access key to
+	// authenticate with.
+	// +kubebuilder:validation:Optional
+	SecretAccessKeySecretRef *xpv1.SecretKeySelector `json:"secretAccessKeySecretRef,omitempty"`
+
+	// AssumeRole assumes an IAM role to access the registry.
+	// +kubebuilder:validation:Optional
+	AssumeRole *ECRAssumeRole `json:"assumeRole,omitempty"`
+}
+
+// An ECRAssumeRole is an IAM role assumed to access a registry.
+type ECRAssumeRole struct {
+	// RoleID is the ARN of the role.
+	RoleID string `json:"roleID"`
+
+	// ExternalID required to assume the role.
+	// +kubebuilder:validation:Optional
+	ExternalID string `json:"externalID,omitempty"`
+}
+
+// A GoogleImageIntegration integrates a Google Container or Artifact
+// Registry.
+type GoogleImageIntegration struct {
+	// Endpoint of the registry.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=gcr.io
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Project of the registry.
+	Project string `json:"project"`
+
+	// ServiceAccountSecretRef references the JSON key of the service account
+	// to authenticate with.
+	ServiceAccountSecretRef xpv1.SecretKeySelector `json:"serviceAccountSecretRef"`
+}
+
+// An ImageIntegrationTest is the result of testing an image integration.
+type ImageIntegrationTest struct {
+	// Succeeded is true if Central could connect to the registry or
+	// scanner.
+	Succeeded bool `json:"succeeded"`
+
+	// Message describes why the test failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTestTime is the time of the test.
+	LastTestTime metav1.Time `json:"lastTestTime"`
+
+	// ObservedGeneration is the generation of the ImageIntegration that was
+	// tested.
+	ObservedGeneration int64 `json:"observedGeneration"`
+}
+
+// ImageIntegrationObservation are the observable fields of an
+// ImageIntegration.
+type ImageIntegrationObservation struct {
+	// ID represents a unique identifier for the image integration.
+	ID string `json:"id,omitempty"`
+
+	// Type of the image integration.
+	Type string `json:"type,omitempty"`
+
+	apisv1alpha1.SecretObservation `json:",inline"`
+
+	// Test is the result of the last test of the image integration through
+	// Central.
+	Test *ImageIntegrationTest `json:"test,omitempty"`
+}
+
+// An ImageIntegrationSpec defines the desired state of an ImageIntegration.
+type ImageIntegrationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ImageIntegrationParameters `json:"forProvider"`
+}
+
+// An ImageIntegrationStatus represents the observed state of an
+// ImageIntegration.
+type ImageIntegrationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ImageIntegrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ImageIntegration represents an image registry or scanner integration of
+// an ACS Central.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="TESTED",type="string",JSONPath=".status.atProvider.test.succeeded"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type ImageIntegration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageIntegrationSpec   `json:"spec"`
+	Status ImageIntegrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageIntegrationList contains a list of ImageIntegration
+type ImageIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageIntegration `json:"items"`
+}
+
+// ImageIntegration type metadata.
+var (
+	ImageIntegrationKind             = reflect.TypeOf(ImageIntegration{}).Name()
+	ImageIntegrationGroupKind        = schema.GroupKind{Group: Group, Kind: ImageIntegrationKind}.String()
+	ImageIntegrationKindAPIVersion   = ImageIntegrationKind + "." + SchemeGroupVersion.String()
+	ImageIntegrationGroupVersionKind = SchemeGroupVersion.WithKind(ImageIntegrationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ImageIntegration{}, &ImageIntegrationList{})
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection detail keys of an InitBundle.
+const (
+	ConnectionKeyHelmValuesBundle     = "helm-values.yaml"
+	ConnectionKeyKubectlBundle        = "kubectl-bundle.yaml"
+	ConnectionKeyCACert               = "ca.pem"
+	ConnectionKeySensorCert           = "sensor-cert.pem"
+	ConnectionKeySensorKey            = "sensor-key.pem"
+	ConnectionKeyCollectorCert        = "collector-cert.pem"
+	ConnectionKeyCollectorKey         = "collector-key.pem"
+	ConnectionKeyAdmissionControlCert = "admission-control-cert.pem"
+	ConnectionKeyAdmissionControlKey  = "admission-control-key.pem"
+)
+
+// InitBundleParameters are the configurable fields of an InitBundle.
+type InitBundleParameters struct {
+	// Name of the init bundle.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9._-]+$`
+	Name string `json:"name"`
+
+	// CentralURL is the UI URL of the Central the init bundle is generated by.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// InitBundleObservation are the observable fields of an InitBundle.
+type InitBundleObservation struct {
+	// ID represents a unique identifier for the init bundle.
+	ID string `json:"id,omitempty"`
+
+	// Name of the init bundle.
+	Name string `json:"name,omitempty"`
+
+	// CreatedAt defines the timestamp at which the init bundle was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// ExpiresAt defines the timestamp at which the init bundle's
+	// certificates expire.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// ImpactedClusters are the secured clusters set up with the init bundle.
+	ImpactedClusters []ImpactedCluster `json:"impactedClusters,omitempty"`
+}
+
+// An ImpactedCluster is a secured cluster set up with an init bundle.
+type ImpactedCluster struct {
+	// ID of the secured cluster.
+	ID string `json:"id"`
+
+	// Name of the secured cluster.
+	Name string `json:"name"`
+}
+
+// An InitBundleSpec defines the desired state of an InitBundle.
+type InitBundleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InitBundleParameters `json:"forProvider"`
+}
+
+// An InitBundleStatus represents the observed state of an InitBundle.
+type InitBundleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InitBundleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InitBundle represents a cluster init bundle of an ACS Central, which
+// secured clusters use to connect to it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type InitBundle struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InitBundleSpec   `json:"spec"`
+	Status InitBundleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InitBundleList contains a list of InitBundle
+type InitBundleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InitBundle `json:"items"`
+}
+
+// InitBundle type metadata.
+var (
+	InitBundleKind             = reflect.TypeOf(InitBundle{}).Name()
+	InitBundleGroupKind        = schema.GroupKind{Group: Group, Kind: InitBundleKind}.String()
+	InitBundleKindAPIVersion   = InitBundleKind + "." + SchemeGroupVersion.String()
+	InitBundleGroupVersionKind = SchemeGroupVersion.WithKind(InitBundleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&InitBundle{}, &InitBundleList{})
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+)
+
+// NotifierParameters are the configurable fields of a Notifier. Exactly one
+// of Slack, Webhook, Email and Splunk must be set.
+type NotifierParameters struct {
+	// Name of the notifier.
+	Name string `json:"name"`
+
+	// UIEndpoint is the Central URL linked to from notifications. Defaults
+	// to CentralURL.
+	// +kubebuilder:validation:Optional
+	UIEndpoint string `json:"uiEndpoint,omitempty"`
+
+	// LabelKey is the deployment or namespace annotation whose value
+	// overrides the default recipient of a notification.
+	// +kubebuilder:validation:Optional
+	LabelKey string `json:"labelKey,omitempty"`
+
+	// Slack sends notifications to a Slack channel.
+	// +kubebuilder:validation:Optional
+	Slack *SlackNotifier `json:"slack,omitempty"`
+
+	// Webhook sends notifications to a generic webhook.
+	// +kubebuilder:validation:Optional
+	Webhook *WebhookNotifier `json:"webhook,omitempty"`
+
+	// Email sends notifications by email.
+	// +kubebuilder:validation:Optional
+	Email *EmailNotifier `json:"email,omitempty"`
+
+	// Splunk sends notifications to the Splunk HTTP event collector.
+	// +kubebuilder:validation:Optional
+	Splunk *SplunkNotifier `json:"splunk,omitempty"`
+
+	// CentralURL is the UI URL of the Central sending the notifications.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// A SlackNotifier sends notifications to a Slack channel.
+type SlackNotifier struct {
+	// WebhookURLSecretRef references the incoming webhook URL of the Slack
+	// channel.
+	WebhookURLSecretRef xpv1.SecretKeySelector `json:"webhookURLSecretRef"`
+}
+
+// A WebhookNotifier sends notifications to a generic webhook.
+type WebhookNotifier struct {
+	// Endpoint of the webhook.
+	Endpoint string `json:"endpoint"`
+
+	// SkipTLSVerify disables verification of the webhook's certificate.
+	// +kubebuilder:validation:Optional
+	SkipTLSVerify bool `json:"skipTLSVerify,omitempty"`
+
+	// CACert is the PEM encoded CA certificate of the webhook.
+	// +kubebuilder:validation:Optional
+	CACert string `json:"caCert,omitempty"`
+
+	// Username for basic authentication with the webhook.
+	// +kubebuilder:validation:Optional
+	Username string `json:"username,omitempty"`
+
+	// PasswordSecretRef references the password for basic authentication
+	// with the webhook.
+	// +kubebuilder:validation:Optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// Headers added to requests to the webhook.
+	// +kubebuilder:validation:Optional
+	Headers []KeyValue `json:"headers,omitempty"`
+
+	// ExtraFields added to the payload of notifications.
+	// +kubebuilder:validation:Optional
+	ExtraFields []KeyValue `json:"extraFields,omitempty"`
+
+	// AuditLoggingEnabled sends audit logs to the webhook.
+	// +kubebuilder:validation:Optional
+	AuditLoggingEnabled bool `json:"auditLoggingEnabled,omitempty"`
+}
+
+// A KeyValue is a header or field added to notifications.
+type KeyValue struct {
+	// Key of the header or field.
+	Key string `json:"key"`
+
+	// Value of the header or field.
+	Value string `json:"value"`
+}
+
+// StartTLSAuthMethod is a typed enum for the authentication method used with
+// StartTLS.
+// +kubebuilder:validation:Enum=DISABLED;PLAIN;LOGIN
+type StartTLSAuthMethod string
+
+// An EmailNotifier sends notifications by email.
+type EmailNotifier struct {
+	// Server is the address of the SMTP server, including its port.
+	Server string `json:"server"`
+
+	// Sender is the email address notifications are sent from.
+	Sender string `json:"sender"`
+
+	// From is the name notifications are sent from.
+	// +kubebuilder:validation:Optional
+	From string `json:"from,omitempty"`
+
+	// Username for authentication with the SMTP server.
+	// +kubebuilder:validation:Optional
+	Username string `json:"username,omitempty"`
+
+	// PasswordSecretRef references the password for authentication with the
+	// SMTP server.
+	// +kubebuilder:validation:Optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// DisableTLS disables TLS with the SMTP server.
+	// +kubebuilder:validation:Optional
+	DisableTLS bool `json:"disableTLS,omitempty"`
+
+	// StartTLSAuthMethod is the authentication method used with StartTLS.
+	// +kubebuilder:validation:Optional
+	StartTLSAuthMethod StartTLSAuthMethod `json:"startTLSAuthMethod,omitempty"`
+
+	// AllowUnauthenticatedSMTP sends notifications without authentication.
+	// +kubebuilder:validation:Optional
+	AllowUnauthenticatedSMTP bool `json:"allowUnauthenticatedSMTP,omitempty"`
+}
+
+// A SplunkNotifier sends notifications to the Splunk HTTP event collector.
+type SplunkNotifier struct {
+	// HTTPEndpoint of the HTTP event collector.
+	HTTPEndpoint string `json:"httpEndpoint"`
+
+	// HTTPTokenSecretRef references the token of the HTTP event collector.
+	HTTPTokenSecretRef xpv1.SecretKeySelector `json:"httpTokenSecretRef"`
+
+	// Insecure disables verification of the collector's certificate.
+	// +kubebuilder:validation:Optional
+	Insecure bool `json:"insecure,omitempty"`
+
+	// Truncate is the maximum size of a notification in bytes.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=10000
+	Truncate int64 `json:"truncate,omitempty"`
+
+	// AuditLoggingEnabled sends audit logs to the collector.
+	// +kubebuilder:validation:Optional
+	AuditLoggingEnabled bool `json:"auditLoggingEnabled,omitempty"`
+}
+
+// NotifierObservation are the observable fields of a Notifier.
+type NotifierObservation struct {
+	// ID represents a unique identifier for the notifier.
+	ID string `json:"id,omitempty"`
+
+	// Type of the notifier.
+	Type string `json:"type,omitempty"`
+
+	apisv1alpha1.SecretObservation `json:",inline"`
+}
+
+// A NotifierSpec defines the desired state of a Notifier.
+type NotifierSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NotifierParameters `json:"forProvider"`
+}
+
+// A NotifierStatus represents the observed state of a Notifier.
+type NotifierStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NotifierObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Notifier represents a notifier integration of an ACS Central.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type Notifier struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotifierSpec   `json:"spec"`
+	Status NotifierStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotifierList contains a list of Notifier
+type NotifierList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Notifier `json:"items"`
+}
+
+// Notifier type metadata.
+var (
+	NotifierKind             = reflect.TypeOf(Notifier{}).Name()
+	NotifierGroupKind        = schema.GroupKind{Group: Group, Kind: NotifierKind}.String()
+	NotifierKindAPIVersion   = NotifierKind + "." + SchemeGroupVersion.String()
+	NotifierGroupVersionKind = SchemeGroupVersion.WithKind(NotifierKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Notifier{}, &NotifierList{})
+}
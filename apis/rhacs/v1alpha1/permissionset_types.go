@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Access is a typed enum for the access to a resource type.
+// +kubebuilder:validation:Enum=NO_ACCESS;READ_ACCESS;READ_WRITE_ACCESS
+type Access string
+
+// PermissionSetParameters are the configurable fields of a PermissionSet.
+type PermissionSetParameters struct {
+	// Name of the permission set.
+	Name string `json:"name"`
+
+	// Description of the permission set.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// ResourceToAccess is the access granted to each resource type, e.g.
+	// "Deployment". Resource types without access may be omitted.
+	// +kubebuilder:validation:Optional
+	ResourceToAccess map[string]Access `json:"resourceToAccess,omitempty"`
+
+	// CentralURL is the UI URL of the Central the permission set belongs to.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// PermissionSetObservation are the observable fields of a PermissionSet.
+type PermissionSetObservation struct {
+	// ID represents a unique identifier for the permission set.
+	ID string `json:"id,omitempty"`
+}
+
+// A PermissionSetSpec defines the desired state of a PermissionSet.
+type PermissionSetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PermissionSetParameters `json:"forProvider"`
+}
+
+// A PermissionSetStatus represents the observed state of a PermissionSet.
+type PermissionSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PermissionSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PermissionSet represents the access granted to each resource type of an ACS Central.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type PermissionSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PermissionSetSpec   `json:"spec"`
+	Status PermissionSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PermissionSetList contains a list of PermissionSet
+type PermissionSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PermissionSet `json:"items"`
+}
+
+// PermissionSet type metadata.
+var (
+	PermissionSetKind             = reflect.TypeOf(PermissionSet{}).Name()
+	PermissionSetGroupKind        = schema.GroupKind{Group: Group, Kind: PermissionSetKind}.String()
+	PermissionSetKindAPIVersion   = PermissionSetKind + "." + SchemeGroupVersion.String()
+	PermissionSetGroupVersionKind = SchemeGroupVersion.WithKind(PermissionSetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PermissionSet{}, &PermissionSetList{})
+}
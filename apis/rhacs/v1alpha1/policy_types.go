@@ -0,0 +1,291 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Severity is a typed enum for the severity of a policy.
+// +kubebuilder:validation:Enum=LOW_SEVERITY;MEDIUM_SEVERITY;HIGH_SEVERITY;CRITICAL_SEVERITY
+type Severity string
+
+// LifecycleStage is a typed enum for the stages a policy applies to.
+// +kubebuilder:validation:Enum=BUILD;DEPLOY;RUNTIME
+type LifecycleStage string
+
+// EventSource is a typed enum for the events a runtime policy applies to.
+// +kubebuilder:validation:Enum=NOT_APPLICABLE;DEPLOYMENT_EVENT;AUDIT_LOG_EVENT
+type EventSource string
+
+// EnforcementAction is a typed enum for the actions taken on policy violations.
+// +kubebuilder:validation:Enum=SCALE_TO_ZERO_ENFORCEMENT;UNSATISFIABLE_NODE_CONSTRAINT_ENFORCEMENT;KILL_POD_ENFORCEMENT;FAIL_BUILD_ENFORCEMENT;FAIL_KUBE_REQUEST_ENFORCEMENT;FAIL_DEPLOYMENT_CREATE_ENFORCEMENT;FAIL_DEPLOYMENT_UPDATE_ENFORCEMENT
+type EnforcementAction string
+
+// BooleanOperator is a typed enum for how the values of a policy criterion
+// are combined.
+// +kubebuilder:validation:Enum=OR;AND
+type BooleanOperator string
+
+// PolicyParameters are the configurable fields of a Policy. They mirror the
+// policy schema of the Central API.
+type PolicyParameters struct {
+	// Name of the policy.
+	Name string `json:"name"`
+
+	// Description of the policy.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// Rationale of the policy.
+	// +kubebuilder:validation:Optional
+	Rationale string `json:"rationale,omitempty"`
+
+	// Remediation of violations of the policy.
+	// +kubebuilder:validation:Optional
+	Remediation string `json:"remediation,omitempty"`
+
+	// Disabled policies are not evaluated.
+	// +kubebuilder:validation:Optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Categories of the policy.
+	Categories []string `json:"categories"`
+
+	// LifecycleStages the policy applies to.
+	// +kubebuilder:validation:MinItems=1
+	LifecycleStages []LifecycleStage `json:"lifecycleStages"`
+
+	// EventSource of runtime policies. Policies of other lifecycle stages
+	// have no event source.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=NOT_APPLICABLE
+	EventSource EventSource `json:"eventSource,omitempty"`
+
+	// Severity of violations of the policy.
+	Severity Severity `json:"severity"`
+
+	// EnforcementActions taken on violations of the policy.
+	// +kubebuilder:validation:Optional
+	EnforcementActions []EnforcementAction `json:"enforcementActions,omitempty"`
+
+	// Notifiers are the IDs of the notifiers violations of the policy are
+	// sent to.
+	// +kubebuilder:validation:Optional
+	Notifiers []string `json:"notifiers,omitempty"`
+
+	// Scope restricts the policy to the matching clusters, namespaces or
+	// labels.
+	// +kubebuilder:validation:Optional
+	Scope []Scope `json:"scope,omitempty"`
+
+	// Exclusions of deployments or images from the policy.
+	// +kubebuilder:validation:Optional
+	Exclusions []PolicyExclusion `json:"exclusions,omitempty"`
+
+	// PolicySections are the criteria of the policy. The policy is violated
+	// if all criteria of any section match.
+	// +kubebuilder:validation:MinItems=1
+	PolicySections []PolicySection `json:"policySections"`
+
+	// MitreAttackVectors the policy detects.
+	// +kubebuilder:validation:Optional
+	MitreAttackVectors []MitreAttackVector `json:"mitreAttackVectors,omitempty"`
+
+	// CentralURL is the UI URL of the Central that enforces the policy.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// A Scope restricts a policy to a cluster, namespace or label.
+type Scope struct {
+	// Cluster ID the scope matches.
+	// +kubebuilder:validation:Optional
+	Cluster string `json:"cluster,omitempty"`
+
+	// Namespace the scope matches.
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Label the scope matches.
+	// +kubebuilder:validation:Optional
+	Label *ScopeLabel `json:"label,omitempty"`
+}
+
+// A ScopeLabel matches a label of a deployment.
+type ScopeLabel struct {
+	// Key of the label.
+	Key string `json:"key"`
+
+	// Value of the label.
+	// +kubebuilder:validation:Optional
+	Value string `json:"value,omitempty"`
+}
+
+// A PolicyExclusion excludes deployments or images from a policy.
+type PolicyExclusion struct {
+	// Name of the exclusion.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+
+	// Deployment excluded from the policy.
+	// +kubebuilder:validation:Optional
+	Deployment *PolicyExclusionDeployment `json:"deployment,omitempty"`
+
+	// Image excluded from the policy.
+	// +kubebuilder:validation:Optional
+	Image *PolicyExclusionImage `json:"image,omitempty"`
+
+	// Expiration of the exclusion.
+	// +kubebuilder:validation:Optional
+	Expiration *metav1.Time `json:"expiration,omitempty"`
+}
+
+// A PolicyExclusionDeployment excludes deployments from a policy.
+type PolicyExclusionDeployment struct {
+	// Name of the deployment.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+
+	// Scope of the deployment.
+	// +kubebuilder:validation:Optional
+	Scope *Scope `json:"scope,omitempty"`
+}
+
+// A PolicyExclusionImage excludes images from a policy.
+type PolicyExclusionImage struct {
+	// Name of the image.
+	Name string `json:"name"`
+}
+
+// A PolicySection is a set of criteria that all must match for a policy to be
+// violated.
+type PolicySection struct {
+	// SectionName of the policy section.
+	// +kubebuilder:validation:Optional
+	SectionName string `json:"sectionName,omitempty"`
+
+	// PolicyGroups are the criteria of the policy section.
+	// +kubebuilder:validation:MinItems=1
+	PolicyGroups []PolicyGroup `json:"policyGroups"`
+}
+
+// A PolicyGroup is a criterion of a policy section.
+type PolicyGroup struct {
+	// FieldName of the criterion, e.g. "Image Tag".
+	FieldName string `json:"fieldName"`
+
+	// BooleanOperator combining the values of the criterion.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=OR
+	BooleanOperator BooleanOperator `json:"booleanOperator,omitempty"`
+
+	// Negate the criterion.
+	// +kubebuilder:validation:Optional
+	Negate bool `json:"negate,omitempty"`
+
+	// Values of the criterion.
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
+
+// A MitreAttackVector is a MITRE ATT&CK tactic with its techniques.
+type MitreAttackVector struct {
+	// Tactic ID, e.g. "TA0005".
+	Tactic string `json:"tactic"`
+
+	// Techniques IDs, e.g. "T1562".
+	// +kubebuilder:validation:Optional
+	Techniques []string `json:"techniques,omitempty"`
+}
+
+// PolicyObservation are the observable fields of a Policy.
+type PolicyObservation struct {
+	// ID represents a unique identifier for the policy.
+	ID string `json:"id,omitempty"`
+
+	// IsDefault indicates a policy shipped with Central.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// LastUpdated defines the timestamp at which the policy was last updated.
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+}
+
+// A PolicySpec defines the desired state of a Policy.
+type PolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicyParameters `json:"forProvider"`
+}
+
+// A PolicyStatus represents the observed state of a Policy.
+type PolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Policy represents a security policy of an ACS Central.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SEVERITY",type="string",JSONPath=".spec.forProvider.severity"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type Policy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicySpec   `json:"spec"`
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyList contains a list of Policy
+type PolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Policy `json:"items"`
+}
+
+// Policy type metadata.
+var (
+	PolicyKind             = reflect.TypeOf(Policy{}).Name()
+	PolicyGroupKind        = schema.GroupKind{Group: Group, Kind: PolicyKind}.String()
+	PolicyKindAPIVersion   = PolicyKind + "." + SchemeGroupVersion.String()
+	PolicyGroupVersionKind = SchemeGroupVersion.WithKind(PolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Policy{}, &PolicyList{})
+}
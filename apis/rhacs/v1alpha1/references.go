@@ -0,0 +1,292 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// CentralUIURL extracts the UI URL of a referenced CentralInstance.
+func CentralUIURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		ci, ok := mg.(*CentralInstance)
+		if !ok {
+			return ""
+		}
+		return ci.Status.AtProvider.CentralUIURL
+	}
+}
+
+// resolveCentralURL resolves the UI URL of the CentralInstance referenced or
+// selected by a resource of the Central API.
+func resolveCentralURL(ctx context.Context, c client.Reader, mg resource.Managed, url *string, ref **xpv1.Reference, sel *xpv1.Selector) error {
+	rsp, err := reference.NewAPIResolver(c, mg).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: *url,
+		Extract:      CentralUIURL(),
+		Reference:    *ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &CentralInstanceList{},
+			Managed: &CentralInstance{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.centralURL")
+	}
+	*url, *ref = rsp.ResolvedValue, rsp.ResolvedReference
+	return nil
+}
+
+// GetCentralURL returns the UI URL of the Central of this InitBundle.
+func (mg *InitBundle) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this InitBundle.
+func (mg *InitBundle) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector)
+}
+
+// GetCentralURL returns the UI URL of the Central of this APIToken.
+func (mg *APIToken) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this APIToken.
+func (mg *APIToken) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector)
+}
+
+// GetCentralURL returns the UI URL of the Central of this Policy.
+func (mg *Policy) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this Policy.
+func (mg *Policy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector)
+}
+
+// GetCentralURL returns the UI URL of the Central of this Notifier.
+func (mg *Notifier) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this Notifier.
+func (mg *Notifier) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector)
+}
+
+// GetCentralURL returns the UI URL of the Central of this AuthProvider.
+func (mg *AuthProvider) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this AuthProvider.
+func (mg *AuthProvider) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector)
+}
+
+// GetCentralURL returns the UI URL of the Central of this PermissionSet.
+func (mg *PermissionSet) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this PermissionSet.
+func (mg *PermissionSet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector)
+}
+
+// GetCentralURL returns the UI URL of the Central of this AccessScope.
+func (mg *AccessScope) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this AccessScope.
+func (mg *AccessScope) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector)
+}
+
+// GetCentralURL returns the UI URL of the Central of this Role.
+func (mg *Role) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this Role.
+func (mg *Role) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	if err := resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector); err != nil {
+		return err
+	}
+
+	r := reference.NewAPIResolver(c, mg)
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: p.PermissionSetID,
+		Extract:      reference.ExternalName(),
+		Reference:    p.PermissionSetIDRef,
+		Selector:     p.PermissionSetIDSelector,
+		To: reference.To{
+			List:    &PermissionSetList{},
+			Managed: &PermissionSet{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.permissionSetID")
+	}
+	p.PermissionSetID, p.PermissionSetIDRef = rsp.ResolvedValue, rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: p.AccessScopeID,
+		Extract:      reference.ExternalName(),
+		Reference:    p.AccessScopeIDRef,
+		Selector:     p.AccessScopeIDSelector,
+		To: reference.To{
+			List:    &AccessScopeList{},
+			Managed: &AccessScope{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.accessScopeID")
+	}
+	p.AccessScopeID, p.AccessScopeIDRef = rsp.ResolvedValue, rsp.ResolvedReference
+
+	return nil
+}
+
+// GetCentralURL returns the UI URL of the Central of this ImageIntegration.
+func (mg *ImageIntegration) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this ImageIntegration.
+func (mg *ImageIntegration) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector)
+}
+
+// GetCentralURL returns the UI URL of the Central of this Collection.
+func (mg *Collection) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this Collection.
+func (mg *Collection) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	if err := resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector); err != nil {
+		return err
+	}
+
+	rsp, err := reference.NewAPIResolver(c, mg).ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: p.EmbeddedCollectionIDs,
+		Extract:       reference.ExternalName(),
+		References:    p.EmbeddedCollectionIDRefs,
+		Selector:      p.EmbeddedCollectionIDSelector,
+		To: reference.To{
+			List:    &CollectionList{},
+			Managed: &Collection{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.embeddedCollectionIDs")
+	}
+	p.EmbeddedCollectionIDs, p.EmbeddedCollectionIDRefs = rsp.ResolvedValues, rsp.ResolvedReferences
+
+	return nil
+}
+
+// GetCentralURL returns the UI URL of the Central of this ReportConfiguration.
+func (mg *ReportConfiguration) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this ReportConfiguration.
+func (mg *ReportConfiguration) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	if err := resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector); err != nil {
+		return err
+	}
+
+	r := reference.NewAPIResolver(c, mg)
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: p.CollectionID,
+		Extract:      reference.ExternalName(),
+		Reference:    p.CollectionIDRef,
+		Selector:     p.CollectionIDSelector,
+		To: reference.To{
+			List:    &CollectionList{},
+			Managed: &Collection{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.collectionID")
+	}
+	p.CollectionID, p.CollectionIDRef = rsp.ResolvedValue, rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: p.Email.NotifierID,
+		Extract:      reference.ExternalName(),
+		Reference:    p.Email.NotifierIDRef,
+		Selector:     p.Email.NotifierIDSelector,
+		To: reference.To{
+			List:    &NotifierList{},
+			Managed: &Notifier{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.email.notifierID")
+	}
+	p.Email.NotifierID, p.Email.NotifierIDRef = rsp.ResolvedValue, rsp.ResolvedReference
+
+	return nil
+}
+
+// GetCentralURL returns the UI URL of the Central of this CentralBackup.
+func (mg *CentralBackup) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this CentralBackup.
+func (mg *CentralBackup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector)
+}
+
+// GetCentralURL returns the UI URL of the Central of this
+// CentralConfiguration.
+func (mg *CentralConfiguration) GetCentralURL() string {
+	return mg.Spec.ForProvider.CentralURL
+}
+
+// ResolveReferences of this CentralConfiguration.
+func (mg *CentralConfiguration) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolveCentralURL(ctx, c, mg, &p.CentralURL, &p.CentralURLRef, p.CentralURLSelector)
+}
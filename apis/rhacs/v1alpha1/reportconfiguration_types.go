@@ -0,0 +1,231 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Fixability is a typed enum for the fixability of reported vulnerabilities.
+// +kubebuilder:validation:Enum=BOTH;FIXABLE;NOT_FIXABLE
+type Fixability string
+
+// VulnerabilitySeverity is a typed enum for the severity of vulnerabilities.
+// +kubebuilder:validation:Enum=LOW_VULNERABILITY_SEVERITY;MODERATE_VULNERABILITY_SEVERITY;IMPORTANT_VULNERABILITY_SEVERITY;CRITICAL_VULNERABILITY_SEVERITY
+type VulnerabilitySeverity string
+
+// ImageType is a typed enum for the images whose vulnerabilities are
+// reported.
+// +kubebuilder:validation:Enum=DEPLOYED;WATCHED
+type ImageType string
+
+// IntervalType is a typed enum for the interval a schedule recurs at.
+// +kubebuilder:validation:Enum=DAILY;WEEKLY;MONTHLY
+type IntervalType string
+
+// ReportConfigurationParameters are the configurable fields of a
+// ReportConfiguration.
+type ReportConfigurationParameters struct {
+	// Name of the report configuration.
+	Name string `json:"name"`
+
+	// Description of the report configuration.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// Filters select the vulnerabilities included in reports.
+	// +kubebuilder:validation:Optional
+	Filters VulnReportFilters `json:"filters,omitempty"`
+
+	// CollectionID is the ID of the collection whose vulnerabilities are
+	// reported.
+	// +kubebuilder:validation:Optional
+	CollectionID string `json:"collectionID,omitempty"`
+
+	// CollectionIDRef references a Collection to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	CollectionIDRef *xpv1.Reference `json:"collectionIDRef,omitempty"`
+
+	// CollectionIDSelector selects a reference to a Collection to retrieve
+	// its ID.
+	// +kubebuilder:validation:Optional
+	CollectionIDSelector *xpv1.Selector `json:"collectionIDSelector,omitempty"`
+
+	// Email configures how reports are sent.
+	Email ReportEmail `json:"email"`
+
+	// Schedule reports are generated at.
+	Schedule Schedule `json:"schedule"`
+
+	// CentralURL is the UI URL of the Central generating the reports.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// VulnReportFilters select the vulnerabilities included in reports.
+type VulnReportFilters struct {
+	// Fixability of the reported vulnerabilities.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=BOTH
+	Fixability Fixability `json:"fixability,omitempty"`
+
+	// SinceLastReport only reports vulnerabilities discovered since the last
+	// report.
+	// +kubebuilder:validation:Optional
+	SinceLastReport bool `json:"sinceLastReport,omitempty"`
+
+	// Severities of the reported vulnerabilities.
+	// +kubebuilder:validation:Optional
+	Severities []VulnerabilitySeverity `json:"severities,omitempty"`
+
+	// ImageTypes of the images whose vulnerabilities are reported.
+	// +kubebuilder:validation:Optional
+	ImageTypes []ImageType `json:"imageTypes,omitempty"`
+}
+
+// ReportEmail configures how reports are sent by email.
+type ReportEmail struct {
+	// NotifierID is the ID of the email notifier sending reports.
+	// +kubebuilder:validation:Optional
+	NotifierID string `json:"notifierID,omitempty"`
+
+	// NotifierIDRef references a Notifier to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	NotifierIDRef *xpv1.Reference `json:"notifierIDRef,omitempty"`
+
+	// NotifierIDSelector selects a reference to a Notifier to retrieve its
+	// ID.
+	// +kubebuilder:validation:Optional
+	NotifierIDSelector *xpv1.Selector `json:"notifierIDSelector,omitempty"`
+
+	// MailingLists reports are sent to.
+	MailingLists []string `json:"mailingLists"`
+}
+
+// A Schedule is a recurring time in UTC at which Central generates reports
+// or backups.
+type Schedule struct {
+	// IntervalType of the schedule.
+	IntervalType IntervalType `json:"intervalType"`
+
+	// Hour of the day the schedule runs at.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	Hour int32 `json:"hour"`
+
+	// Minute of the hour the schedule runs at.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59
+	Minute int32 `json:"minute,omitempty"`
+
+	// DaysOfWeek the schedule runs on, starting with Sunday as 0.
+	// Required for weekly schedules.
+	// +kubebuilder:validation:Optional
+	DaysOfWeek []int32 `json:"daysOfWeek,omitempty"`
+
+	// DaysOfMonth the schedule runs on. Required for monthly schedules.
+	// +kubebuilder:validation:Optional
+	DaysOfMonth []int32 `json:"daysOfMonth,omitempty"`
+}
+
+// ReportConfigurationObservation are the observable fields of a
+// ReportConfiguration.
+type ReportConfigurationObservation struct {
+	// ID represents a unique identifier for the report configuration.
+	ID string `json:"id,omitempty"`
+
+	// LastRunStatus is the status of the last report.
+	LastRunStatus string `json:"lastRunStatus,omitempty"`
+
+	// LastRunTime is the time of the last report.
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// LastRunError describes why the last report failed.
+	LastRunError string `json:"lastRunError,omitempty"`
+
+	// LastSuccessfulRunTime is the time of the last successful report.
+	LastSuccessfulRunTime *metav1.Time `json:"lastSuccessfulRunTime,omitempty"`
+}
+
+// A ReportConfigurationSpec defines the desired state of a
+// ReportConfiguration.
+type ReportConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReportConfigurationParameters `json:"forProvider"`
+}
+
+// A ReportConfigurationStatus represents the observed state of a
+// ReportConfiguration.
+type ReportConfigurationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReportConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ReportConfiguration represents a scheduled vulnerability report of an ACS
+// Central.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="LAST-RUN",type="string",JSONPath=".status.atProvider.lastRunStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type ReportConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReportConfigurationSpec   `json:"spec"`
+	Status ReportConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReportConfigurationList contains a list of ReportConfiguration
+type ReportConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReportConfiguration `json:"items"`
+}
+
+// ReportConfiguration type metadata.
+var (
+	ReportConfigurationKind             = reflect.TypeOf(ReportConfiguration{}).Name()
+	ReportConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: ReportConfigurationKind}.String()
+	ReportConfigurationKindAPIVersion   = ReportConfigurationKind + "." + SchemeGroupVersion.String()
+	ReportConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(ReportConfigurationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ReportConfiguration{}, &ReportConfigurationList{})
+}
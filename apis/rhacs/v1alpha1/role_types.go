@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RoleParameters are the configurable fields of a Role.
+type RoleParameters struct {
+	// Name of the role. It identifies the role and cannot be changed.
+	Name string `json:"name"`
+
+	// Description of the role.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// PermissionSetID is the ID of the permission set granted by the role.
+	// +kubebuilder:validation:Optional
+	PermissionSetID string `json:"permissionSetID,omitempty"`
+
+	// PermissionSetIDRef references a PermissionSet to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	PermissionSetIDRef *xpv1.Reference `json:"permissionSetIDRef,omitempty"`
+
+	// PermissionSetIDSelector selects a reference to a PermissionSet to
+	// retrieve its ID.
+	// +kubebuilder:validation:Optional
+	PermissionSetIDSelector *xpv1.Selector `json:"permissionSetIDSelector,omitempty"`
+
+	// AccessScopeID is the ID of the access scope the role is restricted to.
+	// +kubebuilder:validation:Optional
+	AccessScopeID string `json:"accessScopeID,omitempty"`
+
+	// AccessScopeIDRef references an AccessScope to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	AccessScopeIDRef *xpv1.Reference `json:"accessScopeIDRef,omitempty"`
+
+	// AccessScopeIDSelector selects a reference to an AccessScope to
+	// retrieve its ID.
+	// +kubebuilder:validation:Optional
+	AccessScopeIDSelector *xpv1.Selector `json:"accessScopeIDSelector,omitempty"`
+
+	// CentralURL is the UI URL of the Central the role belongs to.
+	// +kubebuilder:validation:Optional
+	CentralURL string `json:"centralURL,omitempty"`
+
+	// CentralURLRef references a CentralInstance to retrieve its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLRef *xpv1.Reference `json:"centralURLRef,omitempty"`
+
+	// CentralURLSelector selects a reference to a CentralInstance to retrieve
+	// its UI URL.
+	// +kubebuilder:validation:Optional
+	CentralURLSelector *xpv1.Selector `json:"centralURLSelector,omitempty"`
+}
+
+// RoleObservation are the observable fields of a Role.
+type RoleObservation struct {
+	// Name of the role.
+	Name string `json:"name,omitempty"`
+}
+
+// A RoleSpec defines the desired state of a Role.
+type RoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RoleParameters `json:"forProvider"`
+}
+
+// A RoleStatus represents the observed state of a Role.
+type RoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Role represents a role of an ACS Central, which grants a permission set within an access scope.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,redhat}
+type Role struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RoleSpec   `json:"spec"`
+	Status RoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RoleList contains a list of Role
+type RoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Role `json:"items"`
+}
+
+// Role type metadata.
+var (
+	RoleKind             = reflect.TypeOf(Role{}).Name()
+	RoleGroupKind        = schema.GroupKind{Group: Group, Kind: RoleKind}.String()
+	RoleKindAPIVersion   = RoleKind + "." + SchemeGroupVersion.String()
+	RoleGroupVersionKind = SchemeGroupVersion.WithKind(RoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Role{}, &RoleList{})
+}
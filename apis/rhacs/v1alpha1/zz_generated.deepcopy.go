@@ -22,11 +22,13 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CentralInstance) DeepCopyInto(out *CentralInstance) {
+func (in *APIToken) DeepCopyInto(out *APIToken) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -34,18 +36,18 @@ func (in *CentralInstance) DeepCopyInto(out *CentralInstance) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstance.
-func (in *CentralInstance) DeepCopy() *CentralInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIToken.
+func (in *APIToken) DeepCopy() *APIToken {
 	if in == nil {
 		return nil
 	}
-	out := new(CentralInstance)
+	out := new(APIToken)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CentralInstance) DeepCopyObject() runtime.Object {
+func (in *APIToken) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -53,31 +55,31 @@ func (in *CentralInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CentralInstanceList) DeepCopyInto(out *CentralInstanceList) {
+func (in *APITokenList) DeepCopyInto(out *APITokenList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CentralInstance, len(*in))
+		*out = make([]APIToken, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstanceList.
-func (in *CentralInstanceList) DeepCopy() *CentralInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokenList.
+func (in *APITokenList) DeepCopy() *APITokenList {
 	if in == nil {
 		return nil
 	}
-	out := new(CentralInstanceList)
+	out := new(APITokenList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CentralInstanceList) DeepCopyObject() runtime.Object {
+func (in *APITokenList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -85,67 +87,2783 @@ func (in *CentralInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CentralInstanceObservation) DeepCopyInto(out *CentralInstanceObservation) {
+func (in *APITokenObservation) DeepCopyInto(out *APITokenObservation) {
 	*out = *in
-	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
-	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IssuedAt != nil {
+		in, out := &in.IssuedAt, &out.IssuedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstanceObservation.
-func (in *CentralInstanceObservation) DeepCopy() *CentralInstanceObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokenObservation.
+func (in *APITokenObservation) DeepCopy() *APITokenObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(CentralInstanceObservation)
+	out := new(APITokenObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CentralInstanceParameters) DeepCopyInto(out *CentralInstanceParameters) {
+func (in *APITokenParameters) DeepCopyInto(out *APITokenParameters) {
 	*out = *in
+	if in.ExpiresAfter != nil {
+		in, out := &in.ExpiresAfter, &out.ExpiresAfter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RotateBefore != nil {
+		in, out := &in.RotateBefore, &out.RotateBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstanceParameters.
-func (in *CentralInstanceParameters) DeepCopy() *CentralInstanceParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokenParameters.
+func (in *APITokenParameters) DeepCopy() *APITokenParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(CentralInstanceParameters)
+	out := new(APITokenParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CentralInstanceSpec) DeepCopyInto(out *CentralInstanceSpec) {
+func (in *APITokenSpec) DeepCopyInto(out *APITokenSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.ForProvider = in.ForProvider
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstanceSpec.
-func (in *CentralInstanceSpec) DeepCopy() *CentralInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokenSpec.
+func (in *APITokenSpec) DeepCopy() *APITokenSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CentralInstanceSpec)
+	out := new(APITokenSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CentralInstanceStatus) DeepCopyInto(out *CentralInstanceStatus) {
+func (in *APITokenStatus) DeepCopyInto(out *APITokenStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstanceStatus.
-func (in *CentralInstanceStatus) DeepCopy() *CentralInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokenStatus.
+func (in *APITokenStatus) DeepCopy() *APITokenStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CentralInstanceStatus)
+	out := new(APITokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessScope) DeepCopyInto(out *AccessScope) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessScope.
+func (in *AccessScope) DeepCopy() *AccessScope {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessScope) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessScopeList) DeepCopyInto(out *AccessScopeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessScope, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessScopeList.
+func (in *AccessScopeList) DeepCopy() *AccessScopeList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessScopeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessScopeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessScopeObservation) DeepCopyInto(out *AccessScopeObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessScopeObservation.
+func (in *AccessScopeObservation) DeepCopy() *AccessScopeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessScopeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessScopeParameters) DeepCopyInto(out *AccessScopeParameters) {
+	*out = *in
+	if in.IncludedClusters != nil {
+		in, out := &in.IncludedClusters, &out.IncludedClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
+		*out = make([]IncludedNamespace, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterLabelSelectors != nil {
+		in, out := &in.ClusterLabelSelectors, &out.ClusterLabelSelectors
+		*out = make([]LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NamespaceLabelSelectors != nil {
+		in, out := &in.NamespaceLabelSelectors, &out.NamespaceLabelSelectors
+		*out = make([]LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessScopeParameters.
+func (in *AccessScopeParameters) DeepCopy() *AccessScopeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessScopeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessScopeSpec) DeepCopyInto(out *AccessScopeSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessScopeSpec.
+func (in *AccessScopeSpec) DeepCopy() *AccessScopeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessScopeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessScopeStatus) DeepCopyInto(out *AccessScopeStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessScopeStatus.
+func (in *AccessScopeStatus) DeepCopy() *AccessScopeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessScopeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthProvider) DeepCopyInto(out *AuthProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthProvider.
+func (in *AuthProvider) DeepCopy() *AuthProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthProviderList) DeepCopyInto(out *AuthProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AuthProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthProviderList.
+func (in *AuthProviderList) DeepCopy() *AuthProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthProviderObservation) DeepCopyInto(out *AuthProviderObservation) {
+	*out = *in
+	out.SecretObservation = in.SecretObservation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthProviderObservation.
+func (in *AuthProviderObservation) DeepCopy() *AuthProviderObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthProviderObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthProviderParameters) DeepCopyInto(out *AuthProviderParameters) {
+	*out = *in
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SAML != nil {
+		in, out := &in.SAML, &out.SAML
+		*out = new(SAMLConfig)
+		**out = **in
+	}
+	if in.RequiredAttributes != nil {
+		in, out := &in.RequiredAttributes, &out.RequiredAttributes
+		*out = make([]RequiredAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClaimMappings != nil {
+		in, out := &in.ClaimMappings, &out.ClaimMappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthProviderParameters.
+func (in *AuthProviderParameters) DeepCopy() *AuthProviderParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthProviderParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthProviderSpec) DeepCopyInto(out *AuthProviderSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthProviderSpec.
+func (in *AuthProviderSpec) DeepCopy() *AuthProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthProviderStatus) DeepCopyInto(out *AuthProviderStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthProviderStatus.
+func (in *AuthProviderStatus) DeepCopy() *AuthProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralBackup) DeepCopyInto(out *CentralBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralBackup.
+func (in *CentralBackup) DeepCopy() *CentralBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CentralBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralBackupList) DeepCopyInto(out *CentralBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CentralBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralBackupList.
+func (in *CentralBackupList) DeepCopy() *CentralBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CentralBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralBackupObservation) DeepCopyInto(out *CentralBackupObservation) {
+	*out = *in
+	out.SecretObservation = in.SecretObservation
+	if in.LastTriggerTime != nil {
+		in, out := &in.LastTriggerTime, &out.LastTriggerTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralBackupObservation.
+func (in *CentralBackupObservation) DeepCopy() *CentralBackupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralBackupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralBackupParameters) DeepCopyInto(out *CentralBackupParameters) {
+	*out = *in
+	in.Schedule.DeepCopyInto(&out.Schedule)
+	out.S3 = in.S3
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralBackupParameters.
+func (in *CentralBackupParameters) DeepCopy() *CentralBackupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralBackupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralBackupSpec) DeepCopyInto(out *CentralBackupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralBackupSpec.
+func (in *CentralBackupSpec) DeepCopy() *CentralBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralBackupStatus) DeepCopyInto(out *CentralBackupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralBackupStatus.
+func (in *CentralBackupStatus) DeepCopy() *CentralBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralConfiguration) DeepCopyInto(out *CentralConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralConfiguration.
+func (in *CentralConfiguration) DeepCopy() *CentralConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CentralConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralConfigurationList) DeepCopyInto(out *CentralConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CentralConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralConfigurationList.
+func (in *CentralConfigurationList) DeepCopy() *CentralConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CentralConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralConfigurationObservation) DeepCopyInto(out *CentralConfigurationObservation) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeclarativeConfigItem, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralConfigurationObservation.
+func (in *CentralConfigurationObservation) DeepCopy() *CentralConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralConfigurationParameters) DeepCopyInto(out *CentralConfigurationParameters) {
+	*out = *in
+	if in.ConfigMapRefs != nil {
+		in, out := &in.ConfigMapRefs, &out.ConfigMapRefs
+		*out = make([]ConfigMapReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralConfigurationParameters.
+func (in *CentralConfigurationParameters) DeepCopy() *CentralConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralConfigurationSpec) DeepCopyInto(out *CentralConfigurationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralConfigurationSpec.
+func (in *CentralConfigurationSpec) DeepCopy() *CentralConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralConfigurationStatus) DeepCopyInto(out *CentralConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralConfigurationStatus.
+func (in *CentralConfigurationStatus) DeepCopy() *CentralConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralInstance) DeepCopyInto(out *CentralInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstance.
+func (in *CentralInstance) DeepCopy() *CentralInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CentralInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralInstanceList) DeepCopyInto(out *CentralInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CentralInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstanceList.
+func (in *CentralInstanceList) DeepCopy() *CentralInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CentralInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralInstanceObservation) DeepCopyInto(out *CentralInstanceObservation) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstanceObservation.
+func (in *CentralInstanceObservation) DeepCopy() *CentralInstanceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralInstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralInstanceParameters) DeepCopyInto(out *CentralInstanceParameters) {
+	*out = *in
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstanceParameters.
+func (in *CentralInstanceParameters) DeepCopy() *CentralInstanceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralInstanceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralInstanceSpec) DeepCopyInto(out *CentralInstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstanceSpec.
+func (in *CentralInstanceSpec) DeepCopy() *CentralInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CentralInstanceStatus) DeepCopyInto(out *CentralInstanceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CentralInstanceStatus.
+func (in *CentralInstanceStatus) DeepCopy() *CentralInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CentralInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Collection) DeepCopyInto(out *Collection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Collection.
+func (in *Collection) DeepCopy() *Collection {
+	if in == nil {
+		return nil
+	}
+	out := new(Collection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Collection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectionList) DeepCopyInto(out *CollectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Collection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionList.
+func (in *CollectionList) DeepCopy() *CollectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CollectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectionObservation) DeepCopyInto(out *CollectionObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionObservation.
+func (in *CollectionObservation) DeepCopy() *CollectionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectionParameters) DeepCopyInto(out *CollectionParameters) {
+	*out = *in
+	if in.ResourceSelectors != nil {
+		in, out := &in.ResourceSelectors, &out.ResourceSelectors
+		*out = make([]ResourceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EmbeddedCollectionIDs != nil {
+		in, out := &in.EmbeddedCollectionIDs, &out.EmbeddedCollectionIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EmbeddedCollectionIDRefs != nil {
+		in, out := &in.EmbeddedCollectionIDRefs, &out.EmbeddedCollectionIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EmbeddedCollectionIDSelector != nil {
+		in, out := &in.EmbeddedCollectionIDSelector, &out.EmbeddedCollectionIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionParameters.
+func (in *CollectionParameters) DeepCopy() *CollectionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectionSpec) DeepCopyInto(out *CollectionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionSpec.
+func (in *CollectionSpec) DeepCopy() *CollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectionStatus) DeepCopyInto(out *CollectionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionStatus.
+func (in *CollectionStatus) DeepCopy() *CollectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapReference.
+func (in *ConfigMapReference) DeepCopy() *ConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeclarativeConfigItem) DeepCopyInto(out *DeclarativeConfigItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeclarativeConfigItem.
+func (in *DeclarativeConfigItem) DeepCopy() *DeclarativeConfigItem {
+	if in == nil {
+		return nil
+	}
+	out := new(DeclarativeConfigItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerImageIntegration) DeepCopyInto(out *DockerImageIntegration) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerImageIntegration.
+func (in *DockerImageIntegration) DeepCopy() *DockerImageIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerImageIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ECRAssumeRole) DeepCopyInto(out *ECRAssumeRole) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRAssumeRole.
+func (in *ECRAssumeRole) DeepCopy() *ECRAssumeRole {
+	if in == nil {
+		return nil
+	}
+	out := new(ECRAssumeRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ECRImageIntegration) DeepCopyInto(out *ECRImageIntegration) {
+	*out = *in
+	if in.AccessKeyIDSecretRef != nil {
+		in, out := &in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.SecretAccessKeySecretRef != nil {
+		in, out := &in.SecretAccessKeySecretRef, &out.SecretAccessKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.AssumeRole != nil {
+		in, out := &in.AssumeRole, &out.AssumeRole
+		*out = new(ECRAssumeRole)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRImageIntegration.
+func (in *ECRImageIntegration) DeepCopy() *ECRImageIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(ECRImageIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailNotifier) DeepCopyInto(out *EmailNotifier) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailNotifier.
+func (in *EmailNotifier) DeepCopy() *EmailNotifier {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailNotifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleImageIntegration) DeepCopyInto(out *GoogleImageIntegration) {
+	*out = *in
+	out.ServiceAccountSecretRef = in.ServiceAccountSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleImageIntegration.
+func (in *GoogleImageIntegration) DeepCopy() *GoogleImageIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleImageIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageIntegration) DeepCopyInto(out *ImageIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageIntegration.
+func (in *ImageIntegration) DeepCopy() *ImageIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageIntegrationList) DeepCopyInto(out *ImageIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageIntegrationList.
+func (in *ImageIntegrationList) DeepCopy() *ImageIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageIntegrationObservation) DeepCopyInto(out *ImageIntegrationObservation) {
+	*out = *in
+	out.SecretObservation = in.SecretObservation
+	if in.Test != nil {
+		in, out := &in.Test, &out.Test
+		*out = new(ImageIntegrationTest)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageIntegrationObservation.
+func (in *ImageIntegrationObservation) DeepCopy() *ImageIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageIntegrationParameters) DeepCopyInto(out *ImageIntegrationParameters) {
+	*out = *in
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]ImageIntegrationCategory, len(*in))
+		copy(*out, *in)
+	}
+	if in.Docker != nil {
+		in, out := &in.Docker, &out.Docker
+		*out = new(DockerImageIntegration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Quay != nil {
+		in, out := &in.Quay, &out.Quay
+		*out = new(QuayImageIntegration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ECR != nil {
+		in, out := &in.ECR, &out.ECR
+		*out = new(ECRImageIntegration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Google != nil {
+		in, out := &in.Google, &out.Google
+		*out = new(GoogleImageIntegration)
+		**out = **in
+	}
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageIntegrationParameters.
+func (in *ImageIntegrationParameters) DeepCopy() *ImageIntegrationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageIntegrationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageIntegrationSpec) DeepCopyInto(out *ImageIntegrationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageIntegrationSpec.
+func (in *ImageIntegrationSpec) DeepCopy() *ImageIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageIntegrationStatus) DeepCopyInto(out *ImageIntegrationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageIntegrationStatus.
+func (in *ImageIntegrationStatus) DeepCopy() *ImageIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageIntegrationTest) DeepCopyInto(out *ImageIntegrationTest) {
+	*out = *in
+	in.LastTestTime.DeepCopyInto(&out.LastTestTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageIntegrationTest.
+func (in *ImageIntegrationTest) DeepCopy() *ImageIntegrationTest {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageIntegrationTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImpactedCluster) DeepCopyInto(out *ImpactedCluster) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImpactedCluster.
+func (in *ImpactedCluster) DeepCopy() *ImpactedCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(ImpactedCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncludedNamespace) DeepCopyInto(out *IncludedNamespace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncludedNamespace.
+func (in *IncludedNamespace) DeepCopy() *IncludedNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(IncludedNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InitBundle) DeepCopyInto(out *InitBundle) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitBundle.
+func (in *InitBundle) DeepCopy() *InitBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(InitBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InitBundle) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InitBundleList) DeepCopyInto(out *InitBundleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InitBundle, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitBundleList.
+func (in *InitBundleList) DeepCopy() *InitBundleList {
+	if in == nil {
+		return nil
+	}
+	out := new(InitBundleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InitBundleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InitBundleObservation) DeepCopyInto(out *InitBundleObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ImpactedClusters != nil {
+		in, out := &in.ImpactedClusters, &out.ImpactedClusters
+		*out = make([]ImpactedCluster, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitBundleObservation.
+func (in *InitBundleObservation) DeepCopy() *InitBundleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InitBundleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InitBundleParameters) DeepCopyInto(out *InitBundleParameters) {
+	*out = *in
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitBundleParameters.
+func (in *InitBundleParameters) DeepCopy() *InitBundleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InitBundleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InitBundleSpec) DeepCopyInto(out *InitBundleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitBundleSpec.
+func (in *InitBundleSpec) DeepCopy() *InitBundleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InitBundleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InitBundleStatus) DeepCopyInto(out *InitBundleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitBundleStatus.
+func (in *InitBundleStatus) DeepCopy() *InitBundleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InitBundleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyValue) DeepCopyInto(out *KeyValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyValue.
+func (in *KeyValue) DeepCopy() *KeyValue {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelRequirement) DeepCopyInto(out *LabelRequirement) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelRequirement.
+func (in *LabelRequirement) DeepCopy() *LabelRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSelector) DeepCopyInto(out *LabelSelector) {
+	*out = *in
+	if in.Requirements != nil {
+		in, out := &in.Requirements, &out.Requirements
+		*out = make([]LabelRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSelector.
+func (in *LabelSelector) DeepCopy() *LabelSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MitreAttackVector) DeepCopyInto(out *MitreAttackVector) {
+	*out = *in
+	if in.Techniques != nil {
+		in, out := &in.Techniques, &out.Techniques
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MitreAttackVector.
+func (in *MitreAttackVector) DeepCopy() *MitreAttackVector {
+	if in == nil {
+		return nil
+	}
+	out := new(MitreAttackVector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notifier) DeepCopyInto(out *Notifier) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notifier.
+func (in *Notifier) DeepCopy() *Notifier {
+	if in == nil {
+		return nil
+	}
+	out := new(Notifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Notifier) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierList) DeepCopyInto(out *NotifierList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Notifier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierList.
+func (in *NotifierList) DeepCopy() *NotifierList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotifierList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierObservation) DeepCopyInto(out *NotifierObservation) {
+	*out = *in
+	out.SecretObservation = in.SecretObservation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierObservation.
+func (in *NotifierObservation) DeepCopy() *NotifierObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierParameters) DeepCopyInto(out *NotifierParameters) {
+	*out = *in
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackNotifier)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookNotifier)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(EmailNotifier)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Splunk != nil {
+		in, out := &in.Splunk, &out.Splunk
+		*out = new(SplunkNotifier)
+		**out = **in
+	}
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierParameters.
+func (in *NotifierParameters) DeepCopy() *NotifierParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierSpec) DeepCopyInto(out *NotifierSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierSpec.
+func (in *NotifierSpec) DeepCopy() *NotifierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierStatus) DeepCopyInto(out *NotifierStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierStatus.
+func (in *NotifierStatus) DeepCopy() *NotifierStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
+	*out = *in
+	if in.ClientSecretSecretRef != nil {
+		in, out := &in.ClientSecretSecretRef, &out.ClientSecretSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfig.
+func (in *OIDCConfig) DeepCopy() *OIDCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionSet) DeepCopyInto(out *PermissionSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionSet.
+func (in *PermissionSet) DeepCopy() *PermissionSet {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PermissionSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionSetList) DeepCopyInto(out *PermissionSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PermissionSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionSetList.
+func (in *PermissionSetList) DeepCopy() *PermissionSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PermissionSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionSetObservation) DeepCopyInto(out *PermissionSetObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionSetObservation.
+func (in *PermissionSetObservation) DeepCopy() *PermissionSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionSetParameters) DeepCopyInto(out *PermissionSetParameters) {
+	*out = *in
+	if in.ResourceToAccess != nil {
+		in, out := &in.ResourceToAccess, &out.ResourceToAccess
+		*out = make(map[string]Access, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionSetParameters.
+func (in *PermissionSetParameters) DeepCopy() *PermissionSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionSetSpec) DeepCopyInto(out *PermissionSetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionSetSpec.
+func (in *PermissionSetSpec) DeepCopy() *PermissionSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionSetStatus) DeepCopyInto(out *PermissionSetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionSetStatus.
+func (in *PermissionSetStatus) DeepCopy() *PermissionSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policy) DeepCopyInto(out *Policy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
+func (in *Policy) DeepCopy() *Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Policy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyExclusion) DeepCopyInto(out *PolicyExclusion) {
+	*out = *in
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(PolicyExclusionDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(PolicyExclusionImage)
+		**out = **in
+	}
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyExclusion.
+func (in *PolicyExclusion) DeepCopy() *PolicyExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyExclusionDeployment) DeepCopyInto(out *PolicyExclusionDeployment) {
+	*out = *in
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(Scope)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyExclusionDeployment.
+func (in *PolicyExclusionDeployment) DeepCopy() *PolicyExclusionDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyExclusionDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyExclusionImage) DeepCopyInto(out *PolicyExclusionImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyExclusionImage.
+func (in *PolicyExclusionImage) DeepCopy() *PolicyExclusionImage {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyExclusionImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyGroup) DeepCopyInto(out *PolicyGroup) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyGroup.
+func (in *PolicyGroup) DeepCopy() *PolicyGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Policy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
+func (in *PolicyList) DeepCopy() *PolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyObservation) DeepCopyInto(out *PolicyObservation) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyObservation.
+func (in *PolicyObservation) DeepCopy() *PolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
+	*out = *in
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LifecycleStages != nil {
+		in, out := &in.LifecycleStages, &out.LifecycleStages
+		*out = make([]LifecycleStage, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnforcementActions != nil {
+		in, out := &in.EnforcementActions, &out.EnforcementActions
+		*out = make([]EnforcementAction, len(*in))
+		copy(*out, *in)
+	}
+	if in.Notifiers != nil {
+		in, out := &in.Notifiers, &out.Notifiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = make([]Scope, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make([]PolicyExclusion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PolicySections != nil {
+		in, out := &in.PolicySections, &out.PolicySections
+		*out = make([]PolicySection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MitreAttackVectors != nil {
+		in, out := &in.MitreAttackVectors, &out.MitreAttackVectors
+		*out = make([]MitreAttackVector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyParameters.
+func (in *PolicyParameters) DeepCopy() *PolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySection) DeepCopyInto(out *PolicySection) {
+	*out = *in
+	if in.PolicyGroups != nil {
+		in, out := &in.PolicyGroups, &out.PolicyGroups
+		*out = make([]PolicyGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySection.
+func (in *PolicySection) DeepCopy() *PolicySection {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
+func (in *PolicySpec) DeepCopy() *PolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
+func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayImageIntegration) DeepCopyInto(out *QuayImageIntegration) {
+	*out = *in
+	if in.OAuthTokenSecretRef != nil {
+		in, out := &in.OAuthTokenSecretRef, &out.OAuthTokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.RobotAccount != nil {
+		in, out := &in.RobotAccount, &out.RobotAccount
+		*out = new(QuayRobotAccount)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayImageIntegration.
+func (in *QuayImageIntegration) DeepCopy() *QuayImageIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayImageIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRobotAccount) DeepCopyInto(out *QuayRobotAccount) {
+	*out = *in
+	out.PasswordSecretRef = in.PasswordSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRobotAccount.
+func (in *QuayRobotAccount) DeepCopy() *QuayRobotAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRobotAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfiguration) DeepCopyInto(out *ReportConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfiguration.
+func (in *ReportConfiguration) DeepCopy() *ReportConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReportConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfigurationList) DeepCopyInto(out *ReportConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReportConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfigurationList.
+func (in *ReportConfigurationList) DeepCopy() *ReportConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReportConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfigurationObservation) DeepCopyInto(out *ReportConfigurationObservation) {
+	*out = *in
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulRunTime != nil {
+		in, out := &in.LastSuccessfulRunTime, &out.LastSuccessfulRunTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfigurationObservation.
+func (in *ReportConfigurationObservation) DeepCopy() *ReportConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfigurationParameters) DeepCopyInto(out *ReportConfigurationParameters) {
+	*out = *in
+	in.Filters.DeepCopyInto(&out.Filters)
+	if in.CollectionIDRef != nil {
+		in, out := &in.CollectionIDRef, &out.CollectionIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CollectionIDSelector != nil {
+		in, out := &in.CollectionIDSelector, &out.CollectionIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Email.DeepCopyInto(&out.Email)
+	in.Schedule.DeepCopyInto(&out.Schedule)
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfigurationParameters.
+func (in *ReportConfigurationParameters) DeepCopy() *ReportConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfigurationSpec) DeepCopyInto(out *ReportConfigurationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfigurationSpec.
+func (in *ReportConfigurationSpec) DeepCopy() *ReportConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfigurationStatus) DeepCopyInto(out *ReportConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfigurationStatus.
+func (in *ReportConfigurationStatus) DeepCopy() *ReportConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportEmail) DeepCopyInto(out *ReportEmail) {
+	*out = *in
+	if in.NotifierIDRef != nil {
+		in, out := &in.NotifierIDRef, &out.NotifierIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotifierIDSelector != nil {
+		in, out := &in.NotifierIDSelector, &out.NotifierIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MailingLists != nil {
+		in, out := &in.MailingLists, &out.MailingLists
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportEmail.
+func (in *ReportEmail) DeepCopy() *ReportEmail {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportEmail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequiredAttribute) DeepCopyInto(out *RequiredAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequiredAttribute.
+func (in *RequiredAttribute) DeepCopy() *RequiredAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(RequiredAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSelector) DeepCopyInto(out *ResourceSelector) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]SelectorRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSelector.
+func (in *ResourceSelector) DeepCopy() *ResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Role) DeepCopyInto(out *Role) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
+func (in *Role) DeepCopy() *Role {
+	if in == nil {
+		return nil
+	}
+	out := new(Role)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Role) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleList) DeepCopyInto(out *RoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Role, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleList.
+func (in *RoleList) DeepCopy() *RoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleObservation) DeepCopyInto(out *RoleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleObservation.
+func (in *RoleObservation) DeepCopy() *RoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleParameters) DeepCopyInto(out *RoleParameters) {
+	*out = *in
+	if in.PermissionSetIDRef != nil {
+		in, out := &in.PermissionSetIDRef, &out.PermissionSetIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PermissionSetIDSelector != nil {
+		in, out := &in.PermissionSetIDSelector, &out.PermissionSetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessScopeIDRef != nil {
+		in, out := &in.AccessScopeIDRef, &out.AccessScopeIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessScopeIDSelector != nil {
+		in, out := &in.AccessScopeIDSelector, &out.AccessScopeIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLRef != nil {
+		in, out := &in.CentralURLRef, &out.CentralURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CentralURLSelector != nil {
+		in, out := &in.CentralURLSelector, &out.CentralURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleParameters.
+func (in *RoleParameters) DeepCopy() *RoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
+func (in *RoleSpec) DeepCopy() *RoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
+func (in *RoleStatus) DeepCopy() *RoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleValue) DeepCopyInto(out *RuleValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleValue.
+func (in *RuleValue) DeepCopy() *RuleValue {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3BackupStore) DeepCopyInto(out *S3BackupStore) {
+	*out = *in
+	out.AccessKeyIDSecretRef = in.AccessKeyIDSecretRef
+	out.SecretAccessKeySecretRef = in.SecretAccessKeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BackupStore.
+func (in *S3BackupStore) DeepCopy() *S3BackupStore {
+	if in == nil {
+		return nil
+	}
+	out := new(S3BackupStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLConfig) DeepCopyInto(out *SAMLConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLConfig.
+func (in *SAMLConfig) DeepCopy() *SAMLConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+	if in.DaysOfWeek != nil {
+		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.DaysOfMonth != nil {
+		in, out := &in.DaysOfMonth, &out.DaysOfMonth
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Scope) DeepCopyInto(out *Scope) {
+	*out = *in
+	if in.Label != nil {
+		in, out := &in.Label, &out.Label
+		*out = new(ScopeLabel)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scope.
+func (in *Scope) DeepCopy() *Scope {
+	if in == nil {
+		return nil
+	}
+	out := new(Scope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScopeLabel) DeepCopyInto(out *ScopeLabel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScopeLabel.
+func (in *ScopeLabel) DeepCopy() *ScopeLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(ScopeLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorRule) DeepCopyInto(out *SelectorRule) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]RuleValue, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorRule.
+func (in *SelectorRule) DeepCopy() *SelectorRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackNotifier) DeepCopyInto(out *SlackNotifier) {
+	*out = *in
+	out.WebhookURLSecretRef = in.WebhookURLSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackNotifier.
+func (in *SlackNotifier) DeepCopy() *SlackNotifier {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackNotifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplunkNotifier) DeepCopyInto(out *SplunkNotifier) {
+	*out = *in
+	out.HTTPTokenSecretRef = in.HTTPTokenSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkNotifier.
+func (in *SplunkNotifier) DeepCopy() *SplunkNotifier {
+	if in == nil {
+		return nil
+	}
+	out := new(SplunkNotifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VulnReportFilters) DeepCopyInto(out *VulnReportFilters) {
+	*out = *in
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]VulnerabilitySeverity, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageTypes != nil {
+		in, out := &in.ImageTypes, &out.ImageTypes
+		*out = make([]ImageType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VulnReportFilters.
+func (in *VulnReportFilters) DeepCopy() *VulnReportFilters {
+	if in == nil {
+		return nil
+	}
+	out := new(VulnReportFilters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookNotifier) DeepCopyInto(out *WebhookNotifier) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]KeyValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraFields != nil {
+		in, out := &in.ExtraFields, &out.ExtraFields
+		*out = make([]KeyValue, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookNotifier.
+func (in *WebhookNotifier) DeepCopy() *WebhookNotifier {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookNotifier)
 	in.DeepCopyInto(out)
 	return out
 }
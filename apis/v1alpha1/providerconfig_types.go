@@ -32,6 +32,43 @@ type ProviderConfigSpec struct {
 
 	// Gateway endpoint of the OpenShift API gateway.
 	Gateway string `json:"gateway"`
+
+	// RateLimit of the requests sent to the OpenShift API gateway on behalf of
+	// all resources using this ProviderConfig. Requests are not limited if unset.
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+	// Quay configures access to the API of a Quay registry, which resources
+	// of the quay API group require.
+	// +optional
+	Quay *QuayConfig `json:"quay,omitempty"`
+}
+
+// QuayConfig configures access to the API of a Quay registry.
+type QuayConfig struct {
+	// Endpoint of the Quay registry.
+	// +kubebuilder:default="https://quay.io"
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Credentials are an OAuth access token of a Quay application, which
+	// authenticates requests to the Quay API. The token must be granted the
+	// scopes to administer organizations and repositories.
+	Credentials ProviderCredentials `json:"credentials"`
+}
+
+// RateLimit configures a token bucket rate limiter.
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the token bucket is refilled.
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerSecond int `json:"requestsPerSecond"`
+
+	// Burst is the size of the token bucket, i.e. the maximum number of
+	// requests that may be sent at once.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	Burst int `json:"burst,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -46,6 +83,32 @@ type ProviderCredentials struct {
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// UnmanagedCentrals are the centrals of the organisation the credentials
+	// belong to that are not managed by any CentralInstance.
+	// +optional
+	UnmanagedCentrals []UnmanagedCentral `json:"unmanagedCentrals,omitempty"`
+}
+
+// An UnmanagedCentral is a central that is not managed by a CentralInstance.
+type UnmanagedCentral struct {
+	// ID of the central in the RHACS fleet manager.
+	ID string `json:"id"`
+
+	// Name of the central.
+	Name string `json:"name"`
+
+	// CloudProvider to which the central is deployed.
+	CloudProvider string `json:"cloudProvider,omitempty"`
+
+	// Region which hosts the central.
+	Region string `json:"region,omitempty"`
+
+	// Owner of the central.
+	Owner string `json:"owner,omitempty"`
+
+	// Status of the central.
+	Status string `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
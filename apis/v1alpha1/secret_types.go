@@ -0,0 +1,25 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// A SecretObservation records the secrets a managed resource last wrote to an
+// external API that does not return them, e.g. because it masks them. Changes
+// to the referenced secrets are detected by comparing hashes of them.
+type SecretObservation struct {
+	// SecretHash is a hash of the secrets last written to the external API.
+	SecretHash string `json:"secretHash,omitempty"`
+}
@@ -39,7 +39,9 @@ import (
 	"github.com/stehessel/provider-redhat/apis"
 	"github.com/stehessel/provider-redhat/apis/v1alpha1"
 	redhat "github.com/stehessel/provider-redhat/pkg/controller"
+	"github.com/stehessel/provider-redhat/pkg/controller/rhacs"
 	"github.com/stehessel/provider-redhat/pkg/features"
+	"github.com/stehessel/provider-redhat/pkg/tracing"
 )
 
 func main() {
@@ -50,10 +52,18 @@ func main() {
 
 		syncInterval     = app.Flag("sync", "How often all resources will be double-checked for drift from the desired state.").Short('s').Default("1h").Duration()
 		pollInterval     = app.Flag("poll", "How often individual resources will be checked for drift from the desired state").Default("1m").Duration()
+		pollTransitional = app.Flag("poll-transitional", "How often CentralInstances will be checked while their central is accepted, preparing, provisioning or deleting.").Default("15s").Duration()
+		pollReady        = app.Flag("poll-ready", "How often CentralInstances will be checked for drift once their central is ready.").Default("5m").Duration()
+		unmanagedScan    = app.Flag("unmanaged-centrals-interval", "How often the centrals of each ProviderConfig are checked for ones not managed by a CentralInstance. Zero disables the check.").Default("10m").Duration()
+		centralCacheTTL  = app.Flag("central-cache-ttl", "How long the centrals listed for a ProviderConfig are reused to observe all CentralInstances using it. Zero disables caching.").Default("5s").Duration()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
 
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
+
+		enableTracing   = app.Flag("enable-tracing", "Export OpenTelemetry traces of reconciles and fleet-manager calls via OTLP/HTTP.").Default("false").Envar("ENABLE_TRACING").Bool()
+		tracingEndpoint = app.Flag("tracing-endpoint", "Host and port of the OTLP/HTTP endpoint traces are exported to.").Default("localhost:4318").Envar("TRACING_ENDPOINT").String()
+		tracingInsecure = app.Flag("tracing-insecure", "Export traces to the OTLP/HTTP endpoint without TLS.").Default("false").Envar("TRACING_INSECURE").Bool()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -66,6 +76,17 @@ func main() {
 		ctrl.SetLogger(zl)
 	}
 
+	if *enableTracing {
+		shutdown, err := tracing.Setup(context.Background(), *tracingEndpoint, *tracingInsecure)
+		kingpin.FatalIfError(err, "Cannot setup tracing")
+		defer func() {
+			if err := shutdown(context.Background()); err != nil {
+				log.Info("Cannot shutdown tracing", "error", err)
+			}
+		}()
+		log.Info("Tracing enabled", "endpoint", *tracingEndpoint)
+	}
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
@@ -115,6 +136,14 @@ func main() {
 		})), "cannot create default store config")
 	}
 
-	kingpin.FatalIfError(redhat.Setup(mgr, o), "Cannot setup RedHat controllers")
+	ro := rhacs.Options{
+		PollIntervals: rhacs.PollIntervals{
+			Transitional: *pollTransitional,
+			Ready:        *pollReady,
+		},
+		CentralCacheTTL:           *centralCacheTTL,
+		UnmanagedCentralsInterval: *unmanagedScan,
+	}
+	kingpin.FatalIfError(redhat.Setup(mgr, redhat.Options{Options: o, RHACS: ro}), "Cannot setup RedHat controllers")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
@@ -0,0 +1,55 @@
+package central
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// APITokenAPI manages the API tokens of Central.
+type APITokenAPI interface {
+	GenerateAPIToken(ctx context.Context, req GenerateAPITokenRequest) (*APIToken, error)
+	GetAPIToken(ctx context.Context, id string) (*APITokenMeta, error)
+	RevokeAPIToken(ctx context.Context, id string) error
+}
+
+// GenerateAPITokenRequest is a request to generate an API token.
+type GenerateAPITokenRequest struct {
+	Name       string     `json:"name"`
+	Roles      []string   `json:"roles"`
+	Expiration *time.Time `json:"expiration,omitempty"`
+}
+
+// APITokenMeta describes an API token.
+type APITokenMeta struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Roles      []string   `json:"roles,omitempty"`
+	IssuedAt   *time.Time `json:"issuedAt,omitempty"`
+	Expiration *time.Time `json:"expiration,omitempty"`
+	Revoked    bool       `json:"revoked,omitempty"`
+}
+
+// An APIToken is a newly generated API token. The token itself is only
+// available at generation.
+type APIToken struct {
+	Token    string       `json:"token"`
+	Metadata APITokenMeta `json:"metadata"`
+}
+
+func (c *client) GenerateAPIToken(ctx context.Context, req GenerateAPITokenRequest) (*APIToken, error) {
+	out := &APIToken{}
+	err := c.Do(ctx, http.MethodPost, "/v1/apitokens/generate", req, out)
+	return out, err
+}
+
+func (c *client) GetAPIToken(ctx context.Context, id string) (*APITokenMeta, error) {
+	out := &APITokenMeta{}
+	err := c.Do(ctx, http.MethodGet, "/v1/apitokens/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) RevokeAPIToken(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodPatch, "/v1/apitokens/revoke/"+url.PathEscape(id), nil, nil)
+}
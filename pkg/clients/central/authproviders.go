@@ -0,0 +1,105 @@
+package central
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Auth provider types supported by Central.
+const (
+	AuthProviderTypeOIDC = "oidc"
+	AuthProviderTypeSAML = "saml"
+)
+
+// AuthProviderAPI manages the auth providers of Central.
+type AuthProviderAPI interface {
+	GetAuthProvider(ctx context.Context, id string) (*AuthProvider, error)
+	CreateAuthProvider(ctx context.Context, p *AuthProvider) (*AuthProvider, error)
+	UpdateAuthProvider(ctx context.Context, p *AuthProvider) error
+	DeleteAuthProvider(ctx context.Context, id string) error
+}
+
+// GroupAPI manages the groups of Central, which grant roles to the users of
+// an auth provider.
+type GroupAPI interface {
+	ListGroups(ctx context.Context, authProviderID string) ([]Group, error)
+	UpdateGroups(ctx context.Context, previous, required []Group) error
+}
+
+// An AuthProvider is an identity provider users log in to Central with.
+// Central omits the sensitive keys of the config of auth providers it returns.
+type AuthProvider struct {
+	ID                 string              `json:"id,omitempty"`
+	Name               string              `json:"name"`
+	Type               string              `json:"type"`
+	UIEndpoint         string              `json:"uiEndpoint"`
+	Enabled            bool                `json:"enabled"`
+	Config             map[string]string   `json:"config"`
+	LoginURL           string              `json:"loginUrl,omitempty"`
+	Validated          bool                `json:"validated,omitempty"`
+	Active             bool                `json:"active,omitempty"`
+	RequiredAttributes []RequiredAttribute `json:"requiredAttributes,omitempty"`
+	ClaimMappings      map[string]string   `json:"claimMappings,omitempty"`
+}
+
+// A RequiredAttribute is an attribute a user must have to log in.
+type RequiredAttribute struct {
+	AttributeKey   string `json:"attributeKey"`
+	AttributeValue string `json:"attributeValue"`
+}
+
+// A Group grants a role to the users of an auth provider whose attribute key
+// has the value of its properties. A group without key applies to all users
+// of the auth provider, i.e. it grants their minimum role.
+type Group struct {
+	Props    GroupProperties `json:"props"`
+	RoleName string          `json:"roleName"`
+}
+
+// GroupProperties select the users of a group.
+type GroupProperties struct {
+	ID             string `json:"id,omitempty"`
+	AuthProviderID string `json:"authProviderId"`
+	Key            string `json:"key,omitempty"`
+	Value          string `json:"value,omitempty"`
+}
+
+func (c *client) GetAuthProvider(ctx context.Context, id string) (*AuthProvider, error) {
+	out := &AuthProvider{}
+	err := c.Do(ctx, http.MethodGet, "/v1/authProviders/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) CreateAuthProvider(ctx context.Context, p *AuthProvider) (*AuthProvider, error) {
+	out := &AuthProvider{}
+	err := c.Do(ctx, http.MethodPost, "/v1/authProviders", p, out)
+	return out, err
+}
+
+func (c *client) UpdateAuthProvider(ctx context.Context, p *AuthProvider) error {
+	return c.Do(ctx, http.MethodPut, "/v1/authProviders/"+url.PathEscape(p.ID), p, nil)
+}
+
+func (c *client) DeleteAuthProvider(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/v1/authProviders/"+url.PathEscape(id), nil, nil)
+}
+
+func (c *client) ListGroups(ctx context.Context, authProviderID string) ([]Group, error) {
+	out := struct {
+		Groups []Group `json:"groups"`
+	}{}
+	q := url.Values{"authProviderId": []string{authProviderID}}
+	err := c.Do(ctx, http.MethodGet, "/v1/groups?"+q.Encode(), nil, &out)
+	return out.Groups, err
+}
+
+// UpdateGroups replaces the previous groups with the required ones. Groups
+// are matched by their properties, so unchanged groups are kept.
+func (c *client) UpdateGroups(ctx context.Context, previous, required []Group) error {
+	in := struct {
+		PreviousGroups []Group `json:"previousGroups"`
+		RequiredGroups []Group `json:"requiredGroups"`
+	}{PreviousGroups: previous, RequiredGroups: required}
+	return c.Do(ctx, http.MethodPost, "/v1/groupsbatch", in, nil)
+}
@@ -0,0 +1,241 @@
+package central
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// External backup types supported by Central.
+const (
+	ExternalBackupTypeS3 = "s3"
+)
+
+const (
+	errRestoreArchive = "cannot read backup archive"
+	errRestoreFormat  = "backup archive matches no restore format of central"
+	errRestoreRequest = "cannot send central restore request"
+)
+
+// ExternalBackupAPI manages the external backup integrations of Central, which
+// back up its database to an object store.
+type ExternalBackupAPI interface {
+	GetExternalBackup(ctx context.Context, id string) (*ExternalBackup, error)
+	CreateExternalBackup(ctx context.Context, b *ExternalBackup) (*ExternalBackup, error)
+	UpdateExternalBackup(ctx context.Context, b *ExternalBackup) error
+	TriggerExternalBackup(ctx context.Context, id string) error
+	DeleteExternalBackup(ctx context.Context, id string) error
+}
+
+// RestoreAPI restores the database of Central.
+type RestoreAPI interface {
+	RestoreDatabase(ctx context.Context, archive io.ReaderAt, size int64) error
+}
+
+// An ExternalBackup is an integration that backs up the database of Central
+// to an object store. Central masks the credentials of the integrations it
+// returns.
+type ExternalBackup struct {
+	ID            string    `json:"id,omitempty"`
+	Name          string    `json:"name"`
+	Type          string    `json:"type"`
+	Schedule      *Schedule `json:"schedule,omitempty"`
+	BackupsToKeep int32     `json:"backupsToKeep"`
+	S3            *S3Config `json:"s3,omitempty"`
+}
+
+// An S3Config configures the bucket of an S3 compatible object store backups
+// are written to. Central names backups backup_<time>.zip below the object
+// prefix.
+type S3Config struct {
+	Bucket          string `json:"bucket"`
+	UseIAM          bool   `json:"useIam,omitempty"`
+	AccessKeyID     string `json:"accessKeyId,omitempty"`
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+	Region          string `json:"region,omitempty"`
+	ObjectPrefix    string `json:"objectPrefix,omitempty"`
+	Endpoint        string `json:"endpoint,omitempty"`
+}
+
+func (c *client) GetExternalBackup(ctx context.Context, id string) (*ExternalBackup, error) {
+	out := &ExternalBackup{}
+	err := c.Do(ctx, http.MethodGet, "/v1/externalbackups/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) CreateExternalBackup(ctx context.Context, b *ExternalBackup) (*ExternalBackup, error) {
+	out := &ExternalBackup{}
+	err := c.Do(ctx, http.MethodPost, "/v1/externalbackups", b, out)
+	return out, err
+}
+
+// UpdateExternalBackup updates the integration including its credentials.
+func (c *client) UpdateExternalBackup(ctx context.Context, b *ExternalBackup) error {
+	in := struct {
+		ExternalBackup *ExternalBackup `json:"externalBackup"`
+		UpdatePassword bool            `json:"updatePassword"`
+	}{ExternalBackup: b, UpdatePassword: true}
+	return c.Do(ctx, http.MethodPatch, "/v1/externalbackups/"+url.PathEscape(b.ID), in, nil)
+}
+
+// TriggerExternalBackup backs up the database of Central through the supplied
+// integration. It returns once the backup has been written.
+func (c *client) TriggerExternalBackup(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodPost, "/v1/externalbackups/"+url.PathEscape(id), nil, nil)
+}
+
+func (c *client) DeleteExternalBackup(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/v1/externalbackups/"+url.PathEscape(id), nil, nil)
+}
+
+// Encodings of the files of a database restore.
+const (
+	restoreEncodingUncompressed = "UNCOMPREESSED" // Sic, as named by Central.
+	restoreEncodingDeflated     = "DEFLATED"
+)
+
+// restoreCapabilities are the database export formats and file encodings
+// Central accepts for restores.
+type restoreCapabilities struct {
+	Formats []struct {
+		FormatName string              `json:"formatName"`
+		Files      []restoreFormatFile `json:"files"`
+	} `json:"formats"`
+	SupportedEncodings []string `json:"supportedEncodings"`
+}
+
+// A restoreFormatFile is a file of a database export format.
+type restoreFormatFile struct {
+	Name     string `json:"name"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// A restoreFile is a file of a database restore.
+type restoreFile struct {
+	zip         *zip.File
+	encoding    string
+	encodedSize int64
+}
+
+// RestoreDatabase replaces the database of Central with the supplied backup
+// archive, as written by an external backup. It returns once Central has
+// restored the database.
+func (c *client) RestoreDatabase(ctx context.Context, archive io.ReaderAt, size int64) error {
+	caps := &restoreCapabilities{}
+	if err := c.Do(ctx, http.MethodGet, "/v1/db/exportcaps", nil, caps); err != nil {
+		return err
+	}
+	deflate := false
+	for _, e := range caps.SupportedEncodings {
+		deflate = deflate || e == restoreEncodingDeflated
+	}
+
+	zr, err := zip.NewReader(archive, size)
+	if err != nil {
+		return errors.Wrap(err, errRestoreArchive)
+	}
+	files := map[string]restoreFile{}
+	for _, f := range zr.File {
+		if strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		rf := restoreFile{zip: f, encoding: restoreEncodingUncompressed, encodedSize: int64(f.UncompressedSize64)}
+		if f.Method == zip.Deflate && deflate {
+			rf.encoding, rf.encodedSize = restoreEncodingDeflated, int64(f.CompressedSize64)
+		}
+		files[f.Name] = rf
+	}
+
+	// The body of a restore is the header followed by the data of the files
+	// of the export format, in the order of the manifest in the header.
+	for _, format := range caps.Formats {
+		if ordered, ok := formatFiles(format.Files, files); ok {
+			return c.restore(ctx, format.FormatName, ordered)
+		}
+	}
+	return errors.New(errRestoreFormat)
+}
+
+// formatFiles returns the supplied archive files in the order of the files of
+// an export format, or false if the archive is not in this format.
+func formatFiles(format []restoreFormatFile, files map[string]restoreFile) ([]restoreFile, bool) {
+	ordered := make([]restoreFile, 0, len(files))
+	for _, ff := range format {
+		f, ok := files[ff.Name]
+		if !ok {
+			if !ff.Optional {
+				return nil, false
+			}
+			continue
+		}
+		ordered = append(ordered, f)
+	}
+	return ordered, len(ordered) == len(files)
+}
+
+func (c *client) restore(ctx context.Context, format string, files []restoreFile) error {
+	header := encodeRestoreHeader(format, files)
+	readers := []io.Reader{bytes.NewReader(header)}
+	for _, f := range files {
+		var r io.Reader
+		var err error
+		if f.encoding == restoreEncodingDeflated {
+			r, err = f.zip.OpenRaw()
+		} else {
+			r, err = f.zip.Open()
+		}
+		if err != nil {
+			return errors.Wrap(err, errRestoreArchive)
+		}
+		readers = append(readers, r)
+	}
+
+	q := url.Values{"headerLength": []string{strconv.Itoa(len(header))}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Endpoint+"/db/v2/restore?"+q.Encode(), io.MultiReader(readers...))
+	if err != nil {
+		return errors.Wrap(err, errRestoreRequest)
+	}
+	_, err = c.Send(req)
+	return err
+}
+
+// encodeRestoreHeader returns the protobuf encoded DBRestoreRequestHeader of a
+// restore of the supplied files in the supplied export format.
+func encodeRestoreHeader(format string, files []restoreFile) []byte {
+	var manifest []byte
+	for _, f := range files {
+		var file []byte
+		file = protowire.AppendTag(file, 1, protowire.BytesType)
+		file = protowire.AppendString(file, f.zip.Name)
+		file = protowire.AppendTag(file, 2, protowire.VarintType)
+		if f.encoding == restoreEncodingDeflated {
+			file = protowire.AppendVarint(file, 2)
+		} else {
+			file = protowire.AppendVarint(file, 1)
+		}
+		file = protowire.AppendTag(file, 3, protowire.VarintType)
+		file = protowire.AppendVarint(file, uint64(f.encodedSize))
+		file = protowire.AppendTag(file, 4, protowire.VarintType)
+		file = protowire.AppendVarint(file, f.zip.UncompressedSize64)
+		file = protowire.AppendTag(file, 5, protowire.Fixed32Type)
+		file = protowire.AppendFixed32(file, f.zip.CRC32)
+
+		manifest = protowire.AppendTag(manifest, 1, protowire.BytesType)
+		manifest = protowire.AppendBytes(manifest, file)
+	}
+
+	var header []byte
+	header = protowire.AppendTag(header, 1, protowire.BytesType)
+	header = protowire.AppendString(header, format)
+	header = protowire.AppendTag(header, 2, protowire.BytesType)
+	header = protowire.AppendBytes(header, manifest)
+	return header
+}
@@ -0,0 +1,68 @@
+package central
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestRestoreDatabase(t *testing.T) {
+	archive := &bytes.Buffer{}
+	zw := zip.NewWriter(archive)
+	for _, f := range []struct{ name, data string }{{"postgres.dump", "dump"}, {"migration_version.yaml", "seq: 1"}} {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Store})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(f.data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var format, body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/db/exportcaps":
+			_, _ = w.Write([]byte(`{"formats":[
+				{"formatName":"rocksdb","files":[{"name":"rocks.db"}]},
+				{"formatName":"postgres","files":[{"name":"migration_version.yaml"},{"name":"postgres.dump"},{"name":"keys","optional":true}]}
+			],"supportedEncodings":["UNCOMPREESSED","DEFLATED"]}`))
+		case "/db/v2/restore":
+			n, err := strconv.Atoi(r.URL.Query().Get("headerLength"))
+			if err != nil {
+				t.Fatalf("\nc.RestoreDatabase(...): invalid header length: %v\n", err)
+			}
+			b, _ := io.ReadAll(r.Body)
+			header := b[:n]
+			// The export format is the first field of the header.
+			_, _, l := protowire.ConsumeTag(header)
+			v, _ := protowire.ConsumeString(header[l:])
+			format, body = v, string(b[n:])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newClient(srv.URL, srv.Client())
+	if err := c.RestoreDatabase(context.Background(), bytes.NewReader(archive.Bytes()), int64(archive.Len())); err != nil {
+		t.Fatalf("\nc.RestoreDatabase(...): unexpected error: %v\n", err)
+	}
+	if format != "postgres" {
+		t.Errorf("\nc.RestoreDatabase(...): want format %q, got %q\n", "postgres", format)
+	}
+	// Files are sent in the order of the export format.
+	if want := "seq: 1dump"; body != want {
+		t.Errorf("\nc.RestoreDatabase(...): want body %q, got %q\n", want, body)
+	}
+}
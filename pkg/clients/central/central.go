@@ -0,0 +1,87 @@
+// Package central contains a client for the API of a RHACS Central instance.
+package central
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+
+	"github.com/stehessel/provider-redhat/pkg/clients/httpapi"
+)
+
+//go:generate go run github.com/matryer/moq@v0.3.1 -out client_moq.go . InitBundleAPI APITokenAPI PolicyAPI NotifierAPI AuthProviderAPI GroupAPI RoleAPI PermissionSetAPI AccessScopeAPI ImageIntegrationAPI CollectionAPI ReportConfigurationAPI ExternalBackupAPI RestoreAPI DeclarativeConfigHealthAPI
+
+// ErrNewClient represents an error to create a new Central client.
+const ErrNewClient = "cannot create central client"
+
+// Client is a client for the API of a Central instance.
+type Client interface {
+	InitBundleAPI
+	APITokenAPI
+	PolicyAPI
+	NotifierAPI
+	AuthProviderAPI
+	GroupAPI
+	RoleAPI
+	PermissionSetAPI
+	AccessScopeAPI
+	ImageIntegrationAPI
+	CollectionAPI
+	ReportConfigurationAPI
+	ExternalBackupAPI
+	RestoreAPI
+	DeclarativeConfigHealthAPI
+}
+
+// NewClient creates a new client for the Central API served at the supplied
+// endpoint. Requests are authenticated with an access token obtained from the
+// supplied OCM refresh token.
+func NewClient(token string, endpoint string) (Client, error) {
+	auth, err := fleetmanager.NewOCMAuth(fleetmanager.OCMOption{RefreshToken: token})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create central authentication")
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse central endpoint")
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("central endpoint %q is not an absolute URL", endpoint)
+	}
+
+	return newClient(strings.TrimSuffix(u.String(), "/"), httpapi.NewHTTPClient(auth)), nil
+}
+
+type client struct {
+	*httpapi.Client
+}
+
+func newClient(endpoint string, h *http.Client) *client {
+	return &client{Client: &httpapi.Client{Name: "central", Endpoint: endpoint, HTTP: h, ErrorMessage: errorMessage}}
+}
+
+// An APIError is returned for requests the Central API did not accept.
+type APIError = httpapi.APIError
+
+// IsNotFound returns true if the supplied error indicates that the requested
+// Central API object does not exist.
+func IsNotFound(err error) bool {
+	return httpapi.IsNotFound(err)
+}
+
+// errorMessage returns the message of an error of the Central API.
+func errorMessage(body []byte) (string, string) {
+	msg := struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}{}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return "", ""
+	}
+	return "", msg.Message
+}
@@ -0,0 +1,71 @@
+package central
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDo(t *testing.T) {
+	cases := []struct {
+		name     string
+		status   int
+		body     string
+		want     map[string]string
+		notFound bool
+		err      bool
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body:   `{"name":"out"}`,
+			want:   map[string]string{"name": "out"},
+		},
+		{
+			name:     "not found",
+			status:   http.StatusNotFound,
+			body:     `{"error":"not found","message":"init bundle not found"}`,
+			notFound: true,
+			err:      true,
+		},
+		{
+			name:   "server error",
+			status: http.StatusInternalServerError,
+			body:   "boom",
+			err:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				in := map[string]string{}
+				if err := json.NewDecoder(r.Body).Decode(&in); err != nil || in["name"] != "in" {
+					t.Errorf("\nc.do(...): unexpected request body %v: %v\n", in, err)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			c := newClient(srv.URL, srv.Client())
+			got := map[string]string{}
+			err := c.Do(context.Background(), http.MethodPost, "/v1/test", map[string]string{"name": "in"}, &got)
+			if (err != nil) != tc.err {
+				t.Errorf("\nc.do(...): unexpected error: %v\n", err)
+			}
+			if diff := cmp.Diff(tc.notFound, IsNotFound(err)); diff != "" {
+				t.Errorf("\nIsNotFound(...): -want, +got:\n%s\n", diff)
+			}
+			if tc.want != nil {
+				if diff := cmp.Diff(tc.want, got); diff != "" {
+					t.Errorf("\nc.do(...): -want, +got:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
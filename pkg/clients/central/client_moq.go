@@ -0,0 +1,3034 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package central
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// Ensure, that InitBundleAPIMock does implement InitBundleAPI.
+// If this is not the case, regenerate this file with moq.
+var _ InitBundleAPI = &InitBundleAPIMock{}
+
+// InitBundleAPIMock is a mock implementation of InitBundleAPI.
+//
+//	func TestSomethingThatUsesInitBundleAPI(t *testing.T) {
+//
+//		// make and configure a mocked InitBundleAPI
+//		mockedInitBundleAPI := &InitBundleAPIMock{
+//			GenerateInitBundleFunc: func(ctx context.Context, name string) (*InitBundle, error) {
+//				panic("mock out the GenerateInitBundle method")
+//			},
+//			ListInitBundlesFunc: func(ctx context.Context) ([]InitBundleMeta, error) {
+//				panic("mock out the ListInitBundles method")
+//			},
+//			RevokeInitBundlesFunc: func(ctx context.Context, ids []string, confirmImpactedClusterIDs []string) error {
+//				panic("mock out the RevokeInitBundles method")
+//			},
+//		}
+//
+//		// use mockedInitBundleAPI in code that requires InitBundleAPI
+//		// and then make assertions.
+//
+//	}
+type InitBundleAPIMock struct {
+	// GenerateInitBundleFunc mocks the GenerateInitBundle method.
+	GenerateInitBundleFunc func(ctx context.Context, name string) (*InitBundle, error)
+
+	// ListInitBundlesFunc mocks the ListInitBundles method.
+	ListInitBundlesFunc func(ctx context.Context) ([]InitBundleMeta, error)
+
+	// RevokeInitBundlesFunc mocks the RevokeInitBundles method.
+	RevokeInitBundlesFunc func(ctx context.Context, ids []string, confirmImpactedClusterIDs []string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GenerateInitBundle holds details about calls to the GenerateInitBundle method.
+		GenerateInitBundle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// ListInitBundles holds details about calls to the ListInitBundles method.
+		ListInitBundles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// RevokeInitBundles holds details about calls to the RevokeInitBundles method.
+		RevokeInitBundles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ids is the ids argument value.
+			Ids []string
+			// ConfirmImpactedClusterIDs is the confirmImpactedClusterIDs argument value.
+			ConfirmImpactedClusterIDs []string
+		}
+	}
+	lockGenerateInitBundle sync.RWMutex
+	lockListInitBundles    sync.RWMutex
+	lockRevokeInitBundles  sync.RWMutex
+}
+
+// GenerateInitBundle calls GenerateInitBundleFunc.
+func (mock *InitBundleAPIMock) GenerateInitBundle(ctx context.Context, name string) (*InitBundle, error) {
+	if mock.GenerateInitBundleFunc == nil {
+		panic("InitBundleAPIMock.GenerateInitBundleFunc: method is nil but InitBundleAPI.GenerateInitBundle was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGenerateInitBundle.Lock()
+	mock.calls.GenerateInitBundle = append(mock.calls.GenerateInitBundle, callInfo)
+	mock.lockGenerateInitBundle.Unlock()
+	return mock.GenerateInitBundleFunc(ctx, name)
+}
+
+// GenerateInitBundleCalls gets all the calls that were made to GenerateInitBundle.
+// Check the length with:
+//
+//	len(mockedInitBundleAPI.GenerateInitBundleCalls())
+func (mock *InitBundleAPIMock) GenerateInitBundleCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGenerateInitBundle.RLock()
+	calls = mock.calls.GenerateInitBundle
+	mock.lockGenerateInitBundle.RUnlock()
+	return calls
+}
+
+// ListInitBundles calls ListInitBundlesFunc.
+func (mock *InitBundleAPIMock) ListInitBundles(ctx context.Context) ([]InitBundleMeta, error) {
+	if mock.ListInitBundlesFunc == nil {
+		panic("InitBundleAPIMock.ListInitBundlesFunc: method is nil but InitBundleAPI.ListInitBundles was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListInitBundles.Lock()
+	mock.calls.ListInitBundles = append(mock.calls.ListInitBundles, callInfo)
+	mock.lockListInitBundles.Unlock()
+	return mock.ListInitBundlesFunc(ctx)
+}
+
+// ListInitBundlesCalls gets all the calls that were made to ListInitBundles.
+// Check the length with:
+//
+//	len(mockedInitBundleAPI.ListInitBundlesCalls())
+func (mock *InitBundleAPIMock) ListInitBundlesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListInitBundles.RLock()
+	calls = mock.calls.ListInitBundles
+	mock.lockListInitBundles.RUnlock()
+	return calls
+}
+
+// RevokeInitBundles calls RevokeInitBundlesFunc.
+func (mock *InitBundleAPIMock) RevokeInitBundles(ctx context.Context, ids []string, confirmImpactedClusterIDs []string) error {
+	if mock.RevokeInitBundlesFunc == nil {
+		panic("InitBundleAPIMock.RevokeInitBundlesFunc: method is nil but InitBundleAPI.RevokeInitBundles was just called")
+	}
+	callInfo := struct {
+		Ctx                       context.Context
+		Ids                       []string
+		ConfirmImpactedClusterIDs []string
+	}{
+		Ctx:                       ctx,
+		Ids:                       ids,
+		ConfirmImpactedClusterIDs: confirmImpactedClusterIDs,
+	}
+	mock.lockRevokeInitBundles.Lock()
+	mock.calls.RevokeInitBundles = append(mock.calls.RevokeInitBundles, callInfo)
+	mock.lockRevokeInitBundles.Unlock()
+	return mock.RevokeInitBundlesFunc(ctx, ids, confirmImpactedClusterIDs)
+}
+
+// RevokeInitBundlesCalls gets all the calls that were made to RevokeInitBundles.
+// Check the length with:
+//
+//	len(mockedInitBundleAPI.RevokeInitBundlesCalls())
+func (mock *InitBundleAPIMock) RevokeInitBundlesCalls() []struct {
+	Ctx                       context.Context
+	Ids                       []string
+	ConfirmImpactedClusterIDs []string
+} {
+	var calls []struct {
+		Ctx                       context.Context
+		Ids                       []string
+		ConfirmImpactedClusterIDs []string
+	}
+	mock.lockRevokeInitBundles.RLock()
+	calls = mock.calls.RevokeInitBundles
+	mock.lockRevokeInitBundles.RUnlock()
+	return calls
+}
+
+// Ensure, that APITokenAPIMock does implement APITokenAPI.
+// If this is not the case, regenerate this file with moq.
+var _ APITokenAPI = &APITokenAPIMock{}
+
+// APITokenAPIMock is a mock implementation of APITokenAPI.
+//
+//	func TestSomethingThatUsesAPITokenAPI(t *testing.T) {
+//
+//		// make and configure a mocked APITokenAPI
+//		mockedAPITokenAPI := &APITokenAPIMock{
+//			GenerateAPITokenFunc: func(ctx context.Context, req GenerateAPITokenRequest) (*APIToken, error) {
+//				panic("mock out the GenerateAPIToken method")
+//			},
+//			GetAPITokenFunc: func(ctx context.Context, id string) (*APITokenMeta, error) {
+//				panic("mock out the GetAPIToken method")
+//			},
+//			RevokeAPITokenFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the RevokeAPIToken method")
+//			},
+//		}
+//
+//		// use mockedAPITokenAPI in code that requires APITokenAPI
+//		// and then make assertions.
+//
+//	}
+type APITokenAPIMock struct {
+	// GenerateAPITokenFunc mocks the GenerateAPIToken method.
+	GenerateAPITokenFunc func(ctx context.Context, req GenerateAPITokenRequest) (*APIToken, error)
+
+	// GetAPITokenFunc mocks the GetAPIToken method.
+	GetAPITokenFunc func(ctx context.Context, id string) (*APITokenMeta, error)
+
+	// RevokeAPITokenFunc mocks the RevokeAPIToken method.
+	RevokeAPITokenFunc func(ctx context.Context, id string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GenerateAPIToken holds details about calls to the GenerateAPIToken method.
+		GenerateAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req GenerateAPITokenRequest
+		}
+		// GetAPIToken holds details about calls to the GetAPIToken method.
+		GetAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// RevokeAPIToken holds details about calls to the RevokeAPIToken method.
+		RevokeAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+	}
+	lockGenerateAPIToken sync.RWMutex
+	lockGetAPIToken      sync.RWMutex
+	lockRevokeAPIToken   sync.RWMutex
+}
+
+// GenerateAPIToken calls GenerateAPITokenFunc.
+func (mock *APITokenAPIMock) GenerateAPIToken(ctx context.Context, req GenerateAPITokenRequest) (*APIToken, error) {
+	if mock.GenerateAPITokenFunc == nil {
+		panic("APITokenAPIMock.GenerateAPITokenFunc: method is nil but APITokenAPI.GenerateAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req GenerateAPITokenRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockGenerateAPIToken.Lock()
+	mock.calls.GenerateAPIToken = append(mock.calls.GenerateAPIToken, callInfo)
+	mock.lockGenerateAPIToken.Unlock()
+	return mock.GenerateAPITokenFunc(ctx, req)
+}
+
+// GenerateAPITokenCalls gets all the calls that were made to GenerateAPIToken.
+// Check the length with:
+//
+//	len(mockedAPITokenAPI.GenerateAPITokenCalls())
+func (mock *APITokenAPIMock) GenerateAPITokenCalls() []struct {
+	Ctx context.Context
+	Req GenerateAPITokenRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req GenerateAPITokenRequest
+	}
+	mock.lockGenerateAPIToken.RLock()
+	calls = mock.calls.GenerateAPIToken
+	mock.lockGenerateAPIToken.RUnlock()
+	return calls
+}
+
+// GetAPIToken calls GetAPITokenFunc.
+func (mock *APITokenAPIMock) GetAPIToken(ctx context.Context, id string) (*APITokenMeta, error) {
+	if mock.GetAPITokenFunc == nil {
+		panic("APITokenAPIMock.GetAPITokenFunc: method is nil but APITokenAPI.GetAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetAPIToken.Lock()
+	mock.calls.GetAPIToken = append(mock.calls.GetAPIToken, callInfo)
+	mock.lockGetAPIToken.Unlock()
+	return mock.GetAPITokenFunc(ctx, id)
+}
+
+// GetAPITokenCalls gets all the calls that were made to GetAPIToken.
+// Check the length with:
+//
+//	len(mockedAPITokenAPI.GetAPITokenCalls())
+func (mock *APITokenAPIMock) GetAPITokenCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetAPIToken.RLock()
+	calls = mock.calls.GetAPIToken
+	mock.lockGetAPIToken.RUnlock()
+	return calls
+}
+
+// RevokeAPIToken calls RevokeAPITokenFunc.
+func (mock *APITokenAPIMock) RevokeAPIToken(ctx context.Context, id string) error {
+	if mock.RevokeAPITokenFunc == nil {
+		panic("APITokenAPIMock.RevokeAPITokenFunc: method is nil but APITokenAPI.RevokeAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRevokeAPIToken.Lock()
+	mock.calls.RevokeAPIToken = append(mock.calls.RevokeAPIToken, callInfo)
+	mock.lockRevokeAPIToken.Unlock()
+	return mock.RevokeAPITokenFunc(ctx, id)
+}
+
+// RevokeAPITokenCalls gets all the calls that were made to RevokeAPIToken.
+// Check the length with:
+//
+//	len(mockedAPITokenAPI.RevokeAPITokenCalls())
+func (mock *APITokenAPIMock) RevokeAPITokenCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockRevokeAPIToken.RLock()
+	calls = mock.calls.RevokeAPIToken
+	mock.lockRevokeAPIToken.RUnlock()
+	return calls
+}
+
+// Ensure, that PolicyAPIMock does implement PolicyAPI.
+// If this is not the case, regenerate this file with moq.
+var _ PolicyAPI = &PolicyAPIMock{}
+
+// PolicyAPIMock is a mock implementation of PolicyAPI.
+//
+//	func TestSomethingThatUsesPolicyAPI(t *testing.T) {
+//
+//		// make and configure a mocked PolicyAPI
+//		mockedPolicyAPI := &PolicyAPIMock{
+//			CreatePolicyFunc: func(ctx context.Context, p *Policy) (*Policy, error) {
+//				panic("mock out the CreatePolicy method")
+//			},
+//			DeletePolicyFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeletePolicy method")
+//			},
+//			GetPolicyFunc: func(ctx context.Context, id string) (*Policy, error) {
+//				panic("mock out the GetPolicy method")
+//			},
+//			UpdatePolicyFunc: func(ctx context.Context, p *Policy) error {
+//				panic("mock out the UpdatePolicy method")
+//			},
+//		}
+//
+//		// use mockedPolicyAPI in code that requires PolicyAPI
+//		// and then make assertions.
+//
+//	}
+type PolicyAPIMock struct {
+	// CreatePolicyFunc mocks the CreatePolicy method.
+	CreatePolicyFunc func(ctx context.Context, p *Policy) (*Policy, error)
+
+	// DeletePolicyFunc mocks the DeletePolicy method.
+	DeletePolicyFunc func(ctx context.Context, id string) error
+
+	// GetPolicyFunc mocks the GetPolicy method.
+	GetPolicyFunc func(ctx context.Context, id string) (*Policy, error)
+
+	// UpdatePolicyFunc mocks the UpdatePolicy method.
+	UpdatePolicyFunc func(ctx context.Context, p *Policy) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreatePolicy holds details about calls to the CreatePolicy method.
+		CreatePolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// P is the p argument value.
+			P *Policy
+		}
+		// DeletePolicy holds details about calls to the DeletePolicy method.
+		DeletePolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetPolicy holds details about calls to the GetPolicy method.
+		GetPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdatePolicy holds details about calls to the UpdatePolicy method.
+		UpdatePolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// P is the p argument value.
+			P *Policy
+		}
+	}
+	lockCreatePolicy sync.RWMutex
+	lockDeletePolicy sync.RWMutex
+	lockGetPolicy    sync.RWMutex
+	lockUpdatePolicy sync.RWMutex
+}
+
+// CreatePolicy calls CreatePolicyFunc.
+func (mock *PolicyAPIMock) CreatePolicy(ctx context.Context, p *Policy) (*Policy, error) {
+	if mock.CreatePolicyFunc == nil {
+		panic("PolicyAPIMock.CreatePolicyFunc: method is nil but PolicyAPI.CreatePolicy was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		P   *Policy
+	}{
+		Ctx: ctx,
+		P:   p,
+	}
+	mock.lockCreatePolicy.Lock()
+	mock.calls.CreatePolicy = append(mock.calls.CreatePolicy, callInfo)
+	mock.lockCreatePolicy.Unlock()
+	return mock.CreatePolicyFunc(ctx, p)
+}
+
+// CreatePolicyCalls gets all the calls that were made to CreatePolicy.
+// Check the length with:
+//
+//	len(mockedPolicyAPI.CreatePolicyCalls())
+func (mock *PolicyAPIMock) CreatePolicyCalls() []struct {
+	Ctx context.Context
+	P   *Policy
+} {
+	var calls []struct {
+		Ctx context.Context
+		P   *Policy
+	}
+	mock.lockCreatePolicy.RLock()
+	calls = mock.calls.CreatePolicy
+	mock.lockCreatePolicy.RUnlock()
+	return calls
+}
+
+// DeletePolicy calls DeletePolicyFunc.
+func (mock *PolicyAPIMock) DeletePolicy(ctx context.Context, id string) error {
+	if mock.DeletePolicyFunc == nil {
+		panic("PolicyAPIMock.DeletePolicyFunc: method is nil but PolicyAPI.DeletePolicy was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeletePolicy.Lock()
+	mock.calls.DeletePolicy = append(mock.calls.DeletePolicy, callInfo)
+	mock.lockDeletePolicy.Unlock()
+	return mock.DeletePolicyFunc(ctx, id)
+}
+
+// DeletePolicyCalls gets all the calls that were made to DeletePolicy.
+// Check the length with:
+//
+//	len(mockedPolicyAPI.DeletePolicyCalls())
+func (mock *PolicyAPIMock) DeletePolicyCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeletePolicy.RLock()
+	calls = mock.calls.DeletePolicy
+	mock.lockDeletePolicy.RUnlock()
+	return calls
+}
+
+// GetPolicy calls GetPolicyFunc.
+func (mock *PolicyAPIMock) GetPolicy(ctx context.Context, id string) (*Policy, error) {
+	if mock.GetPolicyFunc == nil {
+		panic("PolicyAPIMock.GetPolicyFunc: method is nil but PolicyAPI.GetPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetPolicy.Lock()
+	mock.calls.GetPolicy = append(mock.calls.GetPolicy, callInfo)
+	mock.lockGetPolicy.Unlock()
+	return mock.GetPolicyFunc(ctx, id)
+}
+
+// GetPolicyCalls gets all the calls that were made to GetPolicy.
+// Check the length with:
+//
+//	len(mockedPolicyAPI.GetPolicyCalls())
+func (mock *PolicyAPIMock) GetPolicyCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetPolicy.RLock()
+	calls = mock.calls.GetPolicy
+	mock.lockGetPolicy.RUnlock()
+	return calls
+}
+
+// UpdatePolicy calls UpdatePolicyFunc.
+func (mock *PolicyAPIMock) UpdatePolicy(ctx context.Context, p *Policy) error {
+	if mock.UpdatePolicyFunc == nil {
+		panic("PolicyAPIMock.UpdatePolicyFunc: method is nil but PolicyAPI.UpdatePolicy was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		P   *Policy
+	}{
+		Ctx: ctx,
+		P:   p,
+	}
+	mock.lockUpdatePolicy.Lock()
+	mock.calls.UpdatePolicy = append(mock.calls.UpdatePolicy, callInfo)
+	mock.lockUpdatePolicy.Unlock()
+	return mock.UpdatePolicyFunc(ctx, p)
+}
+
+// UpdatePolicyCalls gets all the calls that were made to UpdatePolicy.
+// Check the length with:
+//
+//	len(mockedPolicyAPI.UpdatePolicyCalls())
+func (mock *PolicyAPIMock) UpdatePolicyCalls() []struct {
+	Ctx context.Context
+	P   *Policy
+} {
+	var calls []struct {
+		Ctx context.Context
+		P   *Policy
+	}
+	mock.lockUpdatePolicy.RLock()
+	calls = mock.calls.UpdatePolicy
+	mock.lockUpdatePolicy.RUnlock()
+	return calls
+}
+
+// Ensure, that NotifierAPIMock does implement NotifierAPI.
+// If this is not the case, regenerate this file with moq.
+var _ NotifierAPI = &NotifierAPIMock{}
+
+// NotifierAPIMock is a mock implementation of NotifierAPI.
+//
+//	func TestSomethingThatUsesNotifierAPI(t *testing.T) {
+//
+//		// make and configure a mocked NotifierAPI
+//		mockedNotifierAPI := &NotifierAPIMock{
+//			CreateNotifierFunc: func(ctx context.Context, n *Notifier) (*Notifier, error) {
+//				panic("mock out the CreateNotifier method")
+//			},
+//			DeleteNotifierFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteNotifier method")
+//			},
+//			GetNotifierFunc: func(ctx context.Context, id string) (*Notifier, error) {
+//				panic("mock out the GetNotifier method")
+//			},
+//			UpdateNotifierFunc: func(ctx context.Context, n *Notifier) error {
+//				panic("mock out the UpdateNotifier method")
+//			},
+//		}
+//
+//		// use mockedNotifierAPI in code that requires NotifierAPI
+//		// and then make assertions.
+//
+//	}
+type NotifierAPIMock struct {
+	// CreateNotifierFunc mocks the CreateNotifier method.
+	CreateNotifierFunc func(ctx context.Context, n *Notifier) (*Notifier, error)
+
+	// DeleteNotifierFunc mocks the DeleteNotifier method.
+	DeleteNotifierFunc func(ctx context.Context, id string) error
+
+	// GetNotifierFunc mocks the GetNotifier method.
+	GetNotifierFunc func(ctx context.Context, id string) (*Notifier, error)
+
+	// UpdateNotifierFunc mocks the UpdateNotifier method.
+	UpdateNotifierFunc func(ctx context.Context, n *Notifier) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateNotifier holds details about calls to the CreateNotifier method.
+		CreateNotifier []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// N is the n argument value.
+			N *Notifier
+		}
+		// DeleteNotifier holds details about calls to the DeleteNotifier method.
+		DeleteNotifier []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetNotifier holds details about calls to the GetNotifier method.
+		GetNotifier []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateNotifier holds details about calls to the UpdateNotifier method.
+		UpdateNotifier []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// N is the n argument value.
+			N *Notifier
+		}
+	}
+	lockCreateNotifier sync.RWMutex
+	lockDeleteNotifier sync.RWMutex
+	lockGetNotifier    sync.RWMutex
+	lockUpdateNotifier sync.RWMutex
+}
+
+// CreateNotifier calls CreateNotifierFunc.
+func (mock *NotifierAPIMock) CreateNotifier(ctx context.Context, n *Notifier) (*Notifier, error) {
+	if mock.CreateNotifierFunc == nil {
+		panic("NotifierAPIMock.CreateNotifierFunc: method is nil but NotifierAPI.CreateNotifier was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		N   *Notifier
+	}{
+		Ctx: ctx,
+		N:   n,
+	}
+	mock.lockCreateNotifier.Lock()
+	mock.calls.CreateNotifier = append(mock.calls.CreateNotifier, callInfo)
+	mock.lockCreateNotifier.Unlock()
+	return mock.CreateNotifierFunc(ctx, n)
+}
+
+// CreateNotifierCalls gets all the calls that were made to CreateNotifier.
+// Check the length with:
+//
+//	len(mockedNotifierAPI.CreateNotifierCalls())
+func (mock *NotifierAPIMock) CreateNotifierCalls() []struct {
+	Ctx context.Context
+	N   *Notifier
+} {
+	var calls []struct {
+		Ctx context.Context
+		N   *Notifier
+	}
+	mock.lockCreateNotifier.RLock()
+	calls = mock.calls.CreateNotifier
+	mock.lockCreateNotifier.RUnlock()
+	return calls
+}
+
+// DeleteNotifier calls DeleteNotifierFunc.
+func (mock *NotifierAPIMock) DeleteNotifier(ctx context.Context, id string) error {
+	if mock.DeleteNotifierFunc == nil {
+		panic("NotifierAPIMock.DeleteNotifierFunc: method is nil but NotifierAPI.DeleteNotifier was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteNotifier.Lock()
+	mock.calls.DeleteNotifier = append(mock.calls.DeleteNotifier, callInfo)
+	mock.lockDeleteNotifier.Unlock()
+	return mock.DeleteNotifierFunc(ctx, id)
+}
+
+// DeleteNotifierCalls gets all the calls that were made to DeleteNotifier.
+// Check the length with:
+//
+//	len(mockedNotifierAPI.DeleteNotifierCalls())
+func (mock *NotifierAPIMock) DeleteNotifierCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteNotifier.RLock()
+	calls = mock.calls.DeleteNotifier
+	mock.lockDeleteNotifier.RUnlock()
+	return calls
+}
+
+// GetNotifier calls GetNotifierFunc.
+func (mock *NotifierAPIMock) GetNotifier(ctx context.Context, id string) (*Notifier, error) {
+	if mock.GetNotifierFunc == nil {
+		panic("NotifierAPIMock.GetNotifierFunc: method is nil but NotifierAPI.GetNotifier was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetNotifier.Lock()
+	mock.calls.GetNotifier = append(mock.calls.GetNotifier, callInfo)
+	mock.lockGetNotifier.Unlock()
+	return mock.GetNotifierFunc(ctx, id)
+}
+
+// GetNotifierCalls gets all the calls that were made to GetNotifier.
+// Check the length with:
+//
+//	len(mockedNotifierAPI.GetNotifierCalls())
+func (mock *NotifierAPIMock) GetNotifierCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetNotifier.RLock()
+	calls = mock.calls.GetNotifier
+	mock.lockGetNotifier.RUnlock()
+	return calls
+}
+
+// UpdateNotifier calls UpdateNotifierFunc.
+func (mock *NotifierAPIMock) UpdateNotifier(ctx context.Context, n *Notifier) error {
+	if mock.UpdateNotifierFunc == nil {
+		panic("NotifierAPIMock.UpdateNotifierFunc: method is nil but NotifierAPI.UpdateNotifier was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		N   *Notifier
+	}{
+		Ctx: ctx,
+		N:   n,
+	}
+	mock.lockUpdateNotifier.Lock()
+	mock.calls.UpdateNotifier = append(mock.calls.UpdateNotifier, callInfo)
+	mock.lockUpdateNotifier.Unlock()
+	return mock.UpdateNotifierFunc(ctx, n)
+}
+
+// UpdateNotifierCalls gets all the calls that were made to UpdateNotifier.
+// Check the length with:
+//
+//	len(mockedNotifierAPI.UpdateNotifierCalls())
+func (mock *NotifierAPIMock) UpdateNotifierCalls() []struct {
+	Ctx context.Context
+	N   *Notifier
+} {
+	var calls []struct {
+		Ctx context.Context
+		N   *Notifier
+	}
+	mock.lockUpdateNotifier.RLock()
+	calls = mock.calls.UpdateNotifier
+	mock.lockUpdateNotifier.RUnlock()
+	return calls
+}
+
+// Ensure, that AuthProviderAPIMock does implement AuthProviderAPI.
+// If this is not the case, regenerate this file with moq.
+var _ AuthProviderAPI = &AuthProviderAPIMock{}
+
+// AuthProviderAPIMock is a mock implementation of AuthProviderAPI.
+//
+//	func TestSomethingThatUsesAuthProviderAPI(t *testing.T) {
+//
+//		// make and configure a mocked AuthProviderAPI
+//		mockedAuthProviderAPI := &AuthProviderAPIMock{
+//			CreateAuthProviderFunc: func(ctx context.Context, p *AuthProvider) (*AuthProvider, error) {
+//				panic("mock out the CreateAuthProvider method")
+//			},
+//			DeleteAuthProviderFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteAuthProvider method")
+//			},
+//			GetAuthProviderFunc: func(ctx context.Context, id string) (*AuthProvider, error) {
+//				panic("mock out the GetAuthProvider method")
+//			},
+//			UpdateAuthProviderFunc: func(ctx context.Context, p *AuthProvider) error {
+//				panic("mock out the UpdateAuthProvider method")
+//			},
+//		}
+//
+//		// use mockedAuthProviderAPI in code that requires AuthProviderAPI
+//		// and then make assertions.
+//
+//	}
+type AuthProviderAPIMock struct {
+	// CreateAuthProviderFunc mocks the CreateAuthProvider method.
+	CreateAuthProviderFunc func(ctx context.Context, p *AuthProvider) (*AuthProvider, error)
+
+	// DeleteAuthProviderFunc mocks the DeleteAuthProvider method.
+	DeleteAuthProviderFunc func(ctx context.Context, id string) error
+
+	// GetAuthProviderFunc mocks the GetAuthProvider method.
+	GetAuthProviderFunc func(ctx context.Context, id string) (*AuthProvider, error)
+
+	// UpdateAuthProviderFunc mocks the UpdateAuthProvider method.
+	UpdateAuthProviderFunc func(ctx context.Context, p *AuthProvider) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateAuthProvider holds details about calls to the CreateAuthProvider method.
+		CreateAuthProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// P is the p argument value.
+			P *AuthProvider
+		}
+		// DeleteAuthProvider holds details about calls to the DeleteAuthProvider method.
+		DeleteAuthProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetAuthProvider holds details about calls to the GetAuthProvider method.
+		GetAuthProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateAuthProvider holds details about calls to the UpdateAuthProvider method.
+		UpdateAuthProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// P is the p argument value.
+			P *AuthProvider
+		}
+	}
+	lockCreateAuthProvider sync.RWMutex
+	lockDeleteAuthProvider sync.RWMutex
+	lockGetAuthProvider    sync.RWMutex
+	lockUpdateAuthProvider sync.RWMutex
+}
+
+// CreateAuthProvider calls CreateAuthProviderFunc.
+func (mock *AuthProviderAPIMock) CreateAuthProvider(ctx context.Context, p *AuthProvider) (*AuthProvider, error) {
+	if mock.CreateAuthProviderFunc == nil {
+		panic("AuthProviderAPIMock.CreateAuthProviderFunc: method is nil but AuthProviderAPI.CreateAuthProvider was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		P   *AuthProvider
+	}{
+		Ctx: ctx,
+		P:   p,
+	}
+	mock.lockCreateAuthProvider.Lock()
+	mock.calls.CreateAuthProvider = append(mock.calls.CreateAuthProvider, callInfo)
+	mock.lockCreateAuthProvider.Unlock()
+	return mock.CreateAuthProviderFunc(ctx, p)
+}
+
+// CreateAuthProviderCalls gets all the calls that were made to CreateAuthProvider.
+// Check the length with:
+//
+//	len(mockedAuthProviderAPI.CreateAuthProviderCalls())
+func (mock *AuthProviderAPIMock) CreateAuthProviderCalls() []struct {
+	Ctx context.Context
+	P   *AuthProvider
+} {
+	var calls []struct {
+		Ctx context.Context
+		P   *AuthProvider
+	}
+	mock.lockCreateAuthProvider.RLock()
+	calls = mock.calls.CreateAuthProvider
+	mock.lockCreateAuthProvider.RUnlock()
+	return calls
+}
+
+// DeleteAuthProvider calls DeleteAuthProviderFunc.
+func (mock *AuthProviderAPIMock) DeleteAuthProvider(ctx context.Context, id string) error {
+	if mock.DeleteAuthProviderFunc == nil {
+		panic("AuthProviderAPIMock.DeleteAuthProviderFunc: method is nil but AuthProviderAPI.DeleteAuthProvider was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteAuthProvider.Lock()
+	mock.calls.DeleteAuthProvider = append(mock.calls.DeleteAuthProvider, callInfo)
+	mock.lockDeleteAuthProvider.Unlock()
+	return mock.DeleteAuthProviderFunc(ctx, id)
+}
+
+// DeleteAuthProviderCalls gets all the calls that were made to DeleteAuthProvider.
+// Check the length with:
+//
+//	len(mockedAuthProviderAPI.DeleteAuthProviderCalls())
+func (mock *AuthProviderAPIMock) DeleteAuthProviderCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteAuthProvider.RLock()
+	calls = mock.calls.DeleteAuthProvider
+	mock.lockDeleteAuthProvider.RUnlock()
+	return calls
+}
+
+// GetAuthProvider calls GetAuthProviderFunc.
+func (mock *AuthProviderAPIMock) GetAuthProvider(ctx context.Context, id string) (*AuthProvider, error) {
+	if mock.GetAuthProviderFunc == nil {
+		panic("AuthProviderAPIMock.GetAuthProviderFunc: method is nil but AuthProviderAPI.GetAuthProvider was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetAuthProvider.Lock()
+	mock.calls.GetAuthProvider = append(mock.calls.GetAuthProvider, callInfo)
+	mock.lockGetAuthProvider.Unlock()
+	return mock.GetAuthProviderFunc(ctx, id)
+}
+
+// GetAuthProviderCalls gets all the calls that were made to GetAuthProvider.
+// Check the length with:
+//
+//	len(mockedAuthProviderAPI.GetAuthProviderCalls())
+func (mock *AuthProviderAPIMock) GetAuthProviderCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetAuthProvider.RLock()
+	calls = mock.calls.GetAuthProvider
+	mock.lockGetAuthProvider.RUnlock()
+	return calls
+}
+
+// UpdateAuthProvider calls UpdateAuthProviderFunc.
+func (mock *AuthProviderAPIMock) UpdateAuthProvider(ctx context.Context, p *AuthProvider) error {
+	if mock.UpdateAuthProviderFunc == nil {
+		panic("AuthProviderAPIMock.UpdateAuthProviderFunc: method is nil but AuthProviderAPI.UpdateAuthProvider was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		P   *AuthProvider
+	}{
+		Ctx: ctx,
+		P:   p,
+	}
+	mock.lockUpdateAuthProvider.Lock()
+	mock.calls.UpdateAuthProvider = append(mock.calls.UpdateAuthProvider, callInfo)
+	mock.lockUpdateAuthProvider.Unlock()
+	return mock.UpdateAuthProviderFunc(ctx, p)
+}
+
+// UpdateAuthProviderCalls gets all the calls that were made to UpdateAuthProvider.
+// Check the length with:
+//
+//	len(mockedAuthProviderAPI.UpdateAuthProviderCalls())
+func (mock *AuthProviderAPIMock) UpdateAuthProviderCalls() []struct {
+	Ctx context.Context
+	P   *AuthProvider
+} {
+	var calls []struct {
+		Ctx context.Context
+		P   *AuthProvider
+	}
+	mock.lockUpdateAuthProvider.RLock()
+	calls = mock.calls.UpdateAuthProvider
+	mock.lockUpdateAuthProvider.RUnlock()
+	return calls
+}
+
+// Ensure, that GroupAPIMock does implement GroupAPI.
+// If this is not the case, regenerate this file with moq.
+var _ GroupAPI = &GroupAPIMock{}
+
+// GroupAPIMock is a mock implementation of GroupAPI.
+//
+//	func TestSomethingThatUsesGroupAPI(t *testing.T) {
+//
+//		// make and configure a mocked GroupAPI
+//		mockedGroupAPI := &GroupAPIMock{
+//			ListGroupsFunc: func(ctx context.Context, authProviderID string) ([]Group, error) {
+//				panic("mock out the ListGroups method")
+//			},
+//			UpdateGroupsFunc: func(ctx context.Context, previous []Group, required []Group) error {
+//				panic("mock out the UpdateGroups method")
+//			},
+//		}
+//
+//		// use mockedGroupAPI in code that requires GroupAPI
+//		// and then make assertions.
+//
+//	}
+type GroupAPIMock struct {
+	// ListGroupsFunc mocks the ListGroups method.
+	ListGroupsFunc func(ctx context.Context, authProviderID string) ([]Group, error)
+
+	// UpdateGroupsFunc mocks the UpdateGroups method.
+	UpdateGroupsFunc func(ctx context.Context, previous []Group, required []Group) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListGroups holds details about calls to the ListGroups method.
+		ListGroups []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AuthProviderID is the authProviderID argument value.
+			AuthProviderID string
+		}
+		// UpdateGroups holds details about calls to the UpdateGroups method.
+		UpdateGroups []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Previous is the previous argument value.
+			Previous []Group
+			// Required is the required argument value.
+			Required []Group
+		}
+	}
+	lockListGroups   sync.RWMutex
+	lockUpdateGroups sync.RWMutex
+}
+
+// ListGroups calls ListGroupsFunc.
+func (mock *GroupAPIMock) ListGroups(ctx context.Context, authProviderID string) ([]Group, error) {
+	if mock.ListGroupsFunc == nil {
+		panic("GroupAPIMock.ListGroupsFunc: method is nil but GroupAPI.ListGroups was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		AuthProviderID string
+	}{
+		Ctx:            ctx,
+		AuthProviderID: authProviderID,
+	}
+	mock.lockListGroups.Lock()
+	mock.calls.ListGroups = append(mock.calls.ListGroups, callInfo)
+	mock.lockListGroups.Unlock()
+	return mock.ListGroupsFunc(ctx, authProviderID)
+}
+
+// ListGroupsCalls gets all the calls that were made to ListGroups.
+// Check the length with:
+//
+//	len(mockedGroupAPI.ListGroupsCalls())
+func (mock *GroupAPIMock) ListGroupsCalls() []struct {
+	Ctx            context.Context
+	AuthProviderID string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		AuthProviderID string
+	}
+	mock.lockListGroups.RLock()
+	calls = mock.calls.ListGroups
+	mock.lockListGroups.RUnlock()
+	return calls
+}
+
+// UpdateGroups calls UpdateGroupsFunc.
+func (mock *GroupAPIMock) UpdateGroups(ctx context.Context, previous []Group, required []Group) error {
+	if mock.UpdateGroupsFunc == nil {
+		panic("GroupAPIMock.UpdateGroupsFunc: method is nil but GroupAPI.UpdateGroups was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Previous []Group
+		Required []Group
+	}{
+		Ctx:      ctx,
+		Previous: previous,
+		Required: required,
+	}
+	mock.lockUpdateGroups.Lock()
+	mock.calls.UpdateGroups = append(mock.calls.UpdateGroups, callInfo)
+	mock.lockUpdateGroups.Unlock()
+	return mock.UpdateGroupsFunc(ctx, previous, required)
+}
+
+// UpdateGroupsCalls gets all the calls that were made to UpdateGroups.
+// Check the length with:
+//
+//	len(mockedGroupAPI.UpdateGroupsCalls())
+func (mock *GroupAPIMock) UpdateGroupsCalls() []struct {
+	Ctx      context.Context
+	Previous []Group
+	Required []Group
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Previous []Group
+		Required []Group
+	}
+	mock.lockUpdateGroups.RLock()
+	calls = mock.calls.UpdateGroups
+	mock.lockUpdateGroups.RUnlock()
+	return calls
+}
+
+// Ensure, that RoleAPIMock does implement RoleAPI.
+// If this is not the case, regenerate this file with moq.
+var _ RoleAPI = &RoleAPIMock{}
+
+// RoleAPIMock is a mock implementation of RoleAPI.
+//
+//	func TestSomethingThatUsesRoleAPI(t *testing.T) {
+//
+//		// make and configure a mocked RoleAPI
+//		mockedRoleAPI := &RoleAPIMock{
+//			CreateRoleFunc: func(ctx context.Context, r *Role) error {
+//				panic("mock out the CreateRole method")
+//			},
+//			DeleteRoleFunc: func(ctx context.Context, name string) error {
+//				panic("mock out the DeleteRole method")
+//			},
+//			GetRoleFunc: func(ctx context.Context, name string) (*Role, error) {
+//				panic("mock out the GetRole method")
+//			},
+//			UpdateRoleFunc: func(ctx context.Context, r *Role) error {
+//				panic("mock out the UpdateRole method")
+//			},
+//		}
+//
+//		// use mockedRoleAPI in code that requires RoleAPI
+//		// and then make assertions.
+//
+//	}
+type RoleAPIMock struct {
+	// CreateRoleFunc mocks the CreateRole method.
+	CreateRoleFunc func(ctx context.Context, r *Role) error
+
+	// DeleteRoleFunc mocks the DeleteRole method.
+	DeleteRoleFunc func(ctx context.Context, name string) error
+
+	// GetRoleFunc mocks the GetRole method.
+	GetRoleFunc func(ctx context.Context, name string) (*Role, error)
+
+	// UpdateRoleFunc mocks the UpdateRole method.
+	UpdateRoleFunc func(ctx context.Context, r *Role) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateRole holds details about calls to the CreateRole method.
+		CreateRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R *Role
+		}
+		// DeleteRole holds details about calls to the DeleteRole method.
+		DeleteRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// GetRole holds details about calls to the GetRole method.
+		GetRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// UpdateRole holds details about calls to the UpdateRole method.
+		UpdateRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R *Role
+		}
+	}
+	lockCreateRole sync.RWMutex
+	lockDeleteRole sync.RWMutex
+	lockGetRole    sync.RWMutex
+	lockUpdateRole sync.RWMutex
+}
+
+// CreateRole calls CreateRoleFunc.
+func (mock *RoleAPIMock) CreateRole(ctx context.Context, r *Role) error {
+	if mock.CreateRoleFunc == nil {
+		panic("RoleAPIMock.CreateRoleFunc: method is nil but RoleAPI.CreateRole was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   *Role
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockCreateRole.Lock()
+	mock.calls.CreateRole = append(mock.calls.CreateRole, callInfo)
+	mock.lockCreateRole.Unlock()
+	return mock.CreateRoleFunc(ctx, r)
+}
+
+// CreateRoleCalls gets all the calls that were made to CreateRole.
+// Check the length with:
+//
+//	len(mockedRoleAPI.CreateRoleCalls())
+func (mock *RoleAPIMock) CreateRoleCalls() []struct {
+	Ctx context.Context
+	R   *Role
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   *Role
+	}
+	mock.lockCreateRole.RLock()
+	calls = mock.calls.CreateRole
+	mock.lockCreateRole.RUnlock()
+	return calls
+}
+
+// DeleteRole calls DeleteRoleFunc.
+func (mock *RoleAPIMock) DeleteRole(ctx context.Context, name string) error {
+	if mock.DeleteRoleFunc == nil {
+		panic("RoleAPIMock.DeleteRoleFunc: method is nil but RoleAPI.DeleteRole was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockDeleteRole.Lock()
+	mock.calls.DeleteRole = append(mock.calls.DeleteRole, callInfo)
+	mock.lockDeleteRole.Unlock()
+	return mock.DeleteRoleFunc(ctx, name)
+}
+
+// DeleteRoleCalls gets all the calls that were made to DeleteRole.
+// Check the length with:
+//
+//	len(mockedRoleAPI.DeleteRoleCalls())
+func (mock *RoleAPIMock) DeleteRoleCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockDeleteRole.RLock()
+	calls = mock.calls.DeleteRole
+	mock.lockDeleteRole.RUnlock()
+	return calls
+}
+
+// GetRole calls GetRoleFunc.
+func (mock *RoleAPIMock) GetRole(ctx context.Context, name string) (*Role, error) {
+	if mock.GetRoleFunc == nil {
+		panic("RoleAPIMock.GetRoleFunc: method is nil but RoleAPI.GetRole was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGetRole.Lock()
+	mock.calls.GetRole = append(mock.calls.GetRole, callInfo)
+	mock.lockGetRole.Unlock()
+	return mock.GetRoleFunc(ctx, name)
+}
+
+// GetRoleCalls gets all the calls that were made to GetRole.
+// Check the length with:
+//
+//	len(mockedRoleAPI.GetRoleCalls())
+func (mock *RoleAPIMock) GetRoleCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGetRole.RLock()
+	calls = mock.calls.GetRole
+	mock.lockGetRole.RUnlock()
+	return calls
+}
+
+// UpdateRole calls UpdateRoleFunc.
+func (mock *RoleAPIMock) UpdateRole(ctx context.Context, r *Role) error {
+	if mock.UpdateRoleFunc == nil {
+		panic("RoleAPIMock.UpdateRoleFunc: method is nil but RoleAPI.UpdateRole was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   *Role
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockUpdateRole.Lock()
+	mock.calls.UpdateRole = append(mock.calls.UpdateRole, callInfo)
+	mock.lockUpdateRole.Unlock()
+	return mock.UpdateRoleFunc(ctx, r)
+}
+
+// UpdateRoleCalls gets all the calls that were made to UpdateRole.
+// Check the length with:
+//
+//	len(mockedRoleAPI.UpdateRoleCalls())
+func (mock *RoleAPIMock) UpdateRoleCalls() []struct {
+	Ctx context.Context
+	R   *Role
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   *Role
+	}
+	mock.lockUpdateRole.RLock()
+	calls = mock.calls.UpdateRole
+	mock.lockUpdateRole.RUnlock()
+	return calls
+}
+
+// Ensure, that PermissionSetAPIMock does implement PermissionSetAPI.
+// If this is not the case, regenerate this file with moq.
+var _ PermissionSetAPI = &PermissionSetAPIMock{}
+
+// PermissionSetAPIMock is a mock implementation of PermissionSetAPI.
+//
+//	func TestSomethingThatUsesPermissionSetAPI(t *testing.T) {
+//
+//		// make and configure a mocked PermissionSetAPI
+//		mockedPermissionSetAPI := &PermissionSetAPIMock{
+//			CreatePermissionSetFunc: func(ctx context.Context, p *PermissionSet) (*PermissionSet, error) {
+//				panic("mock out the CreatePermissionSet method")
+//			},
+//			DeletePermissionSetFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeletePermissionSet method")
+//			},
+//			GetPermissionSetFunc: func(ctx context.Context, id string) (*PermissionSet, error) {
+//				panic("mock out the GetPermissionSet method")
+//			},
+//			ListPermissionSetsFunc: func(ctx context.Context) ([]PermissionSet, error) {
+//				panic("mock out the ListPermissionSets method")
+//			},
+//			UpdatePermissionSetFunc: func(ctx context.Context, p *PermissionSet) error {
+//				panic("mock out the UpdatePermissionSet method")
+//			},
+//		}
+//
+//		// use mockedPermissionSetAPI in code that requires PermissionSetAPI
+//		// and then make assertions.
+//
+//	}
+type PermissionSetAPIMock struct {
+	// CreatePermissionSetFunc mocks the CreatePermissionSet method.
+	CreatePermissionSetFunc func(ctx context.Context, p *PermissionSet) (*PermissionSet, error)
+
+	// DeletePermissionSetFunc mocks the DeletePermissionSet method.
+	DeletePermissionSetFunc func(ctx context.Context, id string) error
+
+	// GetPermissionSetFunc mocks the GetPermissionSet method.
+	GetPermissionSetFunc func(ctx context.Context, id string) (*PermissionSet, error)
+
+	// ListPermissionSetsFunc mocks the ListPermissionSets method.
+	ListPermissionSetsFunc func(ctx context.Context) ([]PermissionSet, error)
+
+	// UpdatePermissionSetFunc mocks the UpdatePermissionSet method.
+	UpdatePermissionSetFunc func(ctx context.Context, p *PermissionSet) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreatePermissionSet holds details about calls to the CreatePermissionSet method.
+		CreatePermissionSet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// P is the p argument value.
+			P *PermissionSet
+		}
+		// DeletePermissionSet holds details about calls to the DeletePermissionSet method.
+		DeletePermissionSet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetPermissionSet holds details about calls to the GetPermissionSet method.
+		GetPermissionSet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// ListPermissionSets holds details about calls to the ListPermissionSets method.
+		ListPermissionSets []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// UpdatePermissionSet holds details about calls to the UpdatePermissionSet method.
+		UpdatePermissionSet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// P is the p argument value.
+			P *PermissionSet
+		}
+	}
+	lockCreatePermissionSet sync.RWMutex
+	lockDeletePermissionSet sync.RWMutex
+	lockGetPermissionSet    sync.RWMutex
+	lockListPermissionSets  sync.RWMutex
+	lockUpdatePermissionSet sync.RWMutex
+}
+
+// CreatePermissionSet calls CreatePermissionSetFunc.
+func (mock *PermissionSetAPIMock) CreatePermissionSet(ctx context.Context, p *PermissionSet) (*PermissionSet, error) {
+	if mock.CreatePermissionSetFunc == nil {
+		panic("PermissionSetAPIMock.CreatePermissionSetFunc: method is nil but PermissionSetAPI.CreatePermissionSet was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		P   *PermissionSet
+	}{
+		Ctx: ctx,
+		P:   p,
+	}
+	mock.lockCreatePermissionSet.Lock()
+	mock.calls.CreatePermissionSet = append(mock.calls.CreatePermissionSet, callInfo)
+	mock.lockCreatePermissionSet.Unlock()
+	return mock.CreatePermissionSetFunc(ctx, p)
+}
+
+// CreatePermissionSetCalls gets all the calls that were made to CreatePermissionSet.
+// Check the length with:
+//
+//	len(mockedPermissionSetAPI.CreatePermissionSetCalls())
+func (mock *PermissionSetAPIMock) CreatePermissionSetCalls() []struct {
+	Ctx context.Context
+	P   *PermissionSet
+} {
+	var calls []struct {
+		Ctx context.Context
+		P   *PermissionSet
+	}
+	mock.lockCreatePermissionSet.RLock()
+	calls = mock.calls.CreatePermissionSet
+	mock.lockCreatePermissionSet.RUnlock()
+	return calls
+}
+
+// DeletePermissionSet calls DeletePermissionSetFunc.
+func (mock *PermissionSetAPIMock) DeletePermissionSet(ctx context.Context, id string) error {
+	if mock.DeletePermissionSetFunc == nil {
+		panic("PermissionSetAPIMock.DeletePermissionSetFunc: method is nil but PermissionSetAPI.DeletePermissionSet was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeletePermissionSet.Lock()
+	mock.calls.DeletePermissionSet = append(mock.calls.DeletePermissionSet, callInfo)
+	mock.lockDeletePermissionSet.Unlock()
+	return mock.DeletePermissionSetFunc(ctx, id)
+}
+
+// DeletePermissionSetCalls gets all the calls that were made to DeletePermissionSet.
+// Check the length with:
+//
+//	len(mockedPermissionSetAPI.DeletePermissionSetCalls())
+func (mock *PermissionSetAPIMock) DeletePermissionSetCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeletePermissionSet.RLock()
+	calls = mock.calls.DeletePermissionSet
+	mock.lockDeletePermissionSet.RUnlock()
+	return calls
+}
+
+// GetPermissionSet calls GetPermissionSetFunc.
+func (mock *PermissionSetAPIMock) GetPermissionSet(ctx context.Context, id string) (*PermissionSet, error) {
+	if mock.GetPermissionSetFunc == nil {
+		panic("PermissionSetAPIMock.GetPermissionSetFunc: method is nil but PermissionSetAPI.GetPermissionSet was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetPermissionSet.Lock()
+	mock.calls.GetPermissionSet = append(mock.calls.GetPermissionSet, callInfo)
+	mock.lockGetPermissionSet.Unlock()
+	return mock.GetPermissionSetFunc(ctx, id)
+}
+
+// GetPermissionSetCalls gets all the calls that were made to GetPermissionSet.
+// Check the length with:
+//
+//	len(mockedPermissionSetAPI.GetPermissionSetCalls())
+func (mock *PermissionSetAPIMock) GetPermissionSetCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetPermissionSet.RLock()
+	calls = mock.calls.GetPermissionSet
+	mock.lockGetPermissionSet.RUnlock()
+	return calls
+}
+
+// ListPermissionSets calls ListPermissionSetsFunc.
+func (mock *PermissionSetAPIMock) ListPermissionSets(ctx context.Context) ([]PermissionSet, error) {
+	if mock.ListPermissionSetsFunc == nil {
+		panic("PermissionSetAPIMock.ListPermissionSetsFunc: method is nil but PermissionSetAPI.ListPermissionSets was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListPermissionSets.Lock()
+	mock.calls.ListPermissionSets = append(mock.calls.ListPermissionSets, callInfo)
+	mock.lockListPermissionSets.Unlock()
+	return mock.ListPermissionSetsFunc(ctx)
+}
+
+// ListPermissionSetsCalls gets all the calls that were made to ListPermissionSets.
+// Check the length with:
+//
+//	len(mockedPermissionSetAPI.ListPermissionSetsCalls())
+func (mock *PermissionSetAPIMock) ListPermissionSetsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListPermissionSets.RLock()
+	calls = mock.calls.ListPermissionSets
+	mock.lockListPermissionSets.RUnlock()
+	return calls
+}
+
+// UpdatePermissionSet calls UpdatePermissionSetFunc.
+func (mock *PermissionSetAPIMock) UpdatePermissionSet(ctx context.Context, p *PermissionSet) error {
+	if mock.UpdatePermissionSetFunc == nil {
+		panic("PermissionSetAPIMock.UpdatePermissionSetFunc: method is nil but PermissionSetAPI.UpdatePermissionSet was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		P   *PermissionSet
+	}{
+		Ctx: ctx,
+		P:   p,
+	}
+	mock.lockUpdatePermissionSet.Lock()
+	mock.calls.UpdatePermissionSet = append(mock.calls.UpdatePermissionSet, callInfo)
+	mock.lockUpdatePermissionSet.Unlock()
+	return mock.UpdatePermissionSetFunc(ctx, p)
+}
+
+// UpdatePermissionSetCalls gets all the calls that were made to UpdatePermissionSet.
+// Check the length with:
+//
+//	len(mockedPermissionSetAPI.UpdatePermissionSetCalls())
+func (mock *PermissionSetAPIMock) UpdatePermissionSetCalls() []struct {
+	Ctx context.Context
+	P   *PermissionSet
+} {
+	var calls []struct {
+		Ctx context.Context
+		P   *PermissionSet
+	}
+	mock.lockUpdatePermissionSet.RLock()
+	calls = mock.calls.UpdatePermissionSet
+	mock.lockUpdatePermissionSet.RUnlock()
+	return calls
+}
+
+// Ensure, that AccessScopeAPIMock does implement AccessScopeAPI.
+// If this is not the case, regenerate this file with moq.
+var _ AccessScopeAPI = &AccessScopeAPIMock{}
+
+// AccessScopeAPIMock is a mock implementation of AccessScopeAPI.
+//
+//	func TestSomethingThatUsesAccessScopeAPI(t *testing.T) {
+//
+//		// make and configure a mocked AccessScopeAPI
+//		mockedAccessScopeAPI := &AccessScopeAPIMock{
+//			CreateAccessScopeFunc: func(ctx context.Context, s *AccessScope) (*AccessScope, error) {
+//				panic("mock out the CreateAccessScope method")
+//			},
+//			DeleteAccessScopeFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteAccessScope method")
+//			},
+//			GetAccessScopeFunc: func(ctx context.Context, id string) (*AccessScope, error) {
+//				panic("mock out the GetAccessScope method")
+//			},
+//			ListAccessScopesFunc: func(ctx context.Context) ([]AccessScope, error) {
+//				panic("mock out the ListAccessScopes method")
+//			},
+//			UpdateAccessScopeFunc: func(ctx context.Context, s *AccessScope) error {
+//				panic("mock out the UpdateAccessScope method")
+//			},
+//		}
+//
+//		// use mockedAccessScopeAPI in code that requires AccessScopeAPI
+//		// and then make assertions.
+//
+//	}
+type AccessScopeAPIMock struct {
+	// CreateAccessScopeFunc mocks the CreateAccessScope method.
+	CreateAccessScopeFunc func(ctx context.Context, s *AccessScope) (*AccessScope, error)
+
+	// DeleteAccessScopeFunc mocks the DeleteAccessScope method.
+	DeleteAccessScopeFunc func(ctx context.Context, id string) error
+
+	// GetAccessScopeFunc mocks the GetAccessScope method.
+	GetAccessScopeFunc func(ctx context.Context, id string) (*AccessScope, error)
+
+	// ListAccessScopesFunc mocks the ListAccessScopes method.
+	ListAccessScopesFunc func(ctx context.Context) ([]AccessScope, error)
+
+	// UpdateAccessScopeFunc mocks the UpdateAccessScope method.
+	UpdateAccessScopeFunc func(ctx context.Context, s *AccessScope) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateAccessScope holds details about calls to the CreateAccessScope method.
+		CreateAccessScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S *AccessScope
+		}
+		// DeleteAccessScope holds details about calls to the DeleteAccessScope method.
+		DeleteAccessScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetAccessScope holds details about calls to the GetAccessScope method.
+		GetAccessScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// ListAccessScopes holds details about calls to the ListAccessScopes method.
+		ListAccessScopes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// UpdateAccessScope holds details about calls to the UpdateAccessScope method.
+		UpdateAccessScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S *AccessScope
+		}
+	}
+	lockCreateAccessScope sync.RWMutex
+	lockDeleteAccessScope sync.RWMutex
+	lockGetAccessScope    sync.RWMutex
+	lockListAccessScopes  sync.RWMutex
+	lockUpdateAccessScope sync.RWMutex
+}
+
+// CreateAccessScope calls CreateAccessScopeFunc.
+func (mock *AccessScopeAPIMock) CreateAccessScope(ctx context.Context, s *AccessScope) (*AccessScope, error) {
+	if mock.CreateAccessScopeFunc == nil {
+		panic("AccessScopeAPIMock.CreateAccessScopeFunc: method is nil but AccessScopeAPI.CreateAccessScope was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   *AccessScope
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	mock.lockCreateAccessScope.Lock()
+	mock.calls.CreateAccessScope = append(mock.calls.CreateAccessScope, callInfo)
+	mock.lockCreateAccessScope.Unlock()
+	return mock.CreateAccessScopeFunc(ctx, s)
+}
+
+// CreateAccessScopeCalls gets all the calls that were made to CreateAccessScope.
+// Check the length with:
+//
+//	len(mockedAccessScopeAPI.CreateAccessScopeCalls())
+func (mock *AccessScopeAPIMock) CreateAccessScopeCalls() []struct {
+	Ctx context.Context
+	S   *AccessScope
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   *AccessScope
+	}
+	mock.lockCreateAccessScope.RLock()
+	calls = mock.calls.CreateAccessScope
+	mock.lockCreateAccessScope.RUnlock()
+	return calls
+}
+
+// DeleteAccessScope calls DeleteAccessScopeFunc.
+func (mock *AccessScopeAPIMock) DeleteAccessScope(ctx context.Context, id string) error {
+	if mock.DeleteAccessScopeFunc == nil {
+		panic("AccessScopeAPIMock.DeleteAccessScopeFunc: method is nil but AccessScopeAPI.DeleteAccessScope was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteAccessScope.Lock()
+	mock.calls.DeleteAccessScope = append(mock.calls.DeleteAccessScope, callInfo)
+	mock.lockDeleteAccessScope.Unlock()
+	return mock.DeleteAccessScopeFunc(ctx, id)
+}
+
+// DeleteAccessScopeCalls gets all the calls that were made to DeleteAccessScope.
+// Check the length with:
+//
+//	len(mockedAccessScopeAPI.DeleteAccessScopeCalls())
+func (mock *AccessScopeAPIMock) DeleteAccessScopeCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteAccessScope.RLock()
+	calls = mock.calls.DeleteAccessScope
+	mock.lockDeleteAccessScope.RUnlock()
+	return calls
+}
+
+// GetAccessScope calls GetAccessScopeFunc.
+func (mock *AccessScopeAPIMock) GetAccessScope(ctx context.Context, id string) (*AccessScope, error) {
+	if mock.GetAccessScopeFunc == nil {
+		panic("AccessScopeAPIMock.GetAccessScopeFunc: method is nil but AccessScopeAPI.GetAccessScope was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetAccessScope.Lock()
+	mock.calls.GetAccessScope = append(mock.calls.GetAccessScope, callInfo)
+	mock.lockGetAccessScope.Unlock()
+	return mock.GetAccessScopeFunc(ctx, id)
+}
+
+// GetAccessScopeCalls gets all the calls that were made to GetAccessScope.
+// Check the length with:
+//
+//	len(mockedAccessScopeAPI.GetAccessScopeCalls())
+func (mock *AccessScopeAPIMock) GetAccessScopeCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetAccessScope.RLock()
+	calls = mock.calls.GetAccessScope
+	mock.lockGetAccessScope.RUnlock()
+	return calls
+}
+
+// ListAccessScopes calls ListAccessScopesFunc.
+func (mock *AccessScopeAPIMock) ListAccessScopes(ctx context.Context) ([]AccessScope, error) {
+	if mock.ListAccessScopesFunc == nil {
+		panic("AccessScopeAPIMock.ListAccessScopesFunc: method is nil but AccessScopeAPI.ListAccessScopes was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListAccessScopes.Lock()
+	mock.calls.ListAccessScopes = append(mock.calls.ListAccessScopes, callInfo)
+	mock.lockListAccessScopes.Unlock()
+	return mock.ListAccessScopesFunc(ctx)
+}
+
+// ListAccessScopesCalls gets all the calls that were made to ListAccessScopes.
+// Check the length with:
+//
+//	len(mockedAccessScopeAPI.ListAccessScopesCalls())
+func (mock *AccessScopeAPIMock) ListAccessScopesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListAccessScopes.RLock()
+	calls = mock.calls.ListAccessScopes
+	mock.lockListAccessScopes.RUnlock()
+	return calls
+}
+
+// UpdateAccessScope calls UpdateAccessScopeFunc.
+func (mock *AccessScopeAPIMock) UpdateAccessScope(ctx context.Context, s *AccessScope) error {
+	if mock.UpdateAccessScopeFunc == nil {
+		panic("AccessScopeAPIMock.UpdateAccessScopeFunc: method is nil but AccessScopeAPI.UpdateAccessScope was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   *AccessScope
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	mock.lockUpdateAccessScope.Lock()
+	mock.calls.UpdateAccessScope = append(mock.calls.UpdateAccessScope, callInfo)
+	mock.lockUpdateAccessScope.Unlock()
+	return mock.UpdateAccessScopeFunc(ctx, s)
+}
+
+// UpdateAccessScopeCalls gets all the calls that were made to UpdateAccessScope.
+// Check the length with:
+//
+//	len(mockedAccessScopeAPI.UpdateAccessScopeCalls())
+func (mock *AccessScopeAPIMock) UpdateAccessScopeCalls() []struct {
+	Ctx context.Context
+	S   *AccessScope
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   *AccessScope
+	}
+	mock.lockUpdateAccessScope.RLock()
+	calls = mock.calls.UpdateAccessScope
+	mock.lockUpdateAccessScope.RUnlock()
+	return calls
+}
+
+// Ensure, that ImageIntegrationAPIMock does implement ImageIntegrationAPI.
+// If this is not the case, regenerate this file with moq.
+var _ ImageIntegrationAPI = &ImageIntegrationAPIMock{}
+
+// ImageIntegrationAPIMock is a mock implementation of ImageIntegrationAPI.
+//
+//	func TestSomethingThatUsesImageIntegrationAPI(t *testing.T) {
+//
+//		// make and configure a mocked ImageIntegrationAPI
+//		mockedImageIntegrationAPI := &ImageIntegrationAPIMock{
+//			CreateImageIntegrationFunc: func(ctx context.Context, i *ImageIntegration) (*ImageIntegration, error) {
+//				panic("mock out the CreateImageIntegration method")
+//			},
+//			DeleteImageIntegrationFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteImageIntegration method")
+//			},
+//			GetImageIntegrationFunc: func(ctx context.Context, id string) (*ImageIntegration, error) {
+//				panic("mock out the GetImageIntegration method")
+//			},
+//			TestImageIntegrationFunc: func(ctx context.Context, i *ImageIntegration) error {
+//				panic("mock out the TestImageIntegration method")
+//			},
+//			UpdateImageIntegrationFunc: func(ctx context.Context, i *ImageIntegration) error {
+//				panic("mock out the UpdateImageIntegration method")
+//			},
+//		}
+//
+//		// use mockedImageIntegrationAPI in code that requires ImageIntegrationAPI
+//		// and then make assertions.
+//
+//	}
+type ImageIntegrationAPIMock struct {
+	// CreateImageIntegrationFunc mocks the CreateImageIntegration method.
+	CreateImageIntegrationFunc func(ctx context.Context, i *ImageIntegration) (*ImageIntegration, error)
+
+	// DeleteImageIntegrationFunc mocks the DeleteImageIntegration method.
+	DeleteImageIntegrationFunc func(ctx context.Context, id string) error
+
+	// GetImageIntegrationFunc mocks the GetImageIntegration method.
+	GetImageIntegrationFunc func(ctx context.Context, id string) (*ImageIntegration, error)
+
+	// TestImageIntegrationFunc mocks the TestImageIntegration method.
+	TestImageIntegrationFunc func(ctx context.Context, i *ImageIntegration) error
+
+	// UpdateImageIntegrationFunc mocks the UpdateImageIntegration method.
+	UpdateImageIntegrationFunc func(ctx context.Context, i *ImageIntegration) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateImageIntegration holds details about calls to the CreateImageIntegration method.
+		CreateImageIntegration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// I is the i argument value.
+			I *ImageIntegration
+		}
+		// DeleteImageIntegration holds details about calls to the DeleteImageIntegration method.
+		DeleteImageIntegration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetImageIntegration holds details about calls to the GetImageIntegration method.
+		GetImageIntegration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// TestImageIntegration holds details about calls to the TestImageIntegration method.
+		TestImageIntegration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// I is the i argument value.
+			I *ImageIntegration
+		}
+		// UpdateImageIntegration holds details about calls to the UpdateImageIntegration method.
+		UpdateImageIntegration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// I is the i argument value.
+			I *ImageIntegration
+		}
+	}
+	lockCreateImageIntegration sync.RWMutex
+	lockDeleteImageIntegration sync.RWMutex
+	lockGetImageIntegration    sync.RWMutex
+	lockTestImageIntegration   sync.RWMutex
+	lockUpdateImageIntegration sync.RWMutex
+}
+
+// CreateImageIntegration calls CreateImageIntegrationFunc.
+func (mock *ImageIntegrationAPIMock) CreateImageIntegration(ctx context.Context, i *ImageIntegration) (*ImageIntegration, error) {
+	if mock.CreateImageIntegrationFunc == nil {
+		panic("ImageIntegrationAPIMock.CreateImageIntegrationFunc: method is nil but ImageIntegrationAPI.CreateImageIntegration was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		I   *ImageIntegration
+	}{
+		Ctx: ctx,
+		I:   i,
+	}
+	mock.lockCreateImageIntegration.Lock()
+	mock.calls.CreateImageIntegration = append(mock.calls.CreateImageIntegration, callInfo)
+	mock.lockCreateImageIntegration.Unlock()
+	return mock.CreateImageIntegrationFunc(ctx, i)
+}
+
+// CreateImageIntegrationCalls gets all the calls that were made to CreateImageIntegration.
+// Check the length with:
+//
+//	len(mockedImageIntegrationAPI.CreateImageIntegrationCalls())
+func (mock *ImageIntegrationAPIMock) CreateImageIntegrationCalls() []struct {
+	Ctx context.Context
+	I   *ImageIntegration
+} {
+	var calls []struct {
+		Ctx context.Context
+		I   *ImageIntegration
+	}
+	mock.lockCreateImageIntegration.RLock()
+	calls = mock.calls.CreateImageIntegration
+	mock.lockCreateImageIntegration.RUnlock()
+	return calls
+}
+
+// DeleteImageIntegration calls DeleteImageIntegrationFunc.
+func (mock *ImageIntegrationAPIMock) DeleteImageIntegration(ctx context.Context, id string) error {
+	if mock.DeleteImageIntegrationFunc == nil {
+		panic("ImageIntegrationAPIMock.DeleteImageIntegrationFunc: method is nil but ImageIntegrationAPI.DeleteImageIntegration was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteImageIntegration.Lock()
+	mock.calls.DeleteImageIntegration = append(mock.calls.DeleteImageIntegration, callInfo)
+	mock.lockDeleteImageIntegration.Unlock()
+	return mock.DeleteImageIntegrationFunc(ctx, id)
+}
+
+// DeleteImageIntegrationCalls gets all the calls that were made to DeleteImageIntegration.
+// Check the length with:
+//
+//	len(mockedImageIntegrationAPI.DeleteImageIntegrationCalls())
+func (mock *ImageIntegrationAPIMock) DeleteImageIntegrationCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteImageIntegration.RLock()
+	calls = mock.calls.DeleteImageIntegration
+	mock.lockDeleteImageIntegration.RUnlock()
+	return calls
+}
+
+// GetImageIntegration calls GetImageIntegrationFunc.
+func (mock *ImageIntegrationAPIMock) GetImageIntegration(ctx context.Context, id string) (*ImageIntegration, error) {
+	if mock.GetImageIntegrationFunc == nil {
+		panic("ImageIntegrationAPIMock.GetImageIntegrationFunc: method is nil but ImageIntegrationAPI.GetImageIntegration was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetImageIntegration.Lock()
+	mock.calls.GetImageIntegration = append(mock.calls.GetImageIntegration, callInfo)
+	mock.lockGetImageIntegration.Unlock()
+	return mock.GetImageIntegrationFunc(ctx, id)
+}
+
+// GetImageIntegrationCalls gets all the calls that were made to GetImageIntegration.
+// Check the length with:
+//
+//	len(mockedImageIntegrationAPI.GetImageIntegrationCalls())
+func (mock *ImageIntegrationAPIMock) GetImageIntegrationCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetImageIntegration.RLock()
+	calls = mock.calls.GetImageIntegration
+	mock.lockGetImageIntegration.RUnlock()
+	return calls
+}
+
+// TestImageIntegration calls TestImageIntegrationFunc.
+func (mock *ImageIntegrationAPIMock) TestImageIntegration(ctx context.Context, i *ImageIntegration) error {
+	if mock.TestImageIntegrationFunc == nil {
+		panic("ImageIntegrationAPIMock.TestImageIntegrationFunc: method is nil but ImageIntegrationAPI.TestImageIntegration was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		I   *ImageIntegration
+	}{
+		Ctx: ctx,
+		I:   i,
+	}
+	mock.lockTestImageIntegration.Lock()
+	mock.calls.TestImageIntegration = append(mock.calls.TestImageIntegration, callInfo)
+	mock.lockTestImageIntegration.Unlock()
+	return mock.TestImageIntegrationFunc(ctx, i)
+}
+
+// TestImageIntegrationCalls gets all the calls that were made to TestImageIntegration.
+// Check the length with:
+//
+//	len(mockedImageIntegrationAPI.TestImageIntegrationCalls())
+func (mock *ImageIntegrationAPIMock) TestImageIntegrationCalls() []struct {
+	Ctx context.Context
+	I   *ImageIntegration
+} {
+	var calls []struct {
+		Ctx context.Context
+		I   *ImageIntegration
+	}
+	mock.lockTestImageIntegration.RLock()
+	calls = mock.calls.TestImageIntegration
+	mock.lockTestImageIntegration.RUnlock()
+	return calls
+}
+
+// UpdateImageIntegration calls UpdateImageIntegrationFunc.
+func (mock *ImageIntegrationAPIMock) UpdateImageIntegration(ctx context.Context, i *ImageIntegration) error {
+	if mock.UpdateImageIntegrationFunc == nil {
+		panic("ImageIntegrationAPIMock.UpdateImageIntegrationFunc: method is nil but ImageIntegrationAPI.UpdateImageIntegration was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		I   *ImageIntegration
+	}{
+		Ctx: ctx,
+		I:   i,
+	}
+	mock.lockUpdateImageIntegration.Lock()
+	mock.calls.UpdateImageIntegration = append(mock.calls.UpdateImageIntegration, callInfo)
+	mock.lockUpdateImageIntegration.Unlock()
+	return mock.UpdateImageIntegrationFunc(ctx, i)
+}
+
+// UpdateImageIntegrationCalls gets all the calls that were made to UpdateImageIntegration.
+// Check the length with:
+//
+//	len(mockedImageIntegrationAPI.UpdateImageIntegrationCalls())
+func (mock *ImageIntegrationAPIMock) UpdateImageIntegrationCalls() []struct {
+	Ctx context.Context
+	I   *ImageIntegration
+} {
+	var calls []struct {
+		Ctx context.Context
+		I   *ImageIntegration
+	}
+	mock.lockUpdateImageIntegration.RLock()
+	calls = mock.calls.UpdateImageIntegration
+	mock.lockUpdateImageIntegration.RUnlock()
+	return calls
+}
+
+// Ensure, that CollectionAPIMock does implement CollectionAPI.
+// If this is not the case, regenerate this file with moq.
+var _ CollectionAPI = &CollectionAPIMock{}
+
+// CollectionAPIMock is a mock implementation of CollectionAPI.
+//
+//	func TestSomethingThatUsesCollectionAPI(t *testing.T) {
+//
+//		// make and configure a mocked CollectionAPI
+//		mockedCollectionAPI := &CollectionAPIMock{
+//			CreateCollectionFunc: func(ctx context.Context, c *Collection) (*Collection, error) {
+//				panic("mock out the CreateCollection method")
+//			},
+//			DeleteCollectionFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteCollection method")
+//			},
+//			GetCollectionFunc: func(ctx context.Context, id string) (*Collection, error) {
+//				panic("mock out the GetCollection method")
+//			},
+//			UpdateCollectionFunc: func(ctx context.Context, c *Collection) error {
+//				panic("mock out the UpdateCollection method")
+//			},
+//		}
+//
+//		// use mockedCollectionAPI in code that requires CollectionAPI
+//		// and then make assertions.
+//
+//	}
+type CollectionAPIMock struct {
+	// CreateCollectionFunc mocks the CreateCollection method.
+	CreateCollectionFunc func(ctx context.Context, c *Collection) (*Collection, error)
+
+	// DeleteCollectionFunc mocks the DeleteCollection method.
+	DeleteCollectionFunc func(ctx context.Context, id string) error
+
+	// GetCollectionFunc mocks the GetCollection method.
+	GetCollectionFunc func(ctx context.Context, id string) (*Collection, error)
+
+	// UpdateCollectionFunc mocks the UpdateCollection method.
+	UpdateCollectionFunc func(ctx context.Context, c *Collection) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateCollection holds details about calls to the CreateCollection method.
+		CreateCollection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// C is the c argument value.
+			C *Collection
+		}
+		// DeleteCollection holds details about calls to the DeleteCollection method.
+		DeleteCollection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetCollection holds details about calls to the GetCollection method.
+		GetCollection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateCollection holds details about calls to the UpdateCollection method.
+		UpdateCollection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// C is the c argument value.
+			C *Collection
+		}
+	}
+	lockCreateCollection sync.RWMutex
+	lockDeleteCollection sync.RWMutex
+	lockGetCollection    sync.RWMutex
+	lockUpdateCollection sync.RWMutex
+}
+
+// CreateCollection calls CreateCollectionFunc.
+func (mock *CollectionAPIMock) CreateCollection(ctx context.Context, c *Collection) (*Collection, error) {
+	if mock.CreateCollectionFunc == nil {
+		panic("CollectionAPIMock.CreateCollectionFunc: method is nil but CollectionAPI.CreateCollection was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		C   *Collection
+	}{
+		Ctx: ctx,
+		C:   c,
+	}
+	mock.lockCreateCollection.Lock()
+	mock.calls.CreateCollection = append(mock.calls.CreateCollection, callInfo)
+	mock.lockCreateCollection.Unlock()
+	return mock.CreateCollectionFunc(ctx, c)
+}
+
+// CreateCollectionCalls gets all the calls that were made to CreateCollection.
+// Check the length with:
+//
+//	len(mockedCollectionAPI.CreateCollectionCalls())
+func (mock *CollectionAPIMock) CreateCollectionCalls() []struct {
+	Ctx context.Context
+	C   *Collection
+} {
+	var calls []struct {
+		Ctx context.Context
+		C   *Collection
+	}
+	mock.lockCreateCollection.RLock()
+	calls = mock.calls.CreateCollection
+	mock.lockCreateCollection.RUnlock()
+	return calls
+}
+
+// DeleteCollection calls DeleteCollectionFunc.
+func (mock *CollectionAPIMock) DeleteCollection(ctx context.Context, id string) error {
+	if mock.DeleteCollectionFunc == nil {
+		panic("CollectionAPIMock.DeleteCollectionFunc: method is nil but CollectionAPI.DeleteCollection was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteCollection.Lock()
+	mock.calls.DeleteCollection = append(mock.calls.DeleteCollection, callInfo)
+	mock.lockDeleteCollection.Unlock()
+	return mock.DeleteCollectionFunc(ctx, id)
+}
+
+// DeleteCollectionCalls gets all the calls that were made to DeleteCollection.
+// Check the length with:
+//
+//	len(mockedCollectionAPI.DeleteCollectionCalls())
+func (mock *CollectionAPIMock) DeleteCollectionCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteCollection.RLock()
+	calls = mock.calls.DeleteCollection
+	mock.lockDeleteCollection.RUnlock()
+	return calls
+}
+
+// GetCollection calls GetCollectionFunc.
+func (mock *CollectionAPIMock) GetCollection(ctx context.Context, id string) (*Collection, error) {
+	if mock.GetCollectionFunc == nil {
+		panic("CollectionAPIMock.GetCollectionFunc: method is nil but CollectionAPI.GetCollection was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetCollection.Lock()
+	mock.calls.GetCollection = append(mock.calls.GetCollection, callInfo)
+	mock.lockGetCollection.Unlock()
+	return mock.GetCollectionFunc(ctx, id)
+}
+
+// GetCollectionCalls gets all the calls that were made to GetCollection.
+// Check the length with:
+//
+//	len(mockedCollectionAPI.GetCollectionCalls())
+func (mock *CollectionAPIMock) GetCollectionCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetCollection.RLock()
+	calls = mock.calls.GetCollection
+	mock.lockGetCollection.RUnlock()
+	return calls
+}
+
+// UpdateCollection calls UpdateCollectionFunc.
+func (mock *CollectionAPIMock) UpdateCollection(ctx context.Context, c *Collection) error {
+	if mock.UpdateCollectionFunc == nil {
+		panic("CollectionAPIMock.UpdateCollectionFunc: method is nil but CollectionAPI.UpdateCollection was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		C   *Collection
+	}{
+		Ctx: ctx,
+		C:   c,
+	}
+	mock.lockUpdateCollection.Lock()
+	mock.calls.UpdateCollection = append(mock.calls.UpdateCollection, callInfo)
+	mock.lockUpdateCollection.Unlock()
+	return mock.UpdateCollectionFunc(ctx, c)
+}
+
+// UpdateCollectionCalls gets all the calls that were made to UpdateCollection.
+// Check the length with:
+//
+//	len(mockedCollectionAPI.UpdateCollectionCalls())
+func (mock *CollectionAPIMock) UpdateCollectionCalls() []struct {
+	Ctx context.Context
+	C   *Collection
+} {
+	var calls []struct {
+		Ctx context.Context
+		C   *Collection
+	}
+	mock.lockUpdateCollection.RLock()
+	calls = mock.calls.UpdateCollection
+	mock.lockUpdateCollection.RUnlock()
+	return calls
+}
+
+// Ensure, that ReportConfigurationAPIMock does implement ReportConfigurationAPI.
+// If this is not the case, regenerate this file with moq.
+var _ ReportConfigurationAPI = &ReportConfigurationAPIMock{}
+
+// ReportConfigurationAPIMock is a mock implementation of ReportConfigurationAPI.
+//
+//	func TestSomethingThatUsesReportConfigurationAPI(t *testing.T) {
+//
+//		// make and configure a mocked ReportConfigurationAPI
+//		mockedReportConfigurationAPI := &ReportConfigurationAPIMock{
+//			CreateReportConfigurationFunc: func(ctx context.Context, r *ReportConfiguration) (*ReportConfiguration, error) {
+//				panic("mock out the CreateReportConfiguration method")
+//			},
+//			DeleteReportConfigurationFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteReportConfiguration method")
+//			},
+//			GetReportConfigurationFunc: func(ctx context.Context, id string) (*ReportConfiguration, error) {
+//				panic("mock out the GetReportConfiguration method")
+//			},
+//			UpdateReportConfigurationFunc: func(ctx context.Context, r *ReportConfiguration) error {
+//				panic("mock out the UpdateReportConfiguration method")
+//			},
+//		}
+//
+//		// use mockedReportConfigurationAPI in code that requires ReportConfigurationAPI
+//		// and then make assertions.
+//
+//	}
+type ReportConfigurationAPIMock struct {
+	// CreateReportConfigurationFunc mocks the CreateReportConfiguration method.
+	CreateReportConfigurationFunc func(ctx context.Context, r *ReportConfiguration) (*ReportConfiguration, error)
+
+	// DeleteReportConfigurationFunc mocks the DeleteReportConfiguration method.
+	DeleteReportConfigurationFunc func(ctx context.Context, id string) error
+
+	// GetReportConfigurationFunc mocks the GetReportConfiguration method.
+	GetReportConfigurationFunc func(ctx context.Context, id string) (*ReportConfiguration, error)
+
+	// UpdateReportConfigurationFunc mocks the UpdateReportConfiguration method.
+	UpdateReportConfigurationFunc func(ctx context.Context, r *ReportConfiguration) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateReportConfiguration holds details about calls to the CreateReportConfiguration method.
+		CreateReportConfiguration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R *ReportConfiguration
+		}
+		// DeleteReportConfiguration holds details about calls to the DeleteReportConfiguration method.
+		DeleteReportConfiguration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetReportConfiguration holds details about calls to the GetReportConfiguration method.
+		GetReportConfiguration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateReportConfiguration holds details about calls to the UpdateReportConfiguration method.
+		UpdateReportConfiguration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R *ReportConfiguration
+		}
+	}
+	lockCreateReportConfiguration sync.RWMutex
+	lockDeleteReportConfiguration sync.RWMutex
+	lockGetReportConfiguration    sync.RWMutex
+	lockUpdateReportConfiguration sync.RWMutex
+}
+
+// CreateReportConfiguration calls CreateReportConfigurationFunc.
+func (mock *ReportConfigurationAPIMock) CreateReportConfiguration(ctx context.Context, r *ReportConfiguration) (*ReportConfiguration, error) {
+	if mock.CreateReportConfigurationFunc == nil {
+		panic("ReportConfigurationAPIMock.CreateReportConfigurationFunc: method is nil but ReportConfigurationAPI.CreateReportConfiguration was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   *ReportConfiguration
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockCreateReportConfiguration.Lock()
+	mock.calls.CreateReportConfiguration = append(mock.calls.CreateReportConfiguration, callInfo)
+	mock.lockCreateReportConfiguration.Unlock()
+	return mock.CreateReportConfigurationFunc(ctx, r)
+}
+
+// CreateReportConfigurationCalls gets all the calls that were made to CreateReportConfiguration.
+// Check the length with:
+//
+//	len(mockedReportConfigurationAPI.CreateReportConfigurationCalls())
+func (mock *ReportConfigurationAPIMock) CreateReportConfigurationCalls() []struct {
+	Ctx context.Context
+	R   *ReportConfiguration
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   *ReportConfiguration
+	}
+	mock.lockCreateReportConfiguration.RLock()
+	calls = mock.calls.CreateReportConfiguration
+	mock.lockCreateReportConfiguration.RUnlock()
+	return calls
+}
+
+// DeleteReportConfiguration calls DeleteReportConfigurationFunc.
+func (mock *ReportConfigurationAPIMock) DeleteReportConfiguration(ctx context.Context, id string) error {
+	if mock.DeleteReportConfigurationFunc == nil {
+		panic("ReportConfigurationAPIMock.DeleteReportConfigurationFunc: method is nil but ReportConfigurationAPI.DeleteReportConfiguration was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteReportConfiguration.Lock()
+	mock.calls.DeleteReportConfiguration = append(mock.calls.DeleteReportConfiguration, callInfo)
+	mock.lockDeleteReportConfiguration.Unlock()
+	return mock.DeleteReportConfigurationFunc(ctx, id)
+}
+
+// DeleteReportConfigurationCalls gets all the calls that were made to DeleteReportConfiguration.
+// Check the length with:
+//
+//	len(mockedReportConfigurationAPI.DeleteReportConfigurationCalls())
+func (mock *ReportConfigurationAPIMock) DeleteReportConfigurationCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteReportConfiguration.RLock()
+	calls = mock.calls.DeleteReportConfiguration
+	mock.lockDeleteReportConfiguration.RUnlock()
+	return calls
+}
+
+// GetReportConfiguration calls GetReportConfigurationFunc.
+func (mock *ReportConfigurationAPIMock) GetReportConfiguration(ctx context.Context, id string) (*ReportConfiguration, error) {
+	if mock.GetReportConfigurationFunc == nil {
+		panic("ReportConfigurationAPIMock.GetReportConfigurationFunc: method is nil but ReportConfigurationAPI.GetReportConfiguration was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetReportConfiguration.Lock()
+	mock.calls.GetReportConfiguration = append(mock.calls.GetReportConfiguration, callInfo)
+	mock.lockGetReportConfiguration.Unlock()
+	return mock.GetReportConfigurationFunc(ctx, id)
+}
+
+// GetReportConfigurationCalls gets all the calls that were made to GetReportConfiguration.
+// Check the length with:
+//
+//	len(mockedReportConfigurationAPI.GetReportConfigurationCalls())
+func (mock *ReportConfigurationAPIMock) GetReportConfigurationCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetReportConfiguration.RLock()
+	calls = mock.calls.GetReportConfiguration
+	mock.lockGetReportConfiguration.RUnlock()
+	return calls
+}
+
+// UpdateReportConfiguration calls UpdateReportConfigurationFunc.
+func (mock *ReportConfigurationAPIMock) UpdateReportConfiguration(ctx context.Context, r *ReportConfiguration) error {
+	if mock.UpdateReportConfigurationFunc == nil {
+		panic("ReportConfigurationAPIMock.UpdateReportConfigurationFunc: method is nil but ReportConfigurationAPI.UpdateReportConfiguration was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   *ReportConfiguration
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockUpdateReportConfiguration.Lock()
+	mock.calls.UpdateReportConfiguration = append(mock.calls.UpdateReportConfiguration, callInfo)
+	mock.lockUpdateReportConfiguration.Unlock()
+	return mock.UpdateReportConfigurationFunc(ctx, r)
+}
+
+// UpdateReportConfigurationCalls gets all the calls that were made to UpdateReportConfiguration.
+// Check the length with:
+//
+//	len(mockedReportConfigurationAPI.UpdateReportConfigurationCalls())
+func (mock *ReportConfigurationAPIMock) UpdateReportConfigurationCalls() []struct {
+	Ctx context.Context
+	R   *ReportConfiguration
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   *ReportConfiguration
+	}
+	mock.lockUpdateReportConfiguration.RLock()
+	calls = mock.calls.UpdateReportConfiguration
+	mock.lockUpdateReportConfiguration.RUnlock()
+	return calls
+}
+
+// Ensure, that ExternalBackupAPIMock does implement ExternalBackupAPI.
+// If this is not the case, regenerate this file with moq.
+var _ ExternalBackupAPI = &ExternalBackupAPIMock{}
+
+// ExternalBackupAPIMock is a mock implementation of ExternalBackupAPI.
+//
+//	func TestSomethingThatUsesExternalBackupAPI(t *testing.T) {
+//
+//		// make and configure a mocked ExternalBackupAPI
+//		mockedExternalBackupAPI := &ExternalBackupAPIMock{
+//			CreateExternalBackupFunc: func(ctx context.Context, b *ExternalBackup) (*ExternalBackup, error) {
+//				panic("mock out the CreateExternalBackup method")
+//			},
+//			DeleteExternalBackupFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteExternalBackup method")
+//			},
+//			GetExternalBackupFunc: func(ctx context.Context, id string) (*ExternalBackup, error) {
+//				panic("mock out the GetExternalBackup method")
+//			},
+//			TriggerExternalBackupFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the TriggerExternalBackup method")
+//			},
+//			UpdateExternalBackupFunc: func(ctx context.Context, b *ExternalBackup) error {
+//				panic("mock out the UpdateExternalBackup method")
+//			},
+//		}
+//
+//		// use mockedExternalBackupAPI in code that requires ExternalBackupAPI
+//		// and then make assertions.
+//
+//	}
+type ExternalBackupAPIMock struct {
+	// CreateExternalBackupFunc mocks the CreateExternalBackup method.
+	CreateExternalBackupFunc func(ctx context.Context, b *ExternalBackup) (*ExternalBackup, error)
+
+	// DeleteExternalBackupFunc mocks the DeleteExternalBackup method.
+	DeleteExternalBackupFunc func(ctx context.Context, id string) error
+
+	// GetExternalBackupFunc mocks the GetExternalBackup method.
+	GetExternalBackupFunc func(ctx context.Context, id string) (*ExternalBackup, error)
+
+	// TriggerExternalBackupFunc mocks the TriggerExternalBackup method.
+	TriggerExternalBackupFunc func(ctx context.Context, id string) error
+
+	// UpdateExternalBackupFunc mocks the UpdateExternalBackup method.
+	UpdateExternalBackupFunc func(ctx context.Context, b *ExternalBackup) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateExternalBackup holds details about calls to the CreateExternalBackup method.
+		CreateExternalBackup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// B is the b argument value.
+			B *ExternalBackup
+		}
+		// DeleteExternalBackup holds details about calls to the DeleteExternalBackup method.
+		DeleteExternalBackup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetExternalBackup holds details about calls to the GetExternalBackup method.
+		GetExternalBackup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// TriggerExternalBackup holds details about calls to the TriggerExternalBackup method.
+		TriggerExternalBackup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateExternalBackup holds details about calls to the UpdateExternalBackup method.
+		UpdateExternalBackup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// B is the b argument value.
+			B *ExternalBackup
+		}
+	}
+	lockCreateExternalBackup  sync.RWMutex
+	lockDeleteExternalBackup  sync.RWMutex
+	lockGetExternalBackup     sync.RWMutex
+	lockTriggerExternalBackup sync.RWMutex
+	lockUpdateExternalBackup  sync.RWMutex
+}
+
+// CreateExternalBackup calls CreateExternalBackupFunc.
+func (mock *ExternalBackupAPIMock) CreateExternalBackup(ctx context.Context, b *ExternalBackup) (*ExternalBackup, error) {
+	if mock.CreateExternalBackupFunc == nil {
+		panic("ExternalBackupAPIMock.CreateExternalBackupFunc: method is nil but ExternalBackupAPI.CreateExternalBackup was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		B   *ExternalBackup
+	}{
+		Ctx: ctx,
+		B:   b,
+	}
+	mock.lockCreateExternalBackup.Lock()
+	mock.calls.CreateExternalBackup = append(mock.calls.CreateExternalBackup, callInfo)
+	mock.lockCreateExternalBackup.Unlock()
+	return mock.CreateExternalBackupFunc(ctx, b)
+}
+
+// CreateExternalBackupCalls gets all the calls that were made to CreateExternalBackup.
+// Check the length with:
+//
+//	len(mockedExternalBackupAPI.CreateExternalBackupCalls())
+func (mock *ExternalBackupAPIMock) CreateExternalBackupCalls() []struct {
+	Ctx context.Context
+	B   *ExternalBackup
+} {
+	var calls []struct {
+		Ctx context.Context
+		B   *ExternalBackup
+	}
+	mock.lockCreateExternalBackup.RLock()
+	calls = mock.calls.CreateExternalBackup
+	mock.lockCreateExternalBackup.RUnlock()
+	return calls
+}
+
+// DeleteExternalBackup calls DeleteExternalBackupFunc.
+func (mock *ExternalBackupAPIMock) DeleteExternalBackup(ctx context.Context, id string) error {
+	if mock.DeleteExternalBackupFunc == nil {
+		panic("ExternalBackupAPIMock.DeleteExternalBackupFunc: method is nil but ExternalBackupAPI.DeleteExternalBackup was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteExternalBackup.Lock()
+	mock.calls.DeleteExternalBackup = append(mock.calls.DeleteExternalBackup, callInfo)
+	mock.lockDeleteExternalBackup.Unlock()
+	return mock.DeleteExternalBackupFunc(ctx, id)
+}
+
+// DeleteExternalBackupCalls gets all the calls that were made to DeleteExternalBackup.
+// Check the length with:
+//
+//	len(mockedExternalBackupAPI.DeleteExternalBackupCalls())
+func (mock *ExternalBackupAPIMock) DeleteExternalBackupCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteExternalBackup.RLock()
+	calls = mock.calls.DeleteExternalBackup
+	mock.lockDeleteExternalBackup.RUnlock()
+	return calls
+}
+
+// GetExternalBackup calls GetExternalBackupFunc.
+func (mock *ExternalBackupAPIMock) GetExternalBackup(ctx context.Context, id string) (*ExternalBackup, error) {
+	if mock.GetExternalBackupFunc == nil {
+		panic("ExternalBackupAPIMock.GetExternalBackupFunc: method is nil but ExternalBackupAPI.GetExternalBackup was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetExternalBackup.Lock()
+	mock.calls.GetExternalBackup = append(mock.calls.GetExternalBackup, callInfo)
+	mock.lockGetExternalBackup.Unlock()
+	return mock.GetExternalBackupFunc(ctx, id)
+}
+
+// GetExternalBackupCalls gets all the calls that were made to GetExternalBackup.
+// Check the length with:
+//
+//	len(mockedExternalBackupAPI.GetExternalBackupCalls())
+func (mock *ExternalBackupAPIMock) GetExternalBackupCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetExternalBackup.RLock()
+	calls = mock.calls.GetExternalBackup
+	mock.lockGetExternalBackup.RUnlock()
+	return calls
+}
+
+// TriggerExternalBackup calls TriggerExternalBackupFunc.
+func (mock *ExternalBackupAPIMock) TriggerExternalBackup(ctx context.Context, id string) error {
+	if mock.TriggerExternalBackupFunc == nil {
+		panic("ExternalBackupAPIMock.TriggerExternalBackupFunc: method is nil but ExternalBackupAPI.TriggerExternalBackup was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockTriggerExternalBackup.Lock()
+	mock.calls.TriggerExternalBackup = append(mock.calls.TriggerExternalBackup, callInfo)
+	mock.lockTriggerExternalBackup.Unlock()
+	return mock.TriggerExternalBackupFunc(ctx, id)
+}
+
+// TriggerExternalBackupCalls gets all the calls that were made to TriggerExternalBackup.
+// Check the length with:
+//
+//	len(mockedExternalBackupAPI.TriggerExternalBackupCalls())
+func (mock *ExternalBackupAPIMock) TriggerExternalBackupCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockTriggerExternalBackup.RLock()
+	calls = mock.calls.TriggerExternalBackup
+	mock.lockTriggerExternalBackup.RUnlock()
+	return calls
+}
+
+// UpdateExternalBackup calls UpdateExternalBackupFunc.
+func (mock *ExternalBackupAPIMock) UpdateExternalBackup(ctx context.Context, b *ExternalBackup) error {
+	if mock.UpdateExternalBackupFunc == nil {
+		panic("ExternalBackupAPIMock.UpdateExternalBackupFunc: method is nil but ExternalBackupAPI.UpdateExternalBackup was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		B   *ExternalBackup
+	}{
+		Ctx: ctx,
+		B:   b,
+	}
+	mock.lockUpdateExternalBackup.Lock()
+	mock.calls.UpdateExternalBackup = append(mock.calls.UpdateExternalBackup, callInfo)
+	mock.lockUpdateExternalBackup.Unlock()
+	return mock.UpdateExternalBackupFunc(ctx, b)
+}
+
+// UpdateExternalBackupCalls gets all the calls that were made to UpdateExternalBackup.
+// Check the length with:
+//
+//	len(mockedExternalBackupAPI.UpdateExternalBackupCalls())
+func (mock *ExternalBackupAPIMock) UpdateExternalBackupCalls() []struct {
+	Ctx context.Context
+	B   *ExternalBackup
+} {
+	var calls []struct {
+		Ctx context.Context
+		B   *ExternalBackup
+	}
+	mock.lockUpdateExternalBackup.RLock()
+	calls = mock.calls.UpdateExternalBackup
+	mock.lockUpdateExternalBackup.RUnlock()
+	return calls
+}
+
+// Ensure, that RestoreAPIMock does implement RestoreAPI.
+// If this is not the case, regenerate this file with moq.
+var _ RestoreAPI = &RestoreAPIMock{}
+
+// RestoreAPIMock is a mock implementation of RestoreAPI.
+//
+//	func TestSomethingThatUsesRestoreAPI(t *testing.T) {
+//
+//		// make and configure a mocked RestoreAPI
+//		mockedRestoreAPI := &RestoreAPIMock{
+//			RestoreDatabaseFunc: func(ctx context.Context, archive io.ReaderAt, size int64) error {
+//				panic("mock out the RestoreDatabase method")
+//			},
+//		}
+//
+//		// use mockedRestoreAPI in code that requires RestoreAPI
+//		// and then make assertions.
+//
+//	}
+type RestoreAPIMock struct {
+	// RestoreDatabaseFunc mocks the RestoreDatabase method.
+	RestoreDatabaseFunc func(ctx context.Context, archive io.ReaderAt, size int64) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// RestoreDatabase holds details about calls to the RestoreDatabase method.
+		RestoreDatabase []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Archive is the archive argument value.
+			Archive io.ReaderAt
+			// Size is the size argument value.
+			Size int64
+		}
+	}
+	lockRestoreDatabase sync.RWMutex
+}
+
+// RestoreDatabase calls RestoreDatabaseFunc.
+func (mock *RestoreAPIMock) RestoreDatabase(ctx context.Context, archive io.ReaderAt, size int64) error {
+	if mock.RestoreDatabaseFunc == nil {
+		panic("RestoreAPIMock.RestoreDatabaseFunc: method is nil but RestoreAPI.RestoreDatabase was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Archive io.ReaderAt
+		Size    int64
+	}{
+		Ctx:     ctx,
+		Archive: archive,
+		Size:    size,
+	}
+	mock.lockRestoreDatabase.Lock()
+	mock.calls.RestoreDatabase = append(mock.calls.RestoreDatabase, callInfo)
+	mock.lockRestoreDatabase.Unlock()
+	return mock.RestoreDatabaseFunc(ctx, archive, size)
+}
+
+// RestoreDatabaseCalls gets all the calls that were made to RestoreDatabase.
+// Check the length with:
+//
+//	len(mockedRestoreAPI.RestoreDatabaseCalls())
+func (mock *RestoreAPIMock) RestoreDatabaseCalls() []struct {
+	Ctx     context.Context
+	Archive io.ReaderAt
+	Size    int64
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Archive io.ReaderAt
+		Size    int64
+	}
+	mock.lockRestoreDatabase.RLock()
+	calls = mock.calls.RestoreDatabase
+	mock.lockRestoreDatabase.RUnlock()
+	return calls
+}
+
+// Ensure, that DeclarativeConfigHealthAPIMock does implement DeclarativeConfigHealthAPI.
+// If this is not the case, regenerate this file with moq.
+var _ DeclarativeConfigHealthAPI = &DeclarativeConfigHealthAPIMock{}
+
+// DeclarativeConfigHealthAPIMock is a mock implementation of DeclarativeConfigHealthAPI.
+//
+//	func TestSomethingThatUsesDeclarativeConfigHealthAPI(t *testing.T) {
+//
+//		// make and configure a mocked DeclarativeConfigHealthAPI
+//		mockedDeclarativeConfigHealthAPI := &DeclarativeConfigHealthAPIMock{
+//			GetDeclarativeConfigHealthsFunc: func(ctx context.Context) ([]DeclarativeConfigHealth, error) {
+//				panic("mock out the GetDeclarativeConfigHealths method")
+//			},
+//		}
+//
+//		// use mockedDeclarativeConfigHealthAPI in code that requires DeclarativeConfigHealthAPI
+//		// and then make assertions.
+//
+//	}
+type DeclarativeConfigHealthAPIMock struct {
+	// GetDeclarativeConfigHealthsFunc mocks the GetDeclarativeConfigHealths method.
+	GetDeclarativeConfigHealthsFunc func(ctx context.Context) ([]DeclarativeConfigHealth, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetDeclarativeConfigHealths holds details about calls to the GetDeclarativeConfigHealths method.
+		GetDeclarativeConfigHealths []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockGetDeclarativeConfigHealths sync.RWMutex
+}
+
+// GetDeclarativeConfigHealths calls GetDeclarativeConfigHealthsFunc.
+func (mock *DeclarativeConfigHealthAPIMock) GetDeclarativeConfigHealths(ctx context.Context) ([]DeclarativeConfigHealth, error) {
+	if mock.GetDeclarativeConfigHealthsFunc == nil {
+		panic("DeclarativeConfigHealthAPIMock.GetDeclarativeConfigHealthsFunc: method is nil but DeclarativeConfigHealthAPI.GetDeclarativeConfigHealths was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDeclarativeConfigHealths.Lock()
+	mock.calls.GetDeclarativeConfigHealths = append(mock.calls.GetDeclarativeConfigHealths, callInfo)
+	mock.lockGetDeclarativeConfigHealths.Unlock()
+	return mock.GetDeclarativeConfigHealthsFunc(ctx)
+}
+
+// GetDeclarativeConfigHealthsCalls gets all the calls that were made to GetDeclarativeConfigHealths.
+// Check the length with:
+//
+//	len(mockedDeclarativeConfigHealthAPI.GetDeclarativeConfigHealthsCalls())
+func (mock *DeclarativeConfigHealthAPIMock) GetDeclarativeConfigHealthsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDeclarativeConfigHealths.RLock()
+	calls = mock.calls.GetDeclarativeConfigHealths
+	mock.lockGetDeclarativeConfigHealths.RUnlock()
+	return calls
+}
@@ -0,0 +1,204 @@
+package central
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// CollectionAPI manages the resource collections of Central.
+type CollectionAPI interface {
+	GetCollection(ctx context.Context, id string) (*Collection, error)
+	CreateCollection(ctx context.Context, c *Collection) (*Collection, error)
+	UpdateCollection(ctx context.Context, c *Collection) error
+	DeleteCollection(ctx context.Context, id string) error
+}
+
+// A Collection is a named set of deployments, selected by rules on their
+// cluster, namespace and deployment names and labels.
+type Collection struct {
+	ID                    string             `json:"id,omitempty"`
+	Name                  string             `json:"name"`
+	Description           string             `json:"description,omitempty"`
+	ResourceSelectors     []ResourceSelector `json:"resourceSelectors,omitempty"`
+	EmbeddedCollectionIDs []string           `json:"-"`
+}
+
+// A ResourceSelector selects the deployments matching all of its rules.
+type ResourceSelector struct {
+	Rules []SelectorRule `json:"rules"`
+}
+
+// A SelectorRule matches a field of deployments against values.
+type SelectorRule struct {
+	FieldName string      `json:"fieldName"`
+	Operator  string      `json:"operator"`
+	Values    []RuleValue `json:"values"`
+}
+
+// A RuleValue is a value a SelectorRule matches.
+type RuleValue struct {
+	Value     string `json:"value"`
+	MatchType string `json:"matchType"`
+}
+
+// collectionRequest is the body of requests to create or update collections,
+// which refer to embedded collections by their IDs.
+type collectionRequest struct {
+	Name                  string             `json:"name"`
+	Description           string             `json:"description,omitempty"`
+	ResourceSelectors     []ResourceSelector `json:"resourceSelectors,omitempty"`
+	EmbeddedCollectionIDs []string           `json:"embeddedCollectionIds,omitempty"`
+}
+
+// collection is a collection as returned by Central, which embeds
+// collections as objects.
+type collection struct {
+	Collection
+	EmbeddedCollections []struct {
+		ID string `json:"id"`
+	} `json:"embeddedCollections,omitempty"`
+}
+
+func (c *collection) toCollection() *Collection {
+	out := c.Collection
+	for _, e := range c.EmbeddedCollections {
+		out.EmbeddedCollectionIDs = append(out.EmbeddedCollectionIDs, e.ID)
+	}
+	return &out
+}
+
+func newCollectionRequest(c *Collection) *collectionRequest {
+	return &collectionRequest{
+		Name:                  c.Name,
+		Description:           c.Description,
+		ResourceSelectors:     c.ResourceSelectors,
+		EmbeddedCollectionIDs: c.EmbeddedCollectionIDs,
+	}
+}
+
+func (c *client) GetCollection(ctx context.Context, id string) (*Collection, error) {
+	out := struct {
+		Collection collection `json:"collection"`
+	}{}
+	if err := c.Do(ctx, http.MethodGet, "/v1/collections/"+url.PathEscape(id), nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Collection.toCollection(), nil
+}
+
+func (c *client) CreateCollection(ctx context.Context, in *Collection) (*Collection, error) {
+	out := struct {
+		Collection collection `json:"collection"`
+	}{}
+	if err := c.Do(ctx, http.MethodPost, "/v1/collections", newCollectionRequest(in), &out); err != nil {
+		return nil, err
+	}
+	return out.Collection.toCollection(), nil
+}
+
+func (c *client) UpdateCollection(ctx context.Context, in *Collection) error {
+	return c.Do(ctx, http.MethodPatch, "/v1/collections/"+url.PathEscape(in.ID), newCollectionRequest(in), nil)
+}
+
+func (c *client) DeleteCollection(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/v1/collections/"+url.PathEscape(id), nil, nil)
+}
+
+// ReportConfigurationAPI manages the vulnerability report configurations of
+// Central.
+type ReportConfigurationAPI interface {
+	GetReportConfiguration(ctx context.Context, id string) (*ReportConfiguration, error)
+	CreateReportConfiguration(ctx context.Context, r *ReportConfiguration) (*ReportConfiguration, error)
+	UpdateReportConfiguration(ctx context.Context, r *ReportConfiguration) error
+	DeleteReportConfiguration(ctx context.Context, id string) error
+}
+
+// ReportTypeVulnerability is the type of vulnerability reports.
+const ReportTypeVulnerability = "VULNERABILITY"
+
+// A ReportConfiguration schedules a report on the vulnerabilities of a
+// collection, which is sent by email.
+type ReportConfiguration struct {
+	ID                    string               `json:"id,omitempty"`
+	Name                  string               `json:"name"`
+	Description           string               `json:"description,omitempty"`
+	Type                  string               `json:"type"`
+	VulnReportFilters     *VulnReportFilters   `json:"vulnReportFilters,omitempty"`
+	ScopeID               string               `json:"scopeId"`
+	EmailConfig           *EmailNotifierConfig `json:"emailConfig,omitempty"`
+	Schedule              *Schedule            `json:"schedule,omitempty"`
+	LastRunStatus         *ReportLastRunStatus `json:"lastRunStatus,omitempty"`
+	LastSuccessfulRunTime *time.Time           `json:"lastSuccessfulRunTime,omitempty"`
+}
+
+// VulnReportFilters select the vulnerabilities included in a report.
+type VulnReportFilters struct {
+	Fixability      string   `json:"fixability,omitempty"`
+	SinceLastReport bool     `json:"sinceLastReport,omitempty"`
+	Severities      []string `json:"severities,omitempty"`
+	ImageTypes      []string `json:"imageTypes,omitempty"`
+}
+
+// An EmailNotifierConfig sends reports through an email notifier.
+type EmailNotifierConfig struct {
+	NotifierID   string   `json:"notifierId"`
+	MailingLists []string `json:"mailingLists,omitempty"`
+}
+
+// A Schedule is a recurring time at which Central generates reports or
+// backups.
+type Schedule struct {
+	IntervalType string        `json:"intervalType"`
+	Hour         int32         `json:"hour"`
+	Minute       int32         `json:"minute"`
+	DaysOfWeek   *ScheduleDays `json:"daysOfWeek,omitempty"`
+	DaysOfMonth  *ScheduleDays `json:"daysOfMonth,omitempty"`
+}
+
+// ScheduleDays are days of the week or month.
+type ScheduleDays struct {
+	Days []int32 `json:"days"`
+}
+
+// ReportLastRunStatus is the status of the last run of a report.
+type ReportLastRunStatus struct {
+	ReportStatus string     `json:"reportStatus,omitempty"`
+	LastRunTime  *time.Time `json:"lastRunTime,omitempty"`
+	ErrorMsg     string     `json:"errorMsg,omitempty"`
+}
+
+func (c *client) GetReportConfiguration(ctx context.Context, id string) (*ReportConfiguration, error) {
+	out := struct {
+		ReportConfig *ReportConfiguration `json:"reportConfig"`
+	}{}
+	if err := c.Do(ctx, http.MethodGet, "/v1/report/configurations/"+url.PathEscape(id), nil, &out); err != nil {
+		return nil, err
+	}
+	return out.ReportConfig, nil
+}
+
+func (c *client) CreateReportConfiguration(ctx context.Context, r *ReportConfiguration) (*ReportConfiguration, error) {
+	in := struct {
+		ReportConfig *ReportConfiguration `json:"reportConfig"`
+	}{ReportConfig: r}
+	out := struct {
+		ReportConfig *ReportConfiguration `json:"reportConfig"`
+	}{}
+	if err := c.Do(ctx, http.MethodPost, "/v1/report/configurations", in, &out); err != nil {
+		return nil, err
+	}
+	return out.ReportConfig, nil
+}
+
+func (c *client) UpdateReportConfiguration(ctx context.Context, r *ReportConfiguration) error {
+	in := struct {
+		ReportConfig *ReportConfiguration `json:"reportConfig"`
+	}{ReportConfig: r}
+	return c.Do(ctx, http.MethodPut, "/v1/report/configurations/"+url.PathEscape(r.ID), in, nil)
+}
+
+func (c *client) DeleteReportConfiguration(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/v1/report/configurations/"+url.PathEscape(id), nil, nil)
+}
@@ -0,0 +1,35 @@
+package central
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCollectionEmbeddedIDs(t *testing.T) {
+	var got map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("\nc.CreateCollection(...): unexpected request body: %v\n", err)
+		}
+		_, _ = w.Write([]byte(`{"collection":{"id":"c1","name":"team","embeddedCollections":[{"id":"c0","name":"base"}]}}`))
+	}))
+	defer srv.Close()
+
+	c := newClient(srv.URL, srv.Client())
+	out, err := c.CreateCollection(context.Background(), &Collection{Name: "team", EmbeddedCollectionIDs: []string{"c0"}})
+	if err != nil {
+		t.Fatalf("\nc.CreateCollection(...): unexpected error: %v\n", err)
+	}
+	want := map[string]interface{}{"name": "team", "embeddedCollectionIds": []interface{}{"c0"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\nc.CreateCollection(...): -want request, +got request:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(&Collection{ID: "c1", Name: "team", EmbeddedCollectionIDs: []string{"c0"}}, out); diff != "" {
+		t.Errorf("\nc.CreateCollection(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,334 @@
+package central
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Resource types of declarative configuration, as named by Central.
+const (
+	DeclarativeConfigTypeAuthProvider  = "AUTH_PROVIDER"
+	DeclarativeConfigTypeRole          = "ROLE"
+	DeclarativeConfigTypePermissionSet = "PERMISSION_SET"
+	DeclarativeConfigTypeAccessScope   = "ACCESS_SCOPE"
+	DeclarativeConfigTypeNotifier      = "NOTIFIER"
+)
+
+// Health statuses of declarative configuration.
+const (
+	DeclarativeConfigHealthy   = "HEALTHY"
+	DeclarativeConfigUnhealthy = "UNHEALTHY"
+)
+
+const (
+	errParseDeclarativeConfig = "cannot parse declarative configuration"
+	errDeclarativeConfigType  = "cannot determine type of declarative configuration item"
+	errDeclarativeConfigName  = "declarative configuration item has no name"
+)
+
+// DeclarativeConfigHealthAPI reports the health of the declarative
+// configuration of Central.
+type DeclarativeConfigHealthAPI interface {
+	GetDeclarativeConfigHealths(ctx context.Context) ([]DeclarativeConfigHealth, error)
+}
+
+// A DeclarativeConfigHealth is the health of an item of declarative
+// configuration.
+type DeclarativeConfigHealth struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Status        string     `json:"status"`
+	ErrorMessage  string     `json:"errorMessage,omitempty"`
+	ResourceName  string     `json:"resourceName"`
+	ResourceType  string     `json:"resourceType"`
+	LastTimestamp *time.Time `json:"lastTimestamp,omitempty"`
+}
+
+// GetDeclarativeConfigHealths returns the health of all items of declarative
+// configuration. Centrals without support for declarative configuration
+// respond with not found.
+func (c *client) GetDeclarativeConfigHealths(ctx context.Context) ([]DeclarativeConfigHealth, error) {
+	out := struct {
+		Healths []DeclarativeConfigHealth `json:"healths"`
+	}{}
+	err := c.Do(ctx, http.MethodGet, "/v1/declarative-config/health", nil, &out)
+	return out.Healths, err
+}
+
+// A DeclarativeConfig is an item of declarative configuration in the format
+// ACS reads from mounted config maps. Exactly one of its fields is set.
+type DeclarativeConfig struct {
+	AuthProvider  *DeclarativeAuthProvider
+	Role          *DeclarativeRole
+	PermissionSet *DeclarativePermissionSet
+	AccessScope   *DeclarativeAccessScope
+	Notifier      *DeclarativeNotifier
+}
+
+// Type returns the resource type of the item.
+func (d DeclarativeConfig) Type() string {
+	switch {
+	case d.AuthProvider != nil:
+		return DeclarativeConfigTypeAuthProvider
+	case d.Role != nil:
+		return DeclarativeConfigTypeRole
+	case d.PermissionSet != nil:
+		return DeclarativeConfigTypePermissionSet
+	case d.AccessScope != nil:
+		return DeclarativeConfigTypeAccessScope
+	case d.Notifier != nil:
+		return DeclarativeConfigTypeNotifier
+	}
+	return ""
+}
+
+// Name returns the name of the item.
+func (d DeclarativeConfig) Name() string {
+	switch {
+	case d.AuthProvider != nil:
+		return d.AuthProvider.Name
+	case d.Role != nil:
+		return d.Role.Name
+	case d.PermissionSet != nil:
+		return d.PermissionSet.Name
+	case d.AccessScope != nil:
+		return d.AccessScope.Name
+	case d.Notifier != nil:
+		return d.Notifier.Name
+	}
+	return ""
+}
+
+// A DeclarativeAuthProvider is an auth provider together with the groups
+// that grant roles to its users.
+type DeclarativeAuthProvider struct {
+	Name               string                    `json:"name"`
+	MinimumRole        string                    `json:"minimumRole,omitempty"`
+	UIEndpoint         string                    `json:"uiEndpoint,omitempty"`
+	Groups             []DeclarativeGroup        `json:"groups,omitempty"`
+	RequiredAttributes []DeclarativeAttribute    `json:"requiredAttributes,omitempty"`
+	ClaimMappings      []DeclarativeClaimMapping `json:"claimMappings,omitempty"`
+	OIDC               *DeclarativeOIDC          `json:"oidc,omitempty"`
+	SAML               *DeclarativeSAML          `json:"saml,omitempty"`
+}
+
+// A DeclarativeGroup grants a role to the users of an auth provider whose
+// attribute key has the value.
+type DeclarativeGroup struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Role  string `json:"role"`
+}
+
+// A DeclarativeAttribute is an attribute a user must have to log in.
+type DeclarativeAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// A DeclarativeClaimMapping maps the claim at the path to an attribute name.
+type DeclarativeClaimMapping struct {
+	Path string `json:"path"`
+	Name string `json:"name"`
+}
+
+// DeclarativeOIDC configures an OpenID Connect auth provider.
+type DeclarativeOIDC struct {
+	Issuer       string `json:"issuer"`
+	Mode         string `json:"mode"`
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+}
+
+// DeclarativeSAML configures a SAML 2.0 auth provider.
+type DeclarativeSAML struct {
+	SPIssuer     string `json:"spIssuer"`
+	MetadataURL  string `json:"metadataURL,omitempty"`
+	SSOURL       string `json:"ssoURL,omitempty"`
+	IDPIssuer    string `json:"idpIssuer,omitempty"`
+	Cert         string `json:"cert,omitempty"`
+	NameIDFormat string `json:"nameIdFormat,omitempty"`
+}
+
+// A DeclarativeRole grants the permission set within the access scope, both
+// referenced by name.
+type DeclarativeRole struct {
+	Name          string `json:"name"`
+	Description   string `json:"description,omitempty"`
+	AccessScope   string `json:"accessScope"`
+	PermissionSet string `json:"permissionSet"`
+}
+
+// A DeclarativePermissionSet is the access granted to each resource type.
+type DeclarativePermissionSet struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	Resources   []DeclarativeResource `json:"resources"`
+}
+
+// A DeclarativeResource is the access granted to a resource type.
+type DeclarativeResource struct {
+	Resource string `json:"resource"`
+	Access   string `json:"access"`
+}
+
+// A DeclarativeAccessScope restricts roles to clusters and namespaces.
+type DeclarativeAccessScope struct {
+	Name        string                      `json:"name"`
+	Description string                      `json:"description,omitempty"`
+	Rules       DeclarativeAccessScopeRules `json:"rules"`
+}
+
+// DeclarativeAccessScopeRules select the clusters and namespaces of an
+// access scope.
+type DeclarativeAccessScopeRules struct {
+	Included                []DeclarativeIncludedObject `json:"included,omitempty"`
+	ClusterLabelSelectors   []DeclarativeLabelSelector  `json:"clusterLabelSelectors,omitempty"`
+	NamespaceLabelSelectors []DeclarativeLabelSelector  `json:"namespaceLabelSelectors,omitempty"`
+}
+
+// A DeclarativeIncludedObject includes a cluster, or only the namespaces of
+// it if any are listed.
+type DeclarativeIncludedObject struct {
+	Cluster    string   `json:"cluster"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// A DeclarativeLabelSelector selects clusters or namespaces by their labels.
+type DeclarativeLabelSelector struct {
+	Requirements []DeclarativeLabelRequirement `json:"requirements"`
+}
+
+// A DeclarativeLabelRequirement is a requirement of a label selector.
+type DeclarativeLabelRequirement struct {
+	Key      string   `json:"key"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values,omitempty"`
+}
+
+// A DeclarativeNotifier is a generic or Splunk notifier integration.
+type DeclarativeNotifier struct {
+	Name    string                    `json:"name"`
+	Generic *DeclarativeGenericConfig `json:"generic,omitempty"`
+	Splunk  *DeclarativeSplunkConfig  `json:"splunk,omitempty"`
+}
+
+// DeclarativeGenericConfig configures a notifier that sends notifications to
+// a webhook.
+type DeclarativeGenericConfig struct {
+	Endpoint            string     `json:"endpoint"`
+	SkipTLSVerify       bool       `json:"skipTLSVerify,omitempty"`
+	CACertPEM           string     `json:"caCertPEM,omitempty"`
+	Username            string     `json:"username,omitempty"`
+	Password            string     `json:"password,omitempty"`
+	Headers             []KeyValue `json:"headers,omitempty"`
+	ExtraFields         []KeyValue `json:"extraFields,omitempty"`
+	AuditLoggingEnabled bool       `json:"auditLoggingEnabled,omitempty"`
+}
+
+// DeclarativeSplunkConfig configures a notifier that sends notifications to
+// the Splunk HTTP event collector.
+type DeclarativeSplunkConfig struct {
+	Token               string `json:"token"`
+	Endpoint            string `json:"endpoint"`
+	Truncate            int64  `json:"truncate,omitempty"`
+	Insecure            bool   `json:"insecure,omitempty"`
+	AuditLoggingEnabled bool   `json:"auditLoggingEnabled,omitempty"`
+}
+
+// declarativeConfigKeys are keys that identify the type of an item of
+// declarative configuration, which does not state its type.
+var declarativeConfigKeys = []struct {
+	key string
+	typ string
+}{
+	{"oidc", DeclarativeConfigTypeAuthProvider},
+	{"saml", DeclarativeConfigTypeAuthProvider},
+	{"minimumRole", DeclarativeConfigTypeAuthProvider},
+	{"groups", DeclarativeConfigTypeAuthProvider},
+	{"resources", DeclarativeConfigTypePermissionSet},
+	{"rules", DeclarativeConfigTypeAccessScope},
+	{"permissionSet", DeclarativeConfigTypeRole},
+	{"accessScope", DeclarativeConfigTypeRole},
+	{"generic", DeclarativeConfigTypeNotifier},
+	{"splunk", DeclarativeConfigTypeNotifier},
+}
+
+// ParseDeclarativeConfig parses the items of declarative configuration in
+// the supplied YAML or JSON documents. Unknown fields are rejected, so that
+// typos do not go unnoticed.
+func ParseDeclarativeConfig(data []byte) ([]DeclarativeConfig, error) {
+	var items []DeclarativeConfig
+	d := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		raw := map[string]json.RawMessage{}
+		err := d.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			return items, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, errParseDeclarativeConfig)
+		}
+		if len(raw) == 0 {
+			continue
+		}
+		item, err := parseDeclarativeConfigItem(raw)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+}
+
+func parseDeclarativeConfigItem(raw map[string]json.RawMessage) (DeclarativeConfig, error) {
+	typ := ""
+	for _, k := range declarativeConfigKeys {
+		if _, ok := raw[k.key]; ok {
+			typ = k.typ
+			break
+		}
+	}
+
+	item := DeclarativeConfig{}
+	var out interface{}
+	switch typ {
+	case DeclarativeConfigTypeAuthProvider:
+		item.AuthProvider = &DeclarativeAuthProvider{}
+		out = item.AuthProvider
+	case DeclarativeConfigTypePermissionSet:
+		item.PermissionSet = &DeclarativePermissionSet{}
+		out = item.PermissionSet
+	case DeclarativeConfigTypeAccessScope:
+		item.AccessScope = &DeclarativeAccessScope{}
+		out = item.AccessScope
+	case DeclarativeConfigTypeRole:
+		item.Role = &DeclarativeRole{}
+		out = item.Role
+	case DeclarativeConfigTypeNotifier:
+		item.Notifier = &DeclarativeNotifier{}
+		out = item.Notifier
+	default:
+		return item, errors.New(errDeclarativeConfigType)
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return item, errors.Wrap(err, errParseDeclarativeConfig)
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(out); err != nil {
+		return item, errors.Wrap(err, errParseDeclarativeConfig)
+	}
+	if item.Name() == "" {
+		return item, errors.New(errDeclarativeConfigName)
+	}
+	return item, nil
+}
@@ -0,0 +1,103 @@
+package central
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseDeclarativeConfig(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+		want []DeclarativeConfig
+		err  error
+	}{
+		{
+			name: "items",
+			data: `
+name: readers
+resources:
+- resource: Deployment
+  access: READ_ACCESS
+---
+name: team
+rules:
+  included:
+  - cluster: prod
+    namespaces: [team]
+---
+name: team-reader
+permissionSet: readers
+accessScope: team
+---
+---
+name: sso
+minimumRole: None
+groups:
+- key: groups
+  value: team
+  role: team-reader
+oidc:
+  issuer: https://sso.example.com
+  mode: auto
+  clientID: acs
+---
+name: webhook
+generic:
+  endpoint: https://hooks.example.com
+`,
+			want: []DeclarativeConfig{
+				{PermissionSet: &DeclarativePermissionSet{
+					Name:      "readers",
+					Resources: []DeclarativeResource{{Resource: "Deployment", Access: "READ_ACCESS"}},
+				}},
+				{AccessScope: &DeclarativeAccessScope{
+					Name: "team",
+					Rules: DeclarativeAccessScopeRules{
+						Included: []DeclarativeIncludedObject{{Cluster: "prod", Namespaces: []string{"team"}}},
+					},
+				}},
+				{Role: &DeclarativeRole{Name: "team-reader", PermissionSet: "readers", AccessScope: "team"}},
+				{AuthProvider: &DeclarativeAuthProvider{
+					Name:        "sso",
+					MinimumRole: "None",
+					Groups:      []DeclarativeGroup{{Key: "groups", Value: "team", Role: "team-reader"}},
+					OIDC:        &DeclarativeOIDC{Issuer: "https://sso.example.com", Mode: "auto", ClientID: "acs"},
+				}},
+				{Notifier: &DeclarativeNotifier{
+					Name:    "webhook",
+					Generic: &DeclarativeGenericConfig{Endpoint: "https://hooks.example.com"},
+				}},
+			},
+		},
+		{
+			name: "unknown type",
+			data: "name: what\ncolor: blue\n",
+			err:  cmpopts.AnyError,
+		},
+		{
+			name: "unknown field",
+			data: "name: team-reader\npermissionSet: readers\naccessScope: team\ncolor: blue\n",
+			err:  cmpopts.AnyError,
+		},
+		{
+			name: "no name",
+			data: "permissionSet: readers\naccessScope: team\n",
+			err:  cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseDeclarativeConfig([]byte(tc.data))
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\nParseDeclarativeConfig(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nParseDeclarativeConfig(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
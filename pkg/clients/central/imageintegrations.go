@@ -0,0 +1,113 @@
+package central
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Image integration types supported by Central.
+const (
+	ImageIntegrationTypeDocker = "docker"
+	ImageIntegrationTypeQuay   = "quay"
+	ImageIntegrationTypeECR    = "ecr"
+	ImageIntegrationTypeGoogle = "google"
+)
+
+// ImageIntegrationAPI manages the image integrations of Central.
+type ImageIntegrationAPI interface {
+	GetImageIntegration(ctx context.Context, id string) (*ImageIntegration, error)
+	CreateImageIntegration(ctx context.Context, i *ImageIntegration) (*ImageIntegration, error)
+	UpdateImageIntegration(ctx context.Context, i *ImageIntegration) error
+	TestImageIntegration(ctx context.Context, i *ImageIntegration) error
+	DeleteImageIntegration(ctx context.Context, id string) error
+}
+
+// An ImageIntegration is an image registry or scanner integration of
+// Central. Central masks the sensitive fields of integrations it returns.
+type ImageIntegration struct {
+	ID                  string                  `json:"id,omitempty"`
+	Name                string                  `json:"name"`
+	Type                string                  `json:"type"`
+	Categories          []string                `json:"categories"`
+	SkipTestIntegration bool                    `json:"skipTestIntegration,omitempty"`
+	Docker              *DockerImageIntegration `json:"docker,omitempty"`
+	Quay                *QuayImageIntegration   `json:"quay,omitempty"`
+	ECR                 *ECRImageIntegration    `json:"ecr,omitempty"`
+	Google              *GoogleImageIntegration `json:"google,omitempty"`
+}
+
+// A DockerImageIntegration is a generic Docker registry.
+type DockerImageIntegration struct {
+	Endpoint string `json:"endpoint"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Insecure bool   `json:"insecure,omitempty"`
+}
+
+// A QuayImageIntegration is a Quay registry or scanner.
+type QuayImageIntegration struct {
+	Endpoint                 string                       `json:"endpoint"`
+	OAuthToken               string                       `json:"oauthToken,omitempty"`
+	Insecure                 bool                         `json:"insecure,omitempty"`
+	RegistryRobotCredentials *QuayRobotAccountCredentials `json:"registryRobotCredentials,omitempty"`
+}
+
+// QuayRobotAccountCredentials authenticate a Quay robot account.
+type QuayRobotAccountCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+}
+
+// An ECRImageIntegration is an Amazon Elastic Container Registry.
+type ECRImageIntegration struct {
+	RegistryID           string `json:"registryId"`
+	Region               string `json:"region"`
+	Endpoint             string `json:"endpoint,omitempty"`
+	UseIAM               bool   `json:"useIam,omitempty"`
+	AccessKeyID          string `json:"accessKeyId,omitempty"`
+	SecretAccessKey      string `json:"secretAccessKey,omitempty"`
+	UseAssumeRole        bool   `json:"useAssumeRole,omitempty"`
+	AssumeRoleID         string `json:"assumeRoleId,omitempty"`
+	AssumeRoleExternalID string `json:"assumeRoleExternalId,omitempty"`
+}
+
+// A GoogleImageIntegration is a Google Container or Artifact Registry.
+type GoogleImageIntegration struct {
+	Endpoint       string `json:"endpoint"`
+	Project        string `json:"project"`
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+}
+
+func (c *client) GetImageIntegration(ctx context.Context, id string) (*ImageIntegration, error) {
+	out := &ImageIntegration{}
+	err := c.Do(ctx, http.MethodGet, "/v1/imageintegrations/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) CreateImageIntegration(ctx context.Context, i *ImageIntegration) (*ImageIntegration, error) {
+	out := &ImageIntegration{}
+	err := c.Do(ctx, http.MethodPost, "/v1/imageintegrations", i, out)
+	return out, err
+}
+
+// UpdateImageIntegration updates the integration including its sensitive
+// fields.
+func (c *client) UpdateImageIntegration(ctx context.Context, i *ImageIntegration) error {
+	in := struct {
+		Config         *ImageIntegration `json:"config"`
+		UpdatePassword bool              `json:"updatePassword"`
+	}{Config: i, UpdatePassword: true}
+	return c.Do(ctx, http.MethodPut, "/v1/imageintegrations/"+url.PathEscape(i.ID), in, nil)
+}
+
+// TestImageIntegration lets Central connect to the registry or scanner of
+// the supplied integration. It returns an APIError describing the failure
+// if Central cannot connect.
+func (c *client) TestImageIntegration(ctx context.Context, i *ImageIntegration) error {
+	return c.Do(ctx, http.MethodPost, "/v1/imageintegrations/test", i, nil)
+}
+
+func (c *client) DeleteImageIntegration(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/v1/imageintegrations/"+url.PathEscape(id), nil, nil)
+}
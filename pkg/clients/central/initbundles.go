@@ -0,0 +1,104 @@
+package central
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// InitBundleAPI manages the init bundles secured clusters use to connect to
+// Central.
+type InitBundleAPI interface {
+	GenerateInitBundle(ctx context.Context, name string) (*InitBundle, error)
+	ListInitBundles(ctx context.Context) ([]InitBundleMeta, error)
+	RevokeInitBundles(ctx context.Context, ids []string, confirmImpactedClusterIDs []string) error
+}
+
+// InitBundleMeta describes an init bundle.
+type InitBundleMeta struct {
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	ImpactedClusters []ImpactedCluster `json:"impactedClusters,omitempty"`
+	CreatedAt        *time.Time        `json:"createdAt,omitempty"`
+	ExpiresAt        *time.Time        `json:"expiresAt,omitempty"`
+}
+
+// An ImpactedCluster is a secured cluster that was set up with an init bundle.
+type ImpactedCluster struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// An InitBundle is a newly generated init bundle. Its content is only
+// available at generation.
+type InitBundle struct {
+	Meta InitBundleMeta `json:"meta"`
+
+	// HelmValuesBundle is the init bundle in the form of Helm values for the
+	// secured cluster services chart.
+	HelmValuesBundle []byte `json:"helmValuesBundle"`
+
+	// KubectlBundle is the init bundle in the form of Kubernetes secrets.
+	KubectlBundle []byte `json:"kubectlBundle"`
+}
+
+// InitBundleTLS is the TLS material contained in an init bundle.
+type InitBundleTLS struct {
+	CA struct {
+		Cert string `json:"cert"`
+	} `json:"ca"`
+	Sensor           ServiceTLS `json:"sensor"`
+	Collector        ServiceTLS `json:"collector"`
+	AdmissionControl ServiceTLS `json:"admissionControl"`
+}
+
+// ServiceTLS is the TLS material of a secured cluster service.
+type ServiceTLS struct {
+	ServiceTLS struct {
+		Cert string `json:"cert"`
+		Key  string `json:"key"`
+	} `json:"serviceTLS"`
+}
+
+// TLS parses the TLS material from the Helm values of the init bundle.
+func (b *InitBundle) TLS() (*InitBundleTLS, error) {
+	tls := &InitBundleTLS{}
+	return tls, errors.Wrap(yaml.Unmarshal(b.HelmValuesBundle, tls), "cannot parse init bundle Helm values")
+}
+
+func (c *client) GenerateInitBundle(ctx context.Context, name string) (*InitBundle, error) {
+	out := &InitBundle{}
+	err := c.Do(ctx, http.MethodPost, "/v1/cluster-init/init-bundles", map[string]string{"name": name}, out)
+	return out, err
+}
+
+func (c *client) ListInitBundles(ctx context.Context) ([]InitBundleMeta, error) {
+	out := struct {
+		Items []InitBundleMeta `json:"items"`
+	}{}
+	err := c.Do(ctx, http.MethodGet, "/v1/cluster-init/init-bundles", nil, &out)
+	return out.Items, err
+}
+
+func (c *client) RevokeInitBundles(ctx context.Context, ids []string, confirmImpactedClusterIDs []string) error {
+	in := struct {
+		IDs                        []string `json:"ids"`
+		ConfirmImpactedClustersIDs []string `json:"confirmImpactedClustersIds"`
+	}{IDs: ids, ConfirmImpactedClustersIDs: confirmImpactedClusterIDs}
+	out := struct {
+		Errors []struct {
+			ID    string `json:"id"`
+			Error string `json:"error"`
+		} `json:"initBundleRevocationErrors"`
+	}{}
+	if err := c.Do(ctx, http.MethodPatch, "/v1/cluster-init/init-bundles/revoke", in, &out); err != nil {
+		return err
+	}
+	if len(out.Errors) > 0 {
+		return errors.Errorf("cannot revoke init bundle %s: %s", out.Errors[0].ID, out.Errors[0].Error)
+	}
+	return nil
+}
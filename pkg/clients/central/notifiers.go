@@ -0,0 +1,101 @@
+package central
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Notifier types supported by Central.
+const (
+	NotifierTypeSlack   = "slack"
+	NotifierTypeGeneric = "generic"
+	NotifierTypeEmail   = "email"
+	NotifierTypeSplunk  = "splunk"
+)
+
+// NotifierAPI manages the notifier integrations of Central.
+type NotifierAPI interface {
+	GetNotifier(ctx context.Context, id string) (*Notifier, error)
+	CreateNotifier(ctx context.Context, n *Notifier) (*Notifier, error)
+	UpdateNotifier(ctx context.Context, n *Notifier) error
+	DeleteNotifier(ctx context.Context, id string) error
+}
+
+// A Notifier is a notifier integration of Central. Central masks the
+// sensitive fields of notifiers it returns.
+type Notifier struct {
+	ID           string           `json:"id,omitempty"`
+	Name         string           `json:"name"`
+	Type         string           `json:"type"`
+	UIEndpoint   string           `json:"uiEndpoint"`
+	LabelKey     string           `json:"labelKey,omitempty"`
+	LabelDefault string           `json:"labelDefault,omitempty"`
+	Generic      *GenericNotifier `json:"generic,omitempty"`
+	Email        *EmailNotifier   `json:"email,omitempty"`
+	Splunk       *SplunkNotifier  `json:"splunk,omitempty"`
+}
+
+// A GenericNotifier sends notifications to a webhook.
+type GenericNotifier struct {
+	Endpoint            string     `json:"endpoint"`
+	SkipTLSVerify       bool       `json:"skipTLSVerify,omitempty"`
+	CACert              string     `json:"caCert,omitempty"`
+	Username            string     `json:"username,omitempty"`
+	Password            string     `json:"password,omitempty"`
+	Headers             []KeyValue `json:"headers,omitempty"`
+	ExtraFields         []KeyValue `json:"extraFields,omitempty"`
+	AuditLoggingEnabled bool       `json:"auditLoggingEnabled,omitempty"`
+}
+
+// A KeyValue is a header or field added to notifications.
+type KeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// An EmailNotifier sends notifications by email.
+type EmailNotifier struct {
+	Server                   string `json:"server"`
+	Sender                   string `json:"sender"`
+	From                     string `json:"from,omitempty"`
+	Username                 string `json:"username,omitempty"`
+	Password                 string `json:"password,omitempty"`
+	DisableTLS               bool   `json:"disableTLS,omitempty"`
+	StartTLSAuthMethod       string `json:"startTLSAuthMethod,omitempty"`
+	AllowUnauthenticatedSMTP bool   `json:"allowUnauthenticatedSmtp,omitempty"`
+}
+
+// A SplunkNotifier sends notifications to the Splunk HTTP event collector.
+type SplunkNotifier struct {
+	HTTPToken           string `json:"httpToken"`
+	HTTPEndpoint        string `json:"httpEndpoint"`
+	Insecure            bool   `json:"insecure,omitempty"`
+	Truncate            int64  `json:"truncate,omitempty,string"`
+	AuditLoggingEnabled bool   `json:"auditLoggingEnabled,omitempty"`
+}
+
+func (c *client) GetNotifier(ctx context.Context, id string) (*Notifier, error) {
+	out := &Notifier{}
+	err := c.Do(ctx, http.MethodGet, "/v1/notifiers/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) CreateNotifier(ctx context.Context, n *Notifier) (*Notifier, error) {
+	out := &Notifier{}
+	err := c.Do(ctx, http.MethodPost, "/v1/notifiers", n, out)
+	return out, err
+}
+
+// UpdateNotifier updates the notifier including its sensitive fields.
+func (c *client) UpdateNotifier(ctx context.Context, n *Notifier) error {
+	in := struct {
+		Notifier       *Notifier `json:"notifier"`
+		UpdatePassword bool      `json:"updatePassword"`
+	}{Notifier: n, UpdatePassword: true}
+	return c.Do(ctx, http.MethodPut, "/v1/notifiers/"+url.PathEscape(n.ID), in, nil)
+}
+
+func (c *client) DeleteNotifier(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/v1/notifiers/"+url.PathEscape(id), nil, nil)
+}
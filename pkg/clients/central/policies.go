@@ -0,0 +1,117 @@
+package central
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// PolicyAPI manages the security policies of Central.
+type PolicyAPI interface {
+	GetPolicy(ctx context.Context, id string) (*Policy, error)
+	CreatePolicy(ctx context.Context, p *Policy) (*Policy, error)
+	UpdatePolicy(ctx context.Context, p *Policy) error
+	DeletePolicy(ctx context.Context, id string) error
+}
+
+// A Policy is a security policy of Central.
+type Policy struct {
+	ID                 string              `json:"id,omitempty"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description,omitempty"`
+	Rationale          string              `json:"rationale,omitempty"`
+	Remediation        string              `json:"remediation,omitempty"`
+	Disabled           bool                `json:"disabled"`
+	Categories         []string            `json:"categories"`
+	LifecycleStages    []string            `json:"lifecycleStages"`
+	EventSource        string              `json:"eventSource,omitempty"`
+	Exclusions         []PolicyExclusion   `json:"exclusions"`
+	Scope              []Scope             `json:"scope"`
+	Severity           string              `json:"severity"`
+	EnforcementActions []string            `json:"enforcementActions"`
+	Notifiers          []string            `json:"notifiers"`
+	PolicyVersion      string              `json:"policyVersion,omitempty"`
+	PolicySections     []PolicySection     `json:"policySections"`
+	MitreAttackVectors []MitreAttackVector `json:"mitreAttackVectors,omitempty"`
+	IsDefault          bool                `json:"isDefault,omitempty"`
+	LastUpdated        *time.Time          `json:"lastUpdated,omitempty"`
+}
+
+// A Scope restricts a policy to a cluster, namespace or label.
+type Scope struct {
+	Cluster   string      `json:"cluster,omitempty"`
+	Namespace string      `json:"namespace,omitempty"`
+	Label     *ScopeLabel `json:"label,omitempty"`
+}
+
+// A ScopeLabel matches a label of a deployment.
+type ScopeLabel struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// A PolicyExclusion excludes deployments or images from a policy.
+type PolicyExclusion struct {
+	Name       string                     `json:"name,omitempty"`
+	Deployment *PolicyExclusionDeployment `json:"deployment,omitempty"`
+	Image      *PolicyExclusionImage      `json:"image,omitempty"`
+	Expiration *time.Time                 `json:"expiration,omitempty"`
+}
+
+// A PolicyExclusionDeployment excludes deployments from a policy.
+type PolicyExclusionDeployment struct {
+	Name  string `json:"name,omitempty"`
+	Scope *Scope `json:"scope,omitempty"`
+}
+
+// A PolicyExclusionImage excludes images from a policy.
+type PolicyExclusionImage struct {
+	Name string `json:"name,omitempty"`
+}
+
+// A PolicySection is a set of criteria that all must match for a policy to be
+// violated.
+type PolicySection struct {
+	SectionName  string        `json:"sectionName,omitempty"`
+	PolicyGroups []PolicyGroup `json:"policyGroups"`
+}
+
+// A PolicyGroup is a criterion of a policy section.
+type PolicyGroup struct {
+	FieldName       string        `json:"fieldName"`
+	BooleanOperator string        `json:"booleanOperator,omitempty"`
+	Negate          bool          `json:"negate,omitempty"`
+	Values          []PolicyValue `json:"values"`
+}
+
+// A PolicyValue is a value of a policy criterion.
+type PolicyValue struct {
+	Value string `json:"value"`
+}
+
+// A MitreAttackVector is a MITRE ATT&CK tactic with its techniques.
+type MitreAttackVector struct {
+	Tactic     string   `json:"tactic"`
+	Techniques []string `json:"techniques,omitempty"`
+}
+
+func (c *client) GetPolicy(ctx context.Context, id string) (*Policy, error) {
+	out := &Policy{}
+	err := c.Do(ctx, http.MethodGet, "/v1/policies/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) CreatePolicy(ctx context.Context, p *Policy) (*Policy, error) {
+	out := &Policy{}
+	err := c.Do(ctx, http.MethodPost, "/v1/policies", p, out)
+	return out, err
+}
+
+func (c *client) UpdatePolicy(ctx context.Context, p *Policy) error {
+	return c.Do(ctx, http.MethodPut, "/v1/policies/"+url.PathEscape(p.ID), p, nil)
+}
+
+func (c *client) DeletePolicy(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/v1/policies/"+url.PathEscape(id), nil, nil)
+}
@@ -0,0 +1,158 @@
+package central
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// RoleAPI manages the roles of Central. Roles are identified by their name.
+type RoleAPI interface {
+	GetRole(ctx context.Context, name string) (*Role, error)
+	CreateRole(ctx context.Context, r *Role) error
+	UpdateRole(ctx context.Context, r *Role) error
+	DeleteRole(ctx context.Context, name string) error
+}
+
+// A Role grants the permissions of a permission set within an access scope.
+type Role struct {
+	Name            string `json:"name"`
+	Description     string `json:"description,omitempty"`
+	PermissionSetID string `json:"permissionSetId"`
+	AccessScopeID   string `json:"accessScopeId"`
+}
+
+// PermissionSetAPI manages the permission sets of Central.
+type PermissionSetAPI interface {
+	GetPermissionSet(ctx context.Context, id string) (*PermissionSet, error)
+	ListPermissionSets(ctx context.Context) ([]PermissionSet, error)
+	CreatePermissionSet(ctx context.Context, p *PermissionSet) (*PermissionSet, error)
+	UpdatePermissionSet(ctx context.Context, p *PermissionSet) error
+	DeletePermissionSet(ctx context.Context, id string) error
+}
+
+// A PermissionSet is the access granted to each resource type of Central.
+type PermissionSet struct {
+	ID               string            `json:"id,omitempty"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	ResourceToAccess map[string]string `json:"resourceToAccess,omitempty"`
+}
+
+// AccessScopeAPI manages the access scopes of Central.
+type AccessScopeAPI interface {
+	GetAccessScope(ctx context.Context, id string) (*AccessScope, error)
+	ListAccessScopes(ctx context.Context) ([]AccessScope, error)
+	CreateAccessScope(ctx context.Context, s *AccessScope) (*AccessScope, error)
+	UpdateAccessScope(ctx context.Context, s *AccessScope) error
+	DeleteAccessScope(ctx context.Context, id string) error
+}
+
+// An AccessScope restricts a role to clusters and namespaces.
+type AccessScope struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Rules       *AccessScopeRules `json:"rules,omitempty"`
+}
+
+// AccessScopeRules select the clusters and namespaces of an access scope.
+type AccessScopeRules struct {
+	IncludedClusters        []string            `json:"includedClusters,omitempty"`
+	IncludedNamespaces      []IncludedNamespace `json:"includedNamespaces,omitempty"`
+	ClusterLabelSelectors   []LabelSelector     `json:"clusterLabelSelectors,omitempty"`
+	NamespaceLabelSelectors []LabelSelector     `json:"namespaceLabelSelectors,omitempty"`
+}
+
+// An IncludedNamespace is a namespace of a cluster included in an access
+// scope.
+type IncludedNamespace struct {
+	ClusterName   string `json:"clusterName"`
+	NamespaceName string `json:"namespaceName"`
+}
+
+// A LabelSelector selects clusters or namespaces by their labels.
+type LabelSelector struct {
+	Requirements []LabelRequirement `json:"requirements"`
+}
+
+// A LabelRequirement is a requirement of a label selector.
+type LabelRequirement struct {
+	Key    string   `json:"key"`
+	Op     string   `json:"op"`
+	Values []string `json:"values,omitempty"`
+}
+
+func (c *client) GetRole(ctx context.Context, name string) (*Role, error) {
+	out := &Role{}
+	err := c.Do(ctx, http.MethodGet, "/v1/roles/"+url.PathEscape(name), nil, out)
+	return out, err
+}
+
+func (c *client) CreateRole(ctx context.Context, r *Role) error {
+	return c.Do(ctx, http.MethodPost, "/v1/roles/"+url.PathEscape(r.Name), r, nil)
+}
+
+func (c *client) UpdateRole(ctx context.Context, r *Role) error {
+	return c.Do(ctx, http.MethodPut, "/v1/roles/"+url.PathEscape(r.Name), r, nil)
+}
+
+func (c *client) DeleteRole(ctx context.Context, name string) error {
+	return c.Do(ctx, http.MethodDelete, "/v1/roles/"+url.PathEscape(name), nil, nil)
+}
+
+func (c *client) GetPermissionSet(ctx context.Context, id string) (*PermissionSet, error) {
+	out := &PermissionSet{}
+	err := c.Do(ctx, http.MethodGet, "/v1/permissionsets/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) ListPermissionSets(ctx context.Context) ([]PermissionSet, error) {
+	out := struct {
+		PermissionSets []PermissionSet `json:"permissionSets"`
+	}{}
+	err := c.Do(ctx, http.MethodGet, "/v1/permissionsets", nil, &out)
+	return out.PermissionSets, err
+}
+
+func (c *client) CreatePermissionSet(ctx context.Context, p *PermissionSet) (*PermissionSet, error) {
+	out := &PermissionSet{}
+	err := c.Do(ctx, http.MethodPost, "/v1/permissionsets", p, out)
+	return out, err
+}
+
+func (c *client) UpdatePermissionSet(ctx context.Context, p *PermissionSet) error {
+	return c.Do(ctx, http.MethodPut, "/v1/permissionsets/"+url.PathEscape(p.ID), p, nil)
+}
+
+func (c *client) DeletePermissionSet(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/v1/permissionsets/"+url.PathEscape(id), nil, nil)
+}
+
+func (c *client) GetAccessScope(ctx context.Context, id string) (*AccessScope, error) {
+	out := &AccessScope{}
+	err := c.Do(ctx, http.MethodGet, "/v1/simpleaccessscopes/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) ListAccessScopes(ctx context.Context) ([]AccessScope, error) {
+	out := struct {
+		AccessScopes []AccessScope `json:"accessScopes"`
+	}{}
+	err := c.Do(ctx, http.MethodGet, "/v1/simpleaccessscopes", nil, &out)
+	return out.AccessScopes, err
+}
+
+func (c *client) CreateAccessScope(ctx context.Context, s *AccessScope) (*AccessScope, error) {
+	out := &AccessScope{}
+	err := c.Do(ctx, http.MethodPost, "/v1/simpleaccessscopes", s, out)
+	return out, err
+}
+
+func (c *client) UpdateAccessScope(ctx context.Context, s *AccessScope) error {
+	return c.Do(ctx, http.MethodPut, "/v1/simpleaccessscopes/"+url.PathEscape(s.ID), s, nil)
+}
+
+func (c *client) DeleteAccessScope(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/v1/simpleaccessscopes/"+url.PathEscape(id), nil, nil)
+}
@@ -0,0 +1,152 @@
+// Package httpapi contains the plumbing shared by the clients of the JSON over
+// HTTP APIs the provider manages resources through.
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+
+	"github.com/stehessel/provider-redhat/pkg/tracing"
+)
+
+const (
+	errFmtMarshal   = "cannot marshal %s API request"
+	errFmtUnmarshal = "cannot unmarshal %s API response"
+	errFmtRequest   = "cannot send %s API request"
+)
+
+// A Client sends requests to a JSON over HTTP API.
+type Client struct {
+	// Name of the API in errors, e.g. ocm.
+	Name string
+
+	// Endpoint the paths of requests are relative to.
+	Endpoint string
+
+	// HTTP client requests are sent with.
+	HTTP *http.Client
+
+	// ErrorMessage returns the code and message explaining an error in the
+	// supplied body of a response the API did not accept. Either may be empty,
+	// in which case the message of the error is the body itself.
+	ErrorMessage func(body []byte) (code, message string)
+}
+
+// NewHTTPClient returns an HTTP client that authenticates requests with the
+// supplied Auth. Every request is sent through an instrumented transport, so
+// that API calls show up as child spans of the reconcile that issued them when
+// tracing is enabled.
+func NewHTTPClient(auth fleetmanager.Auth) *http.Client {
+	return &http.Client{Transport: tracing.NewTransport(NewAuthTransport(auth))}
+}
+
+// Do sends a request with the JSON encoding of in as body to the supplied path,
+// and decodes the JSON response into out. Either may be nil.
+func (c *Client) Do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return errors.Wrapf(err, errFmtMarshal, c.Name)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.Endpoint+path, body)
+	if err != nil {
+		return errors.Wrapf(err, errFmtRequest, c.Name)
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	b, err := c.Send(req)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(b) == 0 {
+		return nil
+	}
+	return errors.Wrapf(json.Unmarshal(b, out), errFmtUnmarshal, c.Name)
+}
+
+// Send sends the supplied request and returns the body of the response. The
+// returned error is an *APIError if the API did not accept the request.
+func (c *Client) Send(req *http.Request) ([]byte, error) {
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtRequest, c.Name)
+	}
+	defer resp.Body.Close() //nolint:errcheck // The body is fully read below.
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtRequest, c.Name)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, c.newAPIError(resp.StatusCode, b)
+	}
+	return b, nil
+}
+
+func (c *Client) newAPIError(code int, body []byte) error {
+	e := &APIError{API: c.Name, StatusCode: code}
+	if c.ErrorMessage != nil {
+		e.Code, e.Message = c.ErrorMessage(body)
+	}
+	if e.Message == "" {
+		e.Code, e.Message = "", strings.TrimSpace(string(body))
+	}
+	return e
+}
+
+// An APIError is returned for requests an API did not accept.
+type APIError struct {
+	API        string
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("%s API responded with %d: %s", e.API, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("%s API responded with %d (%s): %s", e.API, e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound returns true if the supplied error indicates that the requested
+// API object does not exist.
+func IsNotFound(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+// NewAuthTransport returns a RoundTripper that wraps http.DefaultTransport and
+// injects the authorization header from the supplied Auth into any request.
+func NewAuthTransport(auth fleetmanager.Auth) http.RoundTripper {
+	return &authTransport{transport: http.DefaultTransport, auth: auth}
+}
+
+type authTransport struct {
+	transport http.RoundTripper
+	auth      fleetmanager.Auth
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrip must not modify the original request.
+	req = req.Clone(req.Context())
+	if err := t.auth.AddAuth(req); err != nil {
+		return nil, errors.Wrap(err, "failed to set authentication")
+	}
+	return t.transport.RoundTrip(req)
+}
@@ -0,0 +1,82 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDo(t *testing.T) {
+	errorMessage := func(body []byte) (string, string) {
+		if string(body) == "described" {
+			return "CODE", "explained"
+		}
+		return "", ""
+	}
+
+	cases := []struct {
+		name     string
+		status   int
+		body     string
+		want     map[string]string
+		message  string
+		notFound bool
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body:   `{"name":"out"}`,
+			want:   map[string]string{"name": "out"},
+		},
+		{
+			name:   "empty response",
+			status: http.StatusNoContent,
+		},
+		{
+			name:     "described error",
+			status:   http.StatusNotFound,
+			body:     "described",
+			message:  "test API responded with 404 (CODE): explained",
+			notFound: true,
+		},
+		{
+			name:    "undescribed error",
+			status:  http.StatusInternalServerError,
+			body:    "boom\n",
+			message: "test API responded with 500: boom",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/test" || r.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("\nc.Do(...): unexpected request %s %s\n", r.URL.Path, r.Header.Get("Content-Type"))
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			c := &Client{Name: "test", Endpoint: srv.URL + "/api", HTTP: srv.Client(), ErrorMessage: errorMessage}
+			var got map[string]string
+			err := c.Do(context.Background(), http.MethodPost, "/test", map[string]string{"name": "in"}, &got)
+			msg := ""
+			if err != nil {
+				msg = err.Error()
+			}
+			if msg != tc.message {
+				t.Errorf("\nc.Do(...): want error %q, got %q\n", tc.message, msg)
+			}
+			if IsNotFound(err) != tc.notFound {
+				t.Errorf("\nIsNotFound(...): want %t, got %t\n", tc.notFound, IsNotFound(err))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nc.Do(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package iam
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that ServiceAccountAPIMock does implement ServiceAccountAPI.
+// If this is not the case, regenerate this file with moq.
+var _ ServiceAccountAPI = &ServiceAccountAPIMock{}
+
+// ServiceAccountAPIMock is a mock implementation of ServiceAccountAPI.
+//
+//	func TestSomethingThatUsesServiceAccountAPI(t *testing.T) {
+//
+//		// make and configure a mocked ServiceAccountAPI
+//		mockedServiceAccountAPI := &ServiceAccountAPIMock{
+//			CreateServiceAccountFunc: func(ctx context.Context, sa *ServiceAccount) (*ServiceAccount, error) {
+//				panic("mock out the CreateServiceAccount method")
+//			},
+//			DeleteServiceAccountFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteServiceAccount method")
+//			},
+//			GetServiceAccountFunc: func(ctx context.Context, id string) (*ServiceAccount, error) {
+//				panic("mock out the GetServiceAccount method")
+//			},
+//			ResetServiceAccountSecretFunc: func(ctx context.Context, id string) (*ServiceAccount, error) {
+//				panic("mock out the ResetServiceAccountSecret method")
+//			},
+//			UpdateServiceAccountFunc: func(ctx context.Context, sa *ServiceAccount) (*ServiceAccount, error) {
+//				panic("mock out the UpdateServiceAccount method")
+//			},
+//		}
+//
+//		// use mockedServiceAccountAPI in code that requires ServiceAccountAPI
+//		// and then make assertions.
+//
+//	}
+type ServiceAccountAPIMock struct {
+	// CreateServiceAccountFunc mocks the CreateServiceAccount method.
+	CreateServiceAccountFunc func(ctx context.Context, sa *ServiceAccount) (*ServiceAccount, error)
+
+	// DeleteServiceAccountFunc mocks the DeleteServiceAccount method.
+	DeleteServiceAccountFunc func(ctx context.Context, id string) error
+
+	// GetServiceAccountFunc mocks the GetServiceAccount method.
+	GetServiceAccountFunc func(ctx context.Context, id string) (*ServiceAccount, error)
+
+	// ResetServiceAccountSecretFunc mocks the ResetServiceAccountSecret method.
+	ResetServiceAccountSecretFunc func(ctx context.Context, id string) (*ServiceAccount, error)
+
+	// UpdateServiceAccountFunc mocks the UpdateServiceAccount method.
+	UpdateServiceAccountFunc func(ctx context.Context, sa *ServiceAccount) (*ServiceAccount, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateServiceAccount holds details about calls to the CreateServiceAccount method.
+		CreateServiceAccount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Sa is the sa argument value.
+			Sa *ServiceAccount
+		}
+		// DeleteServiceAccount holds details about calls to the DeleteServiceAccount method.
+		DeleteServiceAccount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetServiceAccount holds details about calls to the GetServiceAccount method.
+		GetServiceAccount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// ResetServiceAccountSecret holds details about calls to the ResetServiceAccountSecret method.
+		ResetServiceAccountSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateServiceAccount holds details about calls to the UpdateServiceAccount method.
+		UpdateServiceAccount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Sa is the sa argument value.
+			Sa *ServiceAccount
+		}
+	}
+	lockCreateServiceAccount      sync.RWMutex
+	lockDeleteServiceAccount      sync.RWMutex
+	lockGetServiceAccount         sync.RWMutex
+	lockResetServiceAccountSecret sync.RWMutex
+	lockUpdateServiceAccount      sync.RWMutex
+}
+
+// CreateServiceAccount calls CreateServiceAccountFunc.
+func (mock *ServiceAccountAPIMock) CreateServiceAccount(ctx context.Context, sa *ServiceAccount) (*ServiceAccount, error) {
+	if mock.CreateServiceAccountFunc == nil {
+		panic("ServiceAccountAPIMock.CreateServiceAccountFunc: method is nil but ServiceAccountAPI.CreateServiceAccount was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Sa  *ServiceAccount
+	}{
+		Ctx: ctx,
+		Sa:  sa,
+	}
+	mock.lockCreateServiceAccount.Lock()
+	mock.calls.CreateServiceAccount = append(mock.calls.CreateServiceAccount, callInfo)
+	mock.lockCreateServiceAccount.Unlock()
+	return mock.CreateServiceAccountFunc(ctx, sa)
+}
+
+// CreateServiceAccountCalls gets all the calls that were made to CreateServiceAccount.
+// Check the length with:
+//
+//	len(mockedServiceAccountAPI.CreateServiceAccountCalls())
+func (mock *ServiceAccountAPIMock) CreateServiceAccountCalls() []struct {
+	Ctx context.Context
+	Sa  *ServiceAccount
+} {
+	var calls []struct {
+		Ctx context.Context
+		Sa  *ServiceAccount
+	}
+	mock.lockCreateServiceAccount.RLock()
+	calls = mock.calls.CreateServiceAccount
+	mock.lockCreateServiceAccount.RUnlock()
+	return calls
+}
+
+// DeleteServiceAccount calls DeleteServiceAccountFunc.
+func (mock *ServiceAccountAPIMock) DeleteServiceAccount(ctx context.Context, id string) error {
+	if mock.DeleteServiceAccountFunc == nil {
+		panic("ServiceAccountAPIMock.DeleteServiceAccountFunc: method is nil but ServiceAccountAPI.DeleteServiceAccount was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteServiceAccount.Lock()
+	mock.calls.DeleteServiceAccount = append(mock.calls.DeleteServiceAccount, callInfo)
+	mock.lockDeleteServiceAccount.Unlock()
+	return mock.DeleteServiceAccountFunc(ctx, id)
+}
+
+// DeleteServiceAccountCalls gets all the calls that were made to DeleteServiceAccount.
+// Check the length with:
+//
+//	len(mockedServiceAccountAPI.DeleteServiceAccountCalls())
+func (mock *ServiceAccountAPIMock) DeleteServiceAccountCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteServiceAccount.RLock()
+	calls = mock.calls.DeleteServiceAccount
+	mock.lockDeleteServiceAccount.RUnlock()
+	return calls
+}
+
+// GetServiceAccount calls GetServiceAccountFunc.
+func (mock *ServiceAccountAPIMock) GetServiceAccount(ctx context.Context, id string) (*ServiceAccount, error) {
+	if mock.GetServiceAccountFunc == nil {
+		panic("ServiceAccountAPIMock.GetServiceAccountFunc: method is nil but ServiceAccountAPI.GetServiceAccount was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetServiceAccount.Lock()
+	mock.calls.GetServiceAccount = append(mock.calls.GetServiceAccount, callInfo)
+	mock.lockGetServiceAccount.Unlock()
+	return mock.GetServiceAccountFunc(ctx, id)
+}
+
+// GetServiceAccountCalls gets all the calls that were made to GetServiceAccount.
+// Check the length with:
+//
+//	len(mockedServiceAccountAPI.GetServiceAccountCalls())
+func (mock *ServiceAccountAPIMock) GetServiceAccountCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetServiceAccount.RLock()
+	calls = mock.calls.GetServiceAccount
+	mock.lockGetServiceAccount.RUnlock()
+	return calls
+}
+
+// ResetServiceAccountSecret calls ResetServiceAccountSecretFunc.
+func (mock *ServiceAccountAPIMock) ResetServiceAccountSecret(ctx context.Context, id string) (*ServiceAccount, error) {
+	if mock.ResetServiceAccountSecretFunc == nil {
+		panic("ServiceAccountAPIMock.ResetServiceAccountSecretFunc: method is nil but ServiceAccountAPI.ResetServiceAccountSecret was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockResetServiceAccountSecret.Lock()
+	mock.calls.ResetServiceAccountSecret = append(mock.calls.ResetServiceAccountSecret, callInfo)
+	mock.lockResetServiceAccountSecret.Unlock()
+	return mock.ResetServiceAccountSecretFunc(ctx, id)
+}
+
+// ResetServiceAccountSecretCalls gets all the calls that were made to ResetServiceAccountSecret.
+// Check the length with:
+//
+//	len(mockedServiceAccountAPI.ResetServiceAccountSecretCalls())
+func (mock *ServiceAccountAPIMock) ResetServiceAccountSecretCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockResetServiceAccountSecret.RLock()
+	calls = mock.calls.ResetServiceAccountSecret
+	mock.lockResetServiceAccountSecret.RUnlock()
+	return calls
+}
+
+// UpdateServiceAccount calls UpdateServiceAccountFunc.
+func (mock *ServiceAccountAPIMock) UpdateServiceAccount(ctx context.Context, sa *ServiceAccount) (*ServiceAccount, error) {
+	if mock.UpdateServiceAccountFunc == nil {
+		panic("ServiceAccountAPIMock.UpdateServiceAccountFunc: method is nil but ServiceAccountAPI.UpdateServiceAccount was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Sa  *ServiceAccount
+	}{
+		Ctx: ctx,
+		Sa:  sa,
+	}
+	mock.lockUpdateServiceAccount.Lock()
+	mock.calls.UpdateServiceAccount = append(mock.calls.UpdateServiceAccount, callInfo)
+	mock.lockUpdateServiceAccount.Unlock()
+	return mock.UpdateServiceAccountFunc(ctx, sa)
+}
+
+// UpdateServiceAccountCalls gets all the calls that were made to UpdateServiceAccount.
+// Check the length with:
+//
+//	len(mockedServiceAccountAPI.UpdateServiceAccountCalls())
+func (mock *ServiceAccountAPIMock) UpdateServiceAccountCalls() []struct {
+	Ctx context.Context
+	Sa  *ServiceAccount
+} {
+	var calls []struct {
+		Ctx context.Context
+		Sa  *ServiceAccount
+	}
+	mock.lockUpdateServiceAccount.RLock()
+	calls = mock.calls.UpdateServiceAccount
+	mock.lockUpdateServiceAccount.RUnlock()
+	return calls
+}
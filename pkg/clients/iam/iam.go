@@ -0,0 +1,74 @@
+// Package iam contains a client for the service accounts API of Red Hat
+// single sign-on.
+package iam
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+
+	"github.com/stehessel/provider-redhat/pkg/clients/httpapi"
+)
+
+//go:generate go run github.com/matryer/moq@v0.3.1 -out client_moq.go . ServiceAccountAPI
+
+// ErrNewClient represents an error to create a new IAM client.
+const ErrNewClient = "cannot create iam client"
+
+// DefaultEndpoint is the endpoint of the service accounts API of Red Hat
+// single sign-on.
+const DefaultEndpoint = "https://sso.redhat.com/auth/realms/redhat-external/apis/service_accounts/v1"
+
+// Client is a client for the service accounts API of Red Hat single sign-on.
+type Client interface {
+	ServiceAccountAPI
+}
+
+// NewClient creates a new client for the service accounts API served at the
+// supplied endpoint. Requests are authenticated with an access token obtained
+// from the supplied OCM refresh token.
+func NewClient(token string, endpoint string) (Client, error) {
+	auth, err := fleetmanager.NewOCMAuth(fleetmanager.OCMOption{RefreshToken: token})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create iam authentication")
+	}
+
+	return newClient(strings.TrimSuffix(endpoint, "/"), httpapi.NewHTTPClient(auth)), nil
+}
+
+type client struct {
+	*httpapi.Client
+}
+
+func newClient(endpoint string, h *http.Client) *client {
+	return &client{Client: &httpapi.Client{Name: "iam", Endpoint: endpoint, HTTP: h, ErrorMessage: errorMessage}}
+}
+
+// An APIError is returned for requests the service accounts API did not
+// accept.
+type APIError = httpapi.APIError
+
+// IsNotFound returns true if the supplied error indicates that the requested
+// service account does not exist.
+func IsNotFound(err error) bool {
+	return httpapi.IsNotFound(err)
+}
+
+// errorMessage returns the description of an error of the service accounts
+// API, or the error itself if it is not described.
+func errorMessage(body []byte) (string, string) {
+	msg := struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}{}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return "", ""
+	}
+	if msg.ErrorDescription == "" {
+		return "", msg.Error
+	}
+	return "", msg.ErrorDescription
+}
@@ -0,0 +1,72 @@
+package iam
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResetServiceAccountSecret(t *testing.T) {
+	cases := []struct {
+		name     string
+		status   int
+		body     string
+		want     *ServiceAccount
+		message  string
+		notFound bool
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body:   `{"id":"sa1","clientId":"client","secret":"s3cr3t","name":"ci","createdAt":1680307200}`,
+			want:   &ServiceAccount{ID: "sa1", ClientID: "client", Secret: "s3cr3t", Name: "ci", CreatedAt: 1680307200},
+		},
+		{
+			name:     "not found",
+			status:   http.StatusNotFound,
+			body:     `{"error":"service_account_not_found","error_description":"Service account not found"}`,
+			want:     &ServiceAccount{},
+			message:  "iam API responded with 404: Service account not found",
+			notFound: true,
+		},
+		{
+			name:    "server error",
+			status:  http.StatusInternalServerError,
+			body:    "boom",
+			want:    &ServiceAccount{},
+			message: "iam API responded with 500: boom",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/sa1/resetSecret" {
+					t.Errorf("\nc.ResetServiceAccountSecret(...): unexpected request %s %s\n", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			c := newClient(srv.URL, srv.Client())
+			got, err := c.ResetServiceAccountSecret(context.Background(), "sa1")
+			msg := ""
+			if err != nil {
+				msg = err.Error()
+			}
+			if msg != tc.message {
+				t.Errorf("\nc.ResetServiceAccountSecret(...): want error %q, got %q\n", tc.message, msg)
+			}
+			if IsNotFound(err) != tc.notFound {
+				t.Errorf("\nIsNotFound(...): want %t, got %t\n", tc.notFound, IsNotFound(err))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nc.ResetServiceAccountSecret(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package iam
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// ServiceAccountAPI manages the service accounts of the organisation of the
+// authenticated user.
+type ServiceAccountAPI interface {
+	GetServiceAccount(ctx context.Context, id string) (*ServiceAccount, error)
+	CreateServiceAccount(ctx context.Context, sa *ServiceAccount) (*ServiceAccount, error)
+	UpdateServiceAccount(ctx context.Context, sa *ServiceAccount) (*ServiceAccount, error)
+	ResetServiceAccountSecret(ctx context.Context, id string) (*ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, id string) error
+}
+
+// A ServiceAccount authenticates with its client ID and secret. Its secret is
+// only returned when the service account is created or its secret is reset.
+type ServiceAccount struct {
+	ID          string `json:"id,omitempty"`
+	ClientID    string `json:"clientId,omitempty"`
+	Secret      string `json:"secret,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	CreatedBy   string `json:"createdBy,omitempty"`
+	// CreatedAt is the time the service account was created, in seconds
+	// since the Unix epoch.
+	CreatedAt int64 `json:"createdAt,omitempty"`
+}
+
+func (c *client) GetServiceAccount(ctx context.Context, id string) (*ServiceAccount, error) {
+	out := &ServiceAccount{}
+	err := c.Do(ctx, http.MethodGet, "/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) CreateServiceAccount(ctx context.Context, sa *ServiceAccount) (*ServiceAccount, error) {
+	out := &ServiceAccount{}
+	err := c.Do(ctx, http.MethodPost, "", sa, out)
+	return out, err
+}
+
+// UpdateServiceAccount patches the name and description of the service
+// account.
+func (c *client) UpdateServiceAccount(ctx context.Context, sa *ServiceAccount) (*ServiceAccount, error) {
+	in := struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}{Name: sa.Name, Description: sa.Description}
+	out := &ServiceAccount{}
+	err := c.Do(ctx, http.MethodPatch, "/"+url.PathEscape(sa.ID), in, out)
+	return out, err
+}
+
+// ResetServiceAccountSecret replaces the secret of the service account. The
+// previous secret stops working immediately.
+func (c *client) ResetServiceAccountSecret(ctx context.Context, id string) (*ServiceAccount, error) {
+	out := &ServiceAccount{}
+	err := c.Do(ctx, http.MethodPost, "/"+url.PathEscape(id)+"/resetSecret", nil, out)
+	return out, err
+}
+
+func (c *client) DeleteServiceAccount(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/"+url.PathEscape(id), nil, nil)
+}
@@ -0,0 +1,67 @@
+package kafka
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// ACLAPI manages the ACL bindings of a Kafka instance.
+type ACLAPI interface {
+	ListACLBindings(ctx context.Context, filter ACLBinding) ([]ACLBinding, error)
+	CreateACLBinding(ctx context.Context, b ACLBinding) error
+	DeleteACLBindings(ctx context.Context, filter ACLBinding) error
+}
+
+// An ACLBinding allows or denies a principal an operation on the resources
+// of a Kafka instance matching a pattern. ACL bindings have no identifier
+// other than their fields.
+type ACLBinding struct {
+	ResourceType string `json:"resourceType"`
+	ResourceName string `json:"resourceName"`
+	PatternType  string `json:"patternType"`
+	Principal    string `json:"principal"`
+	Operation    string `json:"operation"`
+	Permission   string `json:"permission"`
+}
+
+// query returns the query parameters that filter for ACL bindings matching
+// the fields set in b.
+func (b ACLBinding) query() string {
+	q := url.Values{}
+	for k, v := range map[string]string{
+		"resourceType": b.ResourceType,
+		"resourceName": b.ResourceName,
+		"patternType":  b.PatternType,
+		"principal":    b.Principal,
+		"operation":    b.Operation,
+		"permission":   b.Permission,
+	} {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	return q.Encode()
+}
+
+type aclBindingList struct {
+	Items []ACLBinding `json:"items"`
+}
+
+// ListACLBindings lists the ACL bindings matching the fields set in the
+// supplied filter.
+func (c *instanceClient) ListACLBindings(ctx context.Context, filter ACLBinding) ([]ACLBinding, error) {
+	out := &aclBindingList{}
+	err := c.Do(ctx, http.MethodGet, "/acls?"+filter.query(), nil, out)
+	return out.Items, err
+}
+
+func (c *instanceClient) CreateACLBinding(ctx context.Context, b ACLBinding) error {
+	return c.Do(ctx, http.MethodPost, "/acls", b, nil)
+}
+
+// DeleteACLBindings deletes the ACL bindings matching the fields set in the
+// supplied filter.
+func (c *instanceClient) DeleteACLBindings(ctx context.Context, filter ACLBinding) error {
+	return c.Do(ctx, http.MethodDelete, "/acls?"+filter.query(), nil, nil)
+}
@@ -0,0 +1,631 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package kafka
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that KafkaAPIMock does implement KafkaAPI.
+// If this is not the case, regenerate this file with moq.
+var _ KafkaAPI = &KafkaAPIMock{}
+
+// KafkaAPIMock is a mock implementation of KafkaAPI.
+//
+//	func TestSomethingThatUsesKafkaAPI(t *testing.T) {
+//
+//		// make and configure a mocked KafkaAPI
+//		mockedKafkaAPI := &KafkaAPIMock{
+//			CreateKafkaFunc: func(ctx context.Context, req KafkaRequestPayload) (*Kafka, error) {
+//				panic("mock out the CreateKafka method")
+//			},
+//			DeleteKafkaFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteKafka method")
+//			},
+//			GetKafkaFunc: func(ctx context.Context, id string) (*Kafka, error) {
+//				panic("mock out the GetKafka method")
+//			},
+//			ListKafkasFunc: func(ctx context.Context, search string) ([]Kafka, error) {
+//				panic("mock out the ListKafkas method")
+//			},
+//		}
+//
+//		// use mockedKafkaAPI in code that requires KafkaAPI
+//		// and then make assertions.
+//
+//	}
+type KafkaAPIMock struct {
+	// CreateKafkaFunc mocks the CreateKafka method.
+	CreateKafkaFunc func(ctx context.Context, req KafkaRequestPayload) (*Kafka, error)
+
+	// DeleteKafkaFunc mocks the DeleteKafka method.
+	DeleteKafkaFunc func(ctx context.Context, id string) error
+
+	// GetKafkaFunc mocks the GetKafka method.
+	GetKafkaFunc func(ctx context.Context, id string) (*Kafka, error)
+
+	// ListKafkasFunc mocks the ListKafkas method.
+	ListKafkasFunc func(ctx context.Context, search string) ([]Kafka, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateKafka holds details about calls to the CreateKafka method.
+		CreateKafka []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req KafkaRequestPayload
+		}
+		// DeleteKafka holds details about calls to the DeleteKafka method.
+		DeleteKafka []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetKafka holds details about calls to the GetKafka method.
+		GetKafka []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// ListKafkas holds details about calls to the ListKafkas method.
+		ListKafkas []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Search is the search argument value.
+			Search string
+		}
+	}
+	lockCreateKafka sync.RWMutex
+	lockDeleteKafka sync.RWMutex
+	lockGetKafka    sync.RWMutex
+	lockListKafkas  sync.RWMutex
+}
+
+// CreateKafka calls CreateKafkaFunc.
+func (mock *KafkaAPIMock) CreateKafka(ctx context.Context, req KafkaRequestPayload) (*Kafka, error) {
+	if mock.CreateKafkaFunc == nil {
+		panic("KafkaAPIMock.CreateKafkaFunc: method is nil but KafkaAPI.CreateKafka was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req KafkaRequestPayload
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockCreateKafka.Lock()
+	mock.calls.CreateKafka = append(mock.calls.CreateKafka, callInfo)
+	mock.lockCreateKafka.Unlock()
+	return mock.CreateKafkaFunc(ctx, req)
+}
+
+// CreateKafkaCalls gets all the calls that were made to CreateKafka.
+// Check the length with:
+//
+//	len(mockedKafkaAPI.CreateKafkaCalls())
+func (mock *KafkaAPIMock) CreateKafkaCalls() []struct {
+	Ctx context.Context
+	Req KafkaRequestPayload
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req KafkaRequestPayload
+	}
+	mock.lockCreateKafka.RLock()
+	calls = mock.calls.CreateKafka
+	mock.lockCreateKafka.RUnlock()
+	return calls
+}
+
+// DeleteKafka calls DeleteKafkaFunc.
+func (mock *KafkaAPIMock) DeleteKafka(ctx context.Context, id string) error {
+	if mock.DeleteKafkaFunc == nil {
+		panic("KafkaAPIMock.DeleteKafkaFunc: method is nil but KafkaAPI.DeleteKafka was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteKafka.Lock()
+	mock.calls.DeleteKafka = append(mock.calls.DeleteKafka, callInfo)
+	mock.lockDeleteKafka.Unlock()
+	return mock.DeleteKafkaFunc(ctx, id)
+}
+
+// DeleteKafkaCalls gets all the calls that were made to DeleteKafka.
+// Check the length with:
+//
+//	len(mockedKafkaAPI.DeleteKafkaCalls())
+func (mock *KafkaAPIMock) DeleteKafkaCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteKafka.RLock()
+	calls = mock.calls.DeleteKafka
+	mock.lockDeleteKafka.RUnlock()
+	return calls
+}
+
+// GetKafka calls GetKafkaFunc.
+func (mock *KafkaAPIMock) GetKafka(ctx context.Context, id string) (*Kafka, error) {
+	if mock.GetKafkaFunc == nil {
+		panic("KafkaAPIMock.GetKafkaFunc: method is nil but KafkaAPI.GetKafka was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetKafka.Lock()
+	mock.calls.GetKafka = append(mock.calls.GetKafka, callInfo)
+	mock.lockGetKafka.Unlock()
+	return mock.GetKafkaFunc(ctx, id)
+}
+
+// GetKafkaCalls gets all the calls that were made to GetKafka.
+// Check the length with:
+//
+//	len(mockedKafkaAPI.GetKafkaCalls())
+func (mock *KafkaAPIMock) GetKafkaCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetKafka.RLock()
+	calls = mock.calls.GetKafka
+	mock.lockGetKafka.RUnlock()
+	return calls
+}
+
+// ListKafkas calls ListKafkasFunc.
+func (mock *KafkaAPIMock) ListKafkas(ctx context.Context, search string) ([]Kafka, error) {
+	if mock.ListKafkasFunc == nil {
+		panic("KafkaAPIMock.ListKafkasFunc: method is nil but KafkaAPI.ListKafkas was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Search string
+	}{
+		Ctx:    ctx,
+		Search: search,
+	}
+	mock.lockListKafkas.Lock()
+	mock.calls.ListKafkas = append(mock.calls.ListKafkas, callInfo)
+	mock.lockListKafkas.Unlock()
+	return mock.ListKafkasFunc(ctx, search)
+}
+
+// ListKafkasCalls gets all the calls that were made to ListKafkas.
+// Check the length with:
+//
+//	len(mockedKafkaAPI.ListKafkasCalls())
+func (mock *KafkaAPIMock) ListKafkasCalls() []struct {
+	Ctx    context.Context
+	Search string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Search string
+	}
+	mock.lockListKafkas.RLock()
+	calls = mock.calls.ListKafkas
+	mock.lockListKafkas.RUnlock()
+	return calls
+}
+
+// Ensure, that TopicAPIMock does implement TopicAPI.
+// If this is not the case, regenerate this file with moq.
+var _ TopicAPI = &TopicAPIMock{}
+
+// TopicAPIMock is a mock implementation of TopicAPI.
+//
+//	func TestSomethingThatUsesTopicAPI(t *testing.T) {
+//
+//		// make and configure a mocked TopicAPI
+//		mockedTopicAPI := &TopicAPIMock{
+//			CreateTopicFunc: func(ctx context.Context, t NewTopicInput) (*Topic, error) {
+//				panic("mock out the CreateTopic method")
+//			},
+//			DeleteTopicFunc: func(ctx context.Context, name string) error {
+//				panic("mock out the DeleteTopic method")
+//			},
+//			GetTopicFunc: func(ctx context.Context, name string) (*Topic, error) {
+//				panic("mock out the GetTopic method")
+//			},
+//			UpdateTopicFunc: func(ctx context.Context, name string, s TopicSettings) (*Topic, error) {
+//				panic("mock out the UpdateTopic method")
+//			},
+//		}
+//
+//		// use mockedTopicAPI in code that requires TopicAPI
+//		// and then make assertions.
+//
+//	}
+type TopicAPIMock struct {
+	// CreateTopicFunc mocks the CreateTopic method.
+	CreateTopicFunc func(ctx context.Context, t NewTopicInput) (*Topic, error)
+
+	// DeleteTopicFunc mocks the DeleteTopic method.
+	DeleteTopicFunc func(ctx context.Context, name string) error
+
+	// GetTopicFunc mocks the GetTopic method.
+	GetTopicFunc func(ctx context.Context, name string) (*Topic, error)
+
+	// UpdateTopicFunc mocks the UpdateTopic method.
+	UpdateTopicFunc func(ctx context.Context, name string, s TopicSettings) (*Topic, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateTopic holds details about calls to the CreateTopic method.
+		CreateTopic []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// T is the t argument value.
+			T NewTopicInput
+		}
+		// DeleteTopic holds details about calls to the DeleteTopic method.
+		DeleteTopic []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// GetTopic holds details about calls to the GetTopic method.
+		GetTopic []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// UpdateTopic holds details about calls to the UpdateTopic method.
+		UpdateTopic []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// S is the s argument value.
+			S TopicSettings
+		}
+	}
+	lockCreateTopic sync.RWMutex
+	lockDeleteTopic sync.RWMutex
+	lockGetTopic    sync.RWMutex
+	lockUpdateTopic sync.RWMutex
+}
+
+// CreateTopic calls CreateTopicFunc.
+func (mock *TopicAPIMock) CreateTopic(ctx context.Context, t NewTopicInput) (*Topic, error) {
+	if mock.CreateTopicFunc == nil {
+		panic("TopicAPIMock.CreateTopicFunc: method is nil but TopicAPI.CreateTopic was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		T   NewTopicInput
+	}{
+		Ctx: ctx,
+		T:   t,
+	}
+	mock.lockCreateTopic.Lock()
+	mock.calls.CreateTopic = append(mock.calls.CreateTopic, callInfo)
+	mock.lockCreateTopic.Unlock()
+	return mock.CreateTopicFunc(ctx, t)
+}
+
+// CreateTopicCalls gets all the calls that were made to CreateTopic.
+// Check the length with:
+//
+//	len(mockedTopicAPI.CreateTopicCalls())
+func (mock *TopicAPIMock) CreateTopicCalls() []struct {
+	Ctx context.Context
+	T   NewTopicInput
+} {
+	var calls []struct {
+		Ctx context.Context
+		T   NewTopicInput
+	}
+	mock.lockCreateTopic.RLock()
+	calls = mock.calls.CreateTopic
+	mock.lockCreateTopic.RUnlock()
+	return calls
+}
+
+// DeleteTopic calls DeleteTopicFunc.
+func (mock *TopicAPIMock) DeleteTopic(ctx context.Context, name string) error {
+	if mock.DeleteTopicFunc == nil {
+		panic("TopicAPIMock.DeleteTopicFunc: method is nil but TopicAPI.DeleteTopic was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockDeleteTopic.Lock()
+	mock.calls.DeleteTopic = append(mock.calls.DeleteTopic, callInfo)
+	mock.lockDeleteTopic.Unlock()
+	return mock.DeleteTopicFunc(ctx, name)
+}
+
+// DeleteTopicCalls gets all the calls that were made to DeleteTopic.
+// Check the length with:
+//
+//	len(mockedTopicAPI.DeleteTopicCalls())
+func (mock *TopicAPIMock) DeleteTopicCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockDeleteTopic.RLock()
+	calls = mock.calls.DeleteTopic
+	mock.lockDeleteTopic.RUnlock()
+	return calls
+}
+
+// GetTopic calls GetTopicFunc.
+func (mock *TopicAPIMock) GetTopic(ctx context.Context, name string) (*Topic, error) {
+	if mock.GetTopicFunc == nil {
+		panic("TopicAPIMock.GetTopicFunc: method is nil but TopicAPI.GetTopic was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGetTopic.Lock()
+	mock.calls.GetTopic = append(mock.calls.GetTopic, callInfo)
+	mock.lockGetTopic.Unlock()
+	return mock.GetTopicFunc(ctx, name)
+}
+
+// GetTopicCalls gets all the calls that were made to GetTopic.
+// Check the length with:
+//
+//	len(mockedTopicAPI.GetTopicCalls())
+func (mock *TopicAPIMock) GetTopicCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGetTopic.RLock()
+	calls = mock.calls.GetTopic
+	mock.lockGetTopic.RUnlock()
+	return calls
+}
+
+// UpdateTopic calls UpdateTopicFunc.
+func (mock *TopicAPIMock) UpdateTopic(ctx context.Context, name string, s TopicSettings) (*Topic, error) {
+	if mock.UpdateTopicFunc == nil {
+		panic("TopicAPIMock.UpdateTopicFunc: method is nil but TopicAPI.UpdateTopic was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+		S    TopicSettings
+	}{
+		Ctx:  ctx,
+		Name: name,
+		S:    s,
+	}
+	mock.lockUpdateTopic.Lock()
+	mock.calls.UpdateTopic = append(mock.calls.UpdateTopic, callInfo)
+	mock.lockUpdateTopic.Unlock()
+	return mock.UpdateTopicFunc(ctx, name, s)
+}
+
+// UpdateTopicCalls gets all the calls that were made to UpdateTopic.
+// Check the length with:
+//
+//	len(mockedTopicAPI.UpdateTopicCalls())
+func (mock *TopicAPIMock) UpdateTopicCalls() []struct {
+	Ctx  context.Context
+	Name string
+	S    TopicSettings
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+		S    TopicSettings
+	}
+	mock.lockUpdateTopic.RLock()
+	calls = mock.calls.UpdateTopic
+	mock.lockUpdateTopic.RUnlock()
+	return calls
+}
+
+// Ensure, that ACLAPIMock does implement ACLAPI.
+// If this is not the case, regenerate this file with moq.
+var _ ACLAPI = &ACLAPIMock{}
+
+// ACLAPIMock is a mock implementation of ACLAPI.
+//
+//	func TestSomethingThatUsesACLAPI(t *testing.T) {
+//
+//		// make and configure a mocked ACLAPI
+//		mockedACLAPI := &ACLAPIMock{
+//			CreateACLBindingFunc: func(ctx context.Context, b ACLBinding) error {
+//				panic("mock out the CreateACLBinding method")
+//			},
+//			DeleteACLBindingsFunc: func(ctx context.Context, filter ACLBinding) error {
+//				panic("mock out the DeleteACLBindings method")
+//			},
+//			ListACLBindingsFunc: func(ctx context.Context, filter ACLBinding) ([]ACLBinding, error) {
+//				panic("mock out the ListACLBindings method")
+//			},
+//		}
+//
+//		// use mockedACLAPI in code that requires ACLAPI
+//		// and then make assertions.
+//
+//	}
+type ACLAPIMock struct {
+	// CreateACLBindingFunc mocks the CreateACLBinding method.
+	CreateACLBindingFunc func(ctx context.Context, b ACLBinding) error
+
+	// DeleteACLBindingsFunc mocks the DeleteACLBindings method.
+	DeleteACLBindingsFunc func(ctx context.Context, filter ACLBinding) error
+
+	// ListACLBindingsFunc mocks the ListACLBindings method.
+	ListACLBindingsFunc func(ctx context.Context, filter ACLBinding) ([]ACLBinding, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateACLBinding holds details about calls to the CreateACLBinding method.
+		CreateACLBinding []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// B is the b argument value.
+			B ACLBinding
+		}
+		// DeleteACLBindings holds details about calls to the DeleteACLBindings method.
+		DeleteACLBindings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter ACLBinding
+		}
+		// ListACLBindings holds details about calls to the ListACLBindings method.
+		ListACLBindings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter ACLBinding
+		}
+	}
+	lockCreateACLBinding  sync.RWMutex
+	lockDeleteACLBindings sync.RWMutex
+	lockListACLBindings   sync.RWMutex
+}
+
+// CreateACLBinding calls CreateACLBindingFunc.
+func (mock *ACLAPIMock) CreateACLBinding(ctx context.Context, b ACLBinding) error {
+	if mock.CreateACLBindingFunc == nil {
+		panic("ACLAPIMock.CreateACLBindingFunc: method is nil but ACLAPI.CreateACLBinding was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		B   ACLBinding
+	}{
+		Ctx: ctx,
+		B:   b,
+	}
+	mock.lockCreateACLBinding.Lock()
+	mock.calls.CreateACLBinding = append(mock.calls.CreateACLBinding, callInfo)
+	mock.lockCreateACLBinding.Unlock()
+	return mock.CreateACLBindingFunc(ctx, b)
+}
+
+// CreateACLBindingCalls gets all the calls that were made to CreateACLBinding.
+// Check the length with:
+//
+//	len(mockedACLAPI.CreateACLBindingCalls())
+func (mock *ACLAPIMock) CreateACLBindingCalls() []struct {
+	Ctx context.Context
+	B   ACLBinding
+} {
+	var calls []struct {
+		Ctx context.Context
+		B   ACLBinding
+	}
+	mock.lockCreateACLBinding.RLock()
+	calls = mock.calls.CreateACLBinding
+	mock.lockCreateACLBinding.RUnlock()
+	return calls
+}
+
+// DeleteACLBindings calls DeleteACLBindingsFunc.
+func (mock *ACLAPIMock) DeleteACLBindings(ctx context.Context, filter ACLBinding) error {
+	if mock.DeleteACLBindingsFunc == nil {
+		panic("ACLAPIMock.DeleteACLBindingsFunc: method is nil but ACLAPI.DeleteACLBindings was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter ACLBinding
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+	}
+	mock.lockDeleteACLBindings.Lock()
+	mock.calls.DeleteACLBindings = append(mock.calls.DeleteACLBindings, callInfo)
+	mock.lockDeleteACLBindings.Unlock()
+	return mock.DeleteACLBindingsFunc(ctx, filter)
+}
+
+// DeleteACLBindingsCalls gets all the calls that were made to DeleteACLBindings.
+// Check the length with:
+//
+//	len(mockedACLAPI.DeleteACLBindingsCalls())
+func (mock *ACLAPIMock) DeleteACLBindingsCalls() []struct {
+	Ctx    context.Context
+	Filter ACLBinding
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter ACLBinding
+	}
+	mock.lockDeleteACLBindings.RLock()
+	calls = mock.calls.DeleteACLBindings
+	mock.lockDeleteACLBindings.RUnlock()
+	return calls
+}
+
+// ListACLBindings calls ListACLBindingsFunc.
+func (mock *ACLAPIMock) ListACLBindings(ctx context.Context, filter ACLBinding) ([]ACLBinding, error) {
+	if mock.ListACLBindingsFunc == nil {
+		panic("ACLAPIMock.ListACLBindingsFunc: method is nil but ACLAPI.ListACLBindings was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter ACLBinding
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+	}
+	mock.lockListACLBindings.Lock()
+	mock.calls.ListACLBindings = append(mock.calls.ListACLBindings, callInfo)
+	mock.lockListACLBindings.Unlock()
+	return mock.ListACLBindingsFunc(ctx, filter)
+}
+
+// ListACLBindingsCalls gets all the calls that were made to ListACLBindings.
+// Check the length with:
+//
+//	len(mockedACLAPI.ListACLBindingsCalls())
+func (mock *ACLAPIMock) ListACLBindingsCalls() []struct {
+	Ctx    context.Context
+	Filter ACLBinding
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter ACLBinding
+	}
+	mock.lockListACLBindings.RLock()
+	calls = mock.calls.ListACLBindings
+	mock.lockListACLBindings.RUnlock()
+	return calls
+}
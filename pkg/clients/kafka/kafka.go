@@ -0,0 +1,109 @@
+// Package kafka contains clients for the Kafka management API of Red Hat
+// OpenShift Streams for Apache Kafka (RHOSAK), and for the admin API of its
+// Kafka instances.
+package kafka
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+
+	"github.com/stehessel/provider-redhat/pkg/clients/httpapi"
+)
+
+//go:generate go run github.com/matryer/moq@v0.3.1 -out client_moq.go . KafkaAPI TopicAPI ACLAPI
+
+// ErrNewClient represents an error to create a new Kafka client.
+const ErrNewClient = "cannot create kafka client"
+
+const (
+	// basePath is the path of the Kafka management API below the gateway.
+	basePath = "/api/kafkas_mgmt/v1"
+
+	// adminBasePath is the path of the admin API of a Kafka instance below
+	// its admin API server URL.
+	adminBasePath = "/api/v1"
+)
+
+// Client is a client for the Kafka management API.
+type Client interface {
+	KafkaAPI
+
+	// Instance returns a client for the admin API of the Kafka instance
+	// served at the supplied URL, authenticated like this client.
+	Instance(adminURL string) InstanceClient
+}
+
+// InstanceClient is a client for the admin API of a Kafka instance.
+type InstanceClient interface {
+	TopicAPI
+	ACLAPI
+}
+
+// NewClient creates a new client for the Kafka management API served by the
+// supplied OpenShift API gateway. Requests are authenticated with an access
+// token obtained from the supplied OCM refresh token.
+func NewClient(token string, gateway string) (Client, error) {
+	auth, err := fleetmanager.NewOCMAuth(fleetmanager.OCMOption{RefreshToken: token})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create kafka authentication")
+	}
+
+	u, err := url.Parse(gateway)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse gateway")
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("gateway %q is not an absolute URL", gateway)
+	}
+
+	return newClient(strings.TrimSuffix(u.String(), "/")+basePath, httpapi.NewHTTPClient(auth)), nil
+}
+
+type client struct {
+	*httpapi.Client
+}
+
+func newClient(endpoint string, h *http.Client) *client {
+	return &client{Client: &httpapi.Client{Name: "kafka", Endpoint: endpoint, HTTP: h, ErrorMessage: errorMessage}}
+}
+
+func (c *client) Instance(adminURL string) InstanceClient {
+	return &instanceClient{client: newClient(strings.TrimSuffix(adminURL, "/")+adminBasePath, c.HTTP)}
+}
+
+type instanceClient struct {
+	*client
+}
+
+// An APIError is returned for requests the Kafka management or admin API did
+// not accept.
+type APIError = httpapi.APIError
+
+// IsNotFound returns true if the supplied error indicates that the requested
+// Kafka instance, topic or ACL binding does not exist.
+func IsNotFound(err error) bool {
+	return httpapi.IsNotFound(err)
+}
+
+// errorMessage returns the reason of an error of the Kafka management or admin
+// API.
+func errorMessage(body []byte) (string, string) {
+	// The management API explains errors in reason, the admin API of older
+	// instances in error_message.
+	msg := struct {
+		Reason       string `json:"reason"`
+		ErrorMessage string `json:"error_message"`
+	}{}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return "", ""
+	}
+	if msg.Reason == "" {
+		return "", msg.ErrorMessage
+	}
+	return "", msg.Reason
+}
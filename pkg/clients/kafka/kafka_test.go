@@ -0,0 +1,78 @@
+package kafka
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListACLBindings(t *testing.T) {
+	filter := ACLBinding{ResourceType: "TOPIC", ResourceName: "orders", Principal: "User:*"}
+
+	cases := []struct {
+		name     string
+		status   int
+		body     string
+		want     []ACLBinding
+		message  string
+		notFound bool
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body:   `{"kind":"AclBindingList","items":[{"resourceType":"TOPIC","resourceName":"orders","patternType":"LITERAL","principal":"User:*","operation":"READ","permission":"ALLOW"}],"total":1}`,
+			want: []ACLBinding{{
+				ResourceType: "TOPIC", ResourceName: "orders", PatternType: "LITERAL",
+				Principal: "User:*", Operation: "READ", Permission: "ALLOW",
+			}},
+		},
+		{
+			name:     "not found",
+			status:   http.StatusNotFound,
+			body:     `{"code":404,"error_message":"Not found"}`,
+			message:  "kafka API responded with 404: Not found",
+			notFound: true,
+		},
+		{
+			name:    "server error",
+			status:  http.StatusInternalServerError,
+			body:    `{"kind":"Error","code":"KAFKAS-MGMT-9","reason":"Unspecified error"}`,
+			message: "kafka API responded with 500: Unspecified error",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != adminBasePath+"/acls" {
+					t.Errorf("\nc.ListACLBindings(...): unexpected request %s %s\n", r.Method, r.URL.Path)
+				}
+				if got, want := r.URL.RawQuery, "principal=User%3A%2A&resourceName=orders&resourceType=TOPIC"; got != want {
+					t.Errorf("\nc.ListACLBindings(...): want query %q, got %q\n", want, got)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			c := newClient("", srv.Client()).Instance(srv.URL + "/")
+			got, err := c.ListACLBindings(context.Background(), filter)
+			msg := ""
+			if err != nil {
+				msg = err.Error()
+			}
+			if msg != tc.message {
+				t.Errorf("\nc.ListACLBindings(...): want error %q, got %q\n", tc.message, msg)
+			}
+			if IsNotFound(err) != tc.notFound {
+				t.Errorf("\nIsNotFound(...): want %t, got %t\n", tc.notFound, IsNotFound(err))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nc.ListACLBindings(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package kafka
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Kafka request states in the Kafka management API.
+const (
+	KafkaRequestStatusAccepted     = "accepted"
+	KafkaRequestStatusPreparing    = "preparing"
+	KafkaRequestStatusProvisioning = "provisioning"
+	KafkaRequestStatusReady        = "ready"
+	KafkaRequestStatusFailed       = "failed"
+	KafkaRequestStatusDeprovision  = "deprovision"
+	KafkaRequestStatusDeleting     = "deleting"
+)
+
+// KafkaAPI manages the Kafka instances of the organisation of the
+// authenticated user.
+type KafkaAPI interface {
+	ListKafkas(ctx context.Context, search string) ([]Kafka, error)
+	GetKafka(ctx context.Context, id string) (*Kafka, error)
+	CreateKafka(ctx context.Context, req KafkaRequestPayload) (*Kafka, error)
+	DeleteKafka(ctx context.Context, id string) error
+}
+
+// A Kafka is a Kafka instance. Its bootstrap server host and admin API are
+// only available once it is ready.
+type Kafka struct {
+	ID                  string     `json:"id,omitempty"`
+	Kind                string     `json:"kind,omitempty"`
+	Href                string     `json:"href,omitempty"`
+	Name                string     `json:"name,omitempty"`
+	CloudProvider       string     `json:"cloud_provider,omitempty"`
+	Region              string     `json:"region,omitempty"`
+	MultiAZ             bool       `json:"multi_az,omitempty"`
+	Owner               string     `json:"owner,omitempty"`
+	Status              string     `json:"status,omitempty"`
+	FailedReason        string     `json:"failed_reason,omitempty"`
+	BootstrapServerHost string     `json:"bootstrap_server_host,omitempty"`
+	AdminAPIServerURL   string     `json:"admin_api_server_url,omitempty"`
+	InstanceType        string     `json:"instance_type,omitempty"`
+	Version             string     `json:"version,omitempty"`
+	CreatedAt           *time.Time `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+}
+
+// A KafkaRequestPayload requests a new Kafka instance.
+type KafkaRequestPayload struct {
+	Name          string `json:"name"`
+	CloudProvider string `json:"cloud_provider,omitempty"`
+	Region        string `json:"region,omitempty"`
+	MultiAZ       bool   `json:"multi_az"`
+}
+
+type kafkaList struct {
+	Items []Kafka `json:"items"`
+}
+
+// ListKafkas lists the Kafka instances matching the supplied search query,
+// e.g. "name = my-kafka".
+func (c *client) ListKafkas(ctx context.Context, search string) ([]Kafka, error) {
+	out := &kafkaList{}
+	err := c.Do(ctx, http.MethodGet, "/kafkas?"+url.Values{"search": {search}}.Encode(), nil, out)
+	return out.Items, err
+}
+
+func (c *client) GetKafka(ctx context.Context, id string) (*Kafka, error) {
+	out := &Kafka{}
+	err := c.Do(ctx, http.MethodGet, "/kafkas/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+// CreateKafka requests a new Kafka instance, which is provisioned
+// asynchronously.
+func (c *client) CreateKafka(ctx context.Context, req KafkaRequestPayload) (*Kafka, error) {
+	out := &Kafka{}
+	err := c.Do(ctx, http.MethodPost, "/kafkas?async=true", req, out)
+	return out, err
+}
+
+// DeleteKafka requests the deletion of a Kafka instance, which is
+// deprovisioned asynchronously.
+func (c *client) DeleteKafka(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/kafkas/"+url.PathEscape(id)+"?async=true", nil, nil)
+}
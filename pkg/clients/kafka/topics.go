@@ -0,0 +1,72 @@
+package kafka
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// TopicAPI manages the topics of a Kafka instance.
+type TopicAPI interface {
+	GetTopic(ctx context.Context, name string) (*Topic, error)
+	CreateTopic(ctx context.Context, t NewTopicInput) (*Topic, error)
+	UpdateTopic(ctx context.Context, name string, s TopicSettings) (*Topic, error)
+	DeleteTopic(ctx context.Context, name string) error
+}
+
+// A Topic is a topic of a Kafka instance. Its config lists all configuration
+// entries of the topic, including defaults.
+type Topic struct {
+	Name       string        `json:"name"`
+	IsInternal bool          `json:"isInternal,omitempty"`
+	Partitions []Partition   `json:"partitions,omitempty"`
+	Config     []ConfigEntry `json:"config,omitempty"`
+}
+
+// A Partition is a partition of a topic.
+type Partition struct {
+	Partition int `json:"partition"`
+}
+
+// A ConfigEntry is a configuration entry of a topic, e.g. retention.ms.
+type ConfigEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NewTopicInput requests a new topic.
+type NewTopicInput struct {
+	Name     string        `json:"name"`
+	Settings TopicSettings `json:"settings"`
+}
+
+// TopicSettings are the settings of a topic. The number of partitions of a
+// topic can only be increased, and is left unchanged if zero.
+type TopicSettings struct {
+	NumPartitions int           `json:"numPartitions,omitempty"`
+	Config        []ConfigEntry `json:"config,omitempty"`
+}
+
+func (c *instanceClient) GetTopic(ctx context.Context, name string) (*Topic, error) {
+	out := &Topic{}
+	err := c.Do(ctx, http.MethodGet, "/topics/"+url.PathEscape(name), nil, out)
+	return out, err
+}
+
+func (c *instanceClient) CreateTopic(ctx context.Context, t NewTopicInput) (*Topic, error) {
+	out := &Topic{}
+	err := c.Do(ctx, http.MethodPost, "/topics", t, out)
+	return out, err
+}
+
+// UpdateTopic patches the topic with the supplied settings. Configuration
+// entries that are not supplied are left unchanged.
+func (c *instanceClient) UpdateTopic(ctx context.Context, name string, s TopicSettings) (*Topic, error) {
+	out := &Topic{}
+	err := c.Do(ctx, http.MethodPatch, "/topics/"+url.PathEscape(name), s, out)
+	return out, err
+}
+
+func (c *instanceClient) DeleteTopic(ctx context.Context, name string) error {
+	return c.Do(ctx, http.MethodDelete, "/topics/"+url.PathEscape(name), nil, nil)
+}
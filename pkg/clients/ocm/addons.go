@@ -0,0 +1,121 @@
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Add-on installation states in OCM.
+const (
+	AddOnInstallationStatePending    = "pending"
+	AddOnInstallationStateInstalling = "installing"
+	AddOnInstallationStateUpgrading  = "upgrading"
+	AddOnInstallationStateReady      = "ready"
+	AddOnInstallationStateFailed     = "failed"
+	AddOnInstallationStateDeleting   = "deleting"
+	AddOnInstallationStateDeleted    = "deleted"
+)
+
+// Value types of add-on parameters in OCM.
+const (
+	AddOnParameterValueTypeString   = "string"
+	AddOnParameterValueTypeNumber   = "number"
+	AddOnParameterValueTypeBoolean  = "boolean"
+	AddOnParameterValueTypeCIDR     = "cidr"
+	AddOnParameterValueTypeResource = "resource"
+)
+
+// AddOnInstallationAPI manages the add-ons installed on OCM clusters. An
+// installation is identified by the ID of its add-on.
+type AddOnInstallationAPI interface {
+	GetAddOn(ctx context.Context, id string) (*AddOn, error)
+	GetAddOnInstallation(ctx context.Context, clusterID, id string) (*AddOnInstallation, error)
+	CreateAddOnInstallation(ctx context.Context, clusterID string, a *AddOnInstallation) (*AddOnInstallation, error)
+	UpdateAddOnInstallation(ctx context.Context, clusterID string, a *AddOnInstallation) error
+	DeleteAddOnInstallation(ctx context.Context, clusterID, id string) error
+}
+
+// An AddOnInstallation is an add-on installed on a cluster.
+type AddOnInstallation struct {
+	ID               string              `json:"id,omitempty"`
+	AddOn            *ObjectReference    `json:"addon,omitempty"`
+	AddOnVersion     *ObjectReference    `json:"addon_version,omitempty"`
+	Parameters       *AddOnParameterList `json:"parameters,omitempty"`
+	State            string              `json:"state,omitempty"`
+	StateDescription string              `json:"state_description,omitempty"`
+}
+
+// An AddOnParameterList lists the parameters of an add-on installation.
+type AddOnParameterList struct {
+	Items []AddOnParameter `json:"items"`
+}
+
+// An AddOnParameter configures an add-on installation.
+type AddOnParameter struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+}
+
+// An AddOn can be installed on clusters.
+type AddOn struct {
+	ID         string                        `json:"id,omitempty"`
+	Parameters *AddOnParameterDefinitionList `json:"parameters,omitempty"`
+}
+
+// An AddOnParameterDefinitionList lists the parameters of an add-on.
+type AddOnParameterDefinitionList struct {
+	Items []AddOnParameterDefinition `json:"items"`
+}
+
+// An AddOnParameterDefinition defines a parameter of an add-on. Validation is
+// a regular expression values must match, and Options list the values they
+// must be one of, if any.
+type AddOnParameterDefinition struct {
+	ID           string                 `json:"id"`
+	ValueType    string                 `json:"value_type,omitempty"`
+	Validation   string                 `json:"validation,omitempty"`
+	Required     bool                   `json:"required,omitempty"`
+	DefaultValue string                 `json:"default_value,omitempty"`
+	Options      []AddOnParameterOption `json:"options,omitempty"`
+}
+
+// An AddOnParameterOption is a value an add-on parameter can be set to.
+type AddOnParameterOption struct {
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value"`
+}
+
+func addOnsPath(clusterID string) string {
+	return "/clusters/" + url.PathEscape(clusterID) + "/addons"
+}
+
+// GetAddOn returns the add-on with the supplied ID, including the definitions
+// of its parameters.
+func (c *client) GetAddOn(ctx context.Context, id string) (*AddOn, error) {
+	out := &AddOn{}
+	err := c.Do(ctx, http.MethodGet, "/addons/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) GetAddOnInstallation(ctx context.Context, clusterID, id string) (*AddOnInstallation, error) {
+	out := &AddOnInstallation{}
+	err := c.Do(ctx, http.MethodGet, addOnsPath(clusterID)+"/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) CreateAddOnInstallation(ctx context.Context, clusterID string, a *AddOnInstallation) (*AddOnInstallation, error) {
+	out := &AddOnInstallation{}
+	err := c.Do(ctx, http.MethodPost, addOnsPath(clusterID), a, out)
+	return out, err
+}
+
+// UpdateAddOnInstallation patches the parameters of the add-on installation.
+func (c *client) UpdateAddOnInstallation(ctx context.Context, clusterID string, a *AddOnInstallation) error {
+	in := &AddOnInstallation{Parameters: a.Parameters}
+	return c.Do(ctx, http.MethodPatch, addOnsPath(clusterID)+"/"+url.PathEscape(a.ID), in, nil)
+}
+
+func (c *client) DeleteAddOnInstallation(ctx context.Context, clusterID, id string) error {
+	return c.Do(ctx, http.MethodDelete, addOnsPath(clusterID)+"/"+url.PathEscape(id), nil, nil)
+}
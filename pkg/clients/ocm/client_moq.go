@@ -0,0 +1,1881 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package ocm
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that ClusterAPIMock does implement ClusterAPI.
+// If this is not the case, regenerate this file with moq.
+var _ ClusterAPI = &ClusterAPIMock{}
+
+// ClusterAPIMock is a mock implementation of ClusterAPI.
+//
+//	func TestSomethingThatUsesClusterAPI(t *testing.T) {
+//
+//		// make and configure a mocked ClusterAPI
+//		mockedClusterAPI := &ClusterAPIMock{
+//			CreateClusterFunc: func(ctx context.Context, c *Cluster) (*Cluster, error) {
+//				panic("mock out the CreateCluster method")
+//			},
+//			DeleteClusterFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteCluster method")
+//			},
+//			GetClusterFunc: func(ctx context.Context, id string) (*Cluster, error) {
+//				panic("mock out the GetCluster method")
+//			},
+//			GetClusterCredentialsFunc: func(ctx context.Context, id string) (*ClusterCredentials, error) {
+//				panic("mock out the GetClusterCredentials method")
+//			},
+//			UpdateClusterFunc: func(ctx context.Context, id string, c *Cluster) error {
+//				panic("mock out the UpdateCluster method")
+//			},
+//		}
+//
+//		// use mockedClusterAPI in code that requires ClusterAPI
+//		// and then make assertions.
+//
+//	}
+type ClusterAPIMock struct {
+	// CreateClusterFunc mocks the CreateCluster method.
+	CreateClusterFunc func(ctx context.Context, c *Cluster) (*Cluster, error)
+
+	// DeleteClusterFunc mocks the DeleteCluster method.
+	DeleteClusterFunc func(ctx context.Context, id string) error
+
+	// GetClusterFunc mocks the GetCluster method.
+	GetClusterFunc func(ctx context.Context, id string) (*Cluster, error)
+
+	// GetClusterCredentialsFunc mocks the GetClusterCredentials method.
+	GetClusterCredentialsFunc func(ctx context.Context, id string) (*ClusterCredentials, error)
+
+	// UpdateClusterFunc mocks the UpdateCluster method.
+	UpdateClusterFunc func(ctx context.Context, id string, c *Cluster) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateCluster holds details about calls to the CreateCluster method.
+		CreateCluster []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// C is the c argument value.
+			C *Cluster
+		}
+		// DeleteCluster holds details about calls to the DeleteCluster method.
+		DeleteCluster []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetCluster holds details about calls to the GetCluster method.
+		GetCluster []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetClusterCredentials holds details about calls to the GetClusterCredentials method.
+		GetClusterCredentials []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateCluster holds details about calls to the UpdateCluster method.
+		UpdateCluster []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// C is the c argument value.
+			C *Cluster
+		}
+	}
+	lockCreateCluster         sync.RWMutex
+	lockDeleteCluster         sync.RWMutex
+	lockGetCluster            sync.RWMutex
+	lockGetClusterCredentials sync.RWMutex
+	lockUpdateCluster         sync.RWMutex
+}
+
+// CreateCluster calls CreateClusterFunc.
+func (mock *ClusterAPIMock) CreateCluster(ctx context.Context, c *Cluster) (*Cluster, error) {
+	if mock.CreateClusterFunc == nil {
+		panic("ClusterAPIMock.CreateClusterFunc: method is nil but ClusterAPI.CreateCluster was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		C   *Cluster
+	}{
+		Ctx: ctx,
+		C:   c,
+	}
+	mock.lockCreateCluster.Lock()
+	mock.calls.CreateCluster = append(mock.calls.CreateCluster, callInfo)
+	mock.lockCreateCluster.Unlock()
+	return mock.CreateClusterFunc(ctx, c)
+}
+
+// CreateClusterCalls gets all the calls that were made to CreateCluster.
+// Check the length with:
+//
+//	len(mockedClusterAPI.CreateClusterCalls())
+func (mock *ClusterAPIMock) CreateClusterCalls() []struct {
+	Ctx context.Context
+	C   *Cluster
+} {
+	var calls []struct {
+		Ctx context.Context
+		C   *Cluster
+	}
+	mock.lockCreateCluster.RLock()
+	calls = mock.calls.CreateCluster
+	mock.lockCreateCluster.RUnlock()
+	return calls
+}
+
+// DeleteCluster calls DeleteClusterFunc.
+func (mock *ClusterAPIMock) DeleteCluster(ctx context.Context, id string) error {
+	if mock.DeleteClusterFunc == nil {
+		panic("ClusterAPIMock.DeleteClusterFunc: method is nil but ClusterAPI.DeleteCluster was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteCluster.Lock()
+	mock.calls.DeleteCluster = append(mock.calls.DeleteCluster, callInfo)
+	mock.lockDeleteCluster.Unlock()
+	return mock.DeleteClusterFunc(ctx, id)
+}
+
+// DeleteClusterCalls gets all the calls that were made to DeleteCluster.
+// Check the length with:
+//
+//	len(mockedClusterAPI.DeleteClusterCalls())
+func (mock *ClusterAPIMock) DeleteClusterCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteCluster.RLock()
+	calls = mock.calls.DeleteCluster
+	mock.lockDeleteCluster.RUnlock()
+	return calls
+}
+
+// GetCluster calls GetClusterFunc.
+func (mock *ClusterAPIMock) GetCluster(ctx context.Context, id string) (*Cluster, error) {
+	if mock.GetClusterFunc == nil {
+		panic("ClusterAPIMock.GetClusterFunc: method is nil but ClusterAPI.GetCluster was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetCluster.Lock()
+	mock.calls.GetCluster = append(mock.calls.GetCluster, callInfo)
+	mock.lockGetCluster.Unlock()
+	return mock.GetClusterFunc(ctx, id)
+}
+
+// GetClusterCalls gets all the calls that were made to GetCluster.
+// Check the length with:
+//
+//	len(mockedClusterAPI.GetClusterCalls())
+func (mock *ClusterAPIMock) GetClusterCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetCluster.RLock()
+	calls = mock.calls.GetCluster
+	mock.lockGetCluster.RUnlock()
+	return calls
+}
+
+// GetClusterCredentials calls GetClusterCredentialsFunc.
+func (mock *ClusterAPIMock) GetClusterCredentials(ctx context.Context, id string) (*ClusterCredentials, error) {
+	if mock.GetClusterCredentialsFunc == nil {
+		panic("ClusterAPIMock.GetClusterCredentialsFunc: method is nil but ClusterAPI.GetClusterCredentials was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetClusterCredentials.Lock()
+	mock.calls.GetClusterCredentials = append(mock.calls.GetClusterCredentials, callInfo)
+	mock.lockGetClusterCredentials.Unlock()
+	return mock.GetClusterCredentialsFunc(ctx, id)
+}
+
+// GetClusterCredentialsCalls gets all the calls that were made to GetClusterCredentials.
+// Check the length with:
+//
+//	len(mockedClusterAPI.GetClusterCredentialsCalls())
+func (mock *ClusterAPIMock) GetClusterCredentialsCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetClusterCredentials.RLock()
+	calls = mock.calls.GetClusterCredentials
+	mock.lockGetClusterCredentials.RUnlock()
+	return calls
+}
+
+// UpdateCluster calls UpdateClusterFunc.
+func (mock *ClusterAPIMock) UpdateCluster(ctx context.Context, id string, c *Cluster) error {
+	if mock.UpdateClusterFunc == nil {
+		panic("ClusterAPIMock.UpdateClusterFunc: method is nil but ClusterAPI.UpdateCluster was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+		C   *Cluster
+	}{
+		Ctx: ctx,
+		ID:  id,
+		C:   c,
+	}
+	mock.lockUpdateCluster.Lock()
+	mock.calls.UpdateCluster = append(mock.calls.UpdateCluster, callInfo)
+	mock.lockUpdateCluster.Unlock()
+	return mock.UpdateClusterFunc(ctx, id, c)
+}
+
+// UpdateClusterCalls gets all the calls that were made to UpdateCluster.
+// Check the length with:
+//
+//	len(mockedClusterAPI.UpdateClusterCalls())
+func (mock *ClusterAPIMock) UpdateClusterCalls() []struct {
+	Ctx context.Context
+	ID  string
+	C   *Cluster
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+		C   *Cluster
+	}
+	mock.lockUpdateCluster.RLock()
+	calls = mock.calls.UpdateCluster
+	mock.lockUpdateCluster.RUnlock()
+	return calls
+}
+
+// Ensure, that MachinePoolAPIMock does implement MachinePoolAPI.
+// If this is not the case, regenerate this file with moq.
+var _ MachinePoolAPI = &MachinePoolAPIMock{}
+
+// MachinePoolAPIMock is a mock implementation of MachinePoolAPI.
+//
+//	func TestSomethingThatUsesMachinePoolAPI(t *testing.T) {
+//
+//		// make and configure a mocked MachinePoolAPI
+//		mockedMachinePoolAPI := &MachinePoolAPIMock{
+//			CreateMachinePoolFunc: func(ctx context.Context, clusterID string, p *MachinePool) (*MachinePool, error) {
+//				panic("mock out the CreateMachinePool method")
+//			},
+//			DeleteMachinePoolFunc: func(ctx context.Context, clusterID string, id string) error {
+//				panic("mock out the DeleteMachinePool method")
+//			},
+//			GetMachinePoolFunc: func(ctx context.Context, clusterID string, id string) (*MachinePool, error) {
+//				panic("mock out the GetMachinePool method")
+//			},
+//			UpdateMachinePoolFunc: func(ctx context.Context, clusterID string, p *MachinePool) error {
+//				panic("mock out the UpdateMachinePool method")
+//			},
+//		}
+//
+//		// use mockedMachinePoolAPI in code that requires MachinePoolAPI
+//		// and then make assertions.
+//
+//	}
+type MachinePoolAPIMock struct {
+	// CreateMachinePoolFunc mocks the CreateMachinePool method.
+	CreateMachinePoolFunc func(ctx context.Context, clusterID string, p *MachinePool) (*MachinePool, error)
+
+	// DeleteMachinePoolFunc mocks the DeleteMachinePool method.
+	DeleteMachinePoolFunc func(ctx context.Context, clusterID string, id string) error
+
+	// GetMachinePoolFunc mocks the GetMachinePool method.
+	GetMachinePoolFunc func(ctx context.Context, clusterID string, id string) (*MachinePool, error)
+
+	// UpdateMachinePoolFunc mocks the UpdateMachinePool method.
+	UpdateMachinePoolFunc func(ctx context.Context, clusterID string, p *MachinePool) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateMachinePool holds details about calls to the CreateMachinePool method.
+		CreateMachinePool []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// P is the p argument value.
+			P *MachinePool
+		}
+		// DeleteMachinePool holds details about calls to the DeleteMachinePool method.
+		DeleteMachinePool []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+		}
+		// GetMachinePool holds details about calls to the GetMachinePool method.
+		GetMachinePool []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateMachinePool holds details about calls to the UpdateMachinePool method.
+		UpdateMachinePool []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// P is the p argument value.
+			P *MachinePool
+		}
+	}
+	lockCreateMachinePool sync.RWMutex
+	lockDeleteMachinePool sync.RWMutex
+	lockGetMachinePool    sync.RWMutex
+	lockUpdateMachinePool sync.RWMutex
+}
+
+// CreateMachinePool calls CreateMachinePoolFunc.
+func (mock *MachinePoolAPIMock) CreateMachinePool(ctx context.Context, clusterID string, p *MachinePool) (*MachinePool, error) {
+	if mock.CreateMachinePoolFunc == nil {
+		panic("MachinePoolAPIMock.CreateMachinePoolFunc: method is nil but MachinePoolAPI.CreateMachinePool was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *MachinePool
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		P:         p,
+	}
+	mock.lockCreateMachinePool.Lock()
+	mock.calls.CreateMachinePool = append(mock.calls.CreateMachinePool, callInfo)
+	mock.lockCreateMachinePool.Unlock()
+	return mock.CreateMachinePoolFunc(ctx, clusterID, p)
+}
+
+// CreateMachinePoolCalls gets all the calls that were made to CreateMachinePool.
+// Check the length with:
+//
+//	len(mockedMachinePoolAPI.CreateMachinePoolCalls())
+func (mock *MachinePoolAPIMock) CreateMachinePoolCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	P         *MachinePool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *MachinePool
+	}
+	mock.lockCreateMachinePool.RLock()
+	calls = mock.calls.CreateMachinePool
+	mock.lockCreateMachinePool.RUnlock()
+	return calls
+}
+
+// DeleteMachinePool calls DeleteMachinePoolFunc.
+func (mock *MachinePoolAPIMock) DeleteMachinePool(ctx context.Context, clusterID string, id string) error {
+	if mock.DeleteMachinePoolFunc == nil {
+		panic("MachinePoolAPIMock.DeleteMachinePoolFunc: method is nil but MachinePoolAPI.DeleteMachinePool was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		ID:        id,
+	}
+	mock.lockDeleteMachinePool.Lock()
+	mock.calls.DeleteMachinePool = append(mock.calls.DeleteMachinePool, callInfo)
+	mock.lockDeleteMachinePool.Unlock()
+	return mock.DeleteMachinePoolFunc(ctx, clusterID, id)
+}
+
+// DeleteMachinePoolCalls gets all the calls that were made to DeleteMachinePool.
+// Check the length with:
+//
+//	len(mockedMachinePoolAPI.DeleteMachinePoolCalls())
+func (mock *MachinePoolAPIMock) DeleteMachinePoolCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}
+	mock.lockDeleteMachinePool.RLock()
+	calls = mock.calls.DeleteMachinePool
+	mock.lockDeleteMachinePool.RUnlock()
+	return calls
+}
+
+// GetMachinePool calls GetMachinePoolFunc.
+func (mock *MachinePoolAPIMock) GetMachinePool(ctx context.Context, clusterID string, id string) (*MachinePool, error) {
+	if mock.GetMachinePoolFunc == nil {
+		panic("MachinePoolAPIMock.GetMachinePoolFunc: method is nil but MachinePoolAPI.GetMachinePool was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		ID:        id,
+	}
+	mock.lockGetMachinePool.Lock()
+	mock.calls.GetMachinePool = append(mock.calls.GetMachinePool, callInfo)
+	mock.lockGetMachinePool.Unlock()
+	return mock.GetMachinePoolFunc(ctx, clusterID, id)
+}
+
+// GetMachinePoolCalls gets all the calls that were made to GetMachinePool.
+// Check the length with:
+//
+//	len(mockedMachinePoolAPI.GetMachinePoolCalls())
+func (mock *MachinePoolAPIMock) GetMachinePoolCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}
+	mock.lockGetMachinePool.RLock()
+	calls = mock.calls.GetMachinePool
+	mock.lockGetMachinePool.RUnlock()
+	return calls
+}
+
+// UpdateMachinePool calls UpdateMachinePoolFunc.
+func (mock *MachinePoolAPIMock) UpdateMachinePool(ctx context.Context, clusterID string, p *MachinePool) error {
+	if mock.UpdateMachinePoolFunc == nil {
+		panic("MachinePoolAPIMock.UpdateMachinePoolFunc: method is nil but MachinePoolAPI.UpdateMachinePool was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *MachinePool
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		P:         p,
+	}
+	mock.lockUpdateMachinePool.Lock()
+	mock.calls.UpdateMachinePool = append(mock.calls.UpdateMachinePool, callInfo)
+	mock.lockUpdateMachinePool.Unlock()
+	return mock.UpdateMachinePoolFunc(ctx, clusterID, p)
+}
+
+// UpdateMachinePoolCalls gets all the calls that were made to UpdateMachinePool.
+// Check the length with:
+//
+//	len(mockedMachinePoolAPI.UpdateMachinePoolCalls())
+func (mock *MachinePoolAPIMock) UpdateMachinePoolCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	P         *MachinePool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *MachinePool
+	}
+	mock.lockUpdateMachinePool.RLock()
+	calls = mock.calls.UpdateMachinePool
+	mock.lockUpdateMachinePool.RUnlock()
+	return calls
+}
+
+// Ensure, that IdentityProviderAPIMock does implement IdentityProviderAPI.
+// If this is not the case, regenerate this file with moq.
+var _ IdentityProviderAPI = &IdentityProviderAPIMock{}
+
+// IdentityProviderAPIMock is a mock implementation of IdentityProviderAPI.
+//
+//	func TestSomethingThatUsesIdentityProviderAPI(t *testing.T) {
+//
+//		// make and configure a mocked IdentityProviderAPI
+//		mockedIdentityProviderAPI := &IdentityProviderAPIMock{
+//			CreateIdentityProviderFunc: func(ctx context.Context, clusterID string, p *IdentityProvider) (*IdentityProvider, error) {
+//				panic("mock out the CreateIdentityProvider method")
+//			},
+//			DeleteIdentityProviderFunc: func(ctx context.Context, clusterID string, id string) error {
+//				panic("mock out the DeleteIdentityProvider method")
+//			},
+//			GetIdentityProviderFunc: func(ctx context.Context, clusterID string, id string) (*IdentityProvider, error) {
+//				panic("mock out the GetIdentityProvider method")
+//			},
+//			UpdateIdentityProviderFunc: func(ctx context.Context, clusterID string, p *IdentityProvider) error {
+//				panic("mock out the UpdateIdentityProvider method")
+//			},
+//		}
+//
+//		// use mockedIdentityProviderAPI in code that requires IdentityProviderAPI
+//		// and then make assertions.
+//
+//	}
+type IdentityProviderAPIMock struct {
+	// CreateIdentityProviderFunc mocks the CreateIdentityProvider method.
+	CreateIdentityProviderFunc func(ctx context.Context, clusterID string, p *IdentityProvider) (*IdentityProvider, error)
+
+	// DeleteIdentityProviderFunc mocks the DeleteIdentityProvider method.
+	DeleteIdentityProviderFunc func(ctx context.Context, clusterID string, id string) error
+
+	// GetIdentityProviderFunc mocks the GetIdentityProvider method.
+	GetIdentityProviderFunc func(ctx context.Context, clusterID string, id string) (*IdentityProvider, error)
+
+	// UpdateIdentityProviderFunc mocks the UpdateIdentityProvider method.
+	UpdateIdentityProviderFunc func(ctx context.Context, clusterID string, p *IdentityProvider) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateIdentityProvider holds details about calls to the CreateIdentityProvider method.
+		CreateIdentityProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// P is the p argument value.
+			P *IdentityProvider
+		}
+		// DeleteIdentityProvider holds details about calls to the DeleteIdentityProvider method.
+		DeleteIdentityProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+		}
+		// GetIdentityProvider holds details about calls to the GetIdentityProvider method.
+		GetIdentityProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateIdentityProvider holds details about calls to the UpdateIdentityProvider method.
+		UpdateIdentityProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// P is the p argument value.
+			P *IdentityProvider
+		}
+	}
+	lockCreateIdentityProvider sync.RWMutex
+	lockDeleteIdentityProvider sync.RWMutex
+	lockGetIdentityProvider    sync.RWMutex
+	lockUpdateIdentityProvider sync.RWMutex
+}
+
+// CreateIdentityProvider calls CreateIdentityProviderFunc.
+func (mock *IdentityProviderAPIMock) CreateIdentityProvider(ctx context.Context, clusterID string, p *IdentityProvider) (*IdentityProvider, error) {
+	if mock.CreateIdentityProviderFunc == nil {
+		panic("IdentityProviderAPIMock.CreateIdentityProviderFunc: method is nil but IdentityProviderAPI.CreateIdentityProvider was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *IdentityProvider
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		P:         p,
+	}
+	mock.lockCreateIdentityProvider.Lock()
+	mock.calls.CreateIdentityProvider = append(mock.calls.CreateIdentityProvider, callInfo)
+	mock.lockCreateIdentityProvider.Unlock()
+	return mock.CreateIdentityProviderFunc(ctx, clusterID, p)
+}
+
+// CreateIdentityProviderCalls gets all the calls that were made to CreateIdentityProvider.
+// Check the length with:
+//
+//	len(mockedIdentityProviderAPI.CreateIdentityProviderCalls())
+func (mock *IdentityProviderAPIMock) CreateIdentityProviderCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	P         *IdentityProvider
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *IdentityProvider
+	}
+	mock.lockCreateIdentityProvider.RLock()
+	calls = mock.calls.CreateIdentityProvider
+	mock.lockCreateIdentityProvider.RUnlock()
+	return calls
+}
+
+// DeleteIdentityProvider calls DeleteIdentityProviderFunc.
+func (mock *IdentityProviderAPIMock) DeleteIdentityProvider(ctx context.Context, clusterID string, id string) error {
+	if mock.DeleteIdentityProviderFunc == nil {
+		panic("IdentityProviderAPIMock.DeleteIdentityProviderFunc: method is nil but IdentityProviderAPI.DeleteIdentityProvider was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		ID:        id,
+	}
+	mock.lockDeleteIdentityProvider.Lock()
+	mock.calls.DeleteIdentityProvider = append(mock.calls.DeleteIdentityProvider, callInfo)
+	mock.lockDeleteIdentityProvider.Unlock()
+	return mock.DeleteIdentityProviderFunc(ctx, clusterID, id)
+}
+
+// DeleteIdentityProviderCalls gets all the calls that were made to DeleteIdentityProvider.
+// Check the length with:
+//
+//	len(mockedIdentityProviderAPI.DeleteIdentityProviderCalls())
+func (mock *IdentityProviderAPIMock) DeleteIdentityProviderCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}
+	mock.lockDeleteIdentityProvider.RLock()
+	calls = mock.calls.DeleteIdentityProvider
+	mock.lockDeleteIdentityProvider.RUnlock()
+	return calls
+}
+
+// GetIdentityProvider calls GetIdentityProviderFunc.
+func (mock *IdentityProviderAPIMock) GetIdentityProvider(ctx context.Context, clusterID string, id string) (*IdentityProvider, error) {
+	if mock.GetIdentityProviderFunc == nil {
+		panic("IdentityProviderAPIMock.GetIdentityProviderFunc: method is nil but IdentityProviderAPI.GetIdentityProvider was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		ID:        id,
+	}
+	mock.lockGetIdentityProvider.Lock()
+	mock.calls.GetIdentityProvider = append(mock.calls.GetIdentityProvider, callInfo)
+	mock.lockGetIdentityProvider.Unlock()
+	return mock.GetIdentityProviderFunc(ctx, clusterID, id)
+}
+
+// GetIdentityProviderCalls gets all the calls that were made to GetIdentityProvider.
+// Check the length with:
+//
+//	len(mockedIdentityProviderAPI.GetIdentityProviderCalls())
+func (mock *IdentityProviderAPIMock) GetIdentityProviderCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}
+	mock.lockGetIdentityProvider.RLock()
+	calls = mock.calls.GetIdentityProvider
+	mock.lockGetIdentityProvider.RUnlock()
+	return calls
+}
+
+// UpdateIdentityProvider calls UpdateIdentityProviderFunc.
+func (mock *IdentityProviderAPIMock) UpdateIdentityProvider(ctx context.Context, clusterID string, p *IdentityProvider) error {
+	if mock.UpdateIdentityProviderFunc == nil {
+		panic("IdentityProviderAPIMock.UpdateIdentityProviderFunc: method is nil but IdentityProviderAPI.UpdateIdentityProvider was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *IdentityProvider
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		P:         p,
+	}
+	mock.lockUpdateIdentityProvider.Lock()
+	mock.calls.UpdateIdentityProvider = append(mock.calls.UpdateIdentityProvider, callInfo)
+	mock.lockUpdateIdentityProvider.Unlock()
+	return mock.UpdateIdentityProviderFunc(ctx, clusterID, p)
+}
+
+// UpdateIdentityProviderCalls gets all the calls that were made to UpdateIdentityProvider.
+// Check the length with:
+//
+//	len(mockedIdentityProviderAPI.UpdateIdentityProviderCalls())
+func (mock *IdentityProviderAPIMock) UpdateIdentityProviderCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	P         *IdentityProvider
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *IdentityProvider
+	}
+	mock.lockUpdateIdentityProvider.RLock()
+	calls = mock.calls.UpdateIdentityProvider
+	mock.lockUpdateIdentityProvider.RUnlock()
+	return calls
+}
+
+// Ensure, that GroupUserAPIMock does implement GroupUserAPI.
+// If this is not the case, regenerate this file with moq.
+var _ GroupUserAPI = &GroupUserAPIMock{}
+
+// GroupUserAPIMock is a mock implementation of GroupUserAPI.
+//
+//	func TestSomethingThatUsesGroupUserAPI(t *testing.T) {
+//
+//		// make and configure a mocked GroupUserAPI
+//		mockedGroupUserAPI := &GroupUserAPIMock{
+//			CreateGroupUserFunc: func(ctx context.Context, clusterID string, groupID string, u *User) (*User, error) {
+//				panic("mock out the CreateGroupUser method")
+//			},
+//			DeleteGroupUserFunc: func(ctx context.Context, clusterID string, groupID string, id string) error {
+//				panic("mock out the DeleteGroupUser method")
+//			},
+//			GetGroupUserFunc: func(ctx context.Context, clusterID string, groupID string, id string) (*User, error) {
+//				panic("mock out the GetGroupUser method")
+//			},
+//		}
+//
+//		// use mockedGroupUserAPI in code that requires GroupUserAPI
+//		// and then make assertions.
+//
+//	}
+type GroupUserAPIMock struct {
+	// CreateGroupUserFunc mocks the CreateGroupUser method.
+	CreateGroupUserFunc func(ctx context.Context, clusterID string, groupID string, u *User) (*User, error)
+
+	// DeleteGroupUserFunc mocks the DeleteGroupUser method.
+	DeleteGroupUserFunc func(ctx context.Context, clusterID string, groupID string, id string) error
+
+	// GetGroupUserFunc mocks the GetGroupUser method.
+	GetGroupUserFunc func(ctx context.Context, clusterID string, groupID string, id string) (*User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateGroupUser holds details about calls to the CreateGroupUser method.
+		CreateGroupUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// GroupID is the groupID argument value.
+			GroupID string
+			// U is the u argument value.
+			U *User
+		}
+		// DeleteGroupUser holds details about calls to the DeleteGroupUser method.
+		DeleteGroupUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// GroupID is the groupID argument value.
+			GroupID string
+			// ID is the id argument value.
+			ID string
+		}
+		// GetGroupUser holds details about calls to the GetGroupUser method.
+		GetGroupUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// GroupID is the groupID argument value.
+			GroupID string
+			// ID is the id argument value.
+			ID string
+		}
+	}
+	lockCreateGroupUser sync.RWMutex
+	lockDeleteGroupUser sync.RWMutex
+	lockGetGroupUser    sync.RWMutex
+}
+
+// CreateGroupUser calls CreateGroupUserFunc.
+func (mock *GroupUserAPIMock) CreateGroupUser(ctx context.Context, clusterID string, groupID string, u *User) (*User, error) {
+	if mock.CreateGroupUserFunc == nil {
+		panic("GroupUserAPIMock.CreateGroupUserFunc: method is nil but GroupUserAPI.CreateGroupUser was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		GroupID   string
+		U         *User
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		GroupID:   groupID,
+		U:         u,
+	}
+	mock.lockCreateGroupUser.Lock()
+	mock.calls.CreateGroupUser = append(mock.calls.CreateGroupUser, callInfo)
+	mock.lockCreateGroupUser.Unlock()
+	return mock.CreateGroupUserFunc(ctx, clusterID, groupID, u)
+}
+
+// CreateGroupUserCalls gets all the calls that were made to CreateGroupUser.
+// Check the length with:
+//
+//	len(mockedGroupUserAPI.CreateGroupUserCalls())
+func (mock *GroupUserAPIMock) CreateGroupUserCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	GroupID   string
+	U         *User
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		GroupID   string
+		U         *User
+	}
+	mock.lockCreateGroupUser.RLock()
+	calls = mock.calls.CreateGroupUser
+	mock.lockCreateGroupUser.RUnlock()
+	return calls
+}
+
+// DeleteGroupUser calls DeleteGroupUserFunc.
+func (mock *GroupUserAPIMock) DeleteGroupUser(ctx context.Context, clusterID string, groupID string, id string) error {
+	if mock.DeleteGroupUserFunc == nil {
+		panic("GroupUserAPIMock.DeleteGroupUserFunc: method is nil but GroupUserAPI.DeleteGroupUser was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		GroupID   string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		GroupID:   groupID,
+		ID:        id,
+	}
+	mock.lockDeleteGroupUser.Lock()
+	mock.calls.DeleteGroupUser = append(mock.calls.DeleteGroupUser, callInfo)
+	mock.lockDeleteGroupUser.Unlock()
+	return mock.DeleteGroupUserFunc(ctx, clusterID, groupID, id)
+}
+
+// DeleteGroupUserCalls gets all the calls that were made to DeleteGroupUser.
+// Check the length with:
+//
+//	len(mockedGroupUserAPI.DeleteGroupUserCalls())
+func (mock *GroupUserAPIMock) DeleteGroupUserCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	GroupID   string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		GroupID   string
+		ID        string
+	}
+	mock.lockDeleteGroupUser.RLock()
+	calls = mock.calls.DeleteGroupUser
+	mock.lockDeleteGroupUser.RUnlock()
+	return calls
+}
+
+// GetGroupUser calls GetGroupUserFunc.
+func (mock *GroupUserAPIMock) GetGroupUser(ctx context.Context, clusterID string, groupID string, id string) (*User, error) {
+	if mock.GetGroupUserFunc == nil {
+		panic("GroupUserAPIMock.GetGroupUserFunc: method is nil but GroupUserAPI.GetGroupUser was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		GroupID   string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		GroupID:   groupID,
+		ID:        id,
+	}
+	mock.lockGetGroupUser.Lock()
+	mock.calls.GetGroupUser = append(mock.calls.GetGroupUser, callInfo)
+	mock.lockGetGroupUser.Unlock()
+	return mock.GetGroupUserFunc(ctx, clusterID, groupID, id)
+}
+
+// GetGroupUserCalls gets all the calls that were made to GetGroupUser.
+// Check the length with:
+//
+//	len(mockedGroupUserAPI.GetGroupUserCalls())
+func (mock *GroupUserAPIMock) GetGroupUserCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	GroupID   string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		GroupID   string
+		ID        string
+	}
+	mock.lockGetGroupUser.RLock()
+	calls = mock.calls.GetGroupUser
+	mock.lockGetGroupUser.RUnlock()
+	return calls
+}
+
+// Ensure, that AddOnInstallationAPIMock does implement AddOnInstallationAPI.
+// If this is not the case, regenerate this file with moq.
+var _ AddOnInstallationAPI = &AddOnInstallationAPIMock{}
+
+// AddOnInstallationAPIMock is a mock implementation of AddOnInstallationAPI.
+//
+//	func TestSomethingThatUsesAddOnInstallationAPI(t *testing.T) {
+//
+//		// make and configure a mocked AddOnInstallationAPI
+//		mockedAddOnInstallationAPI := &AddOnInstallationAPIMock{
+//			CreateAddOnInstallationFunc: func(ctx context.Context, clusterID string, a *AddOnInstallation) (*AddOnInstallation, error) {
+//				panic("mock out the CreateAddOnInstallation method")
+//			},
+//			DeleteAddOnInstallationFunc: func(ctx context.Context, clusterID string, id string) error {
+//				panic("mock out the DeleteAddOnInstallation method")
+//			},
+//			GetAddOnFunc: func(ctx context.Context, id string) (*AddOn, error) {
+//				panic("mock out the GetAddOn method")
+//			},
+//			GetAddOnInstallationFunc: func(ctx context.Context, clusterID string, id string) (*AddOnInstallation, error) {
+//				panic("mock out the GetAddOnInstallation method")
+//			},
+//			UpdateAddOnInstallationFunc: func(ctx context.Context, clusterID string, a *AddOnInstallation) error {
+//				panic("mock out the UpdateAddOnInstallation method")
+//			},
+//		}
+//
+//		// use mockedAddOnInstallationAPI in code that requires AddOnInstallationAPI
+//		// and then make assertions.
+//
+//	}
+type AddOnInstallationAPIMock struct {
+	// CreateAddOnInstallationFunc mocks the CreateAddOnInstallation method.
+	CreateAddOnInstallationFunc func(ctx context.Context, clusterID string, a *AddOnInstallation) (*AddOnInstallation, error)
+
+	// DeleteAddOnInstallationFunc mocks the DeleteAddOnInstallation method.
+	DeleteAddOnInstallationFunc func(ctx context.Context, clusterID string, id string) error
+
+	// GetAddOnFunc mocks the GetAddOn method.
+	GetAddOnFunc func(ctx context.Context, id string) (*AddOn, error)
+
+	// GetAddOnInstallationFunc mocks the GetAddOnInstallation method.
+	GetAddOnInstallationFunc func(ctx context.Context, clusterID string, id string) (*AddOnInstallation, error)
+
+	// UpdateAddOnInstallationFunc mocks the UpdateAddOnInstallation method.
+	UpdateAddOnInstallationFunc func(ctx context.Context, clusterID string, a *AddOnInstallation) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateAddOnInstallation holds details about calls to the CreateAddOnInstallation method.
+		CreateAddOnInstallation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// A is the a argument value.
+			A *AddOnInstallation
+		}
+		// DeleteAddOnInstallation holds details about calls to the DeleteAddOnInstallation method.
+		DeleteAddOnInstallation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+		}
+		// GetAddOn holds details about calls to the GetAddOn method.
+		GetAddOn []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetAddOnInstallation holds details about calls to the GetAddOnInstallation method.
+		GetAddOnInstallation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateAddOnInstallation holds details about calls to the UpdateAddOnInstallation method.
+		UpdateAddOnInstallation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// A is the a argument value.
+			A *AddOnInstallation
+		}
+	}
+	lockCreateAddOnInstallation sync.RWMutex
+	lockDeleteAddOnInstallation sync.RWMutex
+	lockGetAddOn                sync.RWMutex
+	lockGetAddOnInstallation    sync.RWMutex
+	lockUpdateAddOnInstallation sync.RWMutex
+}
+
+// CreateAddOnInstallation calls CreateAddOnInstallationFunc.
+func (mock *AddOnInstallationAPIMock) CreateAddOnInstallation(ctx context.Context, clusterID string, a *AddOnInstallation) (*AddOnInstallation, error) {
+	if mock.CreateAddOnInstallationFunc == nil {
+		panic("AddOnInstallationAPIMock.CreateAddOnInstallationFunc: method is nil but AddOnInstallationAPI.CreateAddOnInstallation was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		A         *AddOnInstallation
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		A:         a,
+	}
+	mock.lockCreateAddOnInstallation.Lock()
+	mock.calls.CreateAddOnInstallation = append(mock.calls.CreateAddOnInstallation, callInfo)
+	mock.lockCreateAddOnInstallation.Unlock()
+	return mock.CreateAddOnInstallationFunc(ctx, clusterID, a)
+}
+
+// CreateAddOnInstallationCalls gets all the calls that were made to CreateAddOnInstallation.
+// Check the length with:
+//
+//	len(mockedAddOnInstallationAPI.CreateAddOnInstallationCalls())
+func (mock *AddOnInstallationAPIMock) CreateAddOnInstallationCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	A         *AddOnInstallation
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		A         *AddOnInstallation
+	}
+	mock.lockCreateAddOnInstallation.RLock()
+	calls = mock.calls.CreateAddOnInstallation
+	mock.lockCreateAddOnInstallation.RUnlock()
+	return calls
+}
+
+// DeleteAddOnInstallation calls DeleteAddOnInstallationFunc.
+func (mock *AddOnInstallationAPIMock) DeleteAddOnInstallation(ctx context.Context, clusterID string, id string) error {
+	if mock.DeleteAddOnInstallationFunc == nil {
+		panic("AddOnInstallationAPIMock.DeleteAddOnInstallationFunc: method is nil but AddOnInstallationAPI.DeleteAddOnInstallation was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		ID:        id,
+	}
+	mock.lockDeleteAddOnInstallation.Lock()
+	mock.calls.DeleteAddOnInstallation = append(mock.calls.DeleteAddOnInstallation, callInfo)
+	mock.lockDeleteAddOnInstallation.Unlock()
+	return mock.DeleteAddOnInstallationFunc(ctx, clusterID, id)
+}
+
+// DeleteAddOnInstallationCalls gets all the calls that were made to DeleteAddOnInstallation.
+// Check the length with:
+//
+//	len(mockedAddOnInstallationAPI.DeleteAddOnInstallationCalls())
+func (mock *AddOnInstallationAPIMock) DeleteAddOnInstallationCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}
+	mock.lockDeleteAddOnInstallation.RLock()
+	calls = mock.calls.DeleteAddOnInstallation
+	mock.lockDeleteAddOnInstallation.RUnlock()
+	return calls
+}
+
+// GetAddOn calls GetAddOnFunc.
+func (mock *AddOnInstallationAPIMock) GetAddOn(ctx context.Context, id string) (*AddOn, error) {
+	if mock.GetAddOnFunc == nil {
+		panic("AddOnInstallationAPIMock.GetAddOnFunc: method is nil but AddOnInstallationAPI.GetAddOn was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetAddOn.Lock()
+	mock.calls.GetAddOn = append(mock.calls.GetAddOn, callInfo)
+	mock.lockGetAddOn.Unlock()
+	return mock.GetAddOnFunc(ctx, id)
+}
+
+// GetAddOnCalls gets all the calls that were made to GetAddOn.
+// Check the length with:
+//
+//	len(mockedAddOnInstallationAPI.GetAddOnCalls())
+func (mock *AddOnInstallationAPIMock) GetAddOnCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetAddOn.RLock()
+	calls = mock.calls.GetAddOn
+	mock.lockGetAddOn.RUnlock()
+	return calls
+}
+
+// GetAddOnInstallation calls GetAddOnInstallationFunc.
+func (mock *AddOnInstallationAPIMock) GetAddOnInstallation(ctx context.Context, clusterID string, id string) (*AddOnInstallation, error) {
+	if mock.GetAddOnInstallationFunc == nil {
+		panic("AddOnInstallationAPIMock.GetAddOnInstallationFunc: method is nil but AddOnInstallationAPI.GetAddOnInstallation was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		ID:        id,
+	}
+	mock.lockGetAddOnInstallation.Lock()
+	mock.calls.GetAddOnInstallation = append(mock.calls.GetAddOnInstallation, callInfo)
+	mock.lockGetAddOnInstallation.Unlock()
+	return mock.GetAddOnInstallationFunc(ctx, clusterID, id)
+}
+
+// GetAddOnInstallationCalls gets all the calls that were made to GetAddOnInstallation.
+// Check the length with:
+//
+//	len(mockedAddOnInstallationAPI.GetAddOnInstallationCalls())
+func (mock *AddOnInstallationAPIMock) GetAddOnInstallationCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}
+	mock.lockGetAddOnInstallation.RLock()
+	calls = mock.calls.GetAddOnInstallation
+	mock.lockGetAddOnInstallation.RUnlock()
+	return calls
+}
+
+// UpdateAddOnInstallation calls UpdateAddOnInstallationFunc.
+func (mock *AddOnInstallationAPIMock) UpdateAddOnInstallation(ctx context.Context, clusterID string, a *AddOnInstallation) error {
+	if mock.UpdateAddOnInstallationFunc == nil {
+		panic("AddOnInstallationAPIMock.UpdateAddOnInstallationFunc: method is nil but AddOnInstallationAPI.UpdateAddOnInstallation was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		A         *AddOnInstallation
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		A:         a,
+	}
+	mock.lockUpdateAddOnInstallation.Lock()
+	mock.calls.UpdateAddOnInstallation = append(mock.calls.UpdateAddOnInstallation, callInfo)
+	mock.lockUpdateAddOnInstallation.Unlock()
+	return mock.UpdateAddOnInstallationFunc(ctx, clusterID, a)
+}
+
+// UpdateAddOnInstallationCalls gets all the calls that were made to UpdateAddOnInstallation.
+// Check the length with:
+//
+//	len(mockedAddOnInstallationAPI.UpdateAddOnInstallationCalls())
+func (mock *AddOnInstallationAPIMock) UpdateAddOnInstallationCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	A         *AddOnInstallation
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		A         *AddOnInstallation
+	}
+	mock.lockUpdateAddOnInstallation.RLock()
+	calls = mock.calls.UpdateAddOnInstallation
+	mock.lockUpdateAddOnInstallation.RUnlock()
+	return calls
+}
+
+// Ensure, that UpgradePolicyAPIMock does implement UpgradePolicyAPI.
+// If this is not the case, regenerate this file with moq.
+var _ UpgradePolicyAPI = &UpgradePolicyAPIMock{}
+
+// UpgradePolicyAPIMock is a mock implementation of UpgradePolicyAPI.
+//
+//	func TestSomethingThatUsesUpgradePolicyAPI(t *testing.T) {
+//
+//		// make and configure a mocked UpgradePolicyAPI
+//		mockedUpgradePolicyAPI := &UpgradePolicyAPIMock{
+//			CreateUpgradePolicyFunc: func(ctx context.Context, clusterID string, p *UpgradePolicy) (*UpgradePolicy, error) {
+//				panic("mock out the CreateUpgradePolicy method")
+//			},
+//			DeleteUpgradePolicyFunc: func(ctx context.Context, clusterID string, id string) error {
+//				panic("mock out the DeleteUpgradePolicy method")
+//			},
+//			GetUpgradePolicyFunc: func(ctx context.Context, clusterID string, id string) (*UpgradePolicy, error) {
+//				panic("mock out the GetUpgradePolicy method")
+//			},
+//			GetUpgradePolicyStateFunc: func(ctx context.Context, clusterID string, id string) (*UpgradePolicyState, error) {
+//				panic("mock out the GetUpgradePolicyState method")
+//			},
+//			UpdateUpgradePolicyFunc: func(ctx context.Context, clusterID string, p *UpgradePolicy) error {
+//				panic("mock out the UpdateUpgradePolicy method")
+//			},
+//		}
+//
+//		// use mockedUpgradePolicyAPI in code that requires UpgradePolicyAPI
+//		// and then make assertions.
+//
+//	}
+type UpgradePolicyAPIMock struct {
+	// CreateUpgradePolicyFunc mocks the CreateUpgradePolicy method.
+	CreateUpgradePolicyFunc func(ctx context.Context, clusterID string, p *UpgradePolicy) (*UpgradePolicy, error)
+
+	// DeleteUpgradePolicyFunc mocks the DeleteUpgradePolicy method.
+	DeleteUpgradePolicyFunc func(ctx context.Context, clusterID string, id string) error
+
+	// GetUpgradePolicyFunc mocks the GetUpgradePolicy method.
+	GetUpgradePolicyFunc func(ctx context.Context, clusterID string, id string) (*UpgradePolicy, error)
+
+	// GetUpgradePolicyStateFunc mocks the GetUpgradePolicyState method.
+	GetUpgradePolicyStateFunc func(ctx context.Context, clusterID string, id string) (*UpgradePolicyState, error)
+
+	// UpdateUpgradePolicyFunc mocks the UpdateUpgradePolicy method.
+	UpdateUpgradePolicyFunc func(ctx context.Context, clusterID string, p *UpgradePolicy) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateUpgradePolicy holds details about calls to the CreateUpgradePolicy method.
+		CreateUpgradePolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// P is the p argument value.
+			P *UpgradePolicy
+		}
+		// DeleteUpgradePolicy holds details about calls to the DeleteUpgradePolicy method.
+		DeleteUpgradePolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+		}
+		// GetUpgradePolicy holds details about calls to the GetUpgradePolicy method.
+		GetUpgradePolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+		}
+		// GetUpgradePolicyState holds details about calls to the GetUpgradePolicyState method.
+		GetUpgradePolicyState []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateUpgradePolicy holds details about calls to the UpdateUpgradePolicy method.
+		UpdateUpgradePolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// P is the p argument value.
+			P *UpgradePolicy
+		}
+	}
+	lockCreateUpgradePolicy   sync.RWMutex
+	lockDeleteUpgradePolicy   sync.RWMutex
+	lockGetUpgradePolicy      sync.RWMutex
+	lockGetUpgradePolicyState sync.RWMutex
+	lockUpdateUpgradePolicy   sync.RWMutex
+}
+
+// CreateUpgradePolicy calls CreateUpgradePolicyFunc.
+func (mock *UpgradePolicyAPIMock) CreateUpgradePolicy(ctx context.Context, clusterID string, p *UpgradePolicy) (*UpgradePolicy, error) {
+	if mock.CreateUpgradePolicyFunc == nil {
+		panic("UpgradePolicyAPIMock.CreateUpgradePolicyFunc: method is nil but UpgradePolicyAPI.CreateUpgradePolicy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *UpgradePolicy
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		P:         p,
+	}
+	mock.lockCreateUpgradePolicy.Lock()
+	mock.calls.CreateUpgradePolicy = append(mock.calls.CreateUpgradePolicy, callInfo)
+	mock.lockCreateUpgradePolicy.Unlock()
+	return mock.CreateUpgradePolicyFunc(ctx, clusterID, p)
+}
+
+// CreateUpgradePolicyCalls gets all the calls that were made to CreateUpgradePolicy.
+// Check the length with:
+//
+//	len(mockedUpgradePolicyAPI.CreateUpgradePolicyCalls())
+func (mock *UpgradePolicyAPIMock) CreateUpgradePolicyCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	P         *UpgradePolicy
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *UpgradePolicy
+	}
+	mock.lockCreateUpgradePolicy.RLock()
+	calls = mock.calls.CreateUpgradePolicy
+	mock.lockCreateUpgradePolicy.RUnlock()
+	return calls
+}
+
+// DeleteUpgradePolicy calls DeleteUpgradePolicyFunc.
+func (mock *UpgradePolicyAPIMock) DeleteUpgradePolicy(ctx context.Context, clusterID string, id string) error {
+	if mock.DeleteUpgradePolicyFunc == nil {
+		panic("UpgradePolicyAPIMock.DeleteUpgradePolicyFunc: method is nil but UpgradePolicyAPI.DeleteUpgradePolicy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		ID:        id,
+	}
+	mock.lockDeleteUpgradePolicy.Lock()
+	mock.calls.DeleteUpgradePolicy = append(mock.calls.DeleteUpgradePolicy, callInfo)
+	mock.lockDeleteUpgradePolicy.Unlock()
+	return mock.DeleteUpgradePolicyFunc(ctx, clusterID, id)
+}
+
+// DeleteUpgradePolicyCalls gets all the calls that were made to DeleteUpgradePolicy.
+// Check the length with:
+//
+//	len(mockedUpgradePolicyAPI.DeleteUpgradePolicyCalls())
+func (mock *UpgradePolicyAPIMock) DeleteUpgradePolicyCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}
+	mock.lockDeleteUpgradePolicy.RLock()
+	calls = mock.calls.DeleteUpgradePolicy
+	mock.lockDeleteUpgradePolicy.RUnlock()
+	return calls
+}
+
+// GetUpgradePolicy calls GetUpgradePolicyFunc.
+func (mock *UpgradePolicyAPIMock) GetUpgradePolicy(ctx context.Context, clusterID string, id string) (*UpgradePolicy, error) {
+	if mock.GetUpgradePolicyFunc == nil {
+		panic("UpgradePolicyAPIMock.GetUpgradePolicyFunc: method is nil but UpgradePolicyAPI.GetUpgradePolicy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		ID:        id,
+	}
+	mock.lockGetUpgradePolicy.Lock()
+	mock.calls.GetUpgradePolicy = append(mock.calls.GetUpgradePolicy, callInfo)
+	mock.lockGetUpgradePolicy.Unlock()
+	return mock.GetUpgradePolicyFunc(ctx, clusterID, id)
+}
+
+// GetUpgradePolicyCalls gets all the calls that were made to GetUpgradePolicy.
+// Check the length with:
+//
+//	len(mockedUpgradePolicyAPI.GetUpgradePolicyCalls())
+func (mock *UpgradePolicyAPIMock) GetUpgradePolicyCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}
+	mock.lockGetUpgradePolicy.RLock()
+	calls = mock.calls.GetUpgradePolicy
+	mock.lockGetUpgradePolicy.RUnlock()
+	return calls
+}
+
+// GetUpgradePolicyState calls GetUpgradePolicyStateFunc.
+func (mock *UpgradePolicyAPIMock) GetUpgradePolicyState(ctx context.Context, clusterID string, id string) (*UpgradePolicyState, error) {
+	if mock.GetUpgradePolicyStateFunc == nil {
+		panic("UpgradePolicyAPIMock.GetUpgradePolicyStateFunc: method is nil but UpgradePolicyAPI.GetUpgradePolicyState was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		ID:        id,
+	}
+	mock.lockGetUpgradePolicyState.Lock()
+	mock.calls.GetUpgradePolicyState = append(mock.calls.GetUpgradePolicyState, callInfo)
+	mock.lockGetUpgradePolicyState.Unlock()
+	return mock.GetUpgradePolicyStateFunc(ctx, clusterID, id)
+}
+
+// GetUpgradePolicyStateCalls gets all the calls that were made to GetUpgradePolicyState.
+// Check the length with:
+//
+//	len(mockedUpgradePolicyAPI.GetUpgradePolicyStateCalls())
+func (mock *UpgradePolicyAPIMock) GetUpgradePolicyStateCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}
+	mock.lockGetUpgradePolicyState.RLock()
+	calls = mock.calls.GetUpgradePolicyState
+	mock.lockGetUpgradePolicyState.RUnlock()
+	return calls
+}
+
+// UpdateUpgradePolicy calls UpdateUpgradePolicyFunc.
+func (mock *UpgradePolicyAPIMock) UpdateUpgradePolicy(ctx context.Context, clusterID string, p *UpgradePolicy) error {
+	if mock.UpdateUpgradePolicyFunc == nil {
+		panic("UpgradePolicyAPIMock.UpdateUpgradePolicyFunc: method is nil but UpgradePolicyAPI.UpdateUpgradePolicy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *UpgradePolicy
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		P:         p,
+	}
+	mock.lockUpdateUpgradePolicy.Lock()
+	mock.calls.UpdateUpgradePolicy = append(mock.calls.UpdateUpgradePolicy, callInfo)
+	mock.lockUpdateUpgradePolicy.Unlock()
+	return mock.UpdateUpgradePolicyFunc(ctx, clusterID, p)
+}
+
+// UpdateUpgradePolicyCalls gets all the calls that were made to UpdateUpgradePolicy.
+// Check the length with:
+//
+//	len(mockedUpgradePolicyAPI.UpdateUpgradePolicyCalls())
+func (mock *UpgradePolicyAPIMock) UpdateUpgradePolicyCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	P         *UpgradePolicy
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		P         *UpgradePolicy
+	}
+	mock.lockUpdateUpgradePolicy.RLock()
+	calls = mock.calls.UpdateUpgradePolicy
+	mock.lockUpdateUpgradePolicy.RUnlock()
+	return calls
+}
+
+// Ensure, that IngressAPIMock does implement IngressAPI.
+// If this is not the case, regenerate this file with moq.
+var _ IngressAPI = &IngressAPIMock{}
+
+// IngressAPIMock is a mock implementation of IngressAPI.
+//
+//	func TestSomethingThatUsesIngressAPI(t *testing.T) {
+//
+//		// make and configure a mocked IngressAPI
+//		mockedIngressAPI := &IngressAPIMock{
+//			CreateIngressFunc: func(ctx context.Context, clusterID string, i *Ingress) (*Ingress, error) {
+//				panic("mock out the CreateIngress method")
+//			},
+//			DeleteIngressFunc: func(ctx context.Context, clusterID string, id string) error {
+//				panic("mock out the DeleteIngress method")
+//			},
+//			GetIngressFunc: func(ctx context.Context, clusterID string, id string) (*Ingress, error) {
+//				panic("mock out the GetIngress method")
+//			},
+//			ListIngressesFunc: func(ctx context.Context, clusterID string) ([]Ingress, error) {
+//				panic("mock out the ListIngresses method")
+//			},
+//			UpdateIngressFunc: func(ctx context.Context, clusterID string, i *Ingress) error {
+//				panic("mock out the UpdateIngress method")
+//			},
+//		}
+//
+//		// use mockedIngressAPI in code that requires IngressAPI
+//		// and then make assertions.
+//
+//	}
+type IngressAPIMock struct {
+	// CreateIngressFunc mocks the CreateIngress method.
+	CreateIngressFunc func(ctx context.Context, clusterID string, i *Ingress) (*Ingress, error)
+
+	// DeleteIngressFunc mocks the DeleteIngress method.
+	DeleteIngressFunc func(ctx context.Context, clusterID string, id string) error
+
+	// GetIngressFunc mocks the GetIngress method.
+	GetIngressFunc func(ctx context.Context, clusterID string, id string) (*Ingress, error)
+
+	// ListIngressesFunc mocks the ListIngresses method.
+	ListIngressesFunc func(ctx context.Context, clusterID string) ([]Ingress, error)
+
+	// UpdateIngressFunc mocks the UpdateIngress method.
+	UpdateIngressFunc func(ctx context.Context, clusterID string, i *Ingress) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateIngress holds details about calls to the CreateIngress method.
+		CreateIngress []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// I is the i argument value.
+			I *Ingress
+		}
+		// DeleteIngress holds details about calls to the DeleteIngress method.
+		DeleteIngress []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+		}
+		// GetIngress holds details about calls to the GetIngress method.
+		GetIngress []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+		}
+		// ListIngresses holds details about calls to the ListIngresses method.
+		ListIngresses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// UpdateIngress holds details about calls to the UpdateIngress method.
+		UpdateIngress []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// I is the i argument value.
+			I *Ingress
+		}
+	}
+	lockCreateIngress sync.RWMutex
+	lockDeleteIngress sync.RWMutex
+	lockGetIngress    sync.RWMutex
+	lockListIngresses sync.RWMutex
+	lockUpdateIngress sync.RWMutex
+}
+
+// CreateIngress calls CreateIngressFunc.
+func (mock *IngressAPIMock) CreateIngress(ctx context.Context, clusterID string, i *Ingress) (*Ingress, error) {
+	if mock.CreateIngressFunc == nil {
+		panic("IngressAPIMock.CreateIngressFunc: method is nil but IngressAPI.CreateIngress was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		I         *Ingress
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		I:         i,
+	}
+	mock.lockCreateIngress.Lock()
+	mock.calls.CreateIngress = append(mock.calls.CreateIngress, callInfo)
+	mock.lockCreateIngress.Unlock()
+	return mock.CreateIngressFunc(ctx, clusterID, i)
+}
+
+// CreateIngressCalls gets all the calls that were made to CreateIngress.
+// Check the length with:
+//
+//	len(mockedIngressAPI.CreateIngressCalls())
+func (mock *IngressAPIMock) CreateIngressCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	I         *Ingress
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		I         *Ingress
+	}
+	mock.lockCreateIngress.RLock()
+	calls = mock.calls.CreateIngress
+	mock.lockCreateIngress.RUnlock()
+	return calls
+}
+
+// DeleteIngress calls DeleteIngressFunc.
+func (mock *IngressAPIMock) DeleteIngress(ctx context.Context, clusterID string, id string) error {
+	if mock.DeleteIngressFunc == nil {
+		panic("IngressAPIMock.DeleteIngressFunc: method is nil but IngressAPI.DeleteIngress was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		ID:        id,
+	}
+	mock.lockDeleteIngress.Lock()
+	mock.calls.DeleteIngress = append(mock.calls.DeleteIngress, callInfo)
+	mock.lockDeleteIngress.Unlock()
+	return mock.DeleteIngressFunc(ctx, clusterID, id)
+}
+
+// DeleteIngressCalls gets all the calls that were made to DeleteIngress.
+// Check the length with:
+//
+//	len(mockedIngressAPI.DeleteIngressCalls())
+func (mock *IngressAPIMock) DeleteIngressCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}
+	mock.lockDeleteIngress.RLock()
+	calls = mock.calls.DeleteIngress
+	mock.lockDeleteIngress.RUnlock()
+	return calls
+}
+
+// GetIngress calls GetIngressFunc.
+func (mock *IngressAPIMock) GetIngress(ctx context.Context, clusterID string, id string) (*Ingress, error) {
+	if mock.GetIngressFunc == nil {
+		panic("IngressAPIMock.GetIngressFunc: method is nil but IngressAPI.GetIngress was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		ID:        id,
+	}
+	mock.lockGetIngress.Lock()
+	mock.calls.GetIngress = append(mock.calls.GetIngress, callInfo)
+	mock.lockGetIngress.Unlock()
+	return mock.GetIngressFunc(ctx, clusterID, id)
+}
+
+// GetIngressCalls gets all the calls that were made to GetIngress.
+// Check the length with:
+//
+//	len(mockedIngressAPI.GetIngressCalls())
+func (mock *IngressAPIMock) GetIngressCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	ID        string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		ID        string
+	}
+	mock.lockGetIngress.RLock()
+	calls = mock.calls.GetIngress
+	mock.lockGetIngress.RUnlock()
+	return calls
+}
+
+// ListIngresses calls ListIngressesFunc.
+func (mock *IngressAPIMock) ListIngresses(ctx context.Context, clusterID string) ([]Ingress, error) {
+	if mock.ListIngressesFunc == nil {
+		panic("IngressAPIMock.ListIngressesFunc: method is nil but IngressAPI.ListIngresses was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+	}
+	mock.lockListIngresses.Lock()
+	mock.calls.ListIngresses = append(mock.calls.ListIngresses, callInfo)
+	mock.lockListIngresses.Unlock()
+	return mock.ListIngressesFunc(ctx, clusterID)
+}
+
+// ListIngressesCalls gets all the calls that were made to ListIngresses.
+// Check the length with:
+//
+//	len(mockedIngressAPI.ListIngressesCalls())
+func (mock *IngressAPIMock) ListIngressesCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+	}
+	mock.lockListIngresses.RLock()
+	calls = mock.calls.ListIngresses
+	mock.lockListIngresses.RUnlock()
+	return calls
+}
+
+// UpdateIngress calls UpdateIngressFunc.
+func (mock *IngressAPIMock) UpdateIngress(ctx context.Context, clusterID string, i *Ingress) error {
+	if mock.UpdateIngressFunc == nil {
+		panic("IngressAPIMock.UpdateIngressFunc: method is nil but IngressAPI.UpdateIngress was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+		I         *Ingress
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+		I:         i,
+	}
+	mock.lockUpdateIngress.Lock()
+	mock.calls.UpdateIngress = append(mock.calls.UpdateIngress, callInfo)
+	mock.lockUpdateIngress.Unlock()
+	return mock.UpdateIngressFunc(ctx, clusterID, i)
+}
+
+// UpdateIngressCalls gets all the calls that were made to UpdateIngress.
+// Check the length with:
+//
+//	len(mockedIngressAPI.UpdateIngressCalls())
+func (mock *IngressAPIMock) UpdateIngressCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+	I         *Ingress
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+		I         *Ingress
+	}
+	mock.lockUpdateIngress.RLock()
+	calls = mock.calls.UpdateIngress
+	mock.lockUpdateIngress.RUnlock()
+	return calls
+}
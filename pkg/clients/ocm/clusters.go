@@ -0,0 +1,140 @@
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Cluster states in OCM.
+const (
+	ClusterStateValidating   = "validating"
+	ClusterStatePending      = "pending"
+	ClusterStateWaiting      = "waiting"
+	ClusterStateInstalling   = "installing"
+	ClusterStateReady        = "ready"
+	ClusterStateError        = "error"
+	ClusterStateHibernating  = "hibernating"
+	ClusterStateUninstalling = "uninstalling"
+)
+
+// ClusterAPI manages the OpenShift Dedicated and ROSA clusters of OCM.
+type ClusterAPI interface {
+	GetCluster(ctx context.Context, id string) (*Cluster, error)
+	GetClusterCredentials(ctx context.Context, id string) (*ClusterCredentials, error)
+	CreateCluster(ctx context.Context, c *Cluster) (*Cluster, error)
+	UpdateCluster(ctx context.Context, id string, c *Cluster) error
+	DeleteCluster(ctx context.Context, id string) error
+}
+
+// An ObjectReference references an OCM object by its ID, e.g. a cloud
+// provider or region.
+type ObjectReference struct {
+	ID string `json:"id"`
+}
+
+// A Cluster is an OpenShift cluster provisioned by OCM. OCM omits the cloud
+// credentials of clusters it returns.
+type Cluster struct {
+	ID                string           `json:"id,omitempty"`
+	ExternalID        string           `json:"external_id,omitempty"`
+	Name              string           `json:"name,omitempty"`
+	Product           *ObjectReference `json:"product,omitempty"`
+	CloudProvider     *ObjectReference `json:"cloud_provider,omitempty"`
+	Region            *ObjectReference `json:"region,omitempty"`
+	Version           *Version         `json:"version,omitempty"`
+	MultiAZ           bool             `json:"multi_az,omitempty"`
+	Nodes             *ClusterNodes    `json:"nodes,omitempty"`
+	Network           *Network         `json:"network,omitempty"`
+	CCS               *CCS             `json:"ccs,omitempty"`
+	AWS               *AWS             `json:"aws,omitempty"`
+	State             string           `json:"state,omitempty"`
+	API               *Endpoint        `json:"api,omitempty"`
+	Console           *Endpoint        `json:"console,omitempty"`
+	CreationTimestamp *time.Time       `json:"creation_timestamp,omitempty"`
+}
+
+// A Version is an OpenShift version, e.g. openshift-v4.12.8. The version of
+// a cluster lists the raw IDs of the versions it can be upgraded to.
+type Version struct {
+	ID                string   `json:"id,omitempty"`
+	RawID             string   `json:"raw_id,omitempty"`
+	AvailableUpgrades []string `json:"available_upgrades,omitempty"`
+}
+
+// ClusterNodes are the nodes of the default compute machine pool of a
+// cluster.
+type ClusterNodes struct {
+	Compute            int              `json:"compute,omitempty"`
+	ComputeMachineType *ObjectReference `json:"compute_machine_type,omitempty"`
+}
+
+// A Network configures the address ranges of a cluster.
+type Network struct {
+	MachineCIDR string `json:"machine_cidr,omitempty"`
+	ServiceCIDR string `json:"service_cidr,omitempty"`
+	PodCIDR     string `json:"pod_cidr,omitempty"`
+	HostPrefix  int    `json:"host_prefix,omitempty"`
+}
+
+// CCS configures whether a cluster is provisioned into the cloud account of
+// the customer.
+type CCS struct {
+	Enabled bool `json:"enabled"`
+}
+
+// AWS is the AWS account a customer cloud subscription cluster is
+// provisioned into.
+type AWS struct {
+	AccountID       string `json:"account_id,omitempty"`
+	AccessKeyID     string `json:"access_key_id,omitempty"`
+	SecretAccessKey string `json:"secret_access_key,omitempty"`
+}
+
+// An Endpoint is the URL of the API or console of a cluster.
+type Endpoint struct {
+	URL string `json:"url,omitempty"`
+}
+
+// ClusterCredentials are the credentials of the cluster administrator. OCM
+// only keeps them for some clusters, and only once they are installed.
+type ClusterCredentials struct {
+	Kubeconfig string                   `json:"kubeconfig,omitempty"`
+	Admin      *ClusterAdminCredentials `json:"admin,omitempty"`
+}
+
+// ClusterAdminCredentials are the username and password of the cluster
+// administrator.
+type ClusterAdminCredentials struct {
+	User     string `json:"user,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+func (c *client) GetCluster(ctx context.Context, id string) (*Cluster, error) {
+	out := &Cluster{}
+	err := c.Do(ctx, http.MethodGet, "/clusters/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) GetClusterCredentials(ctx context.Context, id string) (*ClusterCredentials, error) {
+	out := &ClusterCredentials{}
+	err := c.Do(ctx, http.MethodGet, "/clusters/"+url.PathEscape(id)+"/credentials", nil, out)
+	return out, err
+}
+
+func (c *client) CreateCluster(ctx context.Context, cl *Cluster) (*Cluster, error) {
+	out := &Cluster{}
+	err := c.Do(ctx, http.MethodPost, "/clusters", cl, out)
+	return out, err
+}
+
+// UpdateCluster patches the cluster with the fields set in the supplied
+// cluster.
+func (c *client) UpdateCluster(ctx context.Context, id string, cl *Cluster) error {
+	return c.Do(ctx, http.MethodPatch, "/clusters/"+url.PathEscape(id), cl, nil)
+}
+
+func (c *client) DeleteCluster(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "/clusters/"+url.PathEscape(id), nil, nil)
+}
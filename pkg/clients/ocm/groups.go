@@ -0,0 +1,46 @@
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Groups of cluster users with elevated permissions.
+const (
+	GroupDedicatedAdmins = "dedicated-admins"
+	GroupClusterAdmins   = "cluster-admins"
+)
+
+// GroupUserAPI manages the members of the groups of OCM clusters. Users are
+// identified by their username.
+type GroupUserAPI interface {
+	GetGroupUser(ctx context.Context, clusterID, groupID, id string) (*User, error)
+	CreateGroupUser(ctx context.Context, clusterID, groupID string, u *User) (*User, error)
+	DeleteGroupUser(ctx context.Context, clusterID, groupID, id string) error
+}
+
+// A User is a member of a group of a cluster.
+type User struct {
+	ID string `json:"id"`
+}
+
+func groupUsersPath(clusterID, groupID string) string {
+	return "/clusters/" + url.PathEscape(clusterID) + "/groups/" + url.PathEscape(groupID) + "/users"
+}
+
+func (c *client) GetGroupUser(ctx context.Context, clusterID, groupID, id string) (*User, error) {
+	out := &User{}
+	err := c.Do(ctx, http.MethodGet, groupUsersPath(clusterID, groupID)+"/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) CreateGroupUser(ctx context.Context, clusterID, groupID string, u *User) (*User, error) {
+	out := &User{}
+	err := c.Do(ctx, http.MethodPost, groupUsersPath(clusterID, groupID), u, out)
+	return out, err
+}
+
+func (c *client) DeleteGroupUser(ctx context.Context, clusterID, groupID, id string) error {
+	return c.Do(ctx, http.MethodDelete, groupUsersPath(clusterID, groupID)+"/"+url.PathEscape(id), nil, nil)
+}
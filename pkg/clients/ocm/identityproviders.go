@@ -0,0 +1,127 @@
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Types of identity providers.
+const (
+	IdentityProviderTypeOpenID   = "OpenIDIdentityProvider"
+	IdentityProviderTypeGitHub   = "GithubIdentityProvider"
+	IdentityProviderTypeLDAP     = "LDAPIdentityProvider"
+	IdentityProviderTypeHTPasswd = "HTPasswdIdentityProvider"
+)
+
+// IdentityProviderAPI manages the identity providers users log in to OCM
+// clusters with.
+type IdentityProviderAPI interface {
+	GetIdentityProvider(ctx context.Context, clusterID, id string) (*IdentityProvider, error)
+	CreateIdentityProvider(ctx context.Context, clusterID string, p *IdentityProvider) (*IdentityProvider, error)
+	UpdateIdentityProvider(ctx context.Context, clusterID string, p *IdentityProvider) error
+	DeleteIdentityProvider(ctx context.Context, clusterID, id string) error
+}
+
+// An IdentityProvider of a cluster. Exactly one of its OpenID, GitHub, LDAP
+// and HTPasswd configurations is set, depending on its type. OCM omits the
+// client secrets, bind passwords and users of identity providers it returns.
+type IdentityProvider struct {
+	ID            string                    `json:"id,omitempty"`
+	Type          string                    `json:"type"`
+	Name          string                    `json:"name"`
+	MappingMethod string                    `json:"mapping_method,omitempty"`
+	OpenID        *OpenIDIdentityProvider   `json:"open_id,omitempty"`
+	GitHub        *GitHubIdentityProvider   `json:"github,omitempty"`
+	LDAP          *LDAPIdentityProvider     `json:"ldap,omitempty"`
+	HTPasswd      *HTPasswdIdentityProvider `json:"htpasswd,omitempty"`
+}
+
+// An OpenIDIdentityProvider authenticates users with an OpenID Connect
+// provider.
+type OpenIDIdentityProvider struct {
+	ClientID     string        `json:"client_id"`
+	ClientSecret string        `json:"client_secret,omitempty"`
+	Issuer       string        `json:"issuer"`
+	CA           string        `json:"ca,omitempty"`
+	ExtraScopes  []string      `json:"extra_scopes,omitempty"`
+	Claims       *OpenIDClaims `json:"claims,omitempty"`
+}
+
+// OpenIDClaims map the claims of ID tokens to the identities of users.
+type OpenIDClaims struct {
+	Email             []string `json:"email,omitempty"`
+	Name              []string `json:"name,omitempty"`
+	PreferredUsername []string `json:"preferred_username,omitempty"`
+	Groups            []string `json:"groups,omitempty"`
+}
+
+// A GitHubIdentityProvider authenticates users with GitHub.
+type GitHubIdentityProvider struct {
+	ClientID      string   `json:"client_id"`
+	ClientSecret  string   `json:"client_secret,omitempty"`
+	Organizations []string `json:"organizations,omitempty"`
+	Teams         []string `json:"teams,omitempty"`
+	Hostname      string   `json:"hostname,omitempty"`
+	CA            string   `json:"ca,omitempty"`
+}
+
+// An LDAPIdentityProvider authenticates users with an LDAP server.
+type LDAPIdentityProvider struct {
+	URL          string          `json:"url"`
+	BindDN       string          `json:"bind_dn,omitempty"`
+	BindPassword string          `json:"bind_password,omitempty"`
+	Insecure     bool            `json:"insecure,omitempty"`
+	CA           string          `json:"ca,omitempty"`
+	Attributes   *LDAPAttributes `json:"attributes,omitempty"`
+}
+
+// LDAPAttributes map the attributes of LDAP entries to the identities of
+// users.
+type LDAPAttributes struct {
+	ID                []string `json:"id,omitempty"`
+	Email             []string `json:"email,omitempty"`
+	Name              []string `json:"name,omitempty"`
+	PreferredUsername []string `json:"preferred_username,omitempty"`
+}
+
+// An HTPasswdIdentityProvider authenticates a fixed list of users with
+// passwords.
+type HTPasswdIdentityProvider struct {
+	Users *HTPasswdUserList `json:"users,omitempty"`
+}
+
+// An HTPasswdUserList lists the users of an HTPasswd identity provider.
+type HTPasswdUserList struct {
+	Items []HTPasswdUser `json:"items"`
+}
+
+// An HTPasswdUser logs in with a username and password.
+type HTPasswdUser struct {
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+}
+
+func identityProvidersPath(clusterID string) string {
+	return "/clusters/" + url.PathEscape(clusterID) + "/identity_providers"
+}
+
+func (c *client) GetIdentityProvider(ctx context.Context, clusterID, id string) (*IdentityProvider, error) {
+	out := &IdentityProvider{}
+	err := c.Do(ctx, http.MethodGet, identityProvidersPath(clusterID)+"/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) CreateIdentityProvider(ctx context.Context, clusterID string, p *IdentityProvider) (*IdentityProvider, error) {
+	out := &IdentityProvider{}
+	err := c.Do(ctx, http.MethodPost, identityProvidersPath(clusterID), p, out)
+	return out, err
+}
+
+func (c *client) UpdateIdentityProvider(ctx context.Context, clusterID string, p *IdentityProvider) error {
+	return c.Do(ctx, http.MethodPatch, identityProvidersPath(clusterID)+"/"+url.PathEscape(p.ID), p, nil)
+}
+
+func (c *client) DeleteIdentityProvider(ctx context.Context, clusterID, id string) error {
+	return c.Do(ctx, http.MethodDelete, identityProvidersPath(clusterID)+"/"+url.PathEscape(id), nil, nil)
+}
@@ -0,0 +1,82 @@
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Listening methods of ingresses.
+const (
+	IngressListeningExternal = "external"
+	IngressListeningInternal = "internal"
+)
+
+// IngressAPI manages the ingress controllers of OCM clusters. Every cluster
+// has a default ingress, which can be updated but not created or deleted.
+type IngressAPI interface {
+	ListIngresses(ctx context.Context, clusterID string) ([]Ingress, error)
+	GetIngress(ctx context.Context, clusterID, id string) (*Ingress, error)
+	CreateIngress(ctx context.Context, clusterID string, i *Ingress) (*Ingress, error)
+	UpdateIngress(ctx context.Context, clusterID string, i *Ingress) error
+	DeleteIngress(ctx context.Context, clusterID, id string) error
+}
+
+// An Ingress is an ingress controller of a cluster, which routes traffic to
+// the routes it selects.
+type Ingress struct {
+	ID                 string            `json:"id,omitempty"`
+	Default            bool              `json:"default,omitempty"`
+	Listening          string            `json:"listening,omitempty"`
+	DNSName            string            `json:"dns_name,omitempty"`
+	LoadBalancerType   string            `json:"load_balancer_type,omitempty"`
+	RouteSelectors     map[string]string `json:"route_selectors,omitempty"`
+	ExcludedNamespaces []string          `json:"excluded_namespaces,omitempty"`
+}
+
+func ingressesPath(clusterID string) string {
+	return "/clusters/" + url.PathEscape(clusterID) + "/ingresses"
+}
+
+func (c *client) ListIngresses(ctx context.Context, clusterID string) ([]Ingress, error) {
+	out := struct {
+		Items []Ingress `json:"items"`
+	}{}
+	err := c.Do(ctx, http.MethodGet, ingressesPath(clusterID), nil, &out)
+	return out.Items, err
+}
+
+func (c *client) GetIngress(ctx context.Context, clusterID, id string) (*Ingress, error) {
+	out := &Ingress{}
+	err := c.Do(ctx, http.MethodGet, ingressesPath(clusterID)+"/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) CreateIngress(ctx context.Context, clusterID string, i *Ingress) (*Ingress, error) {
+	out := &Ingress{}
+	err := c.Do(ctx, http.MethodPost, ingressesPath(clusterID), i, out)
+	return out, err
+}
+
+// UpdateIngress patches the listening method, load balancer type, route
+// selectors and excluded namespaces of the ingress. Route selectors and
+// excluded namespaces are always sent, so that they can be removed.
+func (c *client) UpdateIngress(ctx context.Context, clusterID string, i *Ingress) error {
+	in := struct {
+		Listening          string            `json:"listening,omitempty"`
+		LoadBalancerType   string            `json:"load_balancer_type,omitempty"`
+		RouteSelectors     map[string]string `json:"route_selectors"`
+		ExcludedNamespaces []string          `json:"excluded_namespaces"`
+	}{Listening: i.Listening, LoadBalancerType: i.LoadBalancerType, RouteSelectors: i.RouteSelectors, ExcludedNamespaces: i.ExcludedNamespaces}
+	if in.RouteSelectors == nil {
+		in.RouteSelectors = map[string]string{}
+	}
+	if in.ExcludedNamespaces == nil {
+		in.ExcludedNamespaces = []string{}
+	}
+	return c.Do(ctx, http.MethodPatch, ingressesPath(clusterID)+"/"+url.PathEscape(i.ID), in, nil)
+}
+
+func (c *client) DeleteIngress(ctx context.Context, clusterID, id string) error {
+	return c.Do(ctx, http.MethodDelete, ingressesPath(clusterID)+"/"+url.PathEscape(id), nil, nil)
+}
@@ -0,0 +1,80 @@
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// MachinePoolAPI manages the machine pools of OCM clusters. Machine pools
+// are identified by their ID, which is chosen when they are created.
+type MachinePoolAPI interface {
+	GetMachinePool(ctx context.Context, clusterID, id string) (*MachinePool, error)
+	CreateMachinePool(ctx context.Context, clusterID string, p *MachinePool) (*MachinePool, error)
+	UpdateMachinePool(ctx context.Context, clusterID string, p *MachinePool) error
+	DeleteMachinePool(ctx context.Context, clusterID, id string) error
+}
+
+// A MachinePool is a set of compute nodes of a cluster with the same
+// instance type.
+type MachinePool struct {
+	ID                string                  `json:"id,omitempty"`
+	InstanceType      string                  `json:"instance_type,omitempty"`
+	Replicas          *int                    `json:"replicas,omitempty"`
+	Autoscaling       *MachinePoolAutoscaling `json:"autoscaling,omitempty"`
+	Labels            map[string]string       `json:"labels,omitempty"`
+	Taints            []Taint                 `json:"taints,omitempty"`
+	AvailabilityZones []string                `json:"availability_zones,omitempty"`
+}
+
+// MachinePoolAutoscaling bounds the replicas of an autoscaled machine pool.
+type MachinePoolAutoscaling struct {
+	MinReplicas int `json:"min_replicas"`
+	MaxReplicas int `json:"max_replicas"`
+}
+
+// A Taint is added to the nodes of a machine pool.
+type Taint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+func machinePoolsPath(clusterID string) string {
+	return "/clusters/" + url.PathEscape(clusterID) + "/machine_pools"
+}
+
+func (c *client) GetMachinePool(ctx context.Context, clusterID, id string) (*MachinePool, error) {
+	out := &MachinePool{}
+	err := c.Do(ctx, http.MethodGet, machinePoolsPath(clusterID)+"/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) CreateMachinePool(ctx context.Context, clusterID string, p *MachinePool) (*MachinePool, error) {
+	out := &MachinePool{}
+	err := c.Do(ctx, http.MethodPost, machinePoolsPath(clusterID), p, out)
+	return out, err
+}
+
+// UpdateMachinePool patches the replicas or autoscaling, labels and taints of
+// the machine pool. Labels and taints are always sent, so that they can be
+// removed.
+func (c *client) UpdateMachinePool(ctx context.Context, clusterID string, p *MachinePool) error {
+	in := struct {
+		Replicas    *int                    `json:"replicas,omitempty"`
+		Autoscaling *MachinePoolAutoscaling `json:"autoscaling,omitempty"`
+		Labels      map[string]string       `json:"labels"`
+		Taints      []Taint                 `json:"taints"`
+	}{Replicas: p.Replicas, Autoscaling: p.Autoscaling, Labels: p.Labels, Taints: p.Taints}
+	if in.Labels == nil {
+		in.Labels = map[string]string{}
+	}
+	if in.Taints == nil {
+		in.Taints = []Taint{}
+	}
+	return c.Do(ctx, http.MethodPatch, machinePoolsPath(clusterID)+"/"+url.PathEscape(p.ID), in, nil)
+}
+
+func (c *client) DeleteMachinePool(ctx context.Context, clusterID, id string) error {
+	return c.Do(ctx, http.MethodDelete, machinePoolsPath(clusterID)+"/"+url.PathEscape(id), nil, nil)
+}
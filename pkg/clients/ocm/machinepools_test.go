@@ -0,0 +1,34 @@
+package ocm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUpdateMachinePool(t *testing.T) {
+	var got map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != basePath+"/clusters/c1/machine_pools/infra" {
+			t.Errorf("\nc.UpdateMachinePool(...): unexpected request %s %s\n", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("\nc.UpdateMachinePool(...): unexpected request body: %v\n", err)
+		}
+	}))
+	defer srv.Close()
+
+	c := newClient(srv.URL+basePath, srv.Client())
+	replicas := 3
+	if err := c.UpdateMachinePool(context.Background(), "c1", &MachinePool{ID: "infra", InstanceType: "m5.xlarge", Replicas: &replicas}); err != nil {
+		t.Fatalf("\nc.UpdateMachinePool(...): unexpected error: %v\n", err)
+	}
+	want := map[string]interface{}{"replicas": float64(3), "labels": map[string]interface{}{}, "taints": []interface{}{}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\nc.UpdateMachinePool(...): -want request, +got request:\n%s\n", diff)
+	}
+}
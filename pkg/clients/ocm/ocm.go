@@ -0,0 +1,83 @@
+// Package ocm contains a client for the clusters management API of the
+// OpenShift Cluster Manager (OCM).
+package ocm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+
+	"github.com/stehessel/provider-redhat/pkg/clients/httpapi"
+)
+
+//go:generate go run github.com/matryer/moq@v0.3.1 -out client_moq.go . ClusterAPI MachinePoolAPI IdentityProviderAPI GroupUserAPI AddOnInstallationAPI UpgradePolicyAPI IngressAPI
+
+// ErrNewClient represents an error to create a new OCM client.
+const ErrNewClient = "cannot create ocm client"
+
+// basePath is the path of the clusters management API below the gateway.
+const basePath = "/api/clusters_mgmt/v1"
+
+// Client is a client for the clusters management API of OCM.
+type Client interface {
+	ClusterAPI
+	MachinePoolAPI
+	IdentityProviderAPI
+	GroupUserAPI
+	AddOnInstallationAPI
+	UpgradePolicyAPI
+	IngressAPI
+}
+
+// NewClient creates a new client for the OCM API served by the supplied
+// OpenShift API gateway. Requests are authenticated with an access token
+// obtained from the supplied OCM refresh token.
+func NewClient(token string, gateway string) (Client, error) {
+	auth, err := fleetmanager.NewOCMAuth(fleetmanager.OCMOption{RefreshToken: token})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create ocm authentication")
+	}
+
+	u, err := url.Parse(gateway)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse gateway")
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("gateway %q is not an absolute URL", gateway)
+	}
+
+	return newClient(strings.TrimSuffix(u.String(), "/")+basePath, httpapi.NewHTTPClient(auth)), nil
+}
+
+type client struct {
+	*httpapi.Client
+}
+
+func newClient(endpoint string, h *http.Client) *client {
+	return &client{Client: &httpapi.Client{Name: "ocm", Endpoint: endpoint, HTTP: h, ErrorMessage: errorMessage}}
+}
+
+// An APIError is returned for requests the OCM API did not accept.
+type APIError = httpapi.APIError
+
+// IsNotFound returns true if the supplied error indicates that the requested
+// OCM object does not exist.
+func IsNotFound(err error) bool {
+	return httpapi.IsNotFound(err)
+}
+
+// errorMessage returns the code and reason of an error of the OCM API.
+func errorMessage(body []byte) (string, string) {
+	msg := struct {
+		Code   string `json:"code"`
+		Reason string `json:"reason"`
+	}{}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return "", ""
+	}
+	return msg.Code, msg.Reason
+}
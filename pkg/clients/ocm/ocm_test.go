@@ -0,0 +1,68 @@
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDo(t *testing.T) {
+	cases := []struct {
+		name     string
+		status   int
+		body     string
+		want     *Cluster
+		notFound bool
+		err      bool
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body:   `{"id":"c1","name":"prod","state":"ready","nodes":{"compute":4}}`,
+			want:   &Cluster{ID: "c1", Name: "prod", State: ClusterStateReady, Nodes: &ClusterNodes{Compute: 4}},
+		},
+		{
+			name:     "not found",
+			status:   http.StatusNotFound,
+			body:     `{"kind":"Error","id":"404","code":"CLUSTERS-MGMT-404","reason":"Cluster 'c1' not found"}`,
+			want:     &Cluster{},
+			notFound: true,
+			err:      true,
+		},
+		{
+			name:   "server error",
+			status: http.StatusInternalServerError,
+			body:   "boom",
+			want:   &Cluster{},
+			err:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != basePath+"/clusters/c1" {
+					t.Errorf("\nc.GetCluster(...): unexpected path %q\n", r.URL.Path)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			c := newClient(srv.URL+basePath, srv.Client())
+			got, err := c.GetCluster(context.Background(), "c1")
+			if (err != nil) != tc.err {
+				t.Errorf("\nc.GetCluster(...): unexpected error: %v\n", err)
+			}
+			if IsNotFound(err) != tc.notFound {
+				t.Errorf("\nIsNotFound(...): want %t, got %t\n", tc.notFound, IsNotFound(err))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nc.GetCluster(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
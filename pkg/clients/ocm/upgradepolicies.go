@@ -0,0 +1,89 @@
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Schedule types of upgrade policies.
+const (
+	UpgradePolicyScheduleManual    = "manual"
+	UpgradePolicyScheduleAutomatic = "automatic"
+)
+
+// States of the upgrades scheduled by upgrade policies.
+const (
+	UpgradePolicyStatePending   = "pending"
+	UpgradePolicyStateScheduled = "scheduled"
+	UpgradePolicyStateStarted   = "started"
+	UpgradePolicyStateDelayed   = "delayed"
+	UpgradePolicyStateCompleted = "completed"
+	UpgradePolicyStateFailed    = "failed"
+	UpgradePolicyStateCancelled = "cancelled"
+)
+
+// UpgradePolicyAPI manages the policies that schedule upgrades of OCM
+// clusters.
+type UpgradePolicyAPI interface {
+	GetUpgradePolicy(ctx context.Context, clusterID, id string) (*UpgradePolicy, error)
+	GetUpgradePolicyState(ctx context.Context, clusterID, id string) (*UpgradePolicyState, error)
+	CreateUpgradePolicy(ctx context.Context, clusterID string, p *UpgradePolicy) (*UpgradePolicy, error)
+	UpdateUpgradePolicy(ctx context.Context, clusterID string, p *UpgradePolicy) error
+	DeleteUpgradePolicy(ctx context.Context, clusterID, id string) error
+}
+
+// An UpgradePolicy schedules an upgrade of a cluster, either once to a
+// version at the next run, or repeatedly to the latest version according to
+// a cron schedule.
+type UpgradePolicy struct {
+	ID           string     `json:"id,omitempty"`
+	ScheduleType string     `json:"schedule_type"`
+	UpgradeType  string     `json:"upgrade_type,omitempty"`
+	Schedule     string     `json:"schedule,omitempty"`
+	Version      string     `json:"version,omitempty"`
+	NextRun      *time.Time `json:"next_run,omitempty"`
+}
+
+// An UpgradePolicyState is the state of the next upgrade scheduled by an
+// upgrade policy.
+type UpgradePolicyState struct {
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+func upgradePoliciesPath(clusterID string) string {
+	return "/clusters/" + url.PathEscape(clusterID) + "/upgrade_policies"
+}
+
+func (c *client) GetUpgradePolicy(ctx context.Context, clusterID, id string) (*UpgradePolicy, error) {
+	out := &UpgradePolicy{}
+	err := c.Do(ctx, http.MethodGet, upgradePoliciesPath(clusterID)+"/"+url.PathEscape(id), nil, out)
+	return out, err
+}
+
+func (c *client) GetUpgradePolicyState(ctx context.Context, clusterID, id string) (*UpgradePolicyState, error) {
+	out := &UpgradePolicyState{}
+	err := c.Do(ctx, http.MethodGet, upgradePoliciesPath(clusterID)+"/"+url.PathEscape(id)+"/state", nil, out)
+	return out, err
+}
+
+func (c *client) CreateUpgradePolicy(ctx context.Context, clusterID string, p *UpgradePolicy) (*UpgradePolicy, error) {
+	out := &UpgradePolicy{}
+	err := c.Do(ctx, http.MethodPost, upgradePoliciesPath(clusterID), p, out)
+	return out, err
+}
+
+// UpdateUpgradePolicy patches the schedule or next run of the upgrade policy.
+func (c *client) UpdateUpgradePolicy(ctx context.Context, clusterID string, p *UpgradePolicy) error {
+	in := struct {
+		Schedule string     `json:"schedule,omitempty"`
+		NextRun  *time.Time `json:"next_run,omitempty"`
+	}{Schedule: p.Schedule, NextRun: p.NextRun}
+	return c.Do(ctx, http.MethodPatch, upgradePoliciesPath(clusterID)+"/"+url.PathEscape(p.ID), in, nil)
+}
+
+func (c *client) DeleteUpgradePolicy(ctx context.Context, clusterID, id string) error {
+	return c.Do(ctx, http.MethodDelete, upgradePoliciesPath(clusterID)+"/"+url.PathEscape(id), nil, nil)
+}
@@ -0,0 +1,927 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package quay
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that OrganizationAPIMock does implement OrganizationAPI.
+// If this is not the case, regenerate this file with moq.
+var _ OrganizationAPI = &OrganizationAPIMock{}
+
+// OrganizationAPIMock is a mock implementation of OrganizationAPI.
+//
+//	func TestSomethingThatUsesOrganizationAPI(t *testing.T) {
+//
+//		// make and configure a mocked OrganizationAPI
+//		mockedOrganizationAPI := &OrganizationAPIMock{
+//			CreateOrganizationFunc: func(ctx context.Context, o *Organization) error {
+//				panic("mock out the CreateOrganization method")
+//			},
+//			DeleteOrganizationFunc: func(ctx context.Context, name string) error {
+//				panic("mock out the DeleteOrganization method")
+//			},
+//			GetOrganizationFunc: func(ctx context.Context, name string) (*Organization, error) {
+//				panic("mock out the GetOrganization method")
+//			},
+//			UpdateOrganizationFunc: func(ctx context.Context, o *Organization) error {
+//				panic("mock out the UpdateOrganization method")
+//			},
+//		}
+//
+//		// use mockedOrganizationAPI in code that requires OrganizationAPI
+//		// and then make assertions.
+//
+//	}
+type OrganizationAPIMock struct {
+	// CreateOrganizationFunc mocks the CreateOrganization method.
+	CreateOrganizationFunc func(ctx context.Context, o *Organization) error
+
+	// DeleteOrganizationFunc mocks the DeleteOrganization method.
+	DeleteOrganizationFunc func(ctx context.Context, name string) error
+
+	// GetOrganizationFunc mocks the GetOrganization method.
+	GetOrganizationFunc func(ctx context.Context, name string) (*Organization, error)
+
+	// UpdateOrganizationFunc mocks the UpdateOrganization method.
+	UpdateOrganizationFunc func(ctx context.Context, o *Organization) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateOrganization holds details about calls to the CreateOrganization method.
+		CreateOrganization []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// O is the o argument value.
+			O *Organization
+		}
+		// DeleteOrganization holds details about calls to the DeleteOrganization method.
+		DeleteOrganization []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// GetOrganization holds details about calls to the GetOrganization method.
+		GetOrganization []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// UpdateOrganization holds details about calls to the UpdateOrganization method.
+		UpdateOrganization []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// O is the o argument value.
+			O *Organization
+		}
+	}
+	lockCreateOrganization sync.RWMutex
+	lockDeleteOrganization sync.RWMutex
+	lockGetOrganization    sync.RWMutex
+	lockUpdateOrganization sync.RWMutex
+}
+
+// CreateOrganization calls CreateOrganizationFunc.
+func (mock *OrganizationAPIMock) CreateOrganization(ctx context.Context, o *Organization) error {
+	if mock.CreateOrganizationFunc == nil {
+		panic("OrganizationAPIMock.CreateOrganizationFunc: method is nil but OrganizationAPI.CreateOrganization was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		O   *Organization
+	}{
+		Ctx: ctx,
+		O:   o,
+	}
+	mock.lockCreateOrganization.Lock()
+	mock.calls.CreateOrganization = append(mock.calls.CreateOrganization, callInfo)
+	mock.lockCreateOrganization.Unlock()
+	return mock.CreateOrganizationFunc(ctx, o)
+}
+
+// CreateOrganizationCalls gets all the calls that were made to CreateOrganization.
+// Check the length with:
+//
+//	len(mockedOrganizationAPI.CreateOrganizationCalls())
+func (mock *OrganizationAPIMock) CreateOrganizationCalls() []struct {
+	Ctx context.Context
+	O   *Organization
+} {
+	var calls []struct {
+		Ctx context.Context
+		O   *Organization
+	}
+	mock.lockCreateOrganization.RLock()
+	calls = mock.calls.CreateOrganization
+	mock.lockCreateOrganization.RUnlock()
+	return calls
+}
+
+// DeleteOrganization calls DeleteOrganizationFunc.
+func (mock *OrganizationAPIMock) DeleteOrganization(ctx context.Context, name string) error {
+	if mock.DeleteOrganizationFunc == nil {
+		panic("OrganizationAPIMock.DeleteOrganizationFunc: method is nil but OrganizationAPI.DeleteOrganization was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockDeleteOrganization.Lock()
+	mock.calls.DeleteOrganization = append(mock.calls.DeleteOrganization, callInfo)
+	mock.lockDeleteOrganization.Unlock()
+	return mock.DeleteOrganizationFunc(ctx, name)
+}
+
+// DeleteOrganizationCalls gets all the calls that were made to DeleteOrganization.
+// Check the length with:
+//
+//	len(mockedOrganizationAPI.DeleteOrganizationCalls())
+func (mock *OrganizationAPIMock) DeleteOrganizationCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockDeleteOrganization.RLock()
+	calls = mock.calls.DeleteOrganization
+	mock.lockDeleteOrganization.RUnlock()
+	return calls
+}
+
+// GetOrganization calls GetOrganizationFunc.
+func (mock *OrganizationAPIMock) GetOrganization(ctx context.Context, name string) (*Organization, error) {
+	if mock.GetOrganizationFunc == nil {
+		panic("OrganizationAPIMock.GetOrganizationFunc: method is nil but OrganizationAPI.GetOrganization was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGetOrganization.Lock()
+	mock.calls.GetOrganization = append(mock.calls.GetOrganization, callInfo)
+	mock.lockGetOrganization.Unlock()
+	return mock.GetOrganizationFunc(ctx, name)
+}
+
+// GetOrganizationCalls gets all the calls that were made to GetOrganization.
+// Check the length with:
+//
+//	len(mockedOrganizationAPI.GetOrganizationCalls())
+func (mock *OrganizationAPIMock) GetOrganizationCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGetOrganization.RLock()
+	calls = mock.calls.GetOrganization
+	mock.lockGetOrganization.RUnlock()
+	return calls
+}
+
+// UpdateOrganization calls UpdateOrganizationFunc.
+func (mock *OrganizationAPIMock) UpdateOrganization(ctx context.Context, o *Organization) error {
+	if mock.UpdateOrganizationFunc == nil {
+		panic("OrganizationAPIMock.UpdateOrganizationFunc: method is nil but OrganizationAPI.UpdateOrganization was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		O   *Organization
+	}{
+		Ctx: ctx,
+		O:   o,
+	}
+	mock.lockUpdateOrganization.Lock()
+	mock.calls.UpdateOrganization = append(mock.calls.UpdateOrganization, callInfo)
+	mock.lockUpdateOrganization.Unlock()
+	return mock.UpdateOrganizationFunc(ctx, o)
+}
+
+// UpdateOrganizationCalls gets all the calls that were made to UpdateOrganization.
+// Check the length with:
+//
+//	len(mockedOrganizationAPI.UpdateOrganizationCalls())
+func (mock *OrganizationAPIMock) UpdateOrganizationCalls() []struct {
+	Ctx context.Context
+	O   *Organization
+} {
+	var calls []struct {
+		Ctx context.Context
+		O   *Organization
+	}
+	mock.lockUpdateOrganization.RLock()
+	calls = mock.calls.UpdateOrganization
+	mock.lockUpdateOrganization.RUnlock()
+	return calls
+}
+
+// Ensure, that RepositoryAPIMock does implement RepositoryAPI.
+// If this is not the case, regenerate this file with moq.
+var _ RepositoryAPI = &RepositoryAPIMock{}
+
+// RepositoryAPIMock is a mock implementation of RepositoryAPI.
+//
+//	func TestSomethingThatUsesRepositoryAPI(t *testing.T) {
+//
+//		// make and configure a mocked RepositoryAPI
+//		mockedRepositoryAPI := &RepositoryAPIMock{
+//			ChangeRepositoryVisibilityFunc: func(ctx context.Context, namespace string, name string, visibility string) error {
+//				panic("mock out the ChangeRepositoryVisibility method")
+//			},
+//			CreateRepositoryFunc: func(ctx context.Context, r *Repository) error {
+//				panic("mock out the CreateRepository method")
+//			},
+//			DeleteRepositoryFunc: func(ctx context.Context, namespace string, name string) error {
+//				panic("mock out the DeleteRepository method")
+//			},
+//			DeleteRepositoryPermissionFunc: func(ctx context.Context, namespace string, name string, kind PermissionKind, grantee string) error {
+//				panic("mock out the DeleteRepositoryPermission method")
+//			},
+//			GetRepositoryFunc: func(ctx context.Context, namespace string, name string) (*Repository, error) {
+//				panic("mock out the GetRepository method")
+//			},
+//			ListRepositoryPermissionsFunc: func(ctx context.Context, namespace string, name string, kind PermissionKind) (map[string]string, error) {
+//				panic("mock out the ListRepositoryPermissions method")
+//			},
+//			SetRepositoryPermissionFunc: func(ctx context.Context, namespace string, name string, kind PermissionKind, grantee string, role string) error {
+//				panic("mock out the SetRepositoryPermission method")
+//			},
+//			UpdateRepositoryFunc: func(ctx context.Context, r *Repository) error {
+//				panic("mock out the UpdateRepository method")
+//			},
+//		}
+//
+//		// use mockedRepositoryAPI in code that requires RepositoryAPI
+//		// and then make assertions.
+//
+//	}
+type RepositoryAPIMock struct {
+	// ChangeRepositoryVisibilityFunc mocks the ChangeRepositoryVisibility method.
+	ChangeRepositoryVisibilityFunc func(ctx context.Context, namespace string, name string, visibility string) error
+
+	// CreateRepositoryFunc mocks the CreateRepository method.
+	CreateRepositoryFunc func(ctx context.Context, r *Repository) error
+
+	// DeleteRepositoryFunc mocks the DeleteRepository method.
+	DeleteRepositoryFunc func(ctx context.Context, namespace string, name string) error
+
+	// DeleteRepositoryPermissionFunc mocks the DeleteRepositoryPermission method.
+	DeleteRepositoryPermissionFunc func(ctx context.Context, namespace string, name string, kind PermissionKind, grantee string) error
+
+	// GetRepositoryFunc mocks the GetRepository method.
+	GetRepositoryFunc func(ctx context.Context, namespace string, name string) (*Repository, error)
+
+	// ListRepositoryPermissionsFunc mocks the ListRepositoryPermissions method.
+	ListRepositoryPermissionsFunc func(ctx context.Context, namespace string, name string, kind PermissionKind) (map[string]string, error)
+
+	// SetRepositoryPermissionFunc mocks the SetRepositoryPermission method.
+	SetRepositoryPermissionFunc func(ctx context.Context, namespace string, name string, kind PermissionKind, grantee string, role string) error
+
+	// UpdateRepositoryFunc mocks the UpdateRepository method.
+	UpdateRepositoryFunc func(ctx context.Context, r *Repository) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ChangeRepositoryVisibility holds details about calls to the ChangeRepositoryVisibility method.
+		ChangeRepositoryVisibility []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Name is the name argument value.
+			Name string
+			// Visibility is the visibility argument value.
+			Visibility string
+		}
+		// CreateRepository holds details about calls to the CreateRepository method.
+		CreateRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R *Repository
+		}
+		// DeleteRepository holds details about calls to the DeleteRepository method.
+		DeleteRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteRepositoryPermission holds details about calls to the DeleteRepositoryPermission method.
+		DeleteRepositoryPermission []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Name is the name argument value.
+			Name string
+			// Kind is the kind argument value.
+			Kind PermissionKind
+			// Grantee is the grantee argument value.
+			Grantee string
+		}
+		// GetRepository holds details about calls to the GetRepository method.
+		GetRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Name is the name argument value.
+			Name string
+		}
+		// ListRepositoryPermissions holds details about calls to the ListRepositoryPermissions method.
+		ListRepositoryPermissions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Name is the name argument value.
+			Name string
+			// Kind is the kind argument value.
+			Kind PermissionKind
+		}
+		// SetRepositoryPermission holds details about calls to the SetRepositoryPermission method.
+		SetRepositoryPermission []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Name is the name argument value.
+			Name string
+			// Kind is the kind argument value.
+			Kind PermissionKind
+			// Grantee is the grantee argument value.
+			Grantee string
+			// Role is the role argument value.
+			Role string
+		}
+		// UpdateRepository holds details about calls to the UpdateRepository method.
+		UpdateRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R *Repository
+		}
+	}
+	lockChangeRepositoryVisibility sync.RWMutex
+	lockCreateRepository           sync.RWMutex
+	lockDeleteRepository           sync.RWMutex
+	lockDeleteRepositoryPermission sync.RWMutex
+	lockGetRepository              sync.RWMutex
+	lockListRepositoryPermissions  sync.RWMutex
+	lockSetRepositoryPermission    sync.RWMutex
+	lockUpdateRepository           sync.RWMutex
+}
+
+// ChangeRepositoryVisibility calls ChangeRepositoryVisibilityFunc.
+func (mock *RepositoryAPIMock) ChangeRepositoryVisibility(ctx context.Context, namespace string, name string, visibility string) error {
+	if mock.ChangeRepositoryVisibilityFunc == nil {
+		panic("RepositoryAPIMock.ChangeRepositoryVisibilityFunc: method is nil but RepositoryAPI.ChangeRepositoryVisibility was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Namespace  string
+		Name       string
+		Visibility string
+	}{
+		Ctx:        ctx,
+		Namespace:  namespace,
+		Name:       name,
+		Visibility: visibility,
+	}
+	mock.lockChangeRepositoryVisibility.Lock()
+	mock.calls.ChangeRepositoryVisibility = append(mock.calls.ChangeRepositoryVisibility, callInfo)
+	mock.lockChangeRepositoryVisibility.Unlock()
+	return mock.ChangeRepositoryVisibilityFunc(ctx, namespace, name, visibility)
+}
+
+// ChangeRepositoryVisibilityCalls gets all the calls that were made to ChangeRepositoryVisibility.
+// Check the length with:
+//
+//	len(mockedRepositoryAPI.ChangeRepositoryVisibilityCalls())
+func (mock *RepositoryAPIMock) ChangeRepositoryVisibilityCalls() []struct {
+	Ctx        context.Context
+	Namespace  string
+	Name       string
+	Visibility string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Namespace  string
+		Name       string
+		Visibility string
+	}
+	mock.lockChangeRepositoryVisibility.RLock()
+	calls = mock.calls.ChangeRepositoryVisibility
+	mock.lockChangeRepositoryVisibility.RUnlock()
+	return calls
+}
+
+// CreateRepository calls CreateRepositoryFunc.
+func (mock *RepositoryAPIMock) CreateRepository(ctx context.Context, r *Repository) error {
+	if mock.CreateRepositoryFunc == nil {
+		panic("RepositoryAPIMock.CreateRepositoryFunc: method is nil but RepositoryAPI.CreateRepository was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   *Repository
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockCreateRepository.Lock()
+	mock.calls.CreateRepository = append(mock.calls.CreateRepository, callInfo)
+	mock.lockCreateRepository.Unlock()
+	return mock.CreateRepositoryFunc(ctx, r)
+}
+
+// CreateRepositoryCalls gets all the calls that were made to CreateRepository.
+// Check the length with:
+//
+//	len(mockedRepositoryAPI.CreateRepositoryCalls())
+func (mock *RepositoryAPIMock) CreateRepositoryCalls() []struct {
+	Ctx context.Context
+	R   *Repository
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   *Repository
+	}
+	mock.lockCreateRepository.RLock()
+	calls = mock.calls.CreateRepository
+	mock.lockCreateRepository.RUnlock()
+	return calls
+}
+
+// DeleteRepository calls DeleteRepositoryFunc.
+func (mock *RepositoryAPIMock) DeleteRepository(ctx context.Context, namespace string, name string) error {
+	if mock.DeleteRepositoryFunc == nil {
+		panic("RepositoryAPIMock.DeleteRepositoryFunc: method is nil but RepositoryAPI.DeleteRepository was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Namespace string
+		Name      string
+	}{
+		Ctx:       ctx,
+		Namespace: namespace,
+		Name:      name,
+	}
+	mock.lockDeleteRepository.Lock()
+	mock.calls.DeleteRepository = append(mock.calls.DeleteRepository, callInfo)
+	mock.lockDeleteRepository.Unlock()
+	return mock.DeleteRepositoryFunc(ctx, namespace, name)
+}
+
+// DeleteRepositoryCalls gets all the calls that were made to DeleteRepository.
+// Check the length with:
+//
+//	len(mockedRepositoryAPI.DeleteRepositoryCalls())
+func (mock *RepositoryAPIMock) DeleteRepositoryCalls() []struct {
+	Ctx       context.Context
+	Namespace string
+	Name      string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Namespace string
+		Name      string
+	}
+	mock.lockDeleteRepository.RLock()
+	calls = mock.calls.DeleteRepository
+	mock.lockDeleteRepository.RUnlock()
+	return calls
+}
+
+// DeleteRepositoryPermission calls DeleteRepositoryPermissionFunc.
+func (mock *RepositoryAPIMock) DeleteRepositoryPermission(ctx context.Context, namespace string, name string, kind PermissionKind, grantee string) error {
+	if mock.DeleteRepositoryPermissionFunc == nil {
+		panic("RepositoryAPIMock.DeleteRepositoryPermissionFunc: method is nil but RepositoryAPI.DeleteRepositoryPermission was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Namespace string
+		Name      string
+		Kind      PermissionKind
+		Grantee   string
+	}{
+		Ctx:       ctx,
+		Namespace: namespace,
+		Name:      name,
+		Kind:      kind,
+		Grantee:   grantee,
+	}
+	mock.lockDeleteRepositoryPermission.Lock()
+	mock.calls.DeleteRepositoryPermission = append(mock.calls.DeleteRepositoryPermission, callInfo)
+	mock.lockDeleteRepositoryPermission.Unlock()
+	return mock.DeleteRepositoryPermissionFunc(ctx, namespace, name, kind, grantee)
+}
+
+// DeleteRepositoryPermissionCalls gets all the calls that were made to DeleteRepositoryPermission.
+// Check the length with:
+//
+//	len(mockedRepositoryAPI.DeleteRepositoryPermissionCalls())
+func (mock *RepositoryAPIMock) DeleteRepositoryPermissionCalls() []struct {
+	Ctx       context.Context
+	Namespace string
+	Name      string
+	Kind      PermissionKind
+	Grantee   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Namespace string
+		Name      string
+		Kind      PermissionKind
+		Grantee   string
+	}
+	mock.lockDeleteRepositoryPermission.RLock()
+	calls = mock.calls.DeleteRepositoryPermission
+	mock.lockDeleteRepositoryPermission.RUnlock()
+	return calls
+}
+
+// GetRepository calls GetRepositoryFunc.
+func (mock *RepositoryAPIMock) GetRepository(ctx context.Context, namespace string, name string) (*Repository, error) {
+	if mock.GetRepositoryFunc == nil {
+		panic("RepositoryAPIMock.GetRepositoryFunc: method is nil but RepositoryAPI.GetRepository was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Namespace string
+		Name      string
+	}{
+		Ctx:       ctx,
+		Namespace: namespace,
+		Name:      name,
+	}
+	mock.lockGetRepository.Lock()
+	mock.calls.GetRepository = append(mock.calls.GetRepository, callInfo)
+	mock.lockGetRepository.Unlock()
+	return mock.GetRepositoryFunc(ctx, namespace, name)
+}
+
+// GetRepositoryCalls gets all the calls that were made to GetRepository.
+// Check the length with:
+//
+//	len(mockedRepositoryAPI.GetRepositoryCalls())
+func (mock *RepositoryAPIMock) GetRepositoryCalls() []struct {
+	Ctx       context.Context
+	Namespace string
+	Name      string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Namespace string
+		Name      string
+	}
+	mock.lockGetRepository.RLock()
+	calls = mock.calls.GetRepository
+	mock.lockGetRepository.RUnlock()
+	return calls
+}
+
+// ListRepositoryPermissions calls ListRepositoryPermissionsFunc.
+func (mock *RepositoryAPIMock) ListRepositoryPermissions(ctx context.Context, namespace string, name string, kind PermissionKind) (map[string]string, error) {
+	if mock.ListRepositoryPermissionsFunc == nil {
+		panic("RepositoryAPIMock.ListRepositoryPermissionsFunc: method is nil but RepositoryAPI.ListRepositoryPermissions was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Namespace string
+		Name      string
+		Kind      PermissionKind
+	}{
+		Ctx:       ctx,
+		Namespace: namespace,
+		Name:      name,
+		Kind:      kind,
+	}
+	mock.lockListRepositoryPermissions.Lock()
+	mock.calls.ListRepositoryPermissions = append(mock.calls.ListRepositoryPermissions, callInfo)
+	mock.lockListRepositoryPermissions.Unlock()
+	return mock.ListRepositoryPermissionsFunc(ctx, namespace, name, kind)
+}
+
+// ListRepositoryPermissionsCalls gets all the calls that were made to ListRepositoryPermissions.
+// Check the length with:
+//
+//	len(mockedRepositoryAPI.ListRepositoryPermissionsCalls())
+func (mock *RepositoryAPIMock) ListRepositoryPermissionsCalls() []struct {
+	Ctx       context.Context
+	Namespace string
+	Name      string
+	Kind      PermissionKind
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Namespace string
+		Name      string
+		Kind      PermissionKind
+	}
+	mock.lockListRepositoryPermissions.RLock()
+	calls = mock.calls.ListRepositoryPermissions
+	mock.lockListRepositoryPermissions.RUnlock()
+	return calls
+}
+
+// SetRepositoryPermission calls SetRepositoryPermissionFunc.
+func (mock *RepositoryAPIMock) SetRepositoryPermission(ctx context.Context, namespace string, name string, kind PermissionKind, grantee string, role string) error {
+	if mock.SetRepositoryPermissionFunc == nil {
+		panic("RepositoryAPIMock.SetRepositoryPermissionFunc: method is nil but RepositoryAPI.SetRepositoryPermission was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Namespace string
+		Name      string
+		Kind      PermissionKind
+		Grantee   string
+		Role      string
+	}{
+		Ctx:       ctx,
+		Namespace: namespace,
+		Name:      name,
+		Kind:      kind,
+		Grantee:   grantee,
+		Role:      role,
+	}
+	mock.lockSetRepositoryPermission.Lock()
+	mock.calls.SetRepositoryPermission = append(mock.calls.SetRepositoryPermission, callInfo)
+	mock.lockSetRepositoryPermission.Unlock()
+	return mock.SetRepositoryPermissionFunc(ctx, namespace, name, kind, grantee, role)
+}
+
+// SetRepositoryPermissionCalls gets all the calls that were made to SetRepositoryPermission.
+// Check the length with:
+//
+//	len(mockedRepositoryAPI.SetRepositoryPermissionCalls())
+func (mock *RepositoryAPIMock) SetRepositoryPermissionCalls() []struct {
+	Ctx       context.Context
+	Namespace string
+	Name      string
+	Kind      PermissionKind
+	Grantee   string
+	Role      string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Namespace string
+		Name      string
+		Kind      PermissionKind
+		Grantee   string
+		Role      string
+	}
+	mock.lockSetRepositoryPermission.RLock()
+	calls = mock.calls.SetRepositoryPermission
+	mock.lockSetRepositoryPermission.RUnlock()
+	return calls
+}
+
+// UpdateRepository calls UpdateRepositoryFunc.
+func (mock *RepositoryAPIMock) UpdateRepository(ctx context.Context, r *Repository) error {
+	if mock.UpdateRepositoryFunc == nil {
+		panic("RepositoryAPIMock.UpdateRepositoryFunc: method is nil but RepositoryAPI.UpdateRepository was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   *Repository
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockUpdateRepository.Lock()
+	mock.calls.UpdateRepository = append(mock.calls.UpdateRepository, callInfo)
+	mock.lockUpdateRepository.Unlock()
+	return mock.UpdateRepositoryFunc(ctx, r)
+}
+
+// UpdateRepositoryCalls gets all the calls that were made to UpdateRepository.
+// Check the length with:
+//
+//	len(mockedRepositoryAPI.UpdateRepositoryCalls())
+func (mock *RepositoryAPIMock) UpdateRepositoryCalls() []struct {
+	Ctx context.Context
+	R   *Repository
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   *Repository
+	}
+	mock.lockUpdateRepository.RLock()
+	calls = mock.calls.UpdateRepository
+	mock.lockUpdateRepository.RUnlock()
+	return calls
+}
+
+// Ensure, that RobotAccountAPIMock does implement RobotAccountAPI.
+// If this is not the case, regenerate this file with moq.
+var _ RobotAccountAPI = &RobotAccountAPIMock{}
+
+// RobotAccountAPIMock is a mock implementation of RobotAccountAPI.
+//
+//	func TestSomethingThatUsesRobotAccountAPI(t *testing.T) {
+//
+//		// make and configure a mocked RobotAccountAPI
+//		mockedRobotAccountAPI := &RobotAccountAPIMock{
+//			CreateRobotAccountFunc: func(ctx context.Context, organization string, name string, description string) (*RobotAccount, error) {
+//				panic("mock out the CreateRobotAccount method")
+//			},
+//			DeleteRobotAccountFunc: func(ctx context.Context, organization string, name string) error {
+//				panic("mock out the DeleteRobotAccount method")
+//			},
+//			GetRobotAccountFunc: func(ctx context.Context, organization string, name string) (*RobotAccount, error) {
+//				panic("mock out the GetRobotAccount method")
+//			},
+//		}
+//
+//		// use mockedRobotAccountAPI in code that requires RobotAccountAPI
+//		// and then make assertions.
+//
+//	}
+type RobotAccountAPIMock struct {
+	// CreateRobotAccountFunc mocks the CreateRobotAccount method.
+	CreateRobotAccountFunc func(ctx context.Context, organization string, name string, description string) (*RobotAccount, error)
+
+	// DeleteRobotAccountFunc mocks the DeleteRobotAccount method.
+	DeleteRobotAccountFunc func(ctx context.Context, organization string, name string) error
+
+	// GetRobotAccountFunc mocks the GetRobotAccount method.
+	GetRobotAccountFunc func(ctx context.Context, organization string, name string) (*RobotAccount, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateRobotAccount holds details about calls to the CreateRobotAccount method.
+		CreateRobotAccount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Organization is the organization argument value.
+			Organization string
+			// Name is the name argument value.
+			Name string
+			// Description is the description argument value.
+			Description string
+		}
+		// DeleteRobotAccount holds details about calls to the DeleteRobotAccount method.
+		DeleteRobotAccount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Organization is the organization argument value.
+			Organization string
+			// Name is the name argument value.
+			Name string
+		}
+		// GetRobotAccount holds details about calls to the GetRobotAccount method.
+		GetRobotAccount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Organization is the organization argument value.
+			Organization string
+			// Name is the name argument value.
+			Name string
+		}
+	}
+	lockCreateRobotAccount sync.RWMutex
+	lockDeleteRobotAccount sync.RWMutex
+	lockGetRobotAccount    sync.RWMutex
+}
+
+// CreateRobotAccount calls CreateRobotAccountFunc.
+func (mock *RobotAccountAPIMock) CreateRobotAccount(ctx context.Context, organization string, name string, description string) (*RobotAccount, error) {
+	if mock.CreateRobotAccountFunc == nil {
+		panic("RobotAccountAPIMock.CreateRobotAccountFunc: method is nil but RobotAccountAPI.CreateRobotAccount was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Organization string
+		Name         string
+		Description  string
+	}{
+		Ctx:          ctx,
+		Organization: organization,
+		Name:         name,
+		Description:  description,
+	}
+	mock.lockCreateRobotAccount.Lock()
+	mock.calls.CreateRobotAccount = append(mock.calls.CreateRobotAccount, callInfo)
+	mock.lockCreateRobotAccount.Unlock()
+	return mock.CreateRobotAccountFunc(ctx, organization, name, description)
+}
+
+// CreateRobotAccountCalls gets all the calls that were made to CreateRobotAccount.
+// Check the length with:
+//
+//	len(mockedRobotAccountAPI.CreateRobotAccountCalls())
+func (mock *RobotAccountAPIMock) CreateRobotAccountCalls() []struct {
+	Ctx          context.Context
+	Organization string
+	Name         string
+	Description  string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Organization string
+		Name         string
+		Description  string
+	}
+	mock.lockCreateRobotAccount.RLock()
+	calls = mock.calls.CreateRobotAccount
+	mock.lockCreateRobotAccount.RUnlock()
+	return calls
+}
+
+// DeleteRobotAccount calls DeleteRobotAccountFunc.
+func (mock *RobotAccountAPIMock) DeleteRobotAccount(ctx context.Context, organization string, name string) error {
+	if mock.DeleteRobotAccountFunc == nil {
+		panic("RobotAccountAPIMock.DeleteRobotAccountFunc: method is nil but RobotAccountAPI.DeleteRobotAccount was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Organization string
+		Name         string
+	}{
+		Ctx:          ctx,
+		Organization: organization,
+		Name:         name,
+	}
+	mock.lockDeleteRobotAccount.Lock()
+	mock.calls.DeleteRobotAccount = append(mock.calls.DeleteRobotAccount, callInfo)
+	mock.lockDeleteRobotAccount.Unlock()
+	return mock.DeleteRobotAccountFunc(ctx, organization, name)
+}
+
+// DeleteRobotAccountCalls gets all the calls that were made to DeleteRobotAccount.
+// Check the length with:
+//
+//	len(mockedRobotAccountAPI.DeleteRobotAccountCalls())
+func (mock *RobotAccountAPIMock) DeleteRobotAccountCalls() []struct {
+	Ctx          context.Context
+	Organization string
+	Name         string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Organization string
+		Name         string
+	}
+	mock.lockDeleteRobotAccount.RLock()
+	calls = mock.calls.DeleteRobotAccount
+	mock.lockDeleteRobotAccount.RUnlock()
+	return calls
+}
+
+// GetRobotAccount calls GetRobotAccountFunc.
+func (mock *RobotAccountAPIMock) GetRobotAccount(ctx context.Context, organization string, name string) (*RobotAccount, error) {
+	if mock.GetRobotAccountFunc == nil {
+		panic("RobotAccountAPIMock.GetRobotAccountFunc: method is nil but RobotAccountAPI.GetRobotAccount was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Organization string
+		Name         string
+	}{
+		Ctx:          ctx,
+		Organization: organization,
+		Name:         name,
+	}
+	mock.lockGetRobotAccount.Lock()
+	mock.calls.GetRobotAccount = append(mock.calls.GetRobotAccount, callInfo)
+	mock.lockGetRobotAccount.Unlock()
+	return mock.GetRobotAccountFunc(ctx, organization, name)
+}
+
+// GetRobotAccountCalls gets all the calls that were made to GetRobotAccount.
+// Check the length with:
+//
+//	len(mockedRobotAccountAPI.GetRobotAccountCalls())
+func (mock *RobotAccountAPIMock) GetRobotAccountCalls() []struct {
+	Ctx          context.Context
+	Organization string
+	Name         string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Organization string
+		Name         string
+	}
+	mock.lockGetRobotAccount.RLock()
+	calls = mock.calls.GetRobotAccount
+	mock.lockGetRobotAccount.RUnlock()
+	return calls
+}
@@ -0,0 +1,58 @@
+package quay
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// OrganizationAPI manages the organizations of a Quay registry.
+type OrganizationAPI interface {
+	GetOrganization(ctx context.Context, name string) (*Organization, error)
+	CreateOrganization(ctx context.Context, o *Organization) error
+	UpdateOrganization(ctx context.Context, o *Organization) error
+	DeleteOrganization(ctx context.Context, name string) error
+}
+
+// An Organization is a namespace of repositories shared by its members. Its
+// email is only returned to administrators of the organization.
+type Organization struct {
+	Name string `json:"name"`
+	// Email is the contact address of the organization. It must not be the
+	// address of a user of the registry.
+	Email string `json:"email,omitempty"`
+	// TagExpirationSeconds is how long tags removed from repositories of the
+	// organization can be restored.
+	TagExpirationSeconds *int `json:"tag_expiration_s,omitempty"`
+}
+
+func (c *client) GetOrganization(ctx context.Context, name string) (*Organization, error) {
+	out := &Organization{}
+	err := c.Do(ctx, http.MethodGet, "/organization/"+url.PathEscape(name), nil, out)
+	return out, err
+}
+
+// CreateOrganization creates the organization. The tag expiration can only be
+// set by updating the organization once it is created.
+func (c *client) CreateOrganization(ctx context.Context, o *Organization) error {
+	in := struct {
+		Name  string `json:"name"`
+		Email string `json:"email,omitempty"`
+	}{Name: o.Name, Email: o.Email}
+	// Quay responds with a string, which is ignored.
+	return c.Do(ctx, http.MethodPost, "/organization/", in, nil)
+}
+
+// UpdateOrganization updates the email and tag expiration of the
+// organization, if set.
+func (c *client) UpdateOrganization(ctx context.Context, o *Organization) error {
+	in := struct {
+		Email                string `json:"email,omitempty"`
+		TagExpirationSeconds *int   `json:"tag_expiration_s,omitempty"`
+	}{Email: o.Email, TagExpirationSeconds: o.TagExpirationSeconds}
+	return c.Do(ctx, http.MethodPut, "/organization/"+url.PathEscape(o.Name), in, nil)
+}
+
+func (c *client) DeleteOrganization(ctx context.Context, name string) error {
+	return c.Do(ctx, http.MethodDelete, "/organization/"+url.PathEscape(name), nil, nil)
+}
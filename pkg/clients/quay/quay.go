@@ -0,0 +1,101 @@
+// Package quay contains a client for the API of a Quay container registry,
+// e.g. quay.io.
+package quay
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+
+	"github.com/stehessel/provider-redhat/pkg/clients/httpapi"
+)
+
+//go:generate go run github.com/matryer/moq@v0.3.1 -out client_moq.go . OrganizationAPI RepositoryAPI RobotAccountAPI
+
+// ErrNewClient represents an error to create a new Quay client.
+const ErrNewClient = "cannot create quay client"
+
+// DefaultEndpoint is the endpoint of quay.io.
+const DefaultEndpoint = "https://quay.io"
+
+// basePath is the path of the Quay API below the endpoint of the registry.
+const basePath = "/api/v1"
+
+// Client is a client for the API of a Quay registry.
+type Client interface {
+	OrganizationAPI
+	RepositoryAPI
+	RobotAccountAPI
+
+	// Registry returns the host container clients log in to the registry
+	// with, e.g. quay.io.
+	Registry() string
+}
+
+// NewClient creates a new client for the API of the Quay registry served at
+// the supplied endpoint. Requests are authenticated with the supplied OAuth
+// access token.
+func NewClient(token string, endpoint string) (Client, error) {
+	auth, err := fleetmanager.NewStaticAuth(fleetmanager.StaticOption{StaticToken: token})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create quay authentication")
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse endpoint")
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("endpoint %q is not an absolute URL", endpoint)
+	}
+
+	c := newClient(strings.TrimSuffix(u.String(), "/")+basePath, httpapi.NewHTTPClient(auth))
+	c.registry = u.Host
+	return c, nil
+}
+
+type client struct {
+	*httpapi.Client
+	registry string
+}
+
+func newClient(endpoint string, h *http.Client) *client {
+	return &client{Client: &httpapi.Client{Name: "quay", Endpoint: endpoint, HTTP: h, ErrorMessage: errorMessage}}
+}
+
+func (c *client) Registry() string {
+	return c.registry
+}
+
+// An APIError is returned for requests the Quay API did not accept.
+type APIError = httpapi.APIError
+
+// IsNotFound returns true if the supplied error indicates that the requested
+// Quay object does not exist.
+func IsNotFound(err error) bool {
+	return httpapi.IsNotFound(err)
+}
+
+// errorMessage returns the message of an error of the Quay API.
+func errorMessage(body []byte) (string, string) {
+	// Quay explains errors in detail or error_message, older versions in
+	// message.
+	msg := struct {
+		Detail       string `json:"detail"`
+		ErrorMessage string `json:"error_message"`
+		Message      string `json:"message"`
+	}{}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return "", ""
+	}
+	for _, m := range []string{msg.Detail, msg.ErrorMessage, msg.Message} {
+		if m != "" {
+			return "", m
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,86 @@
+package quay
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListRepositoryPermissions(t *testing.T) {
+	cases := []struct {
+		name     string
+		status   int
+		body     string
+		want     map[string]string
+		message  string
+		notFound bool
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body:   `{"permissions":{"acme+deployer":{"role":"read","name":"acme+deployer","is_robot":true},"alice":{"role":"admin","name":"alice","is_robot":false}}}`,
+			want:   map[string]string{"acme+deployer": "read", "alice": "admin"},
+		},
+		{
+			name:     "not found",
+			status:   http.StatusNotFound,
+			body:     `{"detail":"Not Found","error_message":"Not Found","error_type":"not_found","status":404,"title":"not_found"}`,
+			message:  "quay API responded with 404: Not Found",
+			notFound: true,
+		},
+		{
+			name:    "unauthorized",
+			status:  http.StatusUnauthorized,
+			body:    `{"message":"Unauthorized"}`,
+			message: "quay API responded with 401: Unauthorized",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != basePath+"/repository/acme/app/permissions/user/" {
+					t.Errorf("\nc.ListRepositoryPermissions(...): unexpected request %s %s\n", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			c := newClient(srv.URL+basePath, srv.Client())
+			got, err := c.ListRepositoryPermissions(context.Background(), "acme", "app", PermissionKindUser)
+			msg := ""
+			if err != nil {
+				msg = err.Error()
+			}
+			if msg != tc.message {
+				t.Errorf("\nc.ListRepositoryPermissions(...): want error %q, got %q\n", tc.message, msg)
+			}
+			if IsNotFound(err) != tc.notFound {
+				t.Errorf("\nIsNotFound(...): want %t, got %t\n", tc.notFound, IsNotFound(err))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nc.ListRepositoryPermissions(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	c, err := NewClient("token", "https://quay.example.com/")
+	if err != nil {
+		t.Fatalf("\nNewClient(...): unexpected error: %s\n", err)
+	}
+	if got := c.(*client).Endpoint; got != "https://quay.example.com"+basePath {
+		t.Errorf("\nNewClient(...): endpoint %q\n", got)
+	}
+	if got := c.Registry(); got != "quay.example.com" {
+		t.Errorf("\nNewClient(...): registry %q, want %q\n", got, "quay.example.com")
+	}
+	if _, err := NewClient("token", "quay.example.com"); err == nil {
+		t.Errorf("\nNewClient(...): want error for relative endpoint\n")
+	}
+}
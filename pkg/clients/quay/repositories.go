@@ -0,0 +1,126 @@
+package quay
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Visibilities of repositories.
+const (
+	VisibilityPublic  = "public"
+	VisibilityPrivate = "private"
+)
+
+// PermissionKind is the kind of grantee of a repository permission.
+type PermissionKind string
+
+// Kinds of grantees of repository permissions. Robot accounts are granted
+// permissions like users.
+const (
+	PermissionKindUser PermissionKind = "user"
+	PermissionKindTeam PermissionKind = "team"
+)
+
+// RepositoryAPI manages the repositories of a Quay registry, and the
+// permissions of users, robot accounts and teams on them.
+type RepositoryAPI interface {
+	GetRepository(ctx context.Context, namespace, name string) (*Repository, error)
+	CreateRepository(ctx context.Context, r *Repository) error
+	UpdateRepository(ctx context.Context, r *Repository) error
+	ChangeRepositoryVisibility(ctx context.Context, namespace, name, visibility string) error
+	DeleteRepository(ctx context.Context, namespace, name string) error
+
+	ListRepositoryPermissions(ctx context.Context, namespace, name string, kind PermissionKind) (map[string]string, error)
+	SetRepositoryPermission(ctx context.Context, namespace, name string, kind PermissionKind, grantee, role string) error
+	DeleteRepositoryPermission(ctx context.Context, namespace, name string, kind PermissionKind, grantee string) error
+}
+
+// A Repository is an image repository in the namespace of an organization or
+// user.
+type Repository struct {
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	IsPublic    bool   `json:"is_public"`
+}
+
+func repositoryPath(namespace, name string) string {
+	return "/repository/" + url.PathEscape(namespace) + "/" + url.PathEscape(name)
+}
+
+func (c *client) GetRepository(ctx context.Context, namespace, name string) (*Repository, error) {
+	out := &Repository{}
+	err := c.Do(ctx, http.MethodGet, repositoryPath(namespace, name), nil, out)
+	return out, err
+}
+
+func (c *client) CreateRepository(ctx context.Context, r *Repository) error {
+	visibility := VisibilityPrivate
+	if r.IsPublic {
+		visibility = VisibilityPublic
+	}
+	in := struct {
+		Namespace   string `json:"namespace"`
+		Repository  string `json:"repository"`
+		Visibility  string `json:"visibility"`
+		Description string `json:"description"`
+		Kind        string `json:"repo_kind"`
+	}{Namespace: r.Namespace, Repository: r.Name, Visibility: visibility, Description: r.Description, Kind: "image"}
+	return c.Do(ctx, http.MethodPost, "/repository", in, nil)
+}
+
+// UpdateRepository updates the description of the repository. Its visibility
+// is changed separately.
+func (c *client) UpdateRepository(ctx context.Context, r *Repository) error {
+	in := struct {
+		Description string `json:"description"`
+	}{Description: r.Description}
+	return c.Do(ctx, http.MethodPut, repositoryPath(r.Namespace, r.Name), in, nil)
+}
+
+func (c *client) ChangeRepositoryVisibility(ctx context.Context, namespace, name, visibility string) error {
+	in := struct {
+		Visibility string `json:"visibility"`
+	}{Visibility: visibility}
+	return c.Do(ctx, http.MethodPost, repositoryPath(namespace, name)+"/changevisibility", in, nil)
+}
+
+func (c *client) DeleteRepository(ctx context.Context, namespace, name string) error {
+	return c.Do(ctx, http.MethodDelete, repositoryPath(namespace, name), nil, nil)
+}
+
+func permissionsPath(namespace, name string, kind PermissionKind) string {
+	return repositoryPath(namespace, name) + "/permissions/" + string(kind) + "/"
+}
+
+// ListRepositoryPermissions returns the roles of the grantees of the supplied
+// kind with explicit permissions on the repository, by grantee.
+func (c *client) ListRepositoryPermissions(ctx context.Context, namespace, name string, kind PermissionKind) (map[string]string, error) {
+	out := struct {
+		Permissions map[string]struct {
+			Role string `json:"role"`
+		} `json:"permissions"`
+	}{}
+	if err := c.Do(ctx, http.MethodGet, permissionsPath(namespace, name, kind), nil, &out); err != nil {
+		return nil, err
+	}
+	roles := make(map[string]string, len(out.Permissions))
+	for grantee, p := range out.Permissions {
+		roles[grantee] = p.Role
+	}
+	return roles, nil
+}
+
+// SetRepositoryPermission grants the supplied role on the repository to the
+// grantee, replacing any role it had before.
+func (c *client) SetRepositoryPermission(ctx context.Context, namespace, name string, kind PermissionKind, grantee, role string) error {
+	in := struct {
+		Role string `json:"role"`
+	}{Role: role}
+	return c.Do(ctx, http.MethodPut, permissionsPath(namespace, name, kind)+url.PathEscape(grantee), in, nil)
+}
+
+func (c *client) DeleteRepositoryPermission(ctx context.Context, namespace, name string, kind PermissionKind, grantee string) error {
+	return c.Do(ctx, http.MethodDelete, permissionsPath(namespace, name, kind)+url.PathEscape(grantee), nil, nil)
+}
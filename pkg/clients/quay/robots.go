@@ -0,0 +1,49 @@
+package quay
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// RobotAccountAPI manages the robot accounts of the organizations of a Quay
+// registry.
+type RobotAccountAPI interface {
+	GetRobotAccount(ctx context.Context, organization, name string) (*RobotAccount, error)
+	CreateRobotAccount(ctx context.Context, organization, name, description string) (*RobotAccount, error)
+	DeleteRobotAccount(ctx context.Context, organization, name string) error
+}
+
+// A RobotAccount authenticates container clients of an organization. Its name
+// is qualified by the organization, e.g. myorg+deployer, and it logs in with
+// this name and its token.
+type RobotAccount struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Token       string `json:"token,omitempty"`
+}
+
+func robotPath(organization, name string) string {
+	return "/organization/" + url.PathEscape(organization) + "/robots/" + url.PathEscape(name)
+}
+
+// GetRobotAccount returns the robot account with the supplied short name,
+// i.e. the name without the organization.
+func (c *client) GetRobotAccount(ctx context.Context, organization, name string) (*RobotAccount, error) {
+	out := &RobotAccount{}
+	err := c.Do(ctx, http.MethodGet, robotPath(organization, name), nil, out)
+	return out, err
+}
+
+func (c *client) CreateRobotAccount(ctx context.Context, organization, name, description string) (*RobotAccount, error) {
+	in := struct {
+		Description string `json:"description"`
+	}{Description: description}
+	out := &RobotAccount{}
+	err := c.Do(ctx, http.MethodPut, robotPath(organization, name), in, out)
+	return out, err
+}
+
+func (c *client) DeleteRobotAccount(ctx context.Context, organization, name string) error {
+	return c.Do(ctx, http.MethodDelete, robotPath(organization, name), nil, nil)
+}
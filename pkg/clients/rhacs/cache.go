@@ -0,0 +1,109 @@
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+)
+
+// A CentralCache holds the list of centrals of each ProviderConfig's
+// organisation for a short time, so that all resources sharing a
+// ProviderConfig are observed with a single list call per TTL.
+type CentralCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	// mu is held while the centrals are listed, so that concurrent lookups
+	// wait for the list call in flight rather than issuing their own.
+	mu      sync.Mutex
+	list    public.CentralRequestList
+	expires time.Time
+}
+
+// NewCentralCache returns a cache whose entries expire after the supplied
+// TTL. A TTL of zero disables caching.
+func NewCentralCache(ttl time.Duration) *CentralCache {
+	return &CentralCache{ttl: ttl, now: time.Now, entries: map[string]*cacheEntry{}}
+}
+
+func (c *CentralCache) entry(pc string) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[pc]
+	if !ok {
+		e = &cacheEntry{}
+		c.entries[pc] = e
+	}
+	return e
+}
+
+// Invalidate drops the cached centrals of the named ProviderConfig.
+func (c *CentralCache) Invalidate(pc string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, pc)
+}
+
+// NewCachedClient wraps the supplied client so that listing centrals is served
+// from the supplied cache for the named ProviderConfig. Creating or deleting a
+// central invalidates the cached list.
+func NewCachedClient(client fleetmanager.PublicAPI, cache *CentralCache, pc string) fleetmanager.PublicAPI {
+	if cache == nil || cache.ttl <= 0 {
+		return client
+	}
+	return &cachedClient{client: client, cache: cache, pc: pc}
+}
+
+type cachedClient struct {
+	client fleetmanager.PublicAPI
+	cache  *CentralCache
+	pc     string
+}
+
+func (c *cachedClient) CreateCentral(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error) {
+	defer c.cache.Invalidate(c.pc)
+	return c.client.CreateCentral(ctx, async, request)
+}
+
+func (c *cachedClient) DeleteCentralById(ctx context.Context, id string, async bool) (*http.Response, error) { //nolint:revive,stylecheck // Matches fleetmanager.PublicAPI.
+	defer c.cache.Invalidate(c.pc)
+	return c.client.DeleteCentralById(ctx, id, async)
+}
+
+func (c *cachedClient) GetCentralById(ctx context.Context, id string) (public.CentralRequest, *http.Response, error) { //nolint:revive,stylecheck // Matches fleetmanager.PublicAPI.
+	return c.client.GetCentralById(ctx, id)
+}
+
+// GetCentrals returns the cached centrals if no options are supplied. Filtered
+// or paginated lists are never cached.
+func (c *cachedClient) GetCentrals(ctx context.Context, localVarOptionals *public.GetCentralsOpts) (public.CentralRequestList, *http.Response, error) {
+	if localVarOptionals != nil {
+		return c.client.GetCentrals(ctx, localVarOptionals)
+	}
+
+	e := c.cache.entry(c.pc)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if c.cache.now().Before(e.expires) {
+		centralCacheLookups.WithLabelValues(c.pc, cacheHit).Inc()
+		return e.list, nil, nil
+	}
+	centralCacheLookups.WithLabelValues(c.pc, cacheMiss).Inc()
+
+	list, resp, err := c.client.GetCentrals(ctx, nil)
+	if err != nil {
+		return list, resp, err
+	}
+	e.list, e.expires = list, c.cache.now().Add(c.cache.ttl)
+	return list, resp, nil
+}
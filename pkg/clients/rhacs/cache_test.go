@@ -0,0 +1,103 @@
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+)
+
+func TestCachedClient(t *testing.T) {
+	ttl := 5 * time.Second
+
+	type step func(ctx context.Context, c fleetmanager.PublicAPI, clock *time.Time)
+
+	list := func(ctx context.Context, c fleetmanager.PublicAPI, _ *time.Time) {
+		_, _, _ = c.GetCentrals(ctx, nil)
+	}
+	listFiltered := func(ctx context.Context, c fleetmanager.PublicAPI, _ *time.Time) {
+		_, _, _ = c.GetCentrals(ctx, &public.GetCentralsOpts{})
+	}
+	create := func(ctx context.Context, c fleetmanager.PublicAPI, _ *time.Time) {
+		_, _, _ = c.CreateCentral(ctx, true, public.CentralRequestPayload{})
+	}
+	remove := func(ctx context.Context, c fleetmanager.PublicAPI, _ *time.Time) {
+		_, _ = c.DeleteCentralById(ctx, "test-id", true)
+	}
+	wait := func(d time.Duration) step {
+		return func(_ context.Context, _ fleetmanager.PublicAPI, clock *time.Time) { *clock = clock.Add(d) }
+	}
+
+	cases := []struct {
+		name  string
+		err   error
+		steps []step
+		want  int
+	}{
+		{
+			name:  "repeated lookups are served from cache",
+			steps: []step{list, list, list},
+			want:  1,
+		},
+		{
+			name:  "entries expire after TTL",
+			steps: []step{list, wait(ttl), list},
+			want:  2,
+		},
+		{
+			name:  "create invalidates cache",
+			steps: []step{list, create, list},
+			want:  2,
+		},
+		{
+			name:  "delete invalidates cache",
+			steps: []step{list, remove, list},
+			want:  2,
+		},
+		{
+			name:  "filtered lookups are not cached",
+			steps: []step{listFiltered, listFiltered},
+			want:  2,
+		},
+		{
+			name:  "errors are not cached",
+			err:   errors.New("boom"),
+			steps: []step{list, list},
+			want:  2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			api := &fleetmanager.PublicAPIMock{
+				GetCentralsFunc: func(ctx context.Context, localVarOptionals *public.GetCentralsOpts) (public.CentralRequestList, *http.Response, error) {
+					calls++
+					return public.CentralRequestList{}, nil, tc.err
+				},
+				CreateCentralFunc: func(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error) {
+					return public.CentralRequest{}, nil, nil
+				},
+				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool) (*http.Response, error) {
+					return nil, nil
+				},
+			}
+			clock := time.Now()
+			cache := NewCentralCache(ttl)
+			cache.now = func() time.Time { return clock }
+
+			c := NewCachedClient(api, cache, "default")
+			for _, s := range tc.steps {
+				s(context.Background(), c, &clock)
+			}
+			if diff := cmp.Diff(tc.want, calls); diff != "" {
+				t.Errorf("\nGetCentrals(...): -want calls, +got calls:\n%s\n", diff)
+			}
+		})
+	}
+}
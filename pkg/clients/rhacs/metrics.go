@@ -0,0 +1,44 @@
+package rhacs
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "provider_redhat"
+	metricsSubsystem = "fleetmanager"
+)
+
+// Central cache lookup results.
+const (
+	cacheHit  = "hit"
+	cacheMiss = "miss"
+)
+
+var (
+	throttledRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "throttled_requests_total",
+		Help:      "Number of fleet-manager requests delayed or rejected by a ProviderConfig rate limit.",
+	}, []string{"providerconfig"})
+
+	throttledReconciles = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "throttled_reconciles_total",
+		Help:      "Number of reconciles requeued because a fleet-manager request was throttled by a ProviderConfig rate limit.",
+	}, []string{"providerconfig"})
+
+	centralCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "central_cache_lookups_total",
+		Help:      "Number of central list lookups by result. The cache hit ratio is hit / (hit + miss).",
+	}, []string{"providerconfig", "result"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(throttledRequests, throttledReconciles, centralCacheLookups)
+}
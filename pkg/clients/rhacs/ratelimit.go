@@ -0,0 +1,177 @@
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	"golang.org/x/time/rate"
+
+	"github.com/stehessel/provider-redhat/apis/v1alpha1"
+)
+
+// ErrThrottled is returned when a fleet-manager call cannot acquire a token
+// from its ProviderConfig's rate limiter before the context expires.
+const ErrThrottled = "fleet manager request throttled by ProviderConfig rate limit"
+
+// RateLimiters holds a token bucket rate limiter per ProviderConfig.
+type RateLimiters struct {
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewRateLimiters returns an empty set of rate limiters.
+func NewRateLimiters() *RateLimiters {
+	return &RateLimiters{limiters: map[string]*rate.Limiter{}}
+}
+
+// Get returns the rate limiter of the named ProviderConfig, creating it or
+// adjusting it to the supplied configuration. It returns nil if no rate
+// limit is configured.
+func (l *RateLimiters) Get(pc string, cfg *v1alpha1.RateLimit) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if cfg == nil {
+		delete(l.limiters, pc)
+		return nil
+	}
+
+	limit, burst := rate.Limit(cfg.RequestsPerSecond), cfg.Burst
+	if burst < 1 {
+		burst = 1
+	}
+	lim, ok := l.limiters[pc]
+	if !ok {
+		lim = rate.NewLimiter(limit, burst)
+		l.limiters[pc] = lim
+		return lim
+	}
+	if lim.Limit() != limit {
+		lim.SetLimit(limit)
+	}
+	if lim.Burst() != burst {
+		lim.SetBurst(burst)
+	}
+	return lim
+}
+
+// A Throttle records whether fleet-manager calls of a reconcile were
+// throttled, so that the reconcile can be requeued once a token is available
+// again rather than failing and backing off.
+type Throttle struct {
+	mu         sync.Mutex
+	retryAfter time.Duration
+}
+
+type throttleKey struct{}
+
+// WithThrottle returns a context that has the rate limited calls made with it
+// record their throttling in the supplied throttle.
+func WithThrottle(ctx context.Context, t *Throttle) context.Context {
+	return context.WithValue(ctx, throttleKey{}, t)
+}
+
+// throttle records that a call of the reconcile could not acquire a token of
+// the rate limiter of the named ProviderConfig before its context expires,
+// and that the token is available after the supplied delay. Only the first
+// throttled call of a reconcile counts as a throttled reconcile.
+func (t *Throttle) throttle(pc string, d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.retryAfter == 0 {
+		throttledReconciles.WithLabelValues(pc).Inc()
+	}
+	if d > t.retryAfter {
+		t.retryAfter = d
+	}
+}
+
+// RetryAfter returns how long the reconcile should be requeued after because
+// one of its calls was throttled, or zero.
+func (t *Throttle) RetryAfter() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.retryAfter
+}
+
+// NewRateLimitedClient wraps the supplied client so that every call waits for
+// a token of the supplied rate limiter of the named ProviderConfig.
+func NewRateLimitedClient(client fleetmanager.PublicAPI, lim *rate.Limiter, pc string) fleetmanager.PublicAPI {
+	return &rateLimitedClient{client: client, limiter: lim, pc: pc}
+}
+
+type rateLimitedClient struct {
+	client  fleetmanager.PublicAPI
+	limiter *rate.Limiter
+	pc      string
+}
+
+// wait reserves a token of the rate limiter for a call that is about to be
+// sent, and waits until the reservation is due. Calls that cannot acquire a
+// token before their context expires cancel their reservation and fail, and
+// have their reconcile requeued after its delay.
+func (c *rateLimitedClient) wait(ctx context.Context) error {
+	r := c.limiter.Reserve()
+	d := r.Delay()
+	if d == 0 {
+		return nil
+	}
+	throttledRequests.WithLabelValues(c.pc).Inc()
+	if dl, ok := ctx.Deadline(); ok && time.Until(dl) < d {
+		r.Cancel()
+		c.throttle(ctx, d)
+		return errors.New(ErrThrottled)
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		r.Cancel()
+		c.throttle(ctx, d)
+		return errors.Wrap(ctx.Err(), ErrThrottled)
+	}
+}
+
+// throttle records in the throttle of the supplied context, if any, that a
+// call was throttled for the supplied delay.
+func (c *rateLimitedClient) throttle(ctx context.Context, d time.Duration) {
+	if t, ok := ctx.Value(throttleKey{}).(*Throttle); ok {
+		t.throttle(c.pc, d)
+	}
+}
+
+func (c *rateLimitedClient) CreateCentral(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error) {
+	if err := c.wait(ctx); err != nil {
+		return public.CentralRequest{}, nil, err
+	}
+	return c.client.CreateCentral(ctx, async, request)
+}
+
+func (c *rateLimitedClient) DeleteCentralById(ctx context.Context, id string, async bool) (*http.Response, error) { //nolint:revive,stylecheck // Matches fleetmanager.PublicAPI.
+	if err := c.wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.DeleteCentralById(ctx, id, async)
+}
+
+func (c *rateLimitedClient) GetCentralById(ctx context.Context, id string) (public.CentralRequest, *http.Response, error) { //nolint:revive,stylecheck // Matches fleetmanager.PublicAPI.
+	if err := c.wait(ctx); err != nil {
+		return public.CentralRequest{}, nil, err
+	}
+	return c.client.GetCentralById(ctx, id)
+}
+
+func (c *rateLimitedClient) GetCentrals(ctx context.Context, localVarOptionals *public.GetCentralsOpts) (public.CentralRequestList, *http.Response, error) {
+	if err := c.wait(ctx); err != nil {
+		return public.CentralRequestList{}, nil, err
+	}
+	return c.client.GetCentrals(ctx, localVarOptionals)
+}
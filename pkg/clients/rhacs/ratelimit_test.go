@@ -0,0 +1,102 @@
+package rhacs
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+
+	"github.com/stehessel/provider-redhat/apis/v1alpha1"
+)
+
+func TestThrottle(t *testing.T) {
+	type want struct {
+		calls      int
+		errs       int
+		retryAfter bool
+		tokens     int
+	}
+
+	cases := []struct {
+		name      string
+		exhausted bool
+		calls     int
+		want      want
+	}{
+		{
+			name: "reconcile without calls takes no token",
+			want: want{
+				tokens: 1,
+			},
+		},
+		{
+			name:  "call takes token",
+			calls: 1,
+			want: want{
+				calls: 1,
+			},
+		},
+		{
+			name:  "throttled call requeues reconcile",
+			calls: 2,
+			want: want{
+				calls:      1,
+				errs:       1,
+				retryAfter: true,
+			},
+		},
+		{
+			name:      "exhausted rate limit requeues reconcile",
+			exhausted: true,
+			calls:     1,
+			want: want{
+				errs:       1,
+				retryAfter: true,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			lim := NewRateLimiters().Get("default", &v1alpha1.RateLimit{RequestsPerSecond: 1, Burst: 1})
+			if tc.exhausted {
+				lim.Allow()
+			}
+
+			calls := 0
+			c := NewRateLimitedClient(&fleetmanager.PublicAPIMock{
+				GetCentralByIdFunc: func(ctx context.Context, id string) (public.CentralRequest, *http.Response, error) {
+					calls++
+					return public.CentralRequest{}, nil, nil
+				},
+			}, lim, "default")
+			th := &Throttle{}
+			ctx, cancel := context.WithTimeout(WithThrottle(context.Background(), th), 100*time.Millisecond)
+			defer cancel()
+			errs := 0
+			for i := 0; i < tc.calls; i++ {
+				if _, _, err := c.GetCentralById(ctx, "test-id"); err != nil {
+					errs++
+				}
+			}
+
+			if diff := cmp.Diff(tc.want.retryAfter, th.RetryAfter() > 0); diff != "" {
+				t.Errorf("\nth.RetryAfter(): -want requeue, +got requeue:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\nc.GetCentralById(...): -want calls, +got calls:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.errs, errs); diff != "" {
+				t.Errorf("\nc.GetCentralById(...): -want errors, +got errors:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.tokens, int(math.Round(lim.Tokens()))); diff != "" {
+				t.Errorf("\nlim.Tokens(): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
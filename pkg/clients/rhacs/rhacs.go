@@ -1,8 +1,13 @@
 package rhacs
 
 import (
+	"net/url"
+
 	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
 	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+
+	"github.com/stehessel/provider-redhat/pkg/clients/httpapi"
 )
 
 // Central request states in fleet manager.
@@ -19,17 +24,24 @@ const (
 // ErrNewClient represents an error to create a new fleet-manager client.
 const ErrNewClient = "cannot create rhacs client"
 
-// NewClient creates a new fleet manager client.
+const userAgent = "crossplane"
+
+// NewClient creates a new fleet manager client. Every request is sent through
+// an instrumented transport, so that fleet-manager calls show up as child spans
+// of the reconcile that issued them when tracing is enabled.
 func NewClient(token string, endpoint string) (fleetmanager.PublicAPI, error) {
 	auth, err := fleetmanager.NewOCMAuth(fleetmanager.OCMOption{RefreshToken: token})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create fleet manager authentication")
 	}
 
-	client, err := fleetmanager.NewClient(endpoint, auth, fleetmanager.WithUserAgent("crossplane"))
-	if err != nil {
+	if _, err := url.Parse(endpoint); err != nil {
 		return nil, errors.Wrap(err, "failed to create fleet manager client")
 	}
 
-	return client.PublicAPI(), nil
+	return public.NewAPIClient(&public.Configuration{
+		BasePath:   endpoint,
+		UserAgent:  userAgent,
+		HTTPClient: httpapi.NewHTTPClient(auth),
+	}).DefaultApi, nil
 }
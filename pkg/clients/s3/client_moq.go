@@ -0,0 +1,252 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package s3
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that ClientMock does implement Client.
+// If this is not the case, regenerate this file with moq.
+var _ Client = &ClientMock{}
+
+// ClientMock is a mock implementation of Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked Client
+//		mockedClient := &ClientMock{
+//			GetObjectFunc: func(ctx context.Context, bucket string, key string) (Object, error) {
+//				panic("mock out the GetObject method")
+//			},
+//			LatestObjectFunc: func(ctx context.Context, bucket string, prefix string) (*ObjectInfo, error) {
+//				panic("mock out the LatestObject method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// GetObjectFunc mocks the GetObject method.
+	GetObjectFunc func(ctx context.Context, bucket string, key string) (Object, error)
+
+	// LatestObjectFunc mocks the LatestObject method.
+	LatestObjectFunc func(ctx context.Context, bucket string, prefix string) (*ObjectInfo, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetObject holds details about calls to the GetObject method.
+		GetObject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Bucket is the bucket argument value.
+			Bucket string
+			// Key is the key argument value.
+			Key string
+		}
+		// LatestObject holds details about calls to the LatestObject method.
+		LatestObject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Bucket is the bucket argument value.
+			Bucket string
+			// Prefix is the prefix argument value.
+			Prefix string
+		}
+	}
+	lockGetObject    sync.RWMutex
+	lockLatestObject sync.RWMutex
+}
+
+// GetObject calls GetObjectFunc.
+func (mock *ClientMock) GetObject(ctx context.Context, bucket string, key string) (Object, error) {
+	if mock.GetObjectFunc == nil {
+		panic("ClientMock.GetObjectFunc: method is nil but Client.GetObject was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Bucket string
+		Key    string
+	}{
+		Ctx:    ctx,
+		Bucket: bucket,
+		Key:    key,
+	}
+	mock.lockGetObject.Lock()
+	mock.calls.GetObject = append(mock.calls.GetObject, callInfo)
+	mock.lockGetObject.Unlock()
+	return mock.GetObjectFunc(ctx, bucket, key)
+}
+
+// GetObjectCalls gets all the calls that were made to GetObject.
+// Check the length with:
+//
+//	len(mockedClient.GetObjectCalls())
+func (mock *ClientMock) GetObjectCalls() []struct {
+	Ctx    context.Context
+	Bucket string
+	Key    string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Bucket string
+		Key    string
+	}
+	mock.lockGetObject.RLock()
+	calls = mock.calls.GetObject
+	mock.lockGetObject.RUnlock()
+	return calls
+}
+
+// LatestObject calls LatestObjectFunc.
+func (mock *ClientMock) LatestObject(ctx context.Context, bucket string, prefix string) (*ObjectInfo, error) {
+	if mock.LatestObjectFunc == nil {
+		panic("ClientMock.LatestObjectFunc: method is nil but Client.LatestObject was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Bucket string
+		Prefix string
+	}{
+		Ctx:    ctx,
+		Bucket: bucket,
+		Prefix: prefix,
+	}
+	mock.lockLatestObject.Lock()
+	mock.calls.LatestObject = append(mock.calls.LatestObject, callInfo)
+	mock.lockLatestObject.Unlock()
+	return mock.LatestObjectFunc(ctx, bucket, prefix)
+}
+
+// LatestObjectCalls gets all the calls that were made to LatestObject.
+// Check the length with:
+//
+//	len(mockedClient.LatestObjectCalls())
+func (mock *ClientMock) LatestObjectCalls() []struct {
+	Ctx    context.Context
+	Bucket string
+	Prefix string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Bucket string
+		Prefix string
+	}
+	mock.lockLatestObject.RLock()
+	calls = mock.calls.LatestObject
+	mock.lockLatestObject.RUnlock()
+	return calls
+}
+
+// Ensure, that ObjectMock does implement Object.
+// If this is not the case, regenerate this file with moq.
+var _ Object = &ObjectMock{}
+
+// ObjectMock is a mock implementation of Object.
+//
+//	func TestSomethingThatUsesObject(t *testing.T) {
+//
+//		// make and configure a mocked Object
+//		mockedObject := &ObjectMock{
+//			CloseFunc: func() error {
+//				panic("mock out the Close method")
+//			},
+//			ReadAtFunc: func(p []byte, off int64) (int, error) {
+//				panic("mock out the ReadAt method")
+//			},
+//		}
+//
+//		// use mockedObject in code that requires Object
+//		// and then make assertions.
+//
+//	}
+type ObjectMock struct {
+	// CloseFunc mocks the Close method.
+	CloseFunc func() error
+
+	// ReadAtFunc mocks the ReadAt method.
+	ReadAtFunc func(p []byte, off int64) (int, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Close holds details about calls to the Close method.
+		Close []struct {
+		}
+		// ReadAt holds details about calls to the ReadAt method.
+		ReadAt []struct {
+			// P is the p argument value.
+			P []byte
+			// Off is the off argument value.
+			Off int64
+		}
+	}
+	lockClose  sync.RWMutex
+	lockReadAt sync.RWMutex
+}
+
+// Close calls CloseFunc.
+func (mock *ObjectMock) Close() error {
+	if mock.CloseFunc == nil {
+		panic("ObjectMock.CloseFunc: method is nil but Object.Close was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockClose.Lock()
+	mock.calls.Close = append(mock.calls.Close, callInfo)
+	mock.lockClose.Unlock()
+	return mock.CloseFunc()
+}
+
+// CloseCalls gets all the calls that were made to Close.
+// Check the length with:
+//
+//	len(mockedObject.CloseCalls())
+func (mock *ObjectMock) CloseCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockClose.RLock()
+	calls = mock.calls.Close
+	mock.lockClose.RUnlock()
+	return calls
+}
+
+// ReadAt calls ReadAtFunc.
+func (mock *ObjectMock) ReadAt(p []byte, off int64) (int, error) {
+	if mock.ReadAtFunc == nil {
+		panic("ObjectMock.ReadAtFunc: method is nil but Object.ReadAt was just called")
+	}
+	callInfo := struct {
+		P   []byte
+		Off int64
+	}{
+		P:   p,
+		Off: off,
+	}
+	mock.lockReadAt.Lock()
+	mock.calls.ReadAt = append(mock.calls.ReadAt, callInfo)
+	mock.lockReadAt.Unlock()
+	return mock.ReadAtFunc(p, off)
+}
+
+// ReadAtCalls gets all the calls that were made to ReadAt.
+// Check the length with:
+//
+//	len(mockedObject.ReadAtCalls())
+func (mock *ObjectMock) ReadAtCalls() []struct {
+	P   []byte
+	Off int64
+} {
+	var calls []struct {
+		P   []byte
+		Off int64
+	}
+	mock.lockReadAt.RLock()
+	calls = mock.calls.ReadAt
+	mock.lockReadAt.RUnlock()
+	return calls
+}
@@ -0,0 +1,107 @@
+// Package s3 contains a client for S3 compatible object stores, such as the
+// ones Central writes external backups to.
+package s3
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+)
+
+//go:generate go run github.com/matryer/moq@v0.3.1 -out client_moq.go . Client Object
+
+// ErrNewClient represents an error to create a new object store client.
+const ErrNewClient = "cannot create object store client"
+
+// defaultEndpoint is the endpoint of AWS S3.
+const defaultEndpoint = "s3.amazonaws.com"
+
+// Client reads objects of an object store.
+type Client interface {
+	// LatestObject returns the most recently modified object whose key starts
+	// with the supplied prefix, or nil if there is none.
+	LatestObject(ctx context.Context, bucket, prefix string) (*ObjectInfo, error)
+
+	// GetObject opens the supplied object for reading.
+	GetObject(ctx context.Context, bucket, key string) (Object, error)
+}
+
+// ObjectInfo describes an object.
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// An Object is an object opened for reading.
+type Object interface {
+	io.ReaderAt
+	io.Closer
+}
+
+// Config configures a client.
+type Config struct {
+	// Endpoint of the object store. An http:// scheme disables TLS. Defaults
+	// to AWS S3.
+	Endpoint string
+
+	// Region of the bucket.
+	Region string
+
+	// AccessKeyID and SecretAccessKey authenticate with the object store.
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// NewClient creates a new client for the supplied object store.
+func NewClient(cfg Config) (Client, error) {
+	endpoint, secure := cfg.Endpoint, true
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse object store endpoint")
+		}
+		endpoint, secure = u.Host, u.Scheme != "http"
+	}
+
+	c, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		Secure: secure,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, ErrNewClient)
+	}
+	return &client{minio: c}, nil
+}
+
+type client struct {
+	minio *minio.Client
+}
+
+func (c *client) LatestObject(ctx context.Context, bucket, prefix string) (*ObjectInfo, error) {
+	var latest *ObjectInfo
+	for o := range c.minio.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if o.Err != nil {
+			return nil, errors.Wrapf(o.Err, "cannot list objects of bucket %q", bucket)
+		}
+		if latest == nil || o.LastModified.After(latest.LastModified) {
+			latest = &ObjectInfo{Key: o.Key, Size: o.Size, LastModified: o.LastModified}
+		}
+	}
+	return latest, nil
+}
+
+func (c *client) GetObject(ctx context.Context, bucket, key string) (Object, error) {
+	o, err := c.minio.GetObject(ctx, bucket, key, minio.GetObjectOptions{})
+	return o, errors.Wrapf(err, "cannot get object %q of bucket %q", key, bucket)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package iam contains the controllers of the iam API group, which manage
+// service accounts of Red Hat single sign-on.
+package iam
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/iam"
+	"github.com/stehessel/provider-redhat/pkg/controller/providerconfig"
+)
+
+// Setup adds the controllers of the iam API group to the supplied manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+		setupServiceAccount,
+	} {
+		if err := setup(mgr, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setupIAMResource adds a controller that reconciles managed resources of the
+// supplied kind through the service accounts API.
+func setupIAMResource(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj client.Object, external func(iam.Client) managed.ExternalClient) error {
+	return providerconfig.SetupController(mgr, o, gvk, obj, &providerconfig.Connector[iam.Client]{
+		NewClient: func(_ *apisv1alpha1.ProviderConfig, token string) (iam.Client, error) {
+			return iam.NewClient(token, iam.DefaultEndpoint)
+		},
+		ErrNewClient: iam.ErrNewClient,
+		External:     external,
+	})
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/iam/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/iam"
+)
+
+const (
+	errNotServiceAccount     = "managed resource is not a ServiceAccount custom resource"
+	errObserveServiceAccount = "cannot observe service account"
+	errCreateServiceAccount  = "cannot create service account"
+	errUpdateServiceAccount  = "cannot update service account"
+	errResetServiceAccount   = "cannot reset service account secret"
+	errDeleteServiceAccount  = "cannot delete service account"
+)
+
+// setupServiceAccount adds a controller that reconciles ServiceAccount managed
+// resources.
+func setupServiceAccount(mgr ctrl.Manager, o controller.Options) error {
+	return setupIAMResource(mgr, o, v1alpha1.ServiceAccountGroupVersionKind, &v1alpha1.ServiceAccount{},
+		func(c iam.Client) managed.ExternalClient {
+			return &serviceAccountExternal{client: c, now: time.Now}
+		})
+}
+
+// A serviceAccountExternal creates, updates and deletes service accounts. The
+// secret of a service account is rotated by resetting it.
+type serviceAccountExternal struct {
+	client iam.ServiceAccountAPI
+	now    func() time.Time
+}
+
+// generateServiceAccountObservation returns the observation of the supplied
+// service account. The API does not report when the secret was last reset, so
+// it is carried over from the supplied previous observation.
+func generateServiceAccountObservation(in *iam.ServiceAccount, prev v1alpha1.ServiceAccountObservation) v1alpha1.ServiceAccountObservation {
+	o := v1alpha1.ServiceAccountObservation{
+		ID:            in.ID,
+		ClientID:      in.ClientID,
+		CreatedBy:     in.CreatedBy,
+		SecretResetAt: prev.SecretResetAt,
+	}
+	if in.CreatedAt != 0 {
+		t := metav1.NewTime(time.Unix(in.CreatedAt, 0))
+		o.CreatedAt = &t
+	}
+	return o
+}
+
+// rotateAt returns the time at which the secret of the supplied service
+// account is due for rotation, or false if it is never rotated. The first
+// secret is issued when the service account is created.
+func rotateAt(cr *v1alpha1.ServiceAccount) (time.Time, bool) {
+	ra := cr.Spec.ForProvider.RotateAfter
+	if ra == nil {
+		return time.Time{}, false
+	}
+	issued := cr.Status.AtProvider.SecretResetAt
+	if issued == nil {
+		issued = cr.Status.AtProvider.CreatedAt
+	}
+	if issued == nil {
+		return time.Time{}, false
+	}
+	return issued.Add(ra.Duration), true
+}
+
+// isSecretDue returns true if the secret of the supplied service account is
+// due for rotation.
+func (c *serviceAccountExternal) isSecretDue(cr *v1alpha1.ServiceAccount) bool {
+	at, ok := rotateAt(cr)
+	return ok && !c.now().Before(at)
+}
+
+func (c *serviceAccountExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccount)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceAccount)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	sa, err := c.client.GetServiceAccount(ctx, id)
+	if iam.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveServiceAccount)
+	}
+
+	cr.Status.AtProvider = generateServiceAccountObservation(sa, cr.Status.AtProvider)
+	cr.SetConditions(xpv1.Available())
+
+	obs := managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{v1alpha1.ConnectionKeyClientID: []byte(sa.ClientID)},
+	}
+	desired := []string{cr.Spec.ForProvider.Name, cr.Spec.ForProvider.Description}
+	actual := []string{sa.Name, sa.Description}
+	if diff := cmp.Diff(desired, actual, cmpopts.EquateEmpty()); diff != "" {
+		obs.ResourceUpToDate = false
+		obs.Diff = "Observed difference in service account\n" + diff
+	} else if c.isSecretDue(cr) {
+		at, _ := rotateAt(cr)
+		obs.ResourceUpToDate = false
+		obs.Diff = fmt.Sprintf("Service account secret is due for rotation since %s", at.UTC().Format(time.RFC3339))
+	}
+	return obs, nil
+}
+
+// getServiceAccountConnectionDetails returns the client credentials of the
+// supplied service account.
+func getServiceAccountConnectionDetails(sa *iam.ServiceAccount) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyClientID:     []byte(sa.ClientID),
+		v1alpha1.ConnectionKeyClientSecret: []byte(sa.Secret),
+	}
+}
+
+func (c *serviceAccountExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccount)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceAccount)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	sa, err := c.client.CreateServiceAccount(ctx, &iam.ServiceAccount{
+		Name:        cr.Spec.ForProvider.Name,
+		Description: cr.Spec.ForProvider.Description,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateServiceAccount)
+	}
+	meta.SetExternalName(cr, sa.ID)
+	return managed.ExternalCreation{ConnectionDetails: getServiceAccountConnectionDetails(sa)}, nil
+}
+
+// Update updates the name and description of the service account, and resets
+// its secret once it is due for rotation. The previous secret stops working
+// as soon as it is reset, so clients must pick up the new secret from the
+// connection secret.
+func (c *serviceAccountExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccount)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServiceAccount)
+	}
+	id := meta.GetExternalName(cr)
+
+	if _, err := c.client.UpdateServiceAccount(ctx, &iam.ServiceAccount{
+		ID:          id,
+		Name:        cr.Spec.ForProvider.Name,
+		Description: cr.Spec.ForProvider.Description,
+	}); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateServiceAccount)
+	}
+	if !c.isSecretDue(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	sa, err := c.client.ResetServiceAccountSecret(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errResetServiceAccount)
+	}
+	now := metav1.NewTime(c.now())
+	cr.Status.AtProvider.SecretResetAt = &now
+	return managed.ExternalUpdate{ConnectionDetails: getServiceAccountConnectionDetails(sa)}, nil
+}
+
+func (c *serviceAccountExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServiceAccount)
+	if !ok {
+		return errors.New(errNotServiceAccount)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteServiceAccount(ctx, meta.GetExternalName(cr))
+	if iam.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteServiceAccount)
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/iam/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/iam"
+)
+
+var _ managed.ExternalClient = &serviceAccountExternal{}
+
+var (
+	saID        = "sa-id"
+	saClientID  = "client-id"
+	saName      = "ci"
+	saCreatedAt = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	saNow       = saCreatedAt.Add(10 * 24 * time.Hour)
+)
+
+type serviceAccountModifier func(*v1alpha1.ServiceAccount)
+
+func withSAConditions(c ...xpv1.Condition) serviceAccountModifier {
+	return func(sa *v1alpha1.ServiceAccount) { sa.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSAExternalName(id string) serviceAccountModifier {
+	return func(sa *v1alpha1.ServiceAccount) { meta.SetExternalName(sa, id) }
+}
+
+func withSAObservation(o v1alpha1.ServiceAccountObservation) serviceAccountModifier {
+	return func(sa *v1alpha1.ServiceAccount) { sa.Status.AtProvider = o }
+}
+
+func withSARotateAfter(d time.Duration) serviceAccountModifier {
+	return func(sa *v1alpha1.ServiceAccount) {
+		sa.Spec.ForProvider.RotateAfter = &metav1.Duration{Duration: d}
+	}
+}
+
+func serviceAccount(mod ...serviceAccountModifier) *v1alpha1.ServiceAccount {
+	sa := &v1alpha1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: saName},
+		Spec: v1alpha1.ServiceAccountSpec{
+			ForProvider: v1alpha1.ServiceAccountParameters{
+				Name:        saName,
+				Description: "continuous integration",
+			},
+		},
+	}
+	for _, m := range mod {
+		m(sa)
+	}
+	return sa
+}
+
+func observedServiceAccount() *iam.ServiceAccount {
+	return &iam.ServiceAccount{
+		ID:          saID,
+		ClientID:    saClientID,
+		Name:        saName,
+		Description: "continuous integration",
+		CreatedBy:   "admin",
+		CreatedAt:   saCreatedAt.Unix(),
+	}
+}
+
+func saObservation(resetAt *time.Time) v1alpha1.ServiceAccountObservation {
+	createdAt := metav1.NewTime(saCreatedAt.Local())
+	o := v1alpha1.ServiceAccountObservation{ID: saID, ClientID: saClientID, CreatedBy: "admin", CreatedAt: &createdAt}
+	if resetAt != nil {
+		t := metav1.NewTime(*resetAt)
+		o.SecretResetAt = &t
+	}
+	return o
+}
+
+func TestServiceAccountObserve(t *testing.T) {
+	recentReset := saNow.Add(-24 * time.Hour)
+	clientIDDetails := managed.ConnectionDetails{v1alpha1.ConnectionKeyClientID: []byte(saClientID)}
+
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name string
+		mg   *v1alpha1.ServiceAccount
+		sa   *iam.ServiceAccount
+		err  error
+		want want
+	}{
+		{
+			name: "no external name",
+			mg:   serviceAccount(),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  serviceAccount(),
+			},
+		},
+		{
+			name: "service account up to date",
+			mg:   serviceAccount(withSAExternalName(saID)),
+			sa:   observedServiceAccount(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: clientIDDetails},
+				mg: serviceAccount(withSAExternalName(saID), withSAConditions(xpv1.Available()),
+					withSAObservation(saObservation(nil))),
+			},
+		},
+		{
+			name: "description changed",
+			mg:   serviceAccount(withSAExternalName(saID)),
+			sa: func() *iam.ServiceAccount {
+				sa := observedServiceAccount()
+				sa.Description = "old"
+				return sa
+			}(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: clientIDDetails},
+				mg: serviceAccount(withSAExternalName(saID), withSAConditions(xpv1.Available()),
+					withSAObservation(saObservation(nil))),
+			},
+		},
+		{
+			name: "secret issued at creation due for rotation",
+			mg:   serviceAccount(withSAExternalName(saID), withSARotateAfter(7*24*time.Hour)),
+			sa:   observedServiceAccount(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: clientIDDetails},
+				mg: serviceAccount(withSAExternalName(saID), withSARotateAfter(7*24*time.Hour),
+					withSAConditions(xpv1.Available()), withSAObservation(saObservation(nil))),
+			},
+		},
+		{
+			name: "recently reset secret not due for rotation",
+			mg: serviceAccount(withSAExternalName(saID), withSARotateAfter(7*24*time.Hour),
+				withSAObservation(saObservation(&recentReset))),
+			sa: observedServiceAccount(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: clientIDDetails},
+				mg: serviceAccount(withSAExternalName(saID), withSARotateAfter(7*24*time.Hour),
+					withSAConditions(xpv1.Available()), withSAObservation(saObservation(&recentReset))),
+			},
+		},
+		{
+			name: "service account not found",
+			mg:   serviceAccount(withSAExternalName(saID)),
+			err:  &iam.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  serviceAccount(withSAExternalName(saID)),
+			},
+		},
+		{
+			name: "get error",
+			mg:   serviceAccount(withSAExternalName(saID)),
+			err:  errors.New("boom"),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  serviceAccount(withSAExternalName(saID)),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := serviceAccountExternal{
+				client: &iam.ServiceAccountAPIMock{
+					GetServiceAccountFunc: func(ctx context.Context, id string) (*iam.ServiceAccount, error) {
+						return tc.sa, tc.err
+					},
+				},
+				now: func() time.Time { return saNow },
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccountCreate(t *testing.T) {
+	var req *iam.ServiceAccount
+	e := serviceAccountExternal{
+		client: &iam.ServiceAccountAPIMock{
+			CreateServiceAccountFunc: func(ctx context.Context, sa *iam.ServiceAccount) (*iam.ServiceAccount, error) {
+				req = sa
+				out := observedServiceAccount()
+				out.Secret = "secret"
+				return out, nil
+			},
+		},
+		now: func() time.Time { return saNow },
+	}
+	mg := serviceAccount()
+	got, err := e.Create(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %s\n", err)
+	}
+
+	wantReq := &iam.ServiceAccount{Name: saName, Description: "continuous integration"}
+	if diff := cmp.Diff(wantReq, req); diff != "" {
+		t.Errorf("\ne.Create(...): -want request, +got request:\n%s\n", diff)
+	}
+	want := managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyClientID:     []byte(saClientID),
+		v1alpha1.ConnectionKeyClientSecret: []byte("secret"),
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(serviceAccount(withSAConditions(xpv1.Creating()), withSAExternalName(saID)), mg); diff != "" {
+		t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestServiceAccountUpdate(t *testing.T) {
+	type want struct {
+		upd     managed.ExternalUpdate
+		mg      resource.Managed
+		updated bool
+		reset   bool
+		err     error
+	}
+
+	cases := []struct {
+		name     string
+		mg       *v1alpha1.ServiceAccount
+		resetErr error
+		want     want
+	}{
+		{
+			name: "description updated",
+			mg:   serviceAccount(withSAExternalName(saID), withSAObservation(saObservation(nil))),
+			want: want{
+				mg:      serviceAccount(withSAExternalName(saID), withSAObservation(saObservation(nil))),
+				updated: true,
+			},
+		},
+		{
+			name: "secret rotated",
+			mg: serviceAccount(withSAExternalName(saID), withSARotateAfter(7*24*time.Hour),
+				withSAObservation(saObservation(nil))),
+			want: want{
+				upd: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					v1alpha1.ConnectionKeyClientID:     []byte(saClientID),
+					v1alpha1.ConnectionKeyClientSecret: []byte("new-secret"),
+				}},
+				mg: serviceAccount(withSAExternalName(saID), withSARotateAfter(7*24*time.Hour),
+					withSAObservation(saObservation(&saNow))),
+				updated: true,
+				reset:   true,
+			},
+		},
+		{
+			name: "reset error",
+			mg: serviceAccount(withSAExternalName(saID), withSARotateAfter(7*24*time.Hour),
+				withSAObservation(saObservation(nil))),
+			resetErr: errors.New("boom"),
+			want: want{
+				mg: serviceAccount(withSAExternalName(saID), withSARotateAfter(7*24*time.Hour),
+					withSAObservation(saObservation(nil))),
+				updated: true,
+				reset:   true,
+				err:     cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated, reset := false, false
+			e := serviceAccountExternal{
+				client: &iam.ServiceAccountAPIMock{
+					UpdateServiceAccountFunc: func(ctx context.Context, sa *iam.ServiceAccount) (*iam.ServiceAccount, error) {
+						updated = true
+						want := &iam.ServiceAccount{ID: saID, Name: saName, Description: "continuous integration"}
+						if diff := cmp.Diff(want, sa); diff != "" {
+							t.Errorf("\ne.Update(...): -want request, +got request:\n%s\n", diff)
+						}
+						return observedServiceAccount(), nil
+					},
+					ResetServiceAccountSecretFunc: func(ctx context.Context, id string) (*iam.ServiceAccount, error) {
+						reset = true
+						if tc.resetErr != nil {
+							return nil, tc.resetErr
+						}
+						out := observedServiceAccount()
+						out.Secret = "new-secret"
+						return out, nil
+					},
+				},
+				now: func() time.Time { return saNow },
+			}
+			got, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.upd, got); diff != "" {
+				t.Errorf("\ne.Update(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\ne.Update(...): -want, +got:\n%s\n", diff)
+			}
+			if tc.want.updated != updated || tc.want.reset != reset {
+				t.Errorf("\ne.Update(...): updated %t, reset %t, want updated %t, reset %t\n", updated, reset, tc.want.updated, tc.want.reset)
+			}
+		})
+	}
+}
+
+func TestServiceAccountDelete(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "deleted"},
+		{name: "already gone", err: &iam.APIError{StatusCode: http.StatusNotFound}},
+		{name: "delete error", err: errors.New("boom"), want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := serviceAccountExternal{client: &iam.ServiceAccountAPIMock{
+				DeleteServiceAccountFunc: func(ctx context.Context, id string) error {
+					if id != saID {
+						t.Errorf("\ne.Delete(...): deleted %q, want %q\n", id, saID)
+					}
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), serviceAccount(withSAExternalName(saID)))
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
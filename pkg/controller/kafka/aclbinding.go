@@ -0,0 +1,235 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/kafka/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/kafka"
+)
+
+const (
+	errNotACLBinding     = "managed resource is not an ACLBinding custom resource"
+	errObserveACLBinding = "cannot observe ACL binding"
+	errCreateACLBinding  = "cannot create ACL binding"
+	errUpdateACLBinding  = "cannot update ACL binding"
+	errDeleteACLBinding  = "cannot delete ACL binding"
+)
+
+// setupACLBinding adds a controller that reconciles ACLBinding managed
+// resources.
+func setupACLBinding(mgr ctrl.Manager, o controller.Options) error {
+	return setupKafkaResource(mgr, o, v1alpha1.ACLBindingGroupVersionKind, &v1alpha1.ACLBinding{},
+		func(c kafka.Client) managed.ExternalClient {
+			return &aclBindingExternal{client: c, instance: func(adminURL string) kafka.ACLAPI {
+				return c.Instance(adminURL)
+			}}
+		})
+}
+
+// An aclBindingExternal creates and deletes the ACL bindings of Kafka
+// instances through their admin API. ACL bindings are identified by all of
+// their fields, so the observation records the binding that exists, and a
+// binding that differs from the desired one is replaced.
+type aclBindingExternal struct {
+	client kafka.KafkaAPI
+	// instance returns a client for the admin API served at the supplied
+	// URL.
+	instance func(adminURL string) kafka.ACLAPI
+}
+
+// acls returns a client for the ACL bindings of the Kafka instance of the
+// supplied ACLBinding.
+func (c *aclBindingExternal) acls(ctx context.Context, cr *v1alpha1.ACLBinding) (kafka.ACLAPI, error) {
+	u, err := getAdminAPIURL(ctx, c.client, cr.Spec.ForProvider.KafkaInstanceID)
+	if err != nil {
+		return nil, err
+	}
+	return c.instance(u), nil
+}
+
+func generateACLBinding(p v1alpha1.ACLBindingParameters) kafka.ACLBinding {
+	return kafka.ACLBinding{
+		ResourceType: string(p.ResourceType),
+		ResourceName: p.ResourceName,
+		PatternType:  string(p.PatternType),
+		Principal:    p.Principal,
+		Operation:    string(p.Operation),
+		Permission:   string(p.Permission),
+	}
+}
+
+func generateACLBindingObservation(b kafka.ACLBinding) v1alpha1.ACLBindingObservation {
+	return v1alpha1.ACLBindingObservation{
+		ResourceType: v1alpha1.ACLResourceType(b.ResourceType),
+		ResourceName: b.ResourceName,
+		PatternType:  v1alpha1.ACLPatternType(b.PatternType),
+		Principal:    b.Principal,
+		Operation:    v1alpha1.ACLOperation(b.Operation),
+		Permission:   v1alpha1.ACLPermission(b.Permission),
+	}
+}
+
+// getBoundACLBinding returns the ACL binding last observed for the supplied
+// ACLBinding, or nil if none was observed yet.
+func getBoundACLBinding(cr *v1alpha1.ACLBinding) *kafka.ACLBinding {
+	o := cr.Status.AtProvider
+	if o.ResourceType == "" {
+		return nil
+	}
+	return &kafka.ACLBinding{
+		ResourceType: string(o.ResourceType),
+		ResourceName: o.ResourceName,
+		PatternType:  string(o.PatternType),
+		Principal:    o.Principal,
+		Operation:    string(o.Operation),
+		Permission:   string(o.Permission),
+	}
+}
+
+// hasACLBinding returns true if the supplied ACL binding exists.
+func hasACLBinding(ctx context.Context, acls kafka.ACLAPI, b kafka.ACLBinding) (bool, error) {
+	bindings, err := acls.ListACLBindings(ctx, b)
+	if err != nil {
+		return false, err
+	}
+	for _, it := range bindings {
+		if it == b {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *aclBindingExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ACLBinding)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotACLBinding)
+	}
+
+	acls, err := c.acls(ctx, cr)
+	if kafka.IsNotFound(err) {
+		// ACL bindings are deleted with their Kafka instance.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveACLBinding)
+	}
+
+	// The binding observed before is looked up first, so that it is replaced
+	// if the desired binding changed.
+	desired := generateACLBinding(cr.Spec.ForProvider)
+	candidates := []kafka.ACLBinding{desired}
+	if bound := getBoundACLBinding(cr); bound != nil && *bound != desired {
+		candidates = []kafka.ACLBinding{*bound, desired}
+	}
+	for _, b := range candidates {
+		exists, err := hasACLBinding(ctx, acls, b)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errObserveACLBinding)
+		}
+		if !exists {
+			continue
+		}
+
+		cr.Status.AtProvider = generateACLBindingObservation(b)
+		cr.SetConditions(xpv1.Available())
+		obs := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}
+		if diff := cmp.Diff(desired, b); diff != "" {
+			obs.ResourceUpToDate = false
+			obs.Diff = "Observed difference in ACL binding\n" + diff
+		}
+		return obs, nil
+	}
+	return managed.ExternalObservation{ResourceExists: false}, nil
+}
+
+func (c *aclBindingExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ACLBinding)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotACLBinding)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	acls, err := c.acls(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateACLBinding)
+	}
+	err = acls.CreateACLBinding(ctx, generateACLBinding(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateACLBinding)
+}
+
+// Update replaces the observed ACL binding with the desired one. The desired
+// binding is created first, so that a principal does not lose access while
+// its binding is replaced.
+func (c *aclBindingExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ACLBinding)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotACLBinding)
+	}
+
+	acls, err := c.acls(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateACLBinding)
+	}
+	desired := generateACLBinding(cr.Spec.ForProvider)
+	if err := acls.CreateACLBinding(ctx, desired); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateACLBinding)
+	}
+	if bound := getBoundACLBinding(cr); bound != nil && *bound != desired {
+		if err := acls.DeleteACLBindings(ctx, *bound); err != nil && !kafka.IsNotFound(err) {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateACLBinding)
+		}
+	}
+	cr.Status.AtProvider = generateACLBindingObservation(desired)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *aclBindingExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ACLBinding)
+	if !ok {
+		return errors.New(errNotACLBinding)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	acls, err := c.acls(ctx, cr)
+	if kafka.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errDeleteACLBinding)
+	}
+	b := generateACLBinding(cr.Spec.ForProvider)
+	if bound := getBoundACLBinding(cr); bound != nil {
+		b = *bound
+	}
+	err = acls.DeleteACLBindings(ctx, b)
+	if kafka.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteACLBinding)
+}
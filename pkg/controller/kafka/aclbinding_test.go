@@ -0,0 +1,248 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/kafka/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/kafka"
+)
+
+var _ managed.ExternalClient = &aclBindingExternal{}
+
+type aclBindingModifier func(*v1alpha1.ACLBinding)
+
+func withACLConditions(c ...xpv1.Condition) aclBindingModifier {
+	return func(b *v1alpha1.ACLBinding) { b.Status.ConditionedStatus.Conditions = c }
+}
+
+func withACLOperation(op v1alpha1.ACLOperation) aclBindingModifier {
+	return func(b *v1alpha1.ACLBinding) { b.Spec.ForProvider.Operation = op }
+}
+
+func withACLObservation(b kafka.ACLBinding) aclBindingModifier {
+	return func(cr *v1alpha1.ACLBinding) { cr.Status.AtProvider = generateACLBindingObservation(b) }
+}
+
+func aclBinding(mod ...aclBindingModifier) *v1alpha1.ACLBinding {
+	b := &v1alpha1.ACLBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders-read"},
+		Spec: v1alpha1.ACLBindingSpec{
+			ForProvider: v1alpha1.ACLBindingParameters{
+				KafkaInstanceID: kafkaID,
+				ResourceType:    "TOPIC",
+				ResourceName:    topicName,
+				PatternType:     "LITERAL",
+				Principal:       "User:client-id",
+				Operation:       "READ",
+				Permission:      "ALLOW",
+			},
+		},
+	}
+	for _, m := range mod {
+		m(b)
+	}
+	return b
+}
+
+func binding(operation string) kafka.ACLBinding {
+	return kafka.ACLBinding{
+		ResourceType: "TOPIC",
+		ResourceName: topicName,
+		PatternType:  "LITERAL",
+		Principal:    "User:client-id",
+		Operation:    operation,
+		Permission:   "ALLOW",
+	}
+}
+
+// aclAPI returns an ACL API of a Kafka instance with the supplied bindings.
+func aclAPI(bindings ...kafka.ACLBinding) *kafka.ACLAPIMock {
+	return &kafka.ACLAPIMock{
+		ListACLBindingsFunc: func(ctx context.Context, filter kafka.ACLBinding) ([]kafka.ACLBinding, error) {
+			var matches []kafka.ACLBinding
+			for _, b := range bindings {
+				if b.Operation == filter.Operation {
+					matches = append(matches, b)
+				}
+			}
+			return matches, nil
+		},
+		CreateACLBindingFunc: func(ctx context.Context, b kafka.ACLBinding) error {
+			return nil
+		},
+		DeleteACLBindingsFunc: func(ctx context.Context, filter kafka.ACLBinding) error {
+			return nil
+		},
+	}
+}
+
+func TestACLBindingObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name     string
+		mg       *v1alpha1.ACLBinding
+		bindings []kafka.ACLBinding
+		want     want
+	}{
+		{
+			name:     "binding exists",
+			mg:       aclBinding(),
+			bindings: []kafka.ACLBinding{binding("READ")},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg:  aclBinding(withACLConditions(xpv1.Available()), withACLObservation(binding("READ"))),
+			},
+		},
+		{
+			name: "binding does not exist",
+			mg:   aclBinding(),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  aclBinding(),
+			},
+		},
+		{
+			name:     "operation changed",
+			mg:       aclBinding(withACLOperation("WRITE"), withACLObservation(binding("READ"))),
+			bindings: []kafka.ACLBinding{binding("READ")},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg: aclBinding(withACLOperation("WRITE"), withACLConditions(xpv1.Available()),
+					withACLObservation(binding("READ"))),
+			},
+		},
+		{
+			name:     "previous binding already replaced",
+			mg:       aclBinding(withACLOperation("WRITE"), withACLObservation(binding("READ"))),
+			bindings: []kafka.ACLBinding{binding("WRITE")},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: aclBinding(withACLOperation("WRITE"), withACLConditions(xpv1.Available()),
+					withACLObservation(binding("WRITE"))),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := aclBindingExternal{
+				client:   kafkaAPI(t, kafka.KafkaRequestStatusReady, nil),
+				instance: func(adminURL string) kafka.ACLAPI { return aclAPI(tc.bindings...) },
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestACLBindingCreate(t *testing.T) {
+	m := aclAPI()
+	e := aclBindingExternal{
+		client:   kafkaAPI(t, kafka.KafkaRequestStatusReady, nil),
+		instance: func(adminURL string) kafka.ACLAPI { return m },
+	}
+	if _, err := e.Create(context.Background(), aclBinding()); err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %s\n", err)
+	}
+	if diff := cmp.Diff(binding("READ"), m.CreateACLBindingCalls()[0].B); diff != "" {
+		t.Errorf("\ne.Create(...): -want binding, +got binding:\n%s\n", diff)
+	}
+}
+
+func TestACLBindingUpdate(t *testing.T) {
+	m := aclAPI(binding("READ"))
+	e := aclBindingExternal{
+		client:   kafkaAPI(t, kafka.KafkaRequestStatusReady, nil),
+		instance: func(adminURL string) kafka.ACLAPI { return m },
+	}
+	mg := aclBinding(withACLOperation("WRITE"), withACLObservation(binding("READ")))
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Update(...): unexpected error: %s\n", err)
+	}
+
+	if got := m.CreateACLBindingCalls(); len(got) != 1 || got[0].B != binding("WRITE") {
+		t.Errorf("\ne.Update(...): created %v, want %v\n", got, binding("WRITE"))
+	}
+	if got := m.DeleteACLBindingsCalls(); len(got) != 1 || got[0].Filter != binding("READ") {
+		t.Errorf("\ne.Update(...): deleted %v, want %v\n", got, binding("READ"))
+	}
+	if diff := cmp.Diff(aclBinding(withACLOperation("WRITE"), withACLObservation(binding("WRITE"))), mg); diff != "" {
+		t.Errorf("\ne.Update(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestACLBindingDelete(t *testing.T) {
+	cases := []struct {
+		name     string
+		kafkaErr error
+		err      error
+		want     error
+	}{
+		{name: "deleted"},
+		{name: "instance gone", kafkaErr: &kafka.APIError{StatusCode: http.StatusNotFound}},
+		{name: "delete error", err: errors.New("boom"), want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := aclBindingExternal{
+				client: kafkaAPI(t, kafka.KafkaRequestStatusReady, tc.kafkaErr),
+				instance: func(adminURL string) kafka.ACLAPI {
+					return &kafka.ACLAPIMock{
+						DeleteACLBindingsFunc: func(ctx context.Context, filter kafka.ACLBinding) error {
+							if filter != binding("READ") {
+								t.Errorf("\ne.Delete(...): deleted %v, want %v\n", filter, binding("READ"))
+							}
+							return tc.err
+						},
+					}
+				},
+			}
+			err := e.Delete(context.Background(), aclBinding(withACLObservation(binding("READ"))))
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
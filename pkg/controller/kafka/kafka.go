@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kafka contains the controllers of the kafka API group, which manage
+// Red Hat OpenShift Streams for Apache Kafka instances, and their topics and
+// ACL bindings.
+package kafka
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/kafka"
+	"github.com/stehessel/provider-redhat/pkg/controller/providerconfig"
+)
+
+const (
+	errGetKafkaInstance      = "cannot get Kafka instance"
+	errKafkaInstanceNotReady = "Kafka instance is not ready"
+)
+
+// Setup adds the controllers of the kafka API group to the supplied manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+		setupKafkaInstance,
+		setupTopic,
+		setupACLBinding,
+	} {
+		if err := setup(mgr, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setupKafkaResource adds a controller that reconciles managed resources of
+// the supplied kind through the Kafka management API, or the admin API of a
+// Kafka instance.
+func setupKafkaResource(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj client.Object, external func(kafka.Client) managed.ExternalClient) error {
+	return providerconfig.SetupController(mgr, o, gvk, obj, &providerconfig.Connector[kafka.Client]{
+		NewClient: func(pc *apisv1alpha1.ProviderConfig, token string) (kafka.Client, error) {
+			return kafka.NewClient(token, pc.Spec.Gateway)
+		},
+		ErrNewClient: kafka.ErrNewClient,
+		External:     external,
+	})
+}
+
+// getAdminAPIURL returns the URL of the admin API of the Kafka instance with
+// the supplied ID, which is only served once the instance is ready. The
+// returned error is a not found error if the instance does not exist.
+func getAdminAPIURL(ctx context.Context, c kafka.KafkaAPI, id string) (string, error) {
+	k, err := c.GetKafka(ctx, id)
+	if err != nil {
+		return "", errors.Wrap(err, errGetKafkaInstance)
+	}
+	if k.Status != kafka.KafkaRequestStatusReady || k.AdminAPIServerURL == "" {
+		return "", errors.Errorf("%s: %s", errKafkaInstanceNotReady, k.Status)
+	}
+	return k.AdminAPIServerURL, nil
+}
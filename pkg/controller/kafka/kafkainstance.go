@@ -0,0 +1,215 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/kafka/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/kafka"
+)
+
+const (
+	errNotKafkaInstance     = "managed resource is not a KafkaInstance custom resource"
+	errObserveKafkaInstance = "cannot observe Kafka instance"
+	errCreateKafkaInstance  = "cannot create Kafka instance"
+	errUpdateKafkaInstance  = "cannot update Kafka instance"
+	errDeleteKafkaInstance  = "cannot delete Kafka instance"
+)
+
+// setupKafkaInstance adds a controller that reconciles KafkaInstance managed
+// resources.
+func setupKafkaInstance(mgr ctrl.Manager, o controller.Options) error {
+	return setupKafkaResource(mgr, o, v1alpha1.KafkaInstanceGroupVersionKind, &v1alpha1.KafkaInstance{},
+		func(c kafka.Client) managed.ExternalClient {
+			return &kafkaInstanceExternal{client: c}
+		})
+}
+
+// A kafkaInstanceExternal creates and deletes Kafka instances. Kafka instances
+// are found by their name, which is also their external name.
+type kafkaInstanceExternal struct {
+	client kafka.KafkaAPI
+}
+
+func toMetaTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	mt := metav1.NewTime(*t)
+	return &mt
+}
+
+func generateKafkaInstanceObservation(in *kafka.Kafka) v1alpha1.KafkaInstanceObservation {
+	return v1alpha1.KafkaInstanceObservation{
+		ID:                  in.ID,
+		HRef:                in.Href,
+		Status:              in.Status,
+		FailedReason:        in.FailedReason,
+		BootstrapServerHost: in.BootstrapServerHost,
+		AdminAPIServerURL:   in.AdminAPIServerURL,
+		InstanceType:        in.InstanceType,
+		Owner:               in.Owner,
+		Version:             in.Version,
+		CreatedAt:           toMetaTime(in.CreatedAt),
+		UpdatedAt:           toMetaTime(in.UpdatedAt),
+	}
+}
+
+func getKafkaInstanceCondition(status string) xpv1.Condition {
+	switch status {
+	case kafka.KafkaRequestStatusAccepted,
+		kafka.KafkaRequestStatusPreparing,
+		kafka.KafkaRequestStatusProvisioning:
+		return xpv1.Creating()
+	case kafka.KafkaRequestStatusReady:
+		return xpv1.Available()
+	case kafka.KafkaRequestStatusDeprovision,
+		kafka.KafkaRequestStatusDeleting:
+		return xpv1.Deleting()
+	default:
+		return xpv1.Unavailable()
+	}
+}
+
+func isKafkaInstanceUpToDate(in *v1alpha1.KafkaInstance, observed *kafka.Kafka) (bool, string) {
+	observedParams := v1alpha1.KafkaInstanceParameters{
+		Name:          observed.Name,
+		CloudProvider: v1alpha1.CloudProvider(observed.CloudProvider),
+		Region:        observed.Region,
+		MultiAZ:       observed.MultiAZ,
+	}
+	if diff := cmp.Diff(in.Spec.ForProvider, observedParams, cmpopts.EquateEmpty()); diff != "" {
+		return false, "Observed difference in Kafka instance\n" + diff
+	}
+	return true, ""
+}
+
+// getKafkaInstance returns the Kafka instance with the name of the supplied
+// KafkaInstance, or nil if there is none.
+func (c *kafkaInstanceExternal) getKafkaInstance(ctx context.Context, cr *v1alpha1.KafkaInstance) (*kafka.Kafka, error) {
+	name := cr.Spec.ForProvider.Name
+	kafkas, err := c.client.ListKafkas(ctx, fmt.Sprintf("name = %s", name))
+	if err != nil {
+		return nil, err
+	}
+	for i := range kafkas {
+		if kafkas[i].Name == name {
+			return &kafkas[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *kafkaInstanceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.KafkaInstance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKafkaInstance)
+	}
+
+	k, err := c.getKafkaInstance(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveKafkaInstance)
+	}
+	if k == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = generateKafkaInstanceObservation(k)
+	cr.SetConditions(getKafkaInstanceCondition(k.Status))
+	meta.SetExternalName(cr, k.Name)
+	upToDate, diff := isKafkaInstanceUpToDate(cr, k)
+
+	var cd managed.ConnectionDetails
+	if k.BootstrapServerHost != "" {
+		cd = managed.ConnectionDetails{v1alpha1.ConnectionKeyBootstrapServerHost: []byte(k.BootstrapServerHost)}
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		Diff:              diff,
+		ConnectionDetails: cd,
+	}, nil
+}
+
+func (c *kafkaInstanceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.KafkaInstance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotKafkaInstance)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	k, err := c.client.CreateKafka(ctx, kafka.KafkaRequestPayload{
+		Name:          cr.Spec.ForProvider.Name,
+		CloudProvider: string(cr.Spec.ForProvider.CloudProvider),
+		Region:        cr.Spec.ForProvider.Region,
+		MultiAZ:       cr.Spec.ForProvider.MultiAZ,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateKafkaInstance)
+	}
+	meta.SetExternalName(cr, k.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update deletes a Kafka instance that differs from the desired one, so that
+// it is created again once it is gone. Kafka instances cannot be changed, and
+// are left alone while they are created or deleted.
+func (c *kafkaInstanceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.KafkaInstance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotKafkaInstance)
+	}
+	if cond := cr.GetCondition(xpv1.TypeReady); cond.Equal(xpv1.Creating()) || cond.Equal(xpv1.Deleting()) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	err := c.Delete(ctx, mg)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateKafkaInstance)
+}
+
+func (c *kafkaInstanceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.KafkaInstance)
+	if !ok {
+		return errors.New(errNotKafkaInstance)
+	}
+	mg.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == kafka.KafkaRequestStatusDeprovision ||
+		cr.Status.AtProvider.Status == kafka.KafkaRequestStatusDeleting {
+		return nil
+	}
+
+	err := c.client.DeleteKafka(ctx, cr.Status.AtProvider.ID)
+	if kafka.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteKafkaInstance)
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/kafka/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/kafka"
+)
+
+var _ managed.ExternalClient = &kafkaInstanceExternal{}
+
+var (
+	kafkaID   = "kafka-id"
+	kafkaName = "events"
+)
+
+type kafkaInstanceModifier func(*v1alpha1.KafkaInstance)
+
+func withKafkaConditions(c ...xpv1.Condition) kafkaInstanceModifier {
+	return func(k *v1alpha1.KafkaInstance) { k.Status.ConditionedStatus.Conditions = c }
+}
+
+func withKafkaExternalName(name string) kafkaInstanceModifier {
+	return func(k *v1alpha1.KafkaInstance) { meta.SetExternalName(k, name) }
+}
+
+func withKafkaObservation(o v1alpha1.KafkaInstanceObservation) kafkaInstanceModifier {
+	return func(k *v1alpha1.KafkaInstance) { k.Status.AtProvider = o }
+}
+
+func kafkaInstance(mod ...kafkaInstanceModifier) *v1alpha1.KafkaInstance {
+	k := &v1alpha1.KafkaInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: kafkaName},
+		Spec: v1alpha1.KafkaInstanceSpec{
+			ForProvider: v1alpha1.KafkaInstanceParameters{
+				Name:          kafkaName,
+				CloudProvider: "aws",
+				Region:        "us-east-1",
+				MultiAZ:       true,
+			},
+		},
+	}
+	for _, m := range mod {
+		m(k)
+	}
+	return k
+}
+
+func observedKafka(status string) *kafka.Kafka {
+	k := &kafka.Kafka{
+		ID:            kafkaID,
+		Name:          kafkaName,
+		CloudProvider: "aws",
+		Region:        "us-east-1",
+		MultiAZ:       true,
+		Status:        status,
+	}
+	if status == kafka.KafkaRequestStatusReady {
+		k.BootstrapServerHost = "events.kafka.example.com:443"
+		k.AdminAPIServerURL = "https://admin-server-events.kafka.example.com"
+	}
+	return k
+}
+
+func TestKafkaInstanceObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name   string
+		kafkas []kafka.Kafka
+		err    error
+		want   want
+	}{
+		{
+			name:   "instance provisioning",
+			kafkas: []kafka.Kafka{*observedKafka(kafka.KafkaRequestStatusProvisioning)},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: kafkaInstance(withKafkaExternalName(kafkaName), withKafkaConditions(xpv1.Creating()),
+					withKafkaObservation(v1alpha1.KafkaInstanceObservation{ID: kafkaID, Status: kafka.KafkaRequestStatusProvisioning})),
+			},
+		},
+		{
+			name:   "instance ready",
+			kafkas: []kafka.Kafka{*observedKafka(kafka.KafkaRequestStatusReady)},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ConnectionKeyBootstrapServerHost: []byte("events.kafka.example.com:443"),
+					},
+				},
+				mg: kafkaInstance(withKafkaExternalName(kafkaName), withKafkaConditions(xpv1.Available()),
+					withKafkaObservation(v1alpha1.KafkaInstanceObservation{
+						ID:                  kafkaID,
+						Status:              kafka.KafkaRequestStatusReady,
+						BootstrapServerHost: "events.kafka.example.com:443",
+						AdminAPIServerURL:   "https://admin-server-events.kafka.example.com",
+					})),
+			},
+		},
+		{
+			name: "region changed",
+			kafkas: func() []kafka.Kafka {
+				k := observedKafka(kafka.KafkaRequestStatusFailed)
+				k.Region = "eu-west-1"
+				return []kafka.Kafka{*k}
+			}(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg: kafkaInstance(withKafkaExternalName(kafkaName), withKafkaConditions(xpv1.Unavailable()),
+					withKafkaObservation(v1alpha1.KafkaInstanceObservation{ID: kafkaID, Status: kafka.KafkaRequestStatusFailed})),
+			},
+		},
+		{
+			name:   "only instances with a similar name",
+			kafkas: []kafka.Kafka{{ID: "other", Name: kafkaName + "-staging"}},
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  kafkaInstance(),
+			},
+		},
+		{
+			name: "list error",
+			err:  errors.New("boom"),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  kafkaInstance(),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := kafkaInstanceExternal{client: &kafka.KafkaAPIMock{
+				ListKafkasFunc: func(ctx context.Context, search string) ([]kafka.Kafka, error) {
+					if want := "name = " + kafkaName; search != want {
+						t.Errorf("\ne.Observe(...): searched %q, want %q\n", search, want)
+					}
+					return tc.kafkas, tc.err
+				},
+			}}
+			mg := kafkaInstance()
+			got, err := e.Observe(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestKafkaInstanceCreate(t *testing.T) {
+	var req kafka.KafkaRequestPayload
+	e := kafkaInstanceExternal{client: &kafka.KafkaAPIMock{
+		CreateKafkaFunc: func(ctx context.Context, r kafka.KafkaRequestPayload) (*kafka.Kafka, error) {
+			req = r
+			return observedKafka(kafka.KafkaRequestStatusAccepted), nil
+		},
+	}}
+	mg := kafkaInstance()
+	if _, err := e.Create(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %s\n", err)
+	}
+
+	want := kafka.KafkaRequestPayload{Name: kafkaName, CloudProvider: "aws", Region: "us-east-1", MultiAZ: true}
+	if diff := cmp.Diff(want, req); diff != "" {
+		t.Errorf("\ne.Create(...): -want request, +got request:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(kafkaInstance(withKafkaConditions(xpv1.Creating()), withKafkaExternalName(kafkaName)), mg); diff != "" {
+		t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestKafkaInstanceUpdate(t *testing.T) {
+	cases := []struct {
+		name    string
+		mg      *v1alpha1.KafkaInstance
+		deleted bool
+	}{
+		{
+			name: "ready instance is replaced",
+			mg: kafkaInstance(withKafkaConditions(xpv1.Available()),
+				withKafkaObservation(v1alpha1.KafkaInstanceObservation{ID: kafkaID, Status: kafka.KafkaRequestStatusReady})),
+			deleted: true,
+		},
+		{
+			name: "provisioning instance is left alone",
+			mg: kafkaInstance(withKafkaConditions(xpv1.Creating()),
+				withKafkaObservation(v1alpha1.KafkaInstanceObservation{ID: kafkaID, Status: kafka.KafkaRequestStatusProvisioning})),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			deleted := false
+			e := kafkaInstanceExternal{client: &kafka.KafkaAPIMock{
+				DeleteKafkaFunc: func(ctx context.Context, id string) error {
+					deleted = true
+					return nil
+				},
+			}}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\ne.Update(...): unexpected error: %s\n", err)
+			}
+			if deleted != tc.deleted {
+				t.Errorf("\ne.Update(...): deleted %t, want %t\n", deleted, tc.deleted)
+			}
+		})
+	}
+}
+
+func TestKafkaInstanceDelete(t *testing.T) {
+	cases := []struct {
+		name   string
+		status string
+		err    error
+		calls  int
+		want   error
+	}{
+		{name: "deleted", status: kafka.KafkaRequestStatusReady, calls: 1},
+		{name: "already deprovisioning", status: kafka.KafkaRequestStatusDeprovision},
+		{name: "already gone", status: kafka.KafkaRequestStatusReady, err: &kafka.APIError{StatusCode: http.StatusNotFound}, calls: 1},
+		{name: "delete error", status: kafka.KafkaRequestStatusReady, err: errors.New("boom"), calls: 1, want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &kafka.KafkaAPIMock{
+				DeleteKafkaFunc: func(ctx context.Context, id string) error {
+					if id != kafkaID {
+						t.Errorf("\ne.Delete(...): deleted %q, want %q\n", id, kafkaID)
+					}
+					return tc.err
+				},
+			}
+			e := kafkaInstanceExternal{client: m}
+			err := e.Delete(context.Background(), kafkaInstance(
+				withKafkaObservation(v1alpha1.KafkaInstanceObservation{ID: kafkaID, Status: tc.status})))
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+			if got := len(m.DeleteKafkaCalls()); got != tc.calls {
+				t.Errorf("\ne.Delete(...): called delete %d times, want %d\n", got, tc.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/kafka/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/kafka"
+)
+
+const (
+	errNotTopic                = "managed resource is not a Topic custom resource"
+	errObserveTopic            = "cannot observe topic"
+	errCreateTopic             = "cannot create topic"
+	errUpdateTopic             = "cannot update topic"
+	errDeleteTopic             = "cannot delete topic"
+	errDecreaseTopicPartitions = "cannot decrease the number of partitions of a topic"
+)
+
+// Configuration entries of topics that are set by dedicated parameters.
+const (
+	topicConfigRetentionMs    = "retention.ms"
+	topicConfigRetentionBytes = "retention.bytes"
+)
+
+// setupTopic adds a controller that reconciles Topic managed resources.
+func setupTopic(mgr ctrl.Manager, o controller.Options) error {
+	return setupKafkaResource(mgr, o, v1alpha1.TopicGroupVersionKind, &v1alpha1.Topic{},
+		func(c kafka.Client) managed.ExternalClient {
+			return &topicExternal{client: c, instance: func(adminURL string) kafka.TopicAPI {
+				return c.Instance(adminURL)
+			}}
+		})
+}
+
+// A topicExternal creates, updates and deletes the topics of Kafka instances
+// through their admin API. The external name of a topic is its name.
+type topicExternal struct {
+	client kafka.KafkaAPI
+	// instance returns a client for the admin API served at the supplied
+	// URL.
+	instance func(adminURL string) kafka.TopicAPI
+}
+
+// topics returns a client for the topics of the Kafka instance of the supplied
+// Topic.
+func (c *topicExternal) topics(ctx context.Context, cr *v1alpha1.Topic) (kafka.TopicAPI, error) {
+	u, err := getAdminAPIURL(ctx, c.client, cr.Spec.ForProvider.KafkaInstanceID)
+	if err != nil {
+		return nil, err
+	}
+	return c.instance(u), nil
+}
+
+// getTopicConfig returns the desired configuration entries of the supplied
+// Topic. The retention parameters take precedence over its configs.
+func getTopicConfig(p v1alpha1.TopicParameters) map[string]string {
+	cfg := make(map[string]string, len(p.Configs)+2)
+	for k, v := range p.Configs {
+		cfg[k] = v
+	}
+	if p.RetentionMs != nil {
+		cfg[topicConfigRetentionMs] = strconv.FormatInt(*p.RetentionMs, 10)
+	}
+	if p.RetentionBytes != nil {
+		cfg[topicConfigRetentionBytes] = strconv.FormatInt(*p.RetentionBytes, 10)
+	}
+	return cfg
+}
+
+// toConfigEntries returns the supplied configuration entries sorted by key.
+func toConfigEntries(cfg map[string]string) []kafka.ConfigEntry {
+	entries := make([]kafka.ConfigEntry, 0, len(cfg))
+	for k, v := range cfg {
+		entries = append(entries, kafka.ConfigEntry{Key: k, Value: v})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	return entries
+}
+
+// isTopicUpToDate returns false if the observed topic does not have the
+// desired number of partitions, or any desired configuration entry. Other
+// configuration entries are not compared, since the admin API returns their
+// defaults.
+func isTopicUpToDate(in *v1alpha1.Topic, observed *kafka.Topic) (bool, string) {
+	desired := getTopicConfig(in.Spec.ForProvider)
+	actual := make(map[string]string, len(desired))
+	for _, e := range observed.Config {
+		if _, ok := desired[e.Key]; ok {
+			actual[e.Key] = e.Value
+		}
+	}
+	want := v1alpha1.TopicParameters{Partitions: in.Spec.ForProvider.Partitions, Configs: desired}
+	got := v1alpha1.TopicParameters{Partitions: len(observed.Partitions), Configs: actual}
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		return false, "Observed difference in topic\n" + diff
+	}
+	return true, ""
+}
+
+func (c *topicExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTopic)
+	}
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	topics, err := c.topics(ctx, cr)
+	if kafka.IsNotFound(err) {
+		// Topics are deleted with their Kafka instance.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveTopic)
+	}
+	t, err := topics.GetTopic(ctx, name)
+	if kafka.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveTopic)
+	}
+
+	cr.Status.AtProvider = v1alpha1.TopicObservation{Partitions: len(t.Partitions)}
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isTopicUpToDate(cr, t)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *topicExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTopic)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	topics, err := c.topics(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTopic)
+	}
+	t, err := topics.CreateTopic(ctx, kafka.NewTopicInput{
+		Name: cr.Spec.ForProvider.Name,
+		Settings: kafka.TopicSettings{
+			NumPartitions: cr.Spec.ForProvider.Partitions,
+			Config:        toConfigEntries(getTopicConfig(cr.Spec.ForProvider)),
+		},
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTopic)
+	}
+	meta.SetExternalName(cr, t.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update adds partitions to the topic and sets its desired configuration
+// entries. Kafka cannot remove partitions of a topic.
+func (c *topicExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTopic)
+	}
+	desired, observed := cr.Spec.ForProvider.Partitions, cr.Status.AtProvider.Partitions
+	if desired < observed {
+		return managed.ExternalUpdate{}, errors.New(errDecreaseTopicPartitions)
+	}
+
+	topics, err := c.topics(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTopic)
+	}
+	s := kafka.TopicSettings{Config: toConfigEntries(getTopicConfig(cr.Spec.ForProvider))}
+	if desired > observed {
+		s.NumPartitions = desired
+	}
+	_, err = topics.UpdateTopic(ctx, meta.GetExternalName(cr), s)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTopic)
+}
+
+func (c *topicExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		return errors.New(errNotTopic)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	topics, err := c.topics(ctx, cr)
+	if kafka.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errDeleteTopic)
+	}
+	err = topics.DeleteTopic(ctx, meta.GetExternalName(cr))
+	if kafka.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteTopic)
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/kafka/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/kafka"
+)
+
+var _ managed.ExternalClient = &topicExternal{}
+
+var topicName = "orders"
+
+type topicModifier func(*v1alpha1.Topic)
+
+func withTopicConditions(c ...xpv1.Condition) topicModifier {
+	return func(t *v1alpha1.Topic) { t.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTopicExternalName(name string) topicModifier {
+	return func(t *v1alpha1.Topic) { meta.SetExternalName(t, name) }
+}
+
+func withTopicPartitions(desired, observed int) topicModifier {
+	return func(t *v1alpha1.Topic) {
+		t.Spec.ForProvider.Partitions = desired
+		t.Status.AtProvider.Partitions = observed
+	}
+}
+
+func int64Ptr(i int64) *int64 { return &i }
+
+func topic(mod ...topicModifier) *v1alpha1.Topic {
+	t := &v1alpha1.Topic{
+		ObjectMeta: metav1.ObjectMeta{Name: topicName},
+		Spec: v1alpha1.TopicSpec{
+			ForProvider: v1alpha1.TopicParameters{
+				KafkaInstanceID: kafkaID,
+				Name:            topicName,
+				Partitions:      3,
+				RetentionMs:     int64Ptr(86400000),
+				Configs:         map[string]string{"cleanup.policy": "compact"},
+			},
+		},
+	}
+	for _, m := range mod {
+		m(t)
+	}
+	return t
+}
+
+func observedTopic(partitions int, retentionMs string) *kafka.Topic {
+	t := &kafka.Topic{
+		Name: topicName,
+		Config: []kafka.ConfigEntry{
+			{Key: "cleanup.policy", Value: "compact"},
+			{Key: "retention.ms", Value: retentionMs},
+			{Key: "retention.bytes", Value: "-1"},
+		},
+	}
+	for i := 0; i < partitions; i++ {
+		t.Partitions = append(t.Partitions, kafka.Partition{Partition: i})
+	}
+	return t
+}
+
+// kafkaAPI returns a Kafka management API with a single Kafka instance in
+// the supplied state.
+func kafkaAPI(t *testing.T, status string, err error) *kafka.KafkaAPIMock {
+	return &kafka.KafkaAPIMock{
+		GetKafkaFunc: func(ctx context.Context, id string) (*kafka.Kafka, error) {
+			if id != kafkaID {
+				t.Errorf("\nGetKafka(...): got %q, want %q\n", id, kafkaID)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return observedKafka(status), nil
+		},
+	}
+}
+
+func TestTopicObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name     string
+		status   string
+		kafkaErr error
+		topic    *kafka.Topic
+		err      error
+		want     want
+	}{
+		{
+			name:   "topic up to date",
+			status: kafka.KafkaRequestStatusReady,
+			topic:  observedTopic(3, "86400000"),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg:  topic(withTopicExternalName(topicName), withTopicPartitions(3, 3), withTopicConditions(xpv1.Available())),
+			},
+		},
+		{
+			name:   "retention changed",
+			status: kafka.KafkaRequestStatusReady,
+			topic:  observedTopic(3, "604800000"),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg:  topic(withTopicExternalName(topicName), withTopicPartitions(3, 3), withTopicConditions(xpv1.Available())),
+			},
+		},
+		{
+			name:   "partitions added",
+			status: kafka.KafkaRequestStatusReady,
+			topic:  observedTopic(1, "86400000"),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg:  topic(withTopicExternalName(topicName), withTopicPartitions(3, 1), withTopicConditions(xpv1.Available())),
+			},
+		},
+		{
+			name:   "topic not found",
+			status: kafka.KafkaRequestStatusReady,
+			err:    &kafka.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  topic(withTopicExternalName(topicName)),
+			},
+		},
+		{
+			name:     "instance gone",
+			kafkaErr: &kafka.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  topic(withTopicExternalName(topicName)),
+			},
+		},
+		{
+			name:   "instance not ready",
+			status: kafka.KafkaRequestStatusProvisioning,
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  topic(withTopicExternalName(topicName)),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := topicExternal{
+				client: kafkaAPI(t, tc.status, tc.kafkaErr),
+				instance: func(adminURL string) kafka.TopicAPI {
+					return &kafka.TopicAPIMock{
+						GetTopicFunc: func(ctx context.Context, name string) (*kafka.Topic, error) {
+							return tc.topic, tc.err
+						},
+					}
+				},
+			}
+			mg := topic(withTopicExternalName(topicName))
+			got, err := e.Observe(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestTopicCreate(t *testing.T) {
+	var req kafka.NewTopicInput
+	var url string
+	e := topicExternal{
+		client: kafkaAPI(t, kafka.KafkaRequestStatusReady, nil),
+		instance: func(adminURL string) kafka.TopicAPI {
+			url = adminURL
+			return &kafka.TopicAPIMock{
+				CreateTopicFunc: func(ctx context.Context, in kafka.NewTopicInput) (*kafka.Topic, error) {
+					req = in
+					return &kafka.Topic{Name: in.Name}, nil
+				},
+			}
+		},
+	}
+	mg := topic()
+	if _, err := e.Create(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %s\n", err)
+	}
+
+	if want := "https://admin-server-events.kafka.example.com"; url != want {
+		t.Errorf("\ne.Create(...): admin API %q, want %q\n", url, want)
+	}
+	wantReq := kafka.NewTopicInput{
+		Name: topicName,
+		Settings: kafka.TopicSettings{
+			NumPartitions: 3,
+			Config: []kafka.ConfigEntry{
+				{Key: "cleanup.policy", Value: "compact"},
+				{Key: "retention.ms", Value: "86400000"},
+			},
+		},
+	}
+	if diff := cmp.Diff(wantReq, req); diff != "" {
+		t.Errorf("\ne.Create(...): -want request, +got request:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(topic(withTopicConditions(xpv1.Creating()), withTopicExternalName(topicName)), mg); diff != "" {
+		t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestTopicUpdate(t *testing.T) {
+	config := []kafka.ConfigEntry{
+		{Key: "cleanup.policy", Value: "compact"},
+		{Key: "retention.ms", Value: "86400000"},
+	}
+
+	type want struct {
+		settings *kafka.TopicSettings
+		err      error
+	}
+
+	cases := []struct {
+		name string
+		mg   *v1alpha1.Topic
+		want want
+	}{
+		{
+			name: "partitions added",
+			mg:   topic(withTopicExternalName(topicName), withTopicPartitions(3, 1)),
+			want: want{settings: &kafka.TopicSettings{NumPartitions: 3, Config: config}},
+		},
+		{
+			name: "config changed",
+			mg:   topic(withTopicExternalName(topicName), withTopicPartitions(3, 3)),
+			want: want{settings: &kafka.TopicSettings{Config: config}},
+		},
+		{
+			name: "partitions removed",
+			mg:   topic(withTopicExternalName(topicName), withTopicPartitions(1, 3)),
+			want: want{err: cmpopts.AnyError},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var settings *kafka.TopicSettings
+			e := topicExternal{
+				client: kafkaAPI(t, kafka.KafkaRequestStatusReady, nil),
+				instance: func(adminURL string) kafka.TopicAPI {
+					return &kafka.TopicAPIMock{
+						UpdateTopicFunc: func(ctx context.Context, name string, s kafka.TopicSettings) (*kafka.Topic, error) {
+							settings = &s
+							return &kafka.Topic{Name: name}, nil
+						},
+					}
+				},
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.settings, settings); diff != "" {
+				t.Errorf("\ne.Update(...): -want settings, +got settings:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestTopicDelete(t *testing.T) {
+	cases := []struct {
+		name     string
+		kafkaErr error
+		err      error
+		want     error
+	}{
+		{name: "deleted"},
+		{name: "already gone", err: &kafka.APIError{StatusCode: http.StatusNotFound}},
+		{name: "instance gone", kafkaErr: &kafka.APIError{StatusCode: http.StatusNotFound}},
+		{name: "delete error", err: errors.New("boom"), want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := topicExternal{
+				client: kafkaAPI(t, kafka.KafkaRequestStatusReady, tc.kafkaErr),
+				instance: func(adminURL string) kafka.TopicAPI {
+					return &kafka.TopicAPIMock{
+						DeleteTopicFunc: func(ctx context.Context, name string) error {
+							if name != topicName {
+								t.Errorf("\ne.Delete(...): deleted %q, want %q\n", name, topicName)
+							}
+							return tc.err
+						},
+					}
+				},
+			}
+			err := e.Delete(context.Background(), topic(withTopicExternalName(topicName)))
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+)
+
+const (
+	errNotAddOnInstallation     = "managed resource is not an AddOnInstallation custom resource"
+	errObserveAddOnInstallation = "cannot observe add-on installation"
+	errCreateAddOnInstallation  = "cannot create add-on installation"
+	errUpdateAddOnInstallation  = "cannot update add-on installation"
+	errDeleteAddOnInstallation  = "cannot delete add-on installation"
+	errGetAddOn                 = "cannot get add-on"
+	errAddOnParameter           = "invalid add-on parameter"
+)
+
+// setupAddOnInstallation adds a controller that reconciles AddOnInstallation
+// managed resources.
+func setupAddOnInstallation(mgr ctrl.Manager, o controller.Options) error {
+	return setupOCMResource(mgr, o, v1alpha1.AddOnInstallationGroupVersionKind, &v1alpha1.AddOnInstallation{},
+		func(c ocm.Client) managed.ExternalClient { return &addOnInstallationExternal{client: c} })
+}
+
+// An addOnInstallationExternal observes, then either installs, updates, or
+// uninstalls an add-on of an OCM cluster.
+type addOnInstallationExternal struct {
+	client ocm.AddOnInstallationAPI
+}
+
+// generateAddOnInstallation sets the parameters of the AddOnInstallation, and
+// resets the parameters of the add-on it does not set to their defaults.
+func generateAddOnInstallation(in *v1alpha1.AddOnInstallation, addOn *ocm.AddOn) *ocm.AddOnInstallation {
+	p := in.Spec.ForProvider
+	a := &ocm.AddOnInstallation{
+		ID:         meta.GetExternalName(in),
+		AddOn:      &ocm.ObjectReference{ID: p.AddOnID},
+		Parameters: &ocm.AddOnParameterList{Items: []ocm.AddOnParameter{}},
+	}
+	set := map[string]bool{}
+	for _, param := range p.Parameters {
+		a.Parameters.Items = append(a.Parameters.Items, ocm.AddOnParameter{ID: param.ID, Value: param.Value})
+		set[param.ID] = true
+	}
+	for _, d := range addOnParameterDefinitions(addOn) {
+		if !set[d.ID] && d.DefaultValue != "" {
+			a.Parameters.Items = append(a.Parameters.Items, ocm.AddOnParameter{ID: d.ID, Value: d.DefaultValue})
+		}
+	}
+	return a
+}
+
+func addOnParameterDefinitions(addOn *ocm.AddOn) []ocm.AddOnParameterDefinition {
+	if addOn.Parameters == nil {
+		return nil
+	}
+	return addOn.Parameters.Items
+}
+
+// validateAddOnParameters returns an error if the AddOnInstallation sets a
+// parameter its add-on does not define, or a value that does not match the
+// definition of the parameter, or if it does not set a required parameter
+// that has no default.
+func validateAddOnParameters(in *v1alpha1.AddOnInstallation, addOn *ocm.AddOn) error {
+	defs := map[string]ocm.AddOnParameterDefinition{}
+	for _, d := range addOnParameterDefinitions(addOn) {
+		defs[d.ID] = d
+	}
+	set := map[string]bool{}
+	for _, p := range in.Spec.ForProvider.Parameters {
+		d, ok := defs[p.ID]
+		if !ok {
+			return errors.Errorf("%s %q: not defined by add-on %s", errAddOnParameter, p.ID, in.Spec.ForProvider.AddOnID)
+		}
+		if err := validateAddOnParameterValue(d, p.Value); err != nil {
+			return errors.Wrapf(err, "%s %q", errAddOnParameter, p.ID)
+		}
+		set[p.ID] = true
+	}
+	for _, d := range addOnParameterDefinitions(addOn) {
+		if d.Required && d.DefaultValue == "" && !set[d.ID] {
+			return errors.Errorf("%s %q: required by add-on %s", errAddOnParameter, d.ID, in.Spec.ForProvider.AddOnID)
+		}
+	}
+	return nil
+}
+
+func validateAddOnParameterValue(d ocm.AddOnParameterDefinition, v string) error {
+	switch d.ValueType {
+	case ocm.AddOnParameterValueTypeNumber:
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return errors.Errorf("%q is not a number", v)
+		}
+	case ocm.AddOnParameterValueTypeBoolean:
+		if _, err := strconv.ParseBool(v); err != nil {
+			return errors.Errorf("%q is not a boolean", v)
+		}
+	case ocm.AddOnParameterValueTypeCIDR:
+		if _, _, err := net.ParseCIDR(v); err != nil {
+			return errors.Errorf("%q is not a CIDR", v)
+		}
+	}
+	if d.Validation != "" {
+		re, err := regexp.Compile(d.Validation)
+		if err != nil {
+			return errors.Wrapf(err, "cannot compile validation %q", d.Validation)
+		}
+		if !re.MatchString(v) {
+			return errors.Errorf("%q does not match %q", v, d.Validation)
+		}
+	}
+	if len(d.Options) == 0 {
+		return nil
+	}
+	for _, o := range d.Options {
+		if o.Value == v {
+			return nil
+		}
+	}
+	return errors.Errorf("%q is not an option", v)
+}
+
+func generateAddOnInstallationObservation(in *ocm.AddOnInstallation) v1alpha1.AddOnInstallationObservation {
+	o := v1alpha1.AddOnInstallationObservation{
+		ID:               in.ID,
+		State:            in.State,
+		StateDescription: in.StateDescription,
+	}
+	if in.AddOnVersion != nil {
+		o.Version = in.AddOnVersion.ID
+	}
+	return o
+}
+
+func getAddOnInstallationCondition(state string) xpv1.Condition {
+	switch state {
+	case ocm.AddOnInstallationStatePending,
+		ocm.AddOnInstallationStateInstalling:
+		return xpv1.Creating()
+	case ocm.AddOnInstallationStateReady,
+		ocm.AddOnInstallationStateUpgrading:
+		return xpv1.Available()
+	case ocm.AddOnInstallationStateDeleting,
+		ocm.AddOnInstallationStateDeleted:
+		return xpv1.Deleting()
+	default:
+		return xpv1.Unavailable()
+	}
+}
+
+// isAddOnInstallationUpToDate compares the parameters set on the
+// AddOnInstallation, and the parameters of the add-on it does not set with
+// their defaults. OCM also returns the parameters that were defaulted by the
+// add-on.
+func isAddOnInstallationUpToDate(in *v1alpha1.AddOnInstallation, observed *ocm.AddOnInstallation, addOn *ocm.AddOn) (bool, string) {
+	if observed.State == ocm.AddOnInstallationStateDeleting || observed.State == ocm.AddOnInstallationStateDeleted {
+		return true, ""
+	}
+	values := map[string]string{}
+	if observed.Parameters != nil {
+		for _, p := range observed.Parameters.Items {
+			values[p.ID] = p.Value
+		}
+	}
+	set := map[string]bool{}
+	for _, p := range in.Spec.ForProvider.Parameters {
+		v, ok := values[p.ID]
+		if !ok || v != p.Value {
+			return false, fmt.Sprintf("Observed difference in parameter %q of add-on installation", p.ID)
+		}
+		set[p.ID] = true
+	}
+	for _, d := range addOnParameterDefinitions(addOn) {
+		if v, ok := values[d.ID]; ok && !set[d.ID] && d.DefaultValue != "" && v != d.DefaultValue {
+			return false, fmt.Sprintf("Observed difference in parameter %q of add-on installation", d.ID)
+		}
+	}
+	return true, ""
+}
+
+func (c *addOnInstallationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AddOnInstallation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAddOnInstallation)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	a, err := c.client.GetAddOnInstallation(ctx, cr.Spec.ForProvider.ClusterID, id)
+	if ocm.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveAddOnInstallation)
+	}
+	addOn, err := c.client.GetAddOn(ctx, cr.Spec.ForProvider.AddOnID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAddOn)
+	}
+
+	cr.Status.AtProvider = generateAddOnInstallationObservation(a)
+	cr.SetConditions(getAddOnInstallationCondition(a.State))
+	upToDate, diff := isAddOnInstallationUpToDate(cr, a, addOn)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *addOnInstallationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AddOnInstallation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAddOnInstallation)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	addOn, err := c.client.GetAddOn(ctx, cr.Spec.ForProvider.AddOnID)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetAddOn)
+	}
+	if err := validateAddOnParameters(cr, addOn); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAddOnInstallation)
+	}
+	a, err := c.client.CreateAddOnInstallation(ctx, cr.Spec.ForProvider.ClusterID, generateAddOnInstallation(cr, addOn))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAddOnInstallation)
+	}
+	meta.SetExternalName(cr, a.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update sets the parameters of the add-on installation, and resets the ones
+// that were removed from the AddOnInstallation to their defaults.
+func (c *addOnInstallationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AddOnInstallation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAddOnInstallation)
+	}
+
+	addOn, err := c.client.GetAddOn(ctx, cr.Spec.ForProvider.AddOnID)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetAddOn)
+	}
+	if err := validateAddOnParameters(cr, addOn); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAddOnInstallation)
+	}
+	err = c.client.UpdateAddOnInstallation(ctx, cr.Spec.ForProvider.ClusterID, generateAddOnInstallation(cr, addOn))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAddOnInstallation)
+}
+
+// Delete uninstalls the add-on. The installation is observed until OCM has
+// removed it.
+func (c *addOnInstallationExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AddOnInstallation)
+	if !ok {
+		return errors.New(errNotAddOnInstallation)
+	}
+	mg.SetConditions(xpv1.Deleting())
+	if s := cr.Status.AtProvider.State; s == ocm.AddOnInstallationStateDeleting || s == ocm.AddOnInstallationStateDeleted {
+		return nil
+	}
+
+	err := c.client.DeleteAddOnInstallation(ctx, cr.Spec.ForProvider.ClusterID, meta.GetExternalName(cr))
+	if ocm.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteAddOnInstallation)
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+)
+
+var _ managed.ExternalClient = &addOnInstallationExternal{}
+
+type addOnInstallationModifier func(*v1alpha1.AddOnInstallation)
+
+func withParameters(params ...v1alpha1.AddOnParameter) addOnInstallationModifier {
+	return func(a *v1alpha1.AddOnInstallation) { a.Spec.ForProvider.Parameters = params }
+}
+
+func withAddOnExternalName(name string) addOnInstallationModifier {
+	return func(a *v1alpha1.AddOnInstallation) { meta.SetExternalName(a, name) }
+}
+
+func addOnInstallation(mod ...addOnInstallationModifier) *v1alpha1.AddOnInstallation {
+	a := &v1alpha1.AddOnInstallation{
+		ObjectMeta: metav1.ObjectMeta{Name: "logging"},
+		Spec: v1alpha1.AddOnInstallationSpec{
+			ForProvider: v1alpha1.AddOnInstallationParameters{
+				ClusterID: clusterID,
+				AddOnID:   "cluster-logging-operator",
+			},
+		},
+	}
+	meta.SetExternalName(a, "cluster-logging-operator")
+	for _, m := range mod {
+		m(a)
+	}
+	return a
+}
+
+func ocmAddOnInstallation(state string, params ...ocm.AddOnParameter) *ocm.AddOnInstallation {
+	return &ocm.AddOnInstallation{
+		ID:           "cluster-logging-operator",
+		AddOn:        &ocm.ObjectReference{ID: "cluster-logging-operator"},
+		AddOnVersion: &ocm.ObjectReference{ID: "5.6.4"},
+		Parameters:   &ocm.AddOnParameterList{Items: params},
+		State:        state,
+	}
+}
+
+func ocmAddOn() *ocm.AddOn {
+	return &ocm.AddOn{
+		ID: "cluster-logging-operator",
+		Parameters: &ocm.AddOnParameterDefinitionList{Items: []ocm.AddOnParameterDefinition{
+			{ID: "retention", ValueType: ocm.AddOnParameterValueTypeString, Validation: "^[0-9]+d$", DefaultValue: "7d"},
+			{ID: "use-cloudwatch", ValueType: ocm.AddOnParameterValueTypeBoolean, DefaultValue: "false"},
+			{ID: "collector", ValueType: ocm.AddOnParameterValueTypeString, Options: []ocm.AddOnParameterOption{{Value: "fluentd"}, {Value: "vector"}}},
+		}},
+	}
+}
+
+func getAddOn(ctx context.Context, id string) (*ocm.AddOn, error) {
+	return ocmAddOn(), nil
+}
+
+func TestAddOnInstallationObserve(t *testing.T) {
+	type want struct {
+		obs       managed.ExternalObservation
+		condition xpv1.Condition
+		err       error
+	}
+
+	retention := v1alpha1.AddOnParameter{ID: "retention", Value: "7d"}
+	defaulted := ocm.AddOnParameter{ID: "use-cloudwatch", Value: "false"}
+
+	cases := []struct {
+		name  string
+		mg    *v1alpha1.AddOnInstallation
+		addOn *ocm.AddOnInstallation
+		err   error
+		want  want
+	}{
+		{
+			name: "not found",
+			mg:   addOnInstallation(),
+			err:  &ocm.APIError{StatusCode: http.StatusNotFound},
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+		{
+			name: "error",
+			mg:   addOnInstallation(),
+			err:  errors.New("boom"),
+			want: want{err: cmpopts.AnyError},
+		},
+		{
+			name:  "installing",
+			mg:    addOnInstallation(withParameters(retention)),
+			addOn: ocmAddOnInstallation(ocm.AddOnInstallationStateInstalling, ocm.AddOnParameter{ID: "retention", Value: "7d"}),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Creating(),
+			},
+		},
+		{
+			name:  "ready with defaults",
+			mg:    addOnInstallation(withParameters(retention)),
+			addOn: ocmAddOnInstallation(ocm.AddOnInstallationStateReady, ocm.AddOnParameter{ID: "retention", Value: "7d"}, defaulted),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Available(),
+			},
+		},
+		{
+			name:  "parameter changed",
+			mg:    addOnInstallation(withParameters(retention)),
+			addOn: ocmAddOnInstallation(ocm.AddOnInstallationStateReady, ocm.AddOnParameter{ID: "retention", Value: "1d"}),
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             `Observed difference in parameter "retention" of add-on installation`,
+				},
+				condition: xpv1.Available(),
+			},
+		},
+		{
+			name:  "parameter removed",
+			mg:    addOnInstallation(),
+			addOn: ocmAddOnInstallation(ocm.AddOnInstallationStateReady, ocm.AddOnParameter{ID: "retention", Value: "1d"}, defaulted),
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             `Observed difference in parameter "retention" of add-on installation`,
+				},
+				condition: xpv1.Available(),
+			},
+		},
+		{
+			name:  "failed",
+			mg:    addOnInstallation(),
+			addOn: ocmAddOnInstallation(ocm.AddOnInstallationStateFailed),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Unavailable(),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &addOnInstallationExternal{client: &ocm.AddOnInstallationAPIMock{
+				GetAddOnInstallationFunc: func(ctx context.Context, cluster, id string) (*ocm.AddOnInstallation, error) {
+					return tc.addOn, tc.err
+				},
+				GetAddOnFunc: getAddOn,
+			}}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if tc.want.condition.Type != "" {
+				if diff := cmp.Diff(tc.want.condition, tc.mg.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+					t.Errorf("\ne.Observe(...): -want condition, +got condition:\n%s\n", diff)
+				}
+				if tc.mg.Status.AtProvider.Version != "5.6.4" {
+					t.Errorf("\ne.Observe(...): want version %q, got %q\n", "5.6.4", tc.mg.Status.AtProvider.Version)
+				}
+			}
+		})
+	}
+}
+
+func TestAddOnInstallationCreate(t *testing.T) {
+	defaults := []ocm.AddOnParameter{{ID: "use-cloudwatch", Value: "false"}}
+
+	cases := []struct {
+		name string
+		mg   *v1alpha1.AddOnInstallation
+		want *ocm.AddOnInstallation
+		err  error
+	}{
+		{
+			name: "valid",
+			mg:   addOnInstallation(withAddOnExternalName(""), withParameters(v1alpha1.AddOnParameter{ID: "retention", Value: "1d"}, v1alpha1.AddOnParameter{ID: "collector", Value: "vector"})),
+			want: &ocm.AddOnInstallation{
+				AddOn: &ocm.ObjectReference{ID: "cluster-logging-operator"},
+				Parameters: &ocm.AddOnParameterList{Items: append([]ocm.AddOnParameter{
+					{ID: "retention", Value: "1d"}, {ID: "collector", Value: "vector"},
+				}, defaults...)},
+			},
+		},
+		{
+			name: "undefined",
+			mg:   addOnInstallation(withAddOnExternalName(""), withParameters(v1alpha1.AddOnParameter{ID: "unknown", Value: "1"})),
+			err:  cmpopts.AnyError,
+		},
+		{
+			name: "not boolean",
+			mg:   addOnInstallation(withAddOnExternalName(""), withParameters(v1alpha1.AddOnParameter{ID: "use-cloudwatch", Value: "yes"})),
+			err:  cmpopts.AnyError,
+		},
+		{
+			name: "not matching validation",
+			mg:   addOnInstallation(withAddOnExternalName(""), withParameters(v1alpha1.AddOnParameter{ID: "retention", Value: "1w"})),
+			err:  cmpopts.AnyError,
+		},
+		{
+			name: "not an option",
+			mg:   addOnInstallation(withAddOnExternalName(""), withParameters(v1alpha1.AddOnParameter{ID: "collector", Value: "logstash"})),
+			err:  cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got *ocm.AddOnInstallation
+			e := &addOnInstallationExternal{client: &ocm.AddOnInstallationAPIMock{
+				GetAddOnFunc: getAddOn,
+				CreateAddOnInstallationFunc: func(ctx context.Context, cluster string, a *ocm.AddOnInstallation) (*ocm.AddOnInstallation, error) {
+					got = a
+					return &ocm.AddOnInstallation{ID: a.AddOn.ID}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\ne.Create(...): -want add-on installation, +got add-on installation:\n%s\n", diff)
+			}
+			if tc.want != nil && meta.GetExternalName(tc.mg) != "cluster-logging-operator" {
+				t.Errorf("\ne.Create(...): want external name %q, got %q\n", "cluster-logging-operator", meta.GetExternalName(tc.mg))
+			}
+		})
+	}
+}
+
+func TestAddOnInstallationUpdate(t *testing.T) {
+	var got *ocm.AddOnInstallation
+	e := &addOnInstallationExternal{client: &ocm.AddOnInstallationAPIMock{
+		GetAddOnFunc: getAddOn,
+		UpdateAddOnInstallationFunc: func(ctx context.Context, cluster string, a *ocm.AddOnInstallation) error {
+			got = a
+			return nil
+		},
+	}}
+	if _, err := e.Update(context.Background(), addOnInstallation()); err != nil {
+		t.Fatalf("\ne.Update(...): unexpected error: %v\n", err)
+	}
+	want := &ocm.AddOnInstallation{
+		ID:    "cluster-logging-operator",
+		AddOn: &ocm.ObjectReference{ID: "cluster-logging-operator"},
+		Parameters: &ocm.AddOnParameterList{Items: []ocm.AddOnParameter{
+			{ID: "retention", Value: "7d"}, {ID: "use-cloudwatch", Value: "false"},
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\ne.Update(...): -want add-on installation, +got add-on installation:\n%s\n", diff)
+	}
+}
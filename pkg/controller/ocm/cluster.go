@@ -0,0 +1,273 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+	"github.com/stehessel/provider-redhat/pkg/secrethash"
+)
+
+const (
+	errNotCluster     = "managed resource is not a Cluster custom resource"
+	errObserveCluster = "cannot observe cluster"
+	errCreateCluster  = "cannot create cluster"
+	errUpdateCluster  = "cannot update cluster"
+	errDeleteCluster  = "cannot delete cluster"
+	errClusterSecrets = "cannot get cluster secrets"
+	errClusterCreds   = "cannot get cluster credentials"
+)
+
+// setupCluster adds a controller that reconciles Cluster managed resources.
+func setupCluster(mgr ctrl.Manager, o controller.Options) error {
+	kube := mgr.GetClient()
+	return setupOCMResource(mgr, o, v1alpha1.ClusterGroupVersionKind, &v1alpha1.Cluster{},
+		func(c ocm.Client) managed.ExternalClient { return &clusterExternal{client: c, kube: kube} })
+}
+
+// A clusterExternal observes, then either creates, updates, or deletes an
+// OCM cluster. The credentials of the AWS account of customer cloud
+// subscription clusters are read from secrets.
+type clusterExternal struct {
+	client ocm.ClusterAPI
+	kube   client.Client
+}
+
+// generateCluster returns the OCM cluster described by the supplied Cluster.
+func (c *clusterExternal) generateCluster(ctx context.Context, cr *v1alpha1.Cluster) (*ocm.Cluster, error) {
+	p := cr.Spec.ForProvider
+	cl := &ocm.Cluster{
+		Name:          p.Name,
+		Product:       &ocm.ObjectReference{ID: string(p.Product)},
+		CloudProvider: &ocm.ObjectReference{ID: string(p.CloudProvider)},
+		Region:        &ocm.ObjectReference{ID: p.Region},
+		MultiAZ:       p.MultiAZ,
+	}
+	if p.Version != "" {
+		cl.Version = &ocm.Version{ID: p.Version}
+	}
+	if p.ComputeNodes != 0 || p.ComputeMachineType != "" {
+		cl.Nodes = &ocm.ClusterNodes{Compute: p.ComputeNodes}
+		if p.ComputeMachineType != "" {
+			cl.Nodes.ComputeMachineType = &ocm.ObjectReference{ID: p.ComputeMachineType}
+		}
+	}
+	if n := p.Network; n != nil {
+		cl.Network = &ocm.Network{
+			MachineCIDR: n.MachineCIDR,
+			ServiceCIDR: n.ServiceCIDR,
+			PodCIDR:     n.PodCIDR,
+			HostPrefix:  n.HostPrefix,
+		}
+	}
+	if a := p.AWS; a != nil {
+		id, err := secrethash.GetSecretValue(ctx, c.kube, a.AccessKeyIDSecretRef)
+		if err != nil {
+			return nil, errors.Wrap(err, errClusterSecrets)
+		}
+		key, err := secrethash.GetSecretValue(ctx, c.kube, a.SecretAccessKeySecretRef)
+		if err != nil {
+			return nil, errors.Wrap(err, errClusterSecrets)
+		}
+		cl.CCS = &ocm.CCS{Enabled: true}
+		cl.AWS = &ocm.AWS{AccountID: a.AccountID, AccessKeyID: id, SecretAccessKey: key}
+	}
+	return cl, nil
+}
+
+func generateClusterObservation(in *ocm.Cluster) v1alpha1.ClusterObservation {
+	o := v1alpha1.ClusterObservation{
+		ID:         in.ID,
+		ExternalID: in.ExternalID,
+		State:      in.State,
+	}
+	if in.Version != nil {
+		o.Version = in.Version.RawID
+		o.AvailableUpgrades = in.Version.AvailableUpgrades
+	}
+	if in.Nodes != nil {
+		o.ComputeNodes = in.Nodes.Compute
+	}
+	if in.API != nil {
+		o.APIURL = in.API.URL
+	}
+	if in.Console != nil {
+		o.ConsoleURL = in.Console.URL
+	}
+	if in.CreationTimestamp != nil {
+		t := metav1.NewTime(*in.CreationTimestamp)
+		o.CreatedAt = &t
+	}
+	return o
+}
+
+func getClusterCondition(state string) xpv1.Condition {
+	switch state {
+	case ocm.ClusterStateValidating,
+		ocm.ClusterStatePending,
+		ocm.ClusterStateWaiting,
+		ocm.ClusterStateInstalling:
+		return xpv1.Creating()
+	case ocm.ClusterStateReady:
+		return xpv1.Available()
+	case ocm.ClusterStateUninstalling:
+		return xpv1.Deleting()
+	default:
+		return xpv1.Unavailable()
+	}
+}
+
+// isClusterUpToDate compares the compute nodes of the cluster, the only
+// parameter that can be changed once it is created. Clusters can only be
+// changed once they are ready.
+func isClusterUpToDate(in *v1alpha1.Cluster, observed *ocm.Cluster) (bool, string) {
+	if observed.State != ocm.ClusterStateReady || in.Spec.ForProvider.ComputeNodes == 0 {
+		return true, ""
+	}
+	if observed.Nodes == nil || observed.Nodes.Compute != in.Spec.ForProvider.ComputeNodes {
+		return false, "Observed difference in compute nodes of cluster"
+	}
+	return true, ""
+}
+
+func (c *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCluster)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cl, err := c.client.GetCluster(ctx, id)
+	if ocm.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveCluster)
+	}
+
+	cr.Status.AtProvider = generateClusterObservation(cl)
+	cr.SetConditions(getClusterCondition(cl.State))
+	upToDate, diff := isClusterUpToDate(cr, cl)
+	cd, err := c.connectionDetails(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errClusterCreds)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		Diff:              diff,
+		ConnectionDetails: cd,
+	}, nil
+}
+
+// connectionDetails returns the URLs of a ready cluster and, if OCM keeps
+// them, the credentials of its administrator. Credentials are only read if
+// they can be published.
+func (c *clusterExternal) connectionDetails(ctx context.Context, cr *v1alpha1.Cluster) (managed.ConnectionDetails, error) {
+	o := cr.Status.AtProvider
+	if o.State != ocm.ClusterStateReady ||
+		(cr.GetWriteConnectionSecretToReference() == nil && cr.GetPublishConnectionDetailsTo() == nil) {
+		return nil, nil
+	}
+	cd := managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyAPIURL:     []byte(o.APIURL),
+		v1alpha1.ConnectionKeyConsoleURL: []byte(o.ConsoleURL),
+	}
+
+	creds, err := c.client.GetClusterCredentials(ctx, o.ID)
+	if ocm.IsNotFound(err) {
+		return cd, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if creds.Kubeconfig != "" {
+		cd[v1alpha1.ConnectionKeyKubeconfig] = []byte(creds.Kubeconfig)
+	}
+	if a := creds.Admin; a != nil && a.User != "" {
+		cd[v1alpha1.ConnectionKeyUsername] = []byte(a.User)
+		cd[v1alpha1.ConnectionKeyPassword] = []byte(a.Password)
+	}
+	return cd, nil
+}
+
+func (c *clusterExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCluster)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	desired, err := c.generateCluster(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	}
+	cl, err := c.client.CreateCluster(ctx, desired)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	}
+	meta.SetExternalName(cr, cl.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update scales the compute nodes of the cluster.
+func (c *clusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCluster)
+	}
+
+	patch := &ocm.Cluster{Nodes: &ocm.ClusterNodes{Compute: cr.Spec.ForProvider.ComputeNodes}}
+	err := c.client.UpdateCluster(ctx, meta.GetExternalName(cr), patch)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCluster)
+}
+
+// Delete uninstalls the cluster. The cluster is observed until OCM has
+// removed it.
+func (c *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return errors.New(errNotCluster)
+	}
+	mg.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == ocm.ClusterStateUninstalling {
+		return nil
+	}
+
+	err := c.client.DeleteCluster(ctx, meta.GetExternalName(cr))
+	if ocm.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteCluster)
+}
@@ -0,0 +1,408 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+)
+
+var _ managed.ExternalClient = &clusterExternal{}
+
+var clusterID = "cluster-id"
+
+type clusterModifier func(*v1alpha1.Cluster)
+
+func withClusterParameters(f func(*v1alpha1.ClusterParameters)) clusterModifier {
+	return func(c *v1alpha1.Cluster) { f(&c.Spec.ForProvider) }
+}
+
+func withClusterExternalName(name string) clusterModifier {
+	return func(c *v1alpha1.Cluster) { meta.SetExternalName(c, name) }
+}
+
+func withComputeNodes(n int) clusterModifier {
+	return func(c *v1alpha1.Cluster) { c.Spec.ForProvider.ComputeNodes = n }
+}
+
+func withConnectionSecret(c *v1alpha1.Cluster) {
+	c.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "prod", Namespace: "crossplane-system"})
+}
+
+func withClusterState(s string) clusterModifier {
+	return func(c *v1alpha1.Cluster) { c.Status.AtProvider.State = s }
+}
+
+func cluster(mod ...clusterModifier) *v1alpha1.Cluster {
+	c := &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+		Spec: v1alpha1.ClusterSpec{
+			ForProvider: v1alpha1.ClusterParameters{
+				Name:          "prod",
+				Product:       v1alpha1.ProductOSD,
+				CloudProvider: "aws",
+				Region:        "us-east-1",
+				ComputeNodes:  3,
+			},
+		},
+	}
+	meta.SetExternalName(c, clusterID)
+	for _, m := range mod {
+		m(c)
+	}
+	return c
+}
+
+type ocmClusterModifier func(*ocm.Cluster)
+
+func withOCMClusterState(s string) ocmClusterModifier {
+	return func(c *ocm.Cluster) { c.State = s }
+}
+
+func ocmCluster(mod ...ocmClusterModifier) *ocm.Cluster {
+	c := &ocm.Cluster{
+		ID:            clusterID,
+		Name:          "prod",
+		Product:       &ocm.ObjectReference{ID: "osd"},
+		CloudProvider: &ocm.ObjectReference{ID: "aws"},
+		Region:        &ocm.ObjectReference{ID: "us-east-1"},
+		Version:       &ocm.Version{ID: "openshift-v4.12.8", RawID: "4.12.8"},
+		Nodes:         &ocm.ClusterNodes{Compute: 3},
+		State:         ocm.ClusterStateReady,
+		API:           &ocm.Endpoint{URL: "https://api.prod.example.com:6443"},
+		Console:       &ocm.Endpoint{URL: "https://console.prod.example.com"},
+	}
+	for _, m := range mod {
+		m(c)
+	}
+	return c
+}
+
+func secretClient(data map[string][]byte) client.Client {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.(*corev1.Secret).Data = data
+			return nil
+		}),
+	}
+}
+
+func TestClusterObserve(t *testing.T) {
+	type want struct {
+		obs       managed.ExternalObservation
+		condition xpv1.Condition
+		version   string
+		err       error
+	}
+
+	cases := []struct {
+		name    string
+		mg      *v1alpha1.Cluster
+		cluster *ocm.Cluster
+		creds   *ocm.ClusterCredentials
+		err     error
+		want    want
+	}{
+		{
+			name: "not created",
+			mg:   cluster(withClusterExternalName("")),
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+		{
+			name: "not found",
+			mg:   cluster(),
+			err:  &ocm.APIError{StatusCode: http.StatusNotFound},
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+		{
+			name: "error",
+			mg:   cluster(),
+			err:  errors.New("boom"),
+			want: want{err: cmpopts.AnyError},
+		},
+		{
+			name:    "ready",
+			mg:      cluster(),
+			cluster: ocmCluster(),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Available(),
+				version:   "4.12.8",
+			},
+		},
+		{
+			name:    "connection details",
+			mg:      cluster(withConnectionSecret),
+			cluster: ocmCluster(),
+			creds: &ocm.ClusterCredentials{
+				Kubeconfig: "apiVersion: v1\nkind: Config\n",
+				Admin:      &ocm.ClusterAdminCredentials{User: "kubeadmin", Password: "s3cr3t"},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						"endpoint":   []byte("https://api.prod.example.com:6443"),
+						"consoleURL": []byte("https://console.prod.example.com"),
+						"kubeconfig": []byte("apiVersion: v1\nkind: Config\n"),
+						"username":   []byte("kubeadmin"),
+						"password":   []byte("s3cr3t"),
+					},
+				},
+				condition: xpv1.Available(),
+				version:   "4.12.8",
+			},
+		},
+		{
+			name:    "no credentials",
+			mg:      cluster(withConnectionSecret),
+			cluster: ocmCluster(),
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						"endpoint":   []byte("https://api.prod.example.com:6443"),
+						"consoleURL": []byte("https://console.prod.example.com"),
+					},
+				},
+				condition: xpv1.Available(),
+				version:   "4.12.8",
+			},
+		},
+		{
+			name:    "installing",
+			mg:      cluster(withComputeNodes(6)),
+			cluster: ocmCluster(withOCMClusterState(ocm.ClusterStateInstalling)),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Creating(),
+				version:   "4.12.8",
+			},
+		},
+		{
+			name:    "compute nodes changed",
+			mg:      cluster(withComputeNodes(6)),
+			cluster: ocmCluster(),
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "Observed difference in compute nodes of cluster",
+				},
+				condition: xpv1.Available(),
+				version:   "4.12.8",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &clusterExternal{client: &ocm.ClusterAPIMock{
+				GetClusterFunc: func(ctx context.Context, id string) (*ocm.Cluster, error) {
+					return tc.cluster, tc.err
+				},
+				GetClusterCredentialsFunc: func(ctx context.Context, id string) (*ocm.ClusterCredentials, error) {
+					if tc.creds == nil {
+						return nil, &ocm.APIError{StatusCode: http.StatusNotFound}
+					}
+					return tc.creds, nil
+				},
+			}}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.version, tc.mg.Status.AtProvider.Version); diff != "" {
+				t.Errorf("\ne.Observe(...): -want version, +got version:\n%s\n", diff)
+			}
+			if tc.want.condition.Type != "" {
+				if diff := cmp.Diff(tc.want.condition, tc.mg.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+					t.Errorf("\ne.Observe(...): -want condition, +got condition:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestClusterCreate(t *testing.T) {
+	ccs := withClusterParameters(func(p *v1alpha1.ClusterParameters) {
+		p.Product = v1alpha1.ProductROSA
+		p.AWS = &v1alpha1.ClusterAWS{
+			AccountID: "123456789012",
+			AccessKeyIDSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "aws", Namespace: "crossplane-system"},
+				Key:             "id",
+			},
+			SecretAccessKeySecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "aws", Namespace: "crossplane-system"},
+				Key:             "key",
+			},
+		}
+		p.Network = &v1alpha1.ClusterNetwork{MachineCIDR: "10.0.0.0/16", HostPrefix: 23}
+	})
+
+	cases := []struct {
+		name string
+		mg   *v1alpha1.Cluster
+		want *ocm.Cluster
+		err  error
+	}{
+		{
+			name: "osd",
+			mg:   cluster(),
+			want: &ocm.Cluster{
+				Name:          "prod",
+				Product:       &ocm.ObjectReference{ID: "osd"},
+				CloudProvider: &ocm.ObjectReference{ID: "aws"},
+				Region:        &ocm.ObjectReference{ID: "us-east-1"},
+				Nodes:         &ocm.ClusterNodes{Compute: 3},
+			},
+		},
+		{
+			name: "rosa",
+			mg:   cluster(ccs),
+			want: &ocm.Cluster{
+				Name:          "prod",
+				Product:       &ocm.ObjectReference{ID: "rosa"},
+				CloudProvider: &ocm.ObjectReference{ID: "aws"},
+				Region:        &ocm.ObjectReference{ID: "us-east-1"},
+				Nodes:         &ocm.ClusterNodes{Compute: 3},
+				Network:       &ocm.Network{MachineCIDR: "10.0.0.0/16", HostPrefix: 23},
+				CCS:           &ocm.CCS{Enabled: true},
+				AWS:           &ocm.AWS{AccountID: "123456789012", AccessKeyID: "AKIA", SecretAccessKey: "s3cr3t"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got *ocm.Cluster
+			e := &clusterExternal{
+				kube: secretClient(map[string][]byte{"id": []byte("AKIA"), "key": []byte("s3cr3t")}),
+				client: &ocm.ClusterAPIMock{
+					CreateClusterFunc: func(ctx context.Context, c *ocm.Cluster) (*ocm.Cluster, error) {
+						got = c
+						return &ocm.Cluster{ID: "new-id"}, nil
+					},
+				},
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\ne.Create(...): -want cluster, +got cluster:\n%s\n", diff)
+			}
+			if id := meta.GetExternalName(tc.mg); id != "new-id" {
+				t.Errorf("\ne.Create(...): want external name %q, got %q\n", "new-id", id)
+			}
+		})
+	}
+}
+
+func TestClusterUpdate(t *testing.T) {
+	var got *ocm.Cluster
+	e := &clusterExternal{client: &ocm.ClusterAPIMock{
+		UpdateClusterFunc: func(ctx context.Context, id string, c *ocm.Cluster) error {
+			if id != clusterID {
+				t.Errorf("\ne.Update(...): want cluster %q, got %q\n", clusterID, id)
+			}
+			got = c
+			return nil
+		},
+	}}
+	mg := cluster(withComputeNodes(6))
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Update(...): unexpected error: %v\n", err)
+	}
+	if diff := cmp.Diff(&ocm.Cluster{Nodes: &ocm.ClusterNodes{Compute: 6}}, got); diff != "" {
+		t.Errorf("\ne.Update(...): -want patch, +got patch:\n%s\n", diff)
+	}
+}
+
+func TestClusterDelete(t *testing.T) {
+	cases := []struct {
+		name    string
+		mg      *v1alpha1.Cluster
+		err     error
+		deleted bool
+		want    error
+	}{
+		{
+			name:    "deleted",
+			mg:      cluster(withClusterState(ocm.ClusterStateReady)),
+			deleted: true,
+		},
+		{
+			name: "already uninstalling",
+			mg:   cluster(withClusterState(ocm.ClusterStateUninstalling)),
+		},
+		{
+			name:    "not found",
+			mg:      cluster(),
+			err:     &ocm.APIError{StatusCode: http.StatusNotFound},
+			deleted: true,
+		},
+		{
+			name:    "error",
+			mg:      cluster(),
+			err:     errors.New("boom"),
+			deleted: true,
+			want:    cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			deleted := false
+			e := &clusterExternal{client: &ocm.ClusterAPIMock{
+				DeleteClusterFunc: func(ctx context.Context, id string) error {
+					deleted = true
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+			if deleted != tc.deleted {
+				t.Errorf("\ne.Delete(...): want deleted %t, got %t\n", tc.deleted, deleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+)
+
+const (
+	errNotClusterGroupUser     = "managed resource is not a ClusterGroupUser custom resource"
+	errObserveClusterGroupUser = "cannot observe cluster group user"
+	errCreateClusterGroupUser  = "cannot create cluster group user"
+	errDeleteClusterGroupUser  = "cannot delete cluster group user"
+)
+
+// setupClusterGroupUser adds a controller that reconciles ClusterGroupUser
+// managed resources.
+func setupClusterGroupUser(mgr ctrl.Manager, o controller.Options) error {
+	return setupOCMResource(mgr, o, v1alpha1.ClusterGroupUserGroupVersionKind, &v1alpha1.ClusterGroupUser{},
+		func(c ocm.Client) managed.ExternalClient { return &clusterGroupUserExternal{client: c} })
+}
+
+// A clusterGroupUserExternal observes, then either adds or removes a user of
+// a group of an OCM cluster. Group members have no properties to update.
+type clusterGroupUserExternal struct {
+	client ocm.GroupUserAPI
+}
+
+func (c *clusterGroupUserExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterGroupUser)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotClusterGroupUser)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := c.client.GetGroupUser(ctx, p.ClusterID, string(p.Group), id)
+	if ocm.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveClusterGroupUser)
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (c *clusterGroupUserExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterGroupUser)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotClusterGroupUser)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	p := cr.Spec.ForProvider
+	u, err := c.client.CreateGroupUser(ctx, p.ClusterID, string(p.Group), &ocm.User{ID: p.User})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateClusterGroupUser)
+	}
+	meta.SetExternalName(cr, u.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *clusterGroupUserExternal) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.ClusterGroupUser); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotClusterGroupUser)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *clusterGroupUserExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ClusterGroupUser)
+	if !ok {
+		return errors.New(errNotClusterGroupUser)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	p := cr.Spec.ForProvider
+	err := c.client.DeleteGroupUser(ctx, p.ClusterID, string(p.Group), meta.GetExternalName(cr))
+	if ocm.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteClusterGroupUser)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+)
+
+var _ managed.ExternalClient = &clusterGroupUserExternal{}
+
+func clusterGroupUser() *v1alpha1.ClusterGroupUser {
+	return &v1alpha1.ClusterGroupUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice"},
+		Spec: v1alpha1.ClusterGroupUserSpec{
+			ForProvider: v1alpha1.ClusterGroupUserParameters{
+				ClusterID: clusterID,
+				Group:     v1alpha1.ClusterGroupDedicatedAdmins,
+				User:      "alice",
+			},
+		},
+	}
+}
+
+func TestClusterGroupUserObserve(t *testing.T) {
+	cases := []struct {
+		name string
+		id   string
+		err  error
+		want managed.ExternalObservation
+		werr error
+	}{
+		{name: "not created"},
+		{
+			name: "not found",
+			id:   "alice",
+			err:  &ocm.APIError{StatusCode: http.StatusNotFound},
+		},
+		{
+			name: "error",
+			id:   "alice",
+			err:  errors.New("boom"),
+			werr: cmpopts.AnyError,
+		},
+		{
+			name: "exists",
+			id:   "alice",
+			want: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &clusterGroupUserExternal{client: &ocm.GroupUserAPIMock{
+				GetGroupUserFunc: func(ctx context.Context, cluster, group, id string) (*ocm.User, error) {
+					if cluster != clusterID || group != "dedicated-admins" || id != "alice" {
+						t.Errorf("\ne.Observe(...): unexpected user %s/%s/%s\n", cluster, group, id)
+					}
+					return &ocm.User{ID: id}, tc.err
+				},
+			}}
+			mg := clusterGroupUser()
+			meta.SetExternalName(mg, tc.id)
+			got, err := e.Observe(context.Background(), mg)
+			if diff := cmp.Diff(tc.werr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestClusterGroupUserCreate(t *testing.T) {
+	e := &clusterGroupUserExternal{client: &ocm.GroupUserAPIMock{
+		CreateGroupUserFunc: func(ctx context.Context, cluster, group string, u *ocm.User) (*ocm.User, error) {
+			if group != "dedicated-admins" {
+				t.Errorf("\ne.Create(...): want group %q, got %q\n", "dedicated-admins", group)
+			}
+			return u, nil
+		},
+	}}
+	mg := clusterGroupUser()
+	if _, err := e.Create(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %v\n", err)
+	}
+	if id := meta.GetExternalName(mg); id != "alice" {
+		t.Errorf("\ne.Create(...): want external name %q, got %q\n", "alice", id)
+	}
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+	"github.com/stehessel/provider-redhat/pkg/secrethash"
+)
+
+const (
+	errNotIdentityProvider     = "managed resource is not an IdentityProvider custom resource"
+	errObserveIdentityProvider = "cannot observe identity provider"
+	errCreateIdentityProvider  = "cannot create identity provider"
+	errUpdateIdentityProvider  = "cannot update identity provider"
+	errDeleteIdentityProvider  = "cannot delete identity provider"
+	errIdentityProviderSecrets = "cannot get identity provider secrets"
+	errIdentityProviderType    = "exactly one of openID, github, ldap and htpasswd must be set"
+)
+
+// setupIdentityProvider adds a controller that reconciles IdentityProvider
+// managed resources.
+func setupIdentityProvider(mgr ctrl.Manager, o controller.Options) error {
+	kube := mgr.GetClient()
+	return setupOCMResource(mgr, o, v1alpha1.IdentityProviderGroupVersionKind, &v1alpha1.IdentityProvider{},
+		func(c ocm.Client) managed.ExternalClient { return &identityProviderExternal{client: c, kube: kube} })
+}
+
+// An identityProviderExternal observes, then either creates, updates, or
+// deletes an identity provider of an OCM cluster. Client secrets and
+// passwords are read from secrets.
+type identityProviderExternal struct {
+	client ocm.IdentityProviderAPI
+	kube   client.Client
+}
+
+// generateIdentityProvider returns the OCM identity provider described by the
+// supplied IdentityProvider, together with a hash of its sensitive fields.
+func (c *identityProviderExternal) generateIdentityProvider(ctx context.Context, cr *v1alpha1.IdentityProvider) (*ocm.IdentityProvider, string, error) {
+	p := cr.Spec.ForProvider
+	idp := &ocm.IdentityProvider{
+		ID:            meta.GetExternalName(cr),
+		Name:          p.Name,
+		MappingMethod: string(p.MappingMethod),
+	}
+	if idp.MappingMethod == "" {
+		idp.MappingMethod = "claim"
+	}
+
+	types := 0
+	secrets := []string{}
+	secret := func(sel *xpv1.SecretKeySelector) (string, error) {
+		if sel == nil {
+			secrets = append(secrets, "")
+			return "", nil
+		}
+		v, err := secrethash.GetSecretValue(ctx, c.kube, *sel)
+		secrets = append(secrets, v)
+		return v, errors.Wrap(err, errIdentityProviderSecrets)
+	}
+
+	var err error
+	if o := p.OpenID; o != nil {
+		types++
+		idp.Type = ocm.IdentityProviderTypeOpenID
+		idp.OpenID = &ocm.OpenIDIdentityProvider{
+			ClientID:    o.ClientID,
+			Issuer:      o.Issuer,
+			CA:          o.CA,
+			ExtraScopes: o.ExtraScopes,
+		}
+		if cl := o.Claims; cl != nil {
+			idp.OpenID.Claims = &ocm.OpenIDClaims{
+				Email:             cl.Email,
+				Name:              cl.Name,
+				PreferredUsername: cl.PreferredUsername,
+				Groups:            cl.Groups,
+			}
+		}
+		if idp.OpenID.ClientSecret, err = secret(&o.ClientSecretSecretRef); err != nil {
+			return nil, "", err
+		}
+	}
+	if g := p.GitHub; g != nil {
+		types++
+		idp.Type = ocm.IdentityProviderTypeGitHub
+		idp.GitHub = &ocm.GitHubIdentityProvider{
+			ClientID:      g.ClientID,
+			Organizations: g.Organizations,
+			Teams:         g.Teams,
+			Hostname:      g.Hostname,
+			CA:            g.CA,
+		}
+		if idp.GitHub.ClientSecret, err = secret(&g.ClientSecretSecretRef); err != nil {
+			return nil, "", err
+		}
+	}
+	if l := p.LDAP; l != nil {
+		types++
+		idp.Type = ocm.IdentityProviderTypeLDAP
+		idp.LDAP = &ocm.LDAPIdentityProvider{
+			URL:      l.URL,
+			BindDN:   l.BindDN,
+			Insecure: l.Insecure,
+			CA:       l.CA,
+		}
+		if a := l.Attributes; a != nil {
+			idp.LDAP.Attributes = &ocm.LDAPAttributes{
+				ID:                a.ID,
+				Email:             a.Email,
+				Name:              a.Name,
+				PreferredUsername: a.PreferredUsername,
+			}
+		}
+		if idp.LDAP.BindPassword, err = secret(l.BindPasswordSecretRef); err != nil {
+			return nil, "", err
+		}
+	}
+	if h := p.HTPasswd; h != nil {
+		types++
+		idp.Type = ocm.IdentityProviderTypeHTPasswd
+		idp.HTPasswd = &ocm.HTPasswdIdentityProvider{Users: &ocm.HTPasswdUserList{}}
+		for i := range h.Users {
+			u := ocm.HTPasswdUser{Username: h.Users[i].Username}
+			// OCM does not return the users either, so their names are
+			// hashed together with their passwords.
+			secrets = append(secrets, u.Username)
+			if u.Password, err = secret(&h.Users[i].PasswordSecretRef); err != nil {
+				return nil, "", err
+			}
+			idp.HTPasswd.Users.Items = append(idp.HTPasswd.Users.Items, u)
+		}
+	}
+	if types != 1 {
+		return nil, "", errors.New(errIdentityProviderType)
+	}
+
+	return idp, secrethash.Hash(secrets), nil
+}
+
+// scrubIdentityProvider returns a copy of the supplied identity provider
+// without its sensitive fields and users, which OCM does not return.
+func scrubIdentityProvider(in *ocm.IdentityProvider) *ocm.IdentityProvider {
+	idp := *in
+	if idp.OpenID != nil {
+		o := *idp.OpenID
+		o.ClientSecret = ""
+		idp.OpenID = &o
+	}
+	if idp.GitHub != nil {
+		g := *idp.GitHub
+		g.ClientSecret = ""
+		idp.GitHub = &g
+	}
+	if idp.LDAP != nil {
+		l := *idp.LDAP
+		l.BindPassword = ""
+		idp.LDAP = &l
+	}
+	if idp.HTPasswd != nil {
+		idp.HTPasswd = &ocm.HTPasswdIdentityProvider{}
+	}
+	return &idp
+}
+
+func isIdentityProviderUpToDate(in *v1alpha1.IdentityProvider, desired *ocm.IdentityProvider, hash string, observed *ocm.IdentityProvider) (bool, string) {
+	if diff := cmp.Diff(scrubIdentityProvider(desired), scrubIdentityProvider(observed), cmpopts.EquateEmpty()); diff != "" {
+		diff = "Observed difference in identity provider\n" + diff
+		return false, diff
+	}
+	if secrethash.Changed(&in.Status.AtProvider.SecretObservation, hash) {
+		return false, "Referenced secrets of identity provider changed"
+	}
+	return true, ""
+}
+
+func (c *identityProviderExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.IdentityProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotIdentityProvider)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	idp, err := c.client.GetIdentityProvider(ctx, cr.Spec.ForProvider.ClusterID, id)
+	if ocm.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveIdentityProvider)
+	}
+	desired, hash, err := c.generateIdentityProvider(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveIdentityProvider)
+	}
+
+	cr.Status.AtProvider.ID = idp.ID
+	cr.Status.AtProvider.Type = idp.Type
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isIdentityProviderUpToDate(cr, desired, hash, idp)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *identityProviderExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.IdentityProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotIdentityProvider)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	desired, _, err := c.generateIdentityProvider(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateIdentityProvider)
+	}
+	idp, err := c.client.CreateIdentityProvider(ctx, cr.Spec.ForProvider.ClusterID, desired)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateIdentityProvider)
+	}
+	meta.SetExternalName(cr, idp.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *identityProviderExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.IdentityProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotIdentityProvider)
+	}
+
+	desired, hash, err := c.generateIdentityProvider(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateIdentityProvider)
+	}
+	if err := c.client.UpdateIdentityProvider(ctx, cr.Spec.ForProvider.ClusterID, desired); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateIdentityProvider)
+	}
+	cr.Status.AtProvider.SecretHash = hash
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *identityProviderExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.IdentityProvider)
+	if !ok {
+		return errors.New(errNotIdentityProvider)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteIdentityProvider(ctx, cr.Spec.ForProvider.ClusterID, meta.GetExternalName(cr))
+	if ocm.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteIdentityProvider)
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+	"github.com/stehessel/provider-redhat/pkg/secrethash"
+)
+
+var _ managed.ExternalClient = &identityProviderExternal{}
+
+var identityProviderID = "idp-id"
+
+func secretKey(key string) xpv1.SecretKeySelector {
+	return xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "idp", Namespace: "crossplane-system"},
+		Key:             key,
+	}
+}
+
+type identityProviderModifier func(*v1alpha1.IdentityProvider)
+
+func withSecretHash(h string) identityProviderModifier {
+	return func(i *v1alpha1.IdentityProvider) { i.Status.AtProvider.SecretHash = h }
+}
+
+func withTeams(teams ...string) identityProviderModifier {
+	return func(i *v1alpha1.IdentityProvider) { i.Spec.ForProvider.GitHub.Teams = teams }
+}
+
+func withHTPasswdUser(username, key string) identityProviderModifier {
+	return func(i *v1alpha1.IdentityProvider) {
+		i.Spec.ForProvider.HTPasswd = &v1alpha1.HTPasswdIdentityProvider{
+			Users: []v1alpha1.HTPasswdUser{{Username: username, PasswordSecretRef: secretKey(key)}},
+		}
+	}
+}
+
+func withLDAP(url string) identityProviderModifier {
+	return func(i *v1alpha1.IdentityProvider) {
+		i.Spec.ForProvider.LDAP = &v1alpha1.LDAPIdentityProvider{URL: url}
+	}
+}
+
+func withoutGitHub(i *v1alpha1.IdentityProvider) { i.Spec.ForProvider.GitHub = nil }
+
+func identityProvider(mod ...identityProviderModifier) *v1alpha1.IdentityProvider {
+	i := &v1alpha1.IdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "github"},
+		Spec: v1alpha1.IdentityProviderSpec{
+			ForProvider: v1alpha1.IdentityProviderParameters{
+				ClusterID:     clusterID,
+				Name:          "github",
+				MappingMethod: "claim",
+				GitHub: &v1alpha1.GitHubIdentityProvider{
+					ClientID:              "client",
+					ClientSecretSecretRef: secretKey("secret"),
+					Teams:                 []string{"org/team"},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(i, identityProviderID)
+	for _, m := range mod {
+		m(i)
+	}
+	return i
+}
+
+func ocmIdentityProvider() *ocm.IdentityProvider {
+	return &ocm.IdentityProvider{
+		ID:            identityProviderID,
+		Type:          ocm.IdentityProviderTypeGitHub,
+		Name:          "github",
+		MappingMethod: "claim",
+		GitHub:        &ocm.GitHubIdentityProvider{ClientID: "client", Teams: []string{"org/team"}},
+	}
+}
+
+func TestIdentityProviderObserve(t *testing.T) {
+	hash := secrethash.Hash([]string{"s3cr3t"})
+	cases := []struct {
+		name     string
+		mg       *v1alpha1.IdentityProvider
+		idp      *ocm.IdentityProvider
+		err      error
+		upToDate bool
+		want     error
+	}{
+		{
+			name: "not found",
+			mg:   identityProvider(),
+			err:  &ocm.APIError{StatusCode: http.StatusNotFound},
+		},
+		{
+			name: "error",
+			mg:   identityProvider(),
+			err:  errors.New("boom"),
+			want: cmpopts.AnyError,
+		},
+		{
+			name:     "up to date",
+			mg:       identityProvider(withSecretHash(hash)),
+			idp:      ocmIdentityProvider(),
+			upToDate: true,
+		},
+		{
+			name:     "first observation",
+			mg:       identityProvider(),
+			idp:      ocmIdentityProvider(),
+			upToDate: true,
+		},
+		{
+			name: "secret changed",
+			mg:   identityProvider(withSecretHash("old")),
+			idp:  ocmIdentityProvider(),
+		},
+		{
+			name: "teams changed",
+			mg:   identityProvider(withSecretHash(hash), withTeams("org/other")),
+			idp:  ocmIdentityProvider(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &identityProviderExternal{
+				kube: secretClient(map[string][]byte{"secret": []byte("s3cr3t")}),
+				client: &ocm.IdentityProviderAPIMock{
+					GetIdentityProviderFunc: func(ctx context.Context, cluster, id string) (*ocm.IdentityProvider, error) {
+						return tc.idp, tc.err
+					},
+				},
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if got.ResourceExists != (tc.idp != nil) || got.ResourceUpToDate != tc.upToDate {
+				t.Errorf("\ne.Observe(...): unexpected observation %+v\n", got)
+			}
+			if tc.upToDate && tc.mg.Status.AtProvider.SecretHash != hash {
+				t.Errorf("\ne.Observe(...): recorded secret hash %q, want %q\n", tc.mg.Status.AtProvider.SecretHash, hash)
+			}
+		})
+	}
+}
+
+func TestIdentityProviderCreate(t *testing.T) {
+	cases := []struct {
+		name string
+		mg   *v1alpha1.IdentityProvider
+		want *ocm.IdentityProvider
+		err  error
+	}{
+		{
+			name: "github",
+			mg:   identityProvider(),
+			want: &ocm.IdentityProvider{
+				ID:            identityProviderID,
+				Type:          ocm.IdentityProviderTypeGitHub,
+				Name:          "github",
+				MappingMethod: "claim",
+				GitHub:        &ocm.GitHubIdentityProvider{ClientID: "client", ClientSecret: "s3cr3t", Teams: []string{"org/team"}},
+			},
+		},
+		{
+			name: "htpasswd",
+			mg:   identityProvider(withoutGitHub, withHTPasswdUser("admin", "password")),
+			want: &ocm.IdentityProvider{
+				ID:            identityProviderID,
+				Type:          ocm.IdentityProviderTypeHTPasswd,
+				Name:          "github",
+				MappingMethod: "claim",
+				HTPasswd: &ocm.HTPasswdIdentityProvider{Users: &ocm.HTPasswdUserList{
+					Items: []ocm.HTPasswdUser{{Username: "admin", Password: "hunter2"}},
+				}},
+			},
+		},
+		{
+			name: "no type",
+			mg:   identityProvider(withoutGitHub),
+			err:  cmpopts.AnyError,
+		},
+		{
+			name: "two types",
+			mg:   identityProvider(withLDAP("ldaps://ldap.example.com/ou=users,dc=example,dc=com")),
+			err:  cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got *ocm.IdentityProvider
+			e := &identityProviderExternal{
+				kube: secretClient(map[string][]byte{"secret": []byte("s3cr3t"), "password": []byte("hunter2")}),
+				client: &ocm.IdentityProviderAPIMock{
+					CreateIdentityProviderFunc: func(ctx context.Context, cluster string, p *ocm.IdentityProvider) (*ocm.IdentityProvider, error) {
+						got = p
+						return &ocm.IdentityProvider{ID: identityProviderID}, nil
+					},
+				},
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\ne.Create(...): -want identity provider, +got identity provider:\n%s\n", diff)
+			}
+		})
+	}
+}
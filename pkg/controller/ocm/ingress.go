@@ -0,0 +1,211 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+)
+
+const (
+	errNotIngress     = "managed resource is not an Ingress custom resource"
+	errObserveIngress = "cannot observe ingress"
+	errCreateIngress  = "cannot create ingress"
+	errUpdateIngress  = "cannot update ingress"
+	errDeleteIngress  = "cannot delete ingress"
+	errNoDefault      = "cluster has no default ingress"
+)
+
+// setupIngress adds a controller that reconciles Ingress managed resources.
+func setupIngress(mgr ctrl.Manager, o controller.Options) error {
+	return setupOCMResource(mgr, o, v1alpha1.IngressGroupVersionKind, &v1alpha1.Ingress{},
+		func(c ocm.Client) managed.ExternalClient { return &ingressExternal{client: c} })
+}
+
+// An ingressExternal observes, then either creates, updates, or deletes an
+// ingress of an OCM cluster. The default ingress of a cluster is adopted
+// instead of created, and never deleted.
+type ingressExternal struct {
+	client ocm.IngressAPI
+}
+
+func generateIngress(in *v1alpha1.Ingress) *ocm.Ingress {
+	p := in.Spec.ForProvider
+	i := &ocm.Ingress{
+		Listening:          ocm.IngressListeningExternal,
+		LoadBalancerType:   string(p.LoadBalancerType),
+		RouteSelectors:     p.RouteSelectors,
+		ExcludedNamespaces: p.ExcludedNamespaces,
+	}
+	if p.Listening == v1alpha1.ListeningPrivate {
+		i.Listening = ocm.IngressListeningInternal
+	}
+	return i
+}
+
+func generateIngressParameters(in *ocm.Ingress) v1alpha1.IngressParameters {
+	p := v1alpha1.IngressParameters{
+		Default:            in.Default,
+		Listening:          v1alpha1.ListeningPublic,
+		LoadBalancerType:   v1alpha1.LoadBalancerType(in.LoadBalancerType),
+		RouteSelectors:     in.RouteSelectors,
+		ExcludedNamespaces: in.ExcludedNamespaces,
+	}
+	if in.Listening == ocm.IngressListeningInternal {
+		p.Listening = v1alpha1.ListeningPrivate
+	}
+	return p
+}
+
+// isIngressUpToDate compares the parameters of the ingress. The load balancer
+// type is only compared if it is set, because OCM defaults it.
+func isIngressUpToDate(in *v1alpha1.Ingress, observed *ocm.Ingress) (bool, string) {
+	desired := in.Spec.ForProvider
+	if desired.Listening == "" {
+		desired.Listening = v1alpha1.ListeningPublic
+	}
+	observedParams := generateIngressParameters(observed)
+	if desired.LoadBalancerType == "" {
+		observedParams.LoadBalancerType = ""
+	}
+	if diff := cmp.Diff(desired, observedParams, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.IngressParameters{}, "ClusterID", "ClusterIDRef", "ClusterIDSelector")); diff != "" {
+		diff = "Observed difference in ingress\n" + diff
+		return false, diff
+	}
+	return true, ""
+}
+
+func (c *ingressExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Ingress)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotIngress)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	// The default ingress is left in place, so it is gone as far as a
+	// deleted Ingress is concerned.
+	if meta.WasDeleted(cr) && cr.Spec.ForProvider.Default {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	ingress, err := c.client.GetIngress(ctx, cr.Spec.ForProvider.ClusterID, id)
+	if ocm.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveIngress)
+	}
+
+	cr.Status.AtProvider = v1alpha1.IngressObservation{
+		ID:               ingress.ID,
+		DNSName:          ingress.DNSName,
+		LoadBalancerType: ingress.LoadBalancerType,
+	}
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isIngressUpToDate(cr, ingress)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+// Create creates an additional ingress, or adopts and updates the default
+// ingress of the cluster.
+func (c *ingressExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Ingress)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotIngress)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	clusterID := cr.Spec.ForProvider.ClusterID
+	desired := generateIngress(cr)
+	if !cr.Spec.ForProvider.Default {
+		ingress, err := c.client.CreateIngress(ctx, clusterID, desired)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreateIngress)
+		}
+		meta.SetExternalName(cr, ingress.ID)
+		return managed.ExternalCreation{}, nil
+	}
+
+	ingresses, err := c.client.ListIngresses(ctx, clusterID)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateIngress)
+	}
+	for _, i := range ingresses {
+		if !i.Default {
+			continue
+		}
+		desired.ID = i.ID
+		if err := c.client.UpdateIngress(ctx, clusterID, desired); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreateIngress)
+		}
+		meta.SetExternalName(cr, i.ID)
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{}, errors.Wrap(errors.New(errNoDefault), errCreateIngress)
+}
+
+func (c *ingressExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Ingress)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotIngress)
+	}
+
+	desired := generateIngress(cr)
+	desired.ID = meta.GetExternalName(cr)
+	err := c.client.UpdateIngress(ctx, cr.Spec.ForProvider.ClusterID, desired)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateIngress)
+}
+
+// Delete deletes an additional ingress. The default ingress of the cluster
+// cannot be deleted, so it is left as is.
+func (c *ingressExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Ingress)
+	if !ok {
+		return errors.New(errNotIngress)
+	}
+	mg.SetConditions(xpv1.Deleting())
+	if cr.Spec.ForProvider.Default {
+		return nil
+	}
+
+	err := c.client.DeleteIngress(ctx, cr.Spec.ForProvider.ClusterID, meta.GetExternalName(cr))
+	if ocm.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteIngress)
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+)
+
+var _ managed.ExternalClient = &ingressExternal{}
+
+type ingressModifier func(*v1alpha1.Ingress)
+
+func withListening(l v1alpha1.Listening) ingressModifier {
+	return func(i *v1alpha1.Ingress) { i.Spec.ForProvider.Listening = l }
+}
+
+func withLoadBalancerType(t v1alpha1.LoadBalancerType) ingressModifier {
+	return func(i *v1alpha1.Ingress) { i.Spec.ForProvider.LoadBalancerType = t }
+}
+
+func withoutRouteSelectors(i *v1alpha1.Ingress) { i.Spec.ForProvider.RouteSelectors = nil }
+
+func withAdditional(i *v1alpha1.Ingress) { i.Spec.ForProvider.Default = false }
+
+func withDeletionTimestamp(i *v1alpha1.Ingress) {
+	now := metav1.Now()
+	i.SetDeletionTimestamp(&now)
+}
+
+func ingress(mod ...ingressModifier) *v1alpha1.Ingress {
+	i := &v1alpha1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps"},
+		Spec: v1alpha1.IngressSpec{
+			ForProvider: v1alpha1.IngressParameters{
+				ClusterID:          clusterID,
+				Default:            true,
+				Listening:          v1alpha1.ListeningPrivate,
+				RouteSelectors:     map[string]string{"route": "private"},
+				ExcludedNamespaces: []string{"openshift-console"},
+			},
+		},
+	}
+	meta.SetExternalName(i, "default-id")
+	for _, m := range mod {
+		m(i)
+	}
+	return i
+}
+
+func ocmIngress(mod ...func(*ocm.Ingress)) *ocm.Ingress {
+	i := &ocm.Ingress{
+		ID:                 "default-id",
+		Default:            true,
+		Listening:          ocm.IngressListeningInternal,
+		DNSName:            "apps.prod.example.com",
+		LoadBalancerType:   "nlb",
+		RouteSelectors:     map[string]string{"route": "private"},
+		ExcludedNamespaces: []string{"openshift-console"},
+	}
+	for _, m := range mod {
+		m(i)
+	}
+	return i
+}
+
+func TestIngressObserve(t *testing.T) {
+	cases := []struct {
+		name     string
+		mg       *v1alpha1.Ingress
+		ingress  *ocm.Ingress
+		err      error
+		upToDate bool
+		want     error
+	}{
+		{
+			name: "not found",
+			mg:   ingress(),
+			err:  &ocm.APIError{StatusCode: http.StatusNotFound},
+		},
+		{
+			name: "error",
+			mg:   ingress(),
+			err:  errors.New("boom"),
+			want: cmpopts.AnyError,
+		},
+		{
+			name:     "up to date",
+			mg:       ingress(),
+			ingress:  ocmIngress(),
+			upToDate: true,
+		},
+		{
+			name:    "listening changed",
+			mg:      ingress(withListening(v1alpha1.ListeningPublic)),
+			ingress: ocmIngress(),
+		},
+		{
+			name:    "selectors removed",
+			mg:      ingress(withoutRouteSelectors),
+			ingress: ocmIngress(),
+		},
+		{
+			name:    "load balancer type changed",
+			mg:      ingress(withLoadBalancerType("classic")),
+			ingress: ocmIngress(),
+		},
+		{
+			name: "default deleted",
+			mg:   ingress(withDeletionTimestamp),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &ingressExternal{client: &ocm.IngressAPIMock{
+				GetIngressFunc: func(ctx context.Context, cluster, id string) (*ocm.Ingress, error) {
+					return tc.ingress, tc.err
+				},
+			}}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if got.ResourceExists != (tc.ingress != nil) || got.ResourceUpToDate != tc.upToDate {
+				t.Errorf("\ne.Observe(...): unexpected observation %+v\n", got)
+			}
+			if got.ResourceExists && !tc.upToDate && !strings.HasPrefix(got.Diff, "Observed difference in ingress") {
+				t.Errorf("\ne.Observe(...): unexpected diff %q\n", got.Diff)
+			}
+		})
+	}
+}
+
+func TestIngressCreate(t *testing.T) {
+	desired := &ocm.Ingress{
+		Listening:          ocm.IngressListeningInternal,
+		RouteSelectors:     map[string]string{"route": "private"},
+		ExcludedNamespaces: []string{"openshift-console"},
+	}
+	additional := ocm.Ingress{ID: "additional-id"}
+
+	cases := []struct {
+		name      string
+		mg        *v1alpha1.Ingress
+		ingresses []ocm.Ingress
+		created   *ocm.Ingress
+		updated   *ocm.Ingress
+		id        string
+		err       error
+	}{
+		{
+			name:    "additional",
+			mg:      ingress(withAdditional),
+			created: desired,
+			id:      "additional-id",
+		},
+		{
+			name:      "adopt default",
+			mg:        ingress(),
+			ingresses: []ocm.Ingress{additional, *ocmIngress()},
+			updated: &ocm.Ingress{
+				ID:                 "default-id",
+				Listening:          ocm.IngressListeningInternal,
+				RouteSelectors:     map[string]string{"route": "private"},
+				ExcludedNamespaces: []string{"openshift-console"},
+			},
+			id: "default-id",
+		},
+		{
+			name:      "no default",
+			mg:        ingress(),
+			ingresses: []ocm.Ingress{additional},
+			id:        "default-id",
+			err:       cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var created, updated *ocm.Ingress
+			e := &ingressExternal{client: &ocm.IngressAPIMock{
+				ListIngressesFunc: func(ctx context.Context, cluster string) ([]ocm.Ingress, error) {
+					return tc.ingresses, nil
+				},
+				CreateIngressFunc: func(ctx context.Context, cluster string, i *ocm.Ingress) (*ocm.Ingress, error) {
+					created = i
+					return &additional, nil
+				},
+				UpdateIngressFunc: func(ctx context.Context, cluster string, i *ocm.Ingress) error {
+					updated = i
+					return nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.created, created); diff != "" {
+				t.Errorf("\ne.Create(...): -want created, +got created:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.updated, updated); diff != "" {
+				t.Errorf("\ne.Create(...): -want updated, +got updated:\n%s\n", diff)
+			}
+			if id := meta.GetExternalName(tc.mg); id != tc.id {
+				t.Errorf("\ne.Create(...): want external name %q, got %q\n", tc.id, id)
+			}
+		})
+	}
+}
+
+func TestIngressDelete(t *testing.T) {
+	cases := []struct {
+		name    string
+		mg      *v1alpha1.Ingress
+		deleted bool
+	}{
+		{
+			name: "default",
+			mg:   ingress(),
+		},
+		{
+			name:    "additional",
+			mg:      ingress(withAdditional),
+			deleted: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			deleted := false
+			e := &ingressExternal{client: &ocm.IngressAPIMock{
+				DeleteIngressFunc: func(ctx context.Context, cluster, id string) error {
+					deleted = true
+					return nil
+				},
+			}}
+			if err := e.Delete(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\ne.Delete(...): unexpected error: %v\n", err)
+			}
+			if deleted != tc.deleted {
+				t.Errorf("\ne.Delete(...): want deleted %t, got %t\n", tc.deleted, deleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+)
+
+const (
+	errNotMachinePool     = "managed resource is not a MachinePool custom resource"
+	errObserveMachinePool = "cannot observe machine pool"
+	errCreateMachinePool  = "cannot create machine pool"
+	errUpdateMachinePool  = "cannot update machine pool"
+	errDeleteMachinePool  = "cannot delete machine pool"
+	errMachinePoolScaling = "exactly one of replicas and autoscaling must be set"
+)
+
+// setupMachinePool adds a controller that reconciles MachinePool managed
+// resources.
+func setupMachinePool(mgr ctrl.Manager, o controller.Options) error {
+	return setupOCMResource(mgr, o, v1alpha1.MachinePoolGroupVersionKind, &v1alpha1.MachinePool{},
+		func(c ocm.Client) managed.ExternalClient { return &machinePoolExternal{client: c} })
+}
+
+// A machinePoolExternal observes, then either creates, updates, or deletes
+// a machine pool of an OCM cluster.
+type machinePoolExternal struct {
+	client ocm.MachinePoolAPI
+}
+
+func generateMachinePool(in *v1alpha1.MachinePool) (*ocm.MachinePool, error) {
+	p := in.Spec.ForProvider
+	if (p.Replicas == nil) == (p.Autoscaling == nil) {
+		return nil, errors.New(errMachinePoolScaling)
+	}
+	out := &ocm.MachinePool{
+		ID:                p.Name,
+		InstanceType:      p.InstanceType,
+		Replicas:          p.Replicas,
+		Labels:            p.Labels,
+		AvailabilityZones: p.AvailabilityZones,
+	}
+	if a := p.Autoscaling; a != nil {
+		out.Autoscaling = &ocm.MachinePoolAutoscaling{MinReplicas: a.MinReplicas, MaxReplicas: a.MaxReplicas}
+	}
+	for _, t := range p.Taints {
+		out.Taints = append(out.Taints, ocm.Taint{Key: t.Key, Value: t.Value, Effect: string(t.Effect)})
+	}
+	return out, nil
+}
+
+func generateMachinePoolParameters(in *ocm.MachinePool) v1alpha1.MachinePoolParameters {
+	out := v1alpha1.MachinePoolParameters{
+		Name:              in.ID,
+		InstanceType:      in.InstanceType,
+		Replicas:          in.Replicas,
+		Labels:            in.Labels,
+		AvailabilityZones: in.AvailabilityZones,
+	}
+	if a := in.Autoscaling; a != nil {
+		out.Autoscaling = &v1alpha1.MachinePoolAutoscaling{MinReplicas: a.MinReplicas, MaxReplicas: a.MaxReplicas}
+	}
+	for _, t := range in.Taints {
+		out.Taints = append(out.Taints, v1alpha1.Taint{Key: t.Key, Value: t.Value, Effect: v1alpha1.TaintEffect(t.Effect)})
+	}
+	return out
+}
+
+// isMachinePoolUpToDate compares the parameters of the machine pool that can
+// be changed once it is created: its replicas or autoscaling, labels and
+// taints.
+func isMachinePoolUpToDate(in *v1alpha1.MachinePool, observed *ocm.MachinePool) (bool, string) {
+	observedParams := generateMachinePoolParameters(observed)
+	if diff := cmp.Diff(in.Spec.ForProvider, observedParams, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.MachinePoolParameters{}, "ClusterID", "ClusterIDRef", "ClusterIDSelector",
+			"Name", "InstanceType", "AvailabilityZones")); diff != "" {
+		diff = "Observed difference in machine pool\n" + diff
+		return false, diff
+	}
+	return true, ""
+}
+
+func (c *machinePoolExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.MachinePool)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMachinePool)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	pool, err := c.client.GetMachinePool(ctx, cr.Spec.ForProvider.ClusterID, id)
+	if ocm.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveMachinePool)
+	}
+
+	cr.Status.AtProvider = v1alpha1.MachinePoolObservation{
+		ID:                pool.ID,
+		Replicas:          pool.Replicas,
+		AvailabilityZones: pool.AvailabilityZones,
+	}
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isMachinePoolUpToDate(cr, pool)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *machinePoolExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.MachinePool)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMachinePool)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	desired, err := generateMachinePool(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMachinePool)
+	}
+	pool, err := c.client.CreateMachinePool(ctx, cr.Spec.ForProvider.ClusterID, desired)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMachinePool)
+	}
+	meta.SetExternalName(cr, pool.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update scales the machine pool and replaces its labels and taints.
+func (c *machinePoolExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.MachinePool)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMachinePool)
+	}
+
+	desired, err := generateMachinePool(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMachinePool)
+	}
+	desired.ID = meta.GetExternalName(cr)
+	err = c.client.UpdateMachinePool(ctx, cr.Spec.ForProvider.ClusterID, desired)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMachinePool)
+}
+
+func (c *machinePoolExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.MachinePool)
+	if !ok {
+		return errors.New(errNotMachinePool)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteMachinePool(ctx, cr.Spec.ForProvider.ClusterID, meta.GetExternalName(cr))
+	if ocm.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteMachinePool)
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+)
+
+var _ managed.ExternalClient = &machinePoolExternal{}
+
+func intPtr(i int) *int { return &i }
+
+type machinePoolModifier func(*v1alpha1.MachinePoolParameters)
+
+func withReplicas(n int) machinePoolModifier {
+	return func(p *v1alpha1.MachinePoolParameters) { p.Replicas = intPtr(n) }
+}
+
+func withAutoscaling(minReplicas, maxReplicas int) machinePoolModifier {
+	return func(p *v1alpha1.MachinePoolParameters) {
+		p.Autoscaling = &v1alpha1.MachinePoolAutoscaling{MinReplicas: minReplicas, MaxReplicas: maxReplicas}
+	}
+}
+
+func withAvailabilityZones(zones ...string) machinePoolModifier {
+	return func(p *v1alpha1.MachinePoolParameters) { p.AvailabilityZones = zones }
+}
+
+func withoutReplicas(p *v1alpha1.MachinePoolParameters) { p.Replicas = nil }
+
+func withoutLabels(p *v1alpha1.MachinePoolParameters) { p.Labels = nil }
+
+func withoutTaints(p *v1alpha1.MachinePoolParameters) { p.Taints = nil }
+
+func machinePool(mod ...machinePoolModifier) *v1alpha1.MachinePool {
+	p := &v1alpha1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "workers"},
+		Spec: v1alpha1.MachinePoolSpec{
+			ForProvider: v1alpha1.MachinePoolParameters{
+				ClusterID:    clusterID,
+				Name:         "workers",
+				InstanceType: "m5.xlarge",
+				Replicas:     intPtr(3),
+				Labels:       map[string]string{"team": "a"},
+				Taints:       []v1alpha1.Taint{{Key: "dedicated", Value: "a", Effect: "NoSchedule"}},
+			},
+		},
+	}
+	meta.SetExternalName(p, "workers")
+	for _, m := range mod {
+		m(&p.Spec.ForProvider)
+	}
+	return p
+}
+
+type ocmMachinePoolModifier func(*ocm.MachinePool)
+
+func withOCMAvailabilityZones(zones ...string) ocmMachinePoolModifier {
+	return func(p *ocm.MachinePool) { p.AvailabilityZones = zones }
+}
+
+func ocmMachinePool(mod ...ocmMachinePoolModifier) *ocm.MachinePool {
+	p := &ocm.MachinePool{
+		ID:                "workers",
+		InstanceType:      "m5.xlarge",
+		Replicas:          intPtr(3),
+		Labels:            map[string]string{"team": "a"},
+		Taints:            []ocm.Taint{{Key: "dedicated", Value: "a", Effect: "NoSchedule"}},
+		AvailabilityZones: []string{"us-east-1a"},
+	}
+	for _, m := range mod {
+		m(p)
+	}
+	return p
+}
+
+func TestMachinePoolObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := []struct {
+		name string
+		mg   *v1alpha1.MachinePool
+		pool *ocm.MachinePool
+		err  error
+		want want
+	}{
+		{
+			name: "not found",
+			mg:   machinePool(),
+			err:  &ocm.APIError{StatusCode: http.StatusNotFound},
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+		{
+			name: "error",
+			mg:   machinePool(),
+			err:  errors.New("boom"),
+			want: want{err: cmpopts.AnyError},
+		},
+		{
+			name: "up to date",
+			mg:   machinePool(),
+			pool: ocmMachinePool(),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		{
+			name: "zones defaulted",
+			mg:   machinePool(withAvailabilityZones()),
+			pool: ocmMachinePool(withOCMAvailabilityZones("us-east-1a", "us-east-1b")),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		{
+			name: "replicas changed",
+			mg:   machinePool(withReplicas(6)),
+			pool: ocmMachinePool(),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		{
+			name: "label removed",
+			mg:   machinePool(withoutLabels),
+			pool: ocmMachinePool(),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		{
+			name: "autoscaled",
+			mg:   machinePool(withoutReplicas, withAutoscaling(2, 6)),
+			pool: ocmMachinePool(),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &machinePoolExternal{client: &ocm.MachinePoolAPIMock{
+				GetMachinePoolFunc: func(ctx context.Context, cluster, id string) (*ocm.MachinePool, error) {
+					if cluster != clusterID || id != "workers" {
+						t.Errorf("\ne.Observe(...): unexpected machine pool %s/%s\n", cluster, id)
+					}
+					return tc.pool, tc.err
+				},
+			}}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if !tc.want.obs.ResourceUpToDate && tc.want.obs.ResourceExists && !strings.HasPrefix(got.Diff, "Observed difference in machine pool") {
+				t.Errorf("\ne.Observe(...): unexpected diff %q\n", got.Diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestMachinePoolCreate(t *testing.T) {
+	cases := []struct {
+		name string
+		mg   *v1alpha1.MachinePool
+		want *ocm.MachinePool
+		err  error
+	}{
+		{
+			name: "replicas",
+			mg:   machinePool(withAvailabilityZones("us-east-1a")),
+			want: &ocm.MachinePool{
+				ID:                "workers",
+				InstanceType:      "m5.xlarge",
+				Replicas:          intPtr(3),
+				Labels:            map[string]string{"team": "a"},
+				Taints:            []ocm.Taint{{Key: "dedicated", Value: "a", Effect: "NoSchedule"}},
+				AvailabilityZones: []string{"us-east-1a"},
+			},
+		},
+		{
+			name: "autoscaling",
+			mg:   machinePool(withoutReplicas, withAutoscaling(2, 6), withoutLabels, withoutTaints),
+			want: &ocm.MachinePool{
+				ID:           "workers",
+				InstanceType: "m5.xlarge",
+				Autoscaling:  &ocm.MachinePoolAutoscaling{MinReplicas: 2, MaxReplicas: 6},
+			},
+		},
+		{
+			name: "replicas and autoscaling",
+			mg:   machinePool(withAutoscaling(2, 6)),
+			err:  cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got *ocm.MachinePool
+			e := &machinePoolExternal{client: &ocm.MachinePoolAPIMock{
+				CreateMachinePoolFunc: func(ctx context.Context, cluster string, p *ocm.MachinePool) (*ocm.MachinePool, error) {
+					got = p
+					return &ocm.MachinePool{ID: p.ID}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\ne.Create(...): -want machine pool, +got machine pool:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestMachinePoolUpdate(t *testing.T) {
+	var got *ocm.MachinePool
+	e := &machinePoolExternal{client: &ocm.MachinePoolAPIMock{
+		UpdateMachinePoolFunc: func(ctx context.Context, cluster string, p *ocm.MachinePool) error {
+			if cluster != clusterID {
+				t.Errorf("\ne.Update(...): want cluster %q, got %q\n", clusterID, cluster)
+			}
+			got = p
+			return nil
+		},
+	}}
+	mg := machinePool(withReplicas(6), withoutTaints)
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Update(...): unexpected error: %v\n", err)
+	}
+	want := &ocm.MachinePool{
+		ID:           "workers",
+		InstanceType: "m5.xlarge",
+		Replicas:     intPtr(6),
+		Labels:       map[string]string{"team": "a"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\ne.Update(...): -want machine pool, +got machine pool:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ocm contains the controllers of the ocm API group, which manage
+// OpenShift clusters through the OpenShift Cluster Manager.
+package ocm
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+	"github.com/stehessel/provider-redhat/pkg/controller/providerconfig"
+)
+
+// Setup adds the controllers of the ocm API group to the supplied manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+		setupCluster,
+		setupMachinePool,
+		setupIdentityProvider,
+		setupClusterGroupUser,
+		setupAddOnInstallation,
+		setupUpgradePolicy,
+		setupIngress,
+	} {
+		if err := setup(mgr, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setupOCMResource adds a controller that reconciles managed resources of the
+// supplied kind through the OCM API.
+func setupOCMResource(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj client.Object, external func(ocm.Client) managed.ExternalClient) error {
+	return providerconfig.SetupController(mgr, o, gvk, obj, &providerconfig.Connector[ocm.Client]{
+		NewClient: func(pc *apisv1alpha1.ProviderConfig, token string) (ocm.Client, error) {
+			return ocm.NewClient(token, pc.Spec.Gateway)
+		},
+		ErrNewClient: ocm.ErrNewClient,
+		External:     external,
+	})
+}
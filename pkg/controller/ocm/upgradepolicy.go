@@ -0,0 +1,280 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"golang.org/x/mod/semver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+)
+
+const (
+	errNotUpgradePolicy       = "managed resource is not an UpgradePolicy custom resource"
+	errObserveUpgradePolicy   = "cannot observe upgrade policy"
+	errCreateUpgradePolicy    = "cannot create upgrade policy"
+	errUpdateUpgradePolicy    = "cannot update upgrade policy"
+	errDeleteUpgradePolicy    = "cannot delete upgrade policy"
+	errUpgradePolicyManual    = "manual upgrade policies require a version and next run, but no schedule"
+	errUpgradePolicyAutomatic = "automatic upgrade policies require a schedule, but no version or next run"
+	errUpgradeVersion         = "version is not an available upgrade of the cluster"
+)
+
+// upgradeTypeOSD is the type of upgrades of the OpenShift version of
+// clusters.
+const upgradeTypeOSD = "OSD"
+
+// setupUpgradePolicy adds a controller that reconciles UpgradePolicy managed
+// resources.
+func setupUpgradePolicy(mgr ctrl.Manager, o controller.Options) error {
+	return setupOCMResource(mgr, o, v1alpha1.UpgradePolicyGroupVersionKind, &v1alpha1.UpgradePolicy{},
+		func(c ocm.Client) managed.ExternalClient { return &upgradePolicyExternal{client: c} })
+}
+
+// upgradePolicyAPI is the part of the OCM API used to schedule upgrades of
+// clusters. Clusters are read to validate the versions they are upgraded to.
+type upgradePolicyAPI interface {
+	ocm.ClusterAPI
+	ocm.UpgradePolicyAPI
+}
+
+// An upgradePolicyExternal observes, then either creates, updates, or
+// deletes an upgrade policy of an OCM cluster.
+type upgradePolicyExternal struct {
+	client upgradePolicyAPI
+}
+
+func generateUpgradePolicy(in *v1alpha1.UpgradePolicy) (*ocm.UpgradePolicy, error) {
+	p := in.Spec.ForProvider
+	out := &ocm.UpgradePolicy{
+		ScheduleType: string(p.ScheduleType),
+		UpgradeType:  upgradeTypeOSD,
+	}
+	switch p.ScheduleType {
+	case v1alpha1.ScheduleTypeManual:
+		if p.Version == "" || p.NextRun == nil || p.Schedule != "" {
+			return nil, errors.New(errUpgradePolicyManual)
+		}
+		t := p.NextRun.UTC()
+		out.Version = p.Version
+		out.NextRun = &t
+	case v1alpha1.ScheduleTypeAutomatic:
+		if p.Schedule == "" || p.Version != "" || p.NextRun != nil {
+			return nil, errors.New(errUpgradePolicyAutomatic)
+		}
+		out.Schedule = p.Schedule
+	}
+	return out, nil
+}
+
+func generateUpgradePolicyObservation(in *ocm.UpgradePolicy, state *ocm.UpgradePolicyState) v1alpha1.UpgradePolicyObservation {
+	o := v1alpha1.UpgradePolicyObservation{
+		ID:               in.ID,
+		Version:          in.Version,
+		State:            state.Value,
+		StateDescription: state.Description,
+	}
+	if in.NextRun != nil {
+		t := metav1.NewTime(*in.NextRun)
+		o.NextRun = &t
+	}
+	return o
+}
+
+func getUpgradePolicyCondition(state string) xpv1.Condition {
+	switch state {
+	case ocm.UpgradePolicyStatePending,
+		ocm.UpgradePolicyStateScheduled,
+		ocm.UpgradePolicyStateStarted,
+		ocm.UpgradePolicyStateDelayed,
+		ocm.UpgradePolicyStateCompleted:
+		return xpv1.Available()
+	default:
+		return xpv1.Unavailable()
+	}
+}
+
+// isUpgradePolicyUpToDate compares the schedule of automatic upgrade
+// policies and the next run of manual ones. The next run can only be changed
+// until the upgrade started.
+func isUpgradePolicyUpToDate(in *v1alpha1.UpgradePolicy, observed *ocm.UpgradePolicy, state string) (bool, string) {
+	p := in.Spec.ForProvider
+	switch p.ScheduleType {
+	case v1alpha1.ScheduleTypeAutomatic:
+		if observed.Schedule != p.Schedule {
+			return false, "Observed difference in schedule of upgrade policy"
+		}
+	case v1alpha1.ScheduleTypeManual:
+		if state != ocm.UpgradePolicyStatePending && state != ocm.UpgradePolicyStateScheduled {
+			return true, ""
+		}
+		if p.NextRun != nil && (observed.NextRun == nil || !observed.NextRun.Equal(p.NextRun.Time)) {
+			return false, "Observed difference in next run of upgrade policy"
+		}
+	}
+	return true, ""
+}
+
+// isUpgraded returns true if the upgrade of a manual UpgradePolicy completed.
+// OCM removes manual upgrade policies once the upgrade completed, which must
+// not be created again. The upgrade completed if it was observed to, or if the
+// cluster runs the version it upgrades to or a later one, e.g. because it was
+// upgraded again since.
+func (c *upgradePolicyExternal) isUpgraded(ctx context.Context, cr *v1alpha1.UpgradePolicy) (bool, error) {
+	p := cr.Spec.ForProvider
+	if p.ScheduleType != v1alpha1.ScheduleTypeManual {
+		return false, nil
+	}
+	if cr.Status.AtProvider.State == ocm.UpgradePolicyStateCompleted {
+		return true, nil
+	}
+	cl, err := c.client.GetCluster(ctx, p.ClusterID)
+	if err != nil {
+		return false, err
+	}
+	if cl.Version == nil || !semver.IsValid("v"+p.Version) {
+		return false, nil
+	}
+	return semver.Compare("v"+cl.Version.RawID, "v"+p.Version) >= 0, nil
+}
+
+// validateVersion returns an error if the version a manual UpgradePolicy
+// upgrades to is not an available upgrade of its cluster.
+func (c *upgradePolicyExternal) validateVersion(ctx context.Context, cr *v1alpha1.UpgradePolicy) error {
+	p := cr.Spec.ForProvider
+	if p.ScheduleType != v1alpha1.ScheduleTypeManual {
+		return nil
+	}
+	cl, err := c.client.GetCluster(ctx, p.ClusterID)
+	if err != nil {
+		return err
+	}
+	if cl.Version != nil {
+		for _, v := range cl.Version.AvailableUpgrades {
+			if v == p.Version {
+				return nil
+			}
+		}
+	}
+	return errors.Errorf("%s: %s", errUpgradeVersion, p.Version)
+}
+
+func (c *upgradePolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.UpgradePolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUpgradePolicy)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	clusterID := cr.Spec.ForProvider.ClusterID
+	policy, err := c.client.GetUpgradePolicy(ctx, clusterID, id)
+	if ocm.IsNotFound(err) {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		upgraded, err := c.isUpgraded(ctx, cr)
+		if err != nil || !upgraded {
+			return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errObserveUpgradePolicy)
+		}
+		cr.Status.AtProvider.State = ocm.UpgradePolicyStateCompleted
+		cr.SetConditions(xpv1.Available())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveUpgradePolicy)
+	}
+	state, err := c.client.GetUpgradePolicyState(ctx, clusterID, id)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveUpgradePolicy)
+	}
+
+	cr.Status.AtProvider = generateUpgradePolicyObservation(policy, state)
+	cr.SetConditions(getUpgradePolicyCondition(state.Value))
+	upToDate, diff := isUpgradePolicyUpToDate(cr, policy, state.Value)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *upgradePolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.UpgradePolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUpgradePolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	desired, err := generateUpgradePolicy(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateUpgradePolicy)
+	}
+	if err := c.validateVersion(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateUpgradePolicy)
+	}
+	policy, err := c.client.CreateUpgradePolicy(ctx, cr.Spec.ForProvider.ClusterID, desired)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateUpgradePolicy)
+	}
+	meta.SetExternalName(cr, policy.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update reschedules the upgrade policy.
+func (c *upgradePolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.UpgradePolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUpgradePolicy)
+	}
+
+	desired, err := generateUpgradePolicy(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateUpgradePolicy)
+	}
+	desired.ID = meta.GetExternalName(cr)
+	err = c.client.UpdateUpgradePolicy(ctx, cr.Spec.ForProvider.ClusterID, desired)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateUpgradePolicy)
+}
+
+func (c *upgradePolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.UpgradePolicy)
+	if !ok {
+		return errors.New(errNotUpgradePolicy)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteUpgradePolicy(ctx, cr.Spec.ForProvider.ClusterID, meta.GetExternalName(cr))
+	if ocm.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteUpgradePolicy)
+}
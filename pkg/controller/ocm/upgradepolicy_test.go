@@ -0,0 +1,327 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/ocm/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/ocm"
+)
+
+var _ managed.ExternalClient = &upgradePolicyExternal{}
+
+// An upgradePolicyMock mocks the parts of the OCM API used by
+// UpgradePolicies.
+type upgradePolicyMock struct {
+	*ocm.ClusterAPIMock
+	*ocm.UpgradePolicyAPIMock
+}
+
+var nextRun = time.Date(2023, 4, 1, 2, 0, 0, 0, time.UTC)
+
+type upgradePolicyModifier func(*v1alpha1.UpgradePolicy)
+
+// withAutomaticSchedule turns a manual UpgradePolicy into an automatic one
+// with the supplied schedule.
+func withAutomaticSchedule(schedule string) upgradePolicyModifier {
+	return func(u *v1alpha1.UpgradePolicy) {
+		p := &u.Spec.ForProvider
+		p.ScheduleType = v1alpha1.ScheduleTypeAutomatic
+		p.Version, p.NextRun, p.Schedule = "", nil, schedule
+	}
+}
+
+func withSchedule(schedule string) upgradePolicyModifier {
+	return func(u *v1alpha1.UpgradePolicy) { u.Spec.ForProvider.Schedule = schedule }
+}
+
+func withUpgradeState(state string) upgradePolicyModifier {
+	return func(u *v1alpha1.UpgradePolicy) { u.Status.AtProvider.State = state }
+}
+
+func manualUpgradePolicy(mod ...upgradePolicyModifier) *v1alpha1.UpgradePolicy {
+	t := metav1.NewTime(nextRun)
+	u := &v1alpha1.UpgradePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "upgrade"},
+		Spec: v1alpha1.UpgradePolicySpec{
+			ForProvider: v1alpha1.UpgradePolicyParameters{
+				ClusterID:    clusterID,
+				ScheduleType: v1alpha1.ScheduleTypeManual,
+				Version:      "4.12.9",
+				NextRun:      &t,
+			},
+		},
+	}
+	meta.SetExternalName(u, "policy-id")
+	for _, m := range mod {
+		m(u)
+	}
+	return u
+}
+
+func clusterWithUpgrades(version string, upgrades ...string) *ocm.ClusterAPIMock {
+	return &ocm.ClusterAPIMock{
+		GetClusterFunc: func(ctx context.Context, id string) (*ocm.Cluster, error) {
+			return ocmCluster(func(c *ocm.Cluster) {
+				c.Version = &ocm.Version{RawID: version, AvailableUpgrades: upgrades}
+			}), nil
+		},
+	}
+}
+
+func TestUpgradePolicyObserve(t *testing.T) {
+	type want struct {
+		obs       managed.ExternalObservation
+		condition xpv1.Condition
+		state     string
+		err       error
+	}
+
+	later := nextRun.Add(time.Hour)
+
+	cases := []struct {
+		name    string
+		mg      *v1alpha1.UpgradePolicy
+		policy  *ocm.UpgradePolicy
+		state   string
+		err     error
+		cluster *ocm.ClusterAPIMock
+		want    want
+	}{
+		{
+			name:    "not found",
+			mg:      manualUpgradePolicy(),
+			err:     &ocm.APIError{StatusCode: http.StatusNotFound},
+			cluster: clusterWithUpgrades("4.12.8", "4.12.9"),
+			want:    want{obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+		{
+			name:    "completed",
+			mg:      manualUpgradePolicy(),
+			err:     &ocm.APIError{StatusCode: http.StatusNotFound},
+			cluster: clusterWithUpgrades("4.12.9"),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Available(),
+				state:     ocm.UpgradePolicyStateCompleted,
+			},
+		},
+		{
+			name:    "upgraded since",
+			mg:      manualUpgradePolicy(),
+			err:     &ocm.APIError{StatusCode: http.StatusNotFound},
+			cluster: clusterWithUpgrades("4.12.10"),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Available(),
+				state:     ocm.UpgradePolicyStateCompleted,
+			},
+		},
+		{
+			name: "completion observed",
+			mg:   manualUpgradePolicy(withUpgradeState(ocm.UpgradePolicyStateCompleted)),
+			err:  &ocm.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Available(),
+				state:     ocm.UpgradePolicyStateCompleted,
+			},
+		},
+		{
+			name: "error",
+			mg:   manualUpgradePolicy(),
+			err:  errors.New("boom"),
+			want: want{err: cmpopts.AnyError},
+		},
+		{
+			name:   "scheduled",
+			mg:     manualUpgradePolicy(),
+			policy: &ocm.UpgradePolicy{ID: "policy-id", ScheduleType: "manual", Version: "4.12.9", NextRun: &nextRun},
+			state:  ocm.UpgradePolicyStateScheduled,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Available(),
+				state:     ocm.UpgradePolicyStateScheduled,
+			},
+		},
+		{
+			name:   "rescheduled",
+			mg:     manualUpgradePolicy(),
+			policy: &ocm.UpgradePolicy{ID: "policy-id", ScheduleType: "manual", Version: "4.12.9", NextRun: &later},
+			state:  ocm.UpgradePolicyStateScheduled,
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "Observed difference in next run of upgrade policy",
+				},
+				condition: xpv1.Available(),
+				state:     ocm.UpgradePolicyStateScheduled,
+			},
+		},
+		{
+			name:   "started",
+			mg:     manualUpgradePolicy(),
+			policy: &ocm.UpgradePolicy{ID: "policy-id", ScheduleType: "manual", Version: "4.12.9", NextRun: &later},
+			state:  ocm.UpgradePolicyStateStarted,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Available(),
+				state:     ocm.UpgradePolicyStateStarted,
+			},
+		},
+		{
+			name:   "failed",
+			mg:     manualUpgradePolicy(),
+			policy: &ocm.UpgradePolicy{ID: "policy-id", ScheduleType: "manual", Version: "4.12.9", NextRun: &nextRun},
+			state:  ocm.UpgradePolicyStateFailed,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Unavailable(),
+				state:     ocm.UpgradePolicyStateFailed,
+			},
+		},
+		{
+			name:   "schedule changed",
+			mg:     manualUpgradePolicy(withAutomaticSchedule("0 2 * * 6")),
+			policy: &ocm.UpgradePolicy{ID: "policy-id", ScheduleType: "automatic", Schedule: "0 2 * * 0"},
+			state:  ocm.UpgradePolicyStatePending,
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "Observed difference in schedule of upgrade policy",
+				},
+				condition: xpv1.Available(),
+				state:     ocm.UpgradePolicyStatePending,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &upgradePolicyExternal{client: upgradePolicyMock{
+				ClusterAPIMock: tc.cluster,
+				UpgradePolicyAPIMock: &ocm.UpgradePolicyAPIMock{
+					GetUpgradePolicyFunc: func(ctx context.Context, cluster, id string) (*ocm.UpgradePolicy, error) {
+						return tc.policy, tc.err
+					},
+					GetUpgradePolicyStateFunc: func(ctx context.Context, cluster, id string) (*ocm.UpgradePolicyState, error) {
+						return &ocm.UpgradePolicyState{Value: tc.state}, nil
+					},
+				},
+			}}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.state, tc.mg.Status.AtProvider.State); diff != "" {
+				t.Errorf("\ne.Observe(...): -want state, +got state:\n%s\n", diff)
+			}
+			if tc.want.condition.Type != "" {
+				if diff := cmp.Diff(tc.want.condition, tc.mg.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+					t.Errorf("\ne.Observe(...): -want condition, +got condition:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpgradePolicyCreate(t *testing.T) {
+	cases := []struct {
+		name    string
+		mg      *v1alpha1.UpgradePolicy
+		cluster *ocm.ClusterAPIMock
+		want    *ocm.UpgradePolicy
+		err     error
+	}{
+		{
+			name:    "manual",
+			mg:      manualUpgradePolicy(),
+			cluster: clusterWithUpgrades("4.12.8", "4.12.9", "4.13.0"),
+			want: &ocm.UpgradePolicy{
+				ScheduleType: "manual",
+				UpgradeType:  "OSD",
+				Version:      "4.12.9",
+				NextRun:      &nextRun,
+			},
+		},
+		{
+			name: "automatic",
+			mg:   manualUpgradePolicy(withAutomaticSchedule("0 2 * * 6")),
+			want: &ocm.UpgradePolicy{
+				ScheduleType: "automatic",
+				UpgradeType:  "OSD",
+				Schedule:     "0 2 * * 6",
+			},
+		},
+		{
+			name:    "unavailable version",
+			mg:      manualUpgradePolicy(),
+			cluster: clusterWithUpgrades("4.12.8", "4.13.0"),
+			err:     cmpopts.AnyError,
+		},
+		{
+			name: "manual with schedule",
+			mg:   manualUpgradePolicy(withSchedule("0 2 * * 6")),
+			err:  cmpopts.AnyError,
+		},
+		{
+			name: "automatic without schedule",
+			mg:   manualUpgradePolicy(withAutomaticSchedule("")),
+			err:  cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got *ocm.UpgradePolicy
+			e := &upgradePolicyExternal{client: upgradePolicyMock{
+				ClusterAPIMock: tc.cluster,
+				UpgradePolicyAPIMock: &ocm.UpgradePolicyAPIMock{
+					CreateUpgradePolicyFunc: func(ctx context.Context, cluster string, p *ocm.UpgradePolicy) (*ocm.UpgradePolicy, error) {
+						got = p
+						return &ocm.UpgradePolicy{ID: "policy-id"}, nil
+					},
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\ne.Create(...): -want upgrade policy, +got upgrade policy:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package providerconfig contains the connector and setup shared by the
+// controllers that reconcile managed resources through an API authenticated
+// with the credentials of their ProviderConfig.
+package providerconfig
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/features"
+	"github.com/stehessel/provider-redhat/pkg/tracing"
+)
+
+const (
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+)
+
+var _ managed.ExternalConnecter = &Connector[any]{}
+
+// A Connector produces a client of type C for an API, authenticated with the
+// credentials of the ProviderConfig of a managed resource.
+type Connector[C any] struct {
+	Kube  client.Client
+	Usage resource.Tracker
+
+	// Credentials returns the credentials for the API configured by the
+	// supplied ProviderConfig. The common credentials of the ProviderConfig
+	// are used if it is nil.
+	Credentials func(pc *apisv1alpha1.ProviderConfig) (*apisv1alpha1.ProviderCredentials, error)
+
+	// NewClient creates a client for the API configured by the supplied
+	// ProviderConfig, authenticated with the supplied token.
+	NewClient func(pc *apisv1alpha1.ProviderConfig, token string) (C, error)
+
+	// ErrNewClient wraps errors of NewClient.
+	ErrNewClient string
+
+	// External returns the external client of a managed resource that uses
+	// the supplied client.
+	External func(C) managed.ExternalClient
+}
+
+// Connect produces an external client for the supplied managed resource.
+func (c *Connector[C]) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if err := c.Usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+	tracing.SetAttributes(ctx, tracing.AttributeProviderConfig.String(mg.GetProviderConfigReference().Name))
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.Kube.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := &pc.Spec.Credentials
+	if c.Credentials != nil {
+		var err error
+		if cd, err = c.Credentials(pc); err != nil {
+			return nil, err
+		}
+	}
+	token, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.Kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	client, err := c.NewClient(pc, string(token))
+	if err != nil {
+		return nil, errors.Wrap(err, c.ErrNewClient)
+	}
+	return c.External(client), nil
+}
+
+// SetupController adds a controller that reconciles managed resources of the
+// supplied kind through the clients produced by the supplied connector, whose
+// Kube and Usage are set up from the manager. Their external name is the
+// identifier the API assigns to them, so it does not default to the
+// resource's name.
+func SetupController[C any](mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj client.Object, c *Connector[C]) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
+	c.Kube = mgr.GetClient()
+	c.Usage = resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{})
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(gvk),
+		managed.WithExternalConnecter(c),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r, otel.GetTracerProvider()), o.GlobalRateLimiter))
+}
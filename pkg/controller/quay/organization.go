@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/quay/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/quay"
+)
+
+const (
+	errNotOrganization     = "managed resource is not an Organization custom resource"
+	errObserveOrganization = "cannot observe organization"
+	errCreateOrganization  = "cannot create organization"
+	errUpdateOrganization  = "cannot update organization"
+	errDeleteOrganization  = "cannot delete organization"
+)
+
+// setupOrganization adds a controller that reconciles Organization managed
+// resources.
+func setupOrganization(mgr ctrl.Manager, o controller.Options) error {
+	return setupQuayResource(mgr, o, v1alpha1.OrganizationGroupVersionKind, &v1alpha1.Organization{},
+		func(c quay.Client) managed.ExternalClient {
+			return &organizationExternal{client: c}
+		})
+}
+
+// An organizationExternal creates, updates and deletes organizations. The
+// external name of an organization is its name.
+type organizationExternal struct {
+	client quay.OrganizationAPI
+}
+
+// generateOrganization returns the organization described by the supplied
+// parameters.
+func generateOrganization(p v1alpha1.OrganizationParameters) *quay.Organization {
+	return &quay.Organization{
+		Name:                 p.Name,
+		Email:                p.Email,
+		TagExpirationSeconds: p.TagExpirationSeconds,
+	}
+}
+
+func (c *organizationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrganization)
+	}
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	org, err := c.client.GetOrganization(ctx, name)
+	if quay.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveOrganization)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	// Fields that are not set keep whatever the registry defaults them to.
+	desired := generateOrganization(cr.Spec.ForProvider)
+	desired.Name = name
+	actual := &quay.Organization{Name: org.Name}
+	if desired.Email != "" {
+		actual.Email = org.Email
+	}
+	if desired.TagExpirationSeconds != nil {
+		actual.TagExpirationSeconds = org.TagExpirationSeconds
+	}
+	if diff := cmp.Diff(desired, actual); diff != "" {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: false,
+			Diff:             "Observed difference in organization\n" + diff,
+		}, nil
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// Create creates the organization. Its tag expiration is set by the update
+// that follows once the organization is observed.
+func (c *organizationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrganization)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	if err := c.client.CreateOrganization(ctx, generateOrganization(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateOrganization)
+	}
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *organizationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrganization)
+	}
+
+	org := generateOrganization(cr.Spec.ForProvider)
+	org.Name = meta.GetExternalName(cr)
+	return managed.ExternalUpdate{}, errors.Wrap(c.client.UpdateOrganization(ctx, org), errUpdateOrganization)
+}
+
+func (c *organizationExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return errors.New(errNotOrganization)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteOrganization(ctx, meta.GetExternalName(cr))
+	if quay.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteOrganization)
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/quay/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/quay"
+)
+
+var _ managed.ExternalClient = &organizationExternal{}
+
+var orgName = "myorg"
+
+type organizationModifier func(*v1alpha1.Organization)
+
+func withOrgConditions(c ...xpv1.Condition) organizationModifier {
+	return func(o *v1alpha1.Organization) { o.Status.ConditionedStatus.Conditions = c }
+}
+
+func withOrgExternalName(name string) organizationModifier {
+	return func(o *v1alpha1.Organization) { meta.SetExternalName(o, name) }
+}
+
+func withOrgTagExpiration(s int) organizationModifier {
+	return func(o *v1alpha1.Organization) { o.Spec.ForProvider.TagExpirationSeconds = &s }
+}
+
+func organization(mod ...organizationModifier) *v1alpha1.Organization {
+	o := &v1alpha1.Organization{
+		ObjectMeta: metav1.ObjectMeta{Name: orgName},
+		Spec: v1alpha1.OrganizationSpec{
+			ForProvider: v1alpha1.OrganizationParameters{
+				Name:  orgName,
+				Email: "images@example.com",
+			},
+		},
+	}
+	for _, m := range mod {
+		m(o)
+	}
+	return o
+}
+
+func intPtr(i int) *int { return &i }
+
+func TestOrganizationObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name string
+		mg   *v1alpha1.Organization
+		org  *quay.Organization
+		err  error
+		want want
+	}{
+		{
+			name: "no external name",
+			mg:   organization(),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  organization(),
+			},
+		},
+		{
+			name: "organization up to date",
+			mg:   organization(withOrgExternalName(orgName)),
+			org:  &quay.Organization{Name: orgName, Email: "images@example.com", TagExpirationSeconds: intPtr(1209600)},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg:  organization(withOrgExternalName(orgName), withOrgConditions(xpv1.Available())),
+			},
+		},
+		{
+			name: "tag expiration changed",
+			mg:   organization(withOrgExternalName(orgName), withOrgTagExpiration(86400)),
+			org:  &quay.Organization{Name: orgName, Email: "images@example.com", TagExpirationSeconds: intPtr(1209600)},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg: organization(withOrgExternalName(orgName), withOrgTagExpiration(86400),
+					withOrgConditions(xpv1.Available())),
+			},
+		},
+		{
+			name: "email changed",
+			mg:   organization(withOrgExternalName(orgName)),
+			org:  &quay.Organization{Name: orgName, Email: "old@example.com"},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg:  organization(withOrgExternalName(orgName), withOrgConditions(xpv1.Available())),
+			},
+		},
+		{
+			name: "organization not found",
+			mg:   organization(withOrgExternalName(orgName)),
+			err:  &quay.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  organization(withOrgExternalName(orgName)),
+			},
+		},
+		{
+			name: "get error",
+			mg:   organization(withOrgExternalName(orgName)),
+			err:  errors.New("boom"),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  organization(withOrgExternalName(orgName)),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := organizationExternal{client: &quay.OrganizationAPIMock{
+				GetOrganizationFunc: func(ctx context.Context, name string) (*quay.Organization, error) {
+					return tc.org, tc.err
+				},
+			}}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestOrganizationCreate(t *testing.T) {
+	var req *quay.Organization
+	e := organizationExternal{client: &quay.OrganizationAPIMock{
+		CreateOrganizationFunc: func(ctx context.Context, o *quay.Organization) error {
+			req = o
+			return nil
+		},
+	}}
+	mg := organization(withOrgTagExpiration(86400))
+	if _, err := e.Create(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %s\n", err)
+	}
+
+	wantReq := &quay.Organization{Name: orgName, Email: "images@example.com", TagExpirationSeconds: intPtr(86400)}
+	if diff := cmp.Diff(wantReq, req); diff != "" {
+		t.Errorf("\ne.Create(...): -want request, +got request:\n%s\n", diff)
+	}
+	want := organization(withOrgTagExpiration(86400), withOrgConditions(xpv1.Creating()), withOrgExternalName(orgName))
+	if diff := cmp.Diff(want, mg); diff != "" {
+		t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestOrganizationUpdate(t *testing.T) {
+	var req *quay.Organization
+	e := organizationExternal{client: &quay.OrganizationAPIMock{
+		UpdateOrganizationFunc: func(ctx context.Context, o *quay.Organization) error {
+			req = o
+			return nil
+		},
+	}}
+	if _, err := e.Update(context.Background(), organization(withOrgExternalName(orgName), withOrgTagExpiration(86400))); err != nil {
+		t.Fatalf("\ne.Update(...): unexpected error: %s\n", err)
+	}
+
+	wantReq := &quay.Organization{Name: orgName, Email: "images@example.com", TagExpirationSeconds: intPtr(86400)}
+	if diff := cmp.Diff(wantReq, req); diff != "" {
+		t.Errorf("\ne.Update(...): -want request, +got request:\n%s\n", diff)
+	}
+}
+
+func TestOrganizationDelete(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "deleted"},
+		{name: "already gone", err: &quay.APIError{StatusCode: http.StatusNotFound}},
+		{name: "delete error", err: errors.New("boom"), want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := organizationExternal{client: &quay.OrganizationAPIMock{
+				DeleteOrganizationFunc: func(ctx context.Context, name string) error {
+					if name != orgName {
+						t.Errorf("\ne.Delete(...): deleted %q, want %q\n", name, orgName)
+					}
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), organization(withOrgExternalName(orgName)))
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
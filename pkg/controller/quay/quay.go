@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quay contains the controllers of the quay API group, which manage
+// organizations, repositories and robot accounts of a Quay registry.
+package quay
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/quay"
+	"github.com/stehessel/provider-redhat/pkg/controller/providerconfig"
+)
+
+const errNoQuayConfig = "ProviderConfig does not configure access to Quay"
+
+// Setup adds the controllers of the quay API group to the supplied manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+		setupOrganization,
+		setupRepository,
+		setupRobotAccount,
+	} {
+		if err := setup(mgr, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setupQuayResource adds a controller that reconciles managed resources of
+// the supplied kind through the API of the Quay registry configured by their
+// ProviderConfig.
+func setupQuayResource(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj client.Object, external func(quay.Client) managed.ExternalClient) error {
+	return providerconfig.SetupController(mgr, o, gvk, obj, &providerconfig.Connector[quay.Client]{
+		Credentials: func(pc *apisv1alpha1.ProviderConfig) (*apisv1alpha1.ProviderCredentials, error) {
+			if pc.Spec.Quay == nil {
+				return nil, errors.New(errNoQuayConfig)
+			}
+			return &pc.Spec.Quay.Credentials, nil
+		},
+		NewClient: func(pc *apisv1alpha1.ProviderConfig, token string) (quay.Client, error) {
+			endpoint := pc.Spec.Quay.Endpoint
+			if endpoint == "" {
+				endpoint = quay.DefaultEndpoint
+			}
+			return quay.NewClient(token, endpoint)
+		},
+		ErrNewClient: quay.ErrNewClient,
+		External:     external,
+	})
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/quay/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/quay"
+)
+
+const (
+	errNotRepository          = "managed resource is not a Repository custom resource"
+	errObserveRepository      = "cannot observe repository"
+	errObservePermissions     = "cannot observe repository permissions"
+	errCreateRepository       = "cannot create repository"
+	errUpdateRepository       = "cannot update repository"
+	errChangeVisibility       = "cannot change repository visibility"
+	errSetPermission          = "cannot set repository permission"
+	errDeletePermission       = "cannot delete repository permission"
+	errDeleteRepository       = "cannot delete repository"
+	errRepositoryOrganization = "organization of repository is not resolved"
+)
+
+// setupRepository adds a controller that reconciles Repository managed
+// resources.
+func setupRepository(mgr ctrl.Manager, o controller.Options) error {
+	return setupQuayResource(mgr, o, v1alpha1.RepositoryGroupVersionKind, &v1alpha1.Repository{},
+		func(c quay.Client) managed.ExternalClient {
+			return &repositoryExternal{client: c}
+		})
+}
+
+// A repositoryExternal creates, updates and deletes repositories, and grants
+// and revokes the permissions on them. The external name of a repository is
+// its name within its organization.
+type repositoryExternal struct {
+	client quay.RepositoryAPI
+}
+
+// permissionKey identifies the grantee of a repository permission.
+type permissionKey struct {
+	kind v1alpha1.GranteeKind
+	name string
+}
+
+func keyOf(p v1alpha1.RepositoryPermission) permissionKey {
+	if p.Kind == "" {
+		return permissionKey{kind: v1alpha1.GranteeKindUser, name: p.Name}
+	}
+	return permissionKey{kind: p.Kind, name: p.Name}
+}
+
+// normalizePermissions returns the supplied permissions with their kind
+// defaulted, sorted by kind and name.
+func normalizePermissions(in []v1alpha1.RepositoryPermission) []v1alpha1.RepositoryPermission {
+	var out []v1alpha1.RepositoryPermission
+	for _, p := range in {
+		k := keyOf(p)
+		out = append(out, v1alpha1.RepositoryPermission{Kind: k.kind, Name: k.name, Role: p.Role})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Kind != out[j].Kind {
+			return out[i].Kind < out[j].Kind
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// observePermissions returns the permissions of the grantees of the supplied
+// desired and previously observed permissions. Permissions of other grantees
+// are not managed by the repository, so they are ignored.
+func (c *repositoryExternal) observePermissions(ctx context.Context, org, name string, desired, prev []v1alpha1.RepositoryPermission) ([]v1alpha1.RepositoryPermission, error) {
+	grantees := map[v1alpha1.GranteeKind]map[string]bool{}
+	for _, p := range append(normalizePermissions(desired), normalizePermissions(prev)...) {
+		if grantees[p.Kind] == nil {
+			grantees[p.Kind] = map[string]bool{}
+		}
+		grantees[p.Kind][p.Name] = true
+	}
+
+	var out []v1alpha1.RepositoryPermission
+	for kind, names := range grantees {
+		roles, err := c.client.ListRepositoryPermissions(ctx, org, name, quay.PermissionKind(kind))
+		if err != nil {
+			return nil, err
+		}
+		for n := range names {
+			if role, ok := roles[n]; ok {
+				out = append(out, v1alpha1.RepositoryPermission{Kind: kind, Name: n, Role: v1alpha1.RepositoryRole(role)})
+			}
+		}
+	}
+	return normalizePermissions(out), nil
+}
+
+func visibilityOf(r *quay.Repository) v1alpha1.Visibility {
+	if r.IsPublic {
+		return v1alpha1.VisibilityPublic
+	}
+	return v1alpha1.VisibilityPrivate
+}
+
+func (c *repositoryExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Repository)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRepository)
+	}
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	p := cr.Spec.ForProvider
+	if p.Organization == "" {
+		return managed.ExternalObservation{}, errors.New(errRepositoryOrganization)
+	}
+
+	repo, err := c.client.GetRepository(ctx, p.Organization, name)
+	if quay.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveRepository)
+	}
+	perms, err := c.observePermissions(ctx, p.Organization, name, p.Permissions, cr.Status.AtProvider.Permissions)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObservePermissions)
+	}
+
+	cr.Status.AtProvider = v1alpha1.RepositoryObservation{Visibility: visibilityOf(repo), Permissions: perms}
+	cr.SetConditions(xpv1.Available())
+
+	desired := v1alpha1.RepositoryParameters{
+		Visibility:  p.Visibility,
+		Description: p.Description,
+		Permissions: normalizePermissions(p.Permissions),
+	}
+	if desired.Visibility == "" {
+		desired.Visibility = v1alpha1.VisibilityPrivate
+	}
+	actual := v1alpha1.RepositoryParameters{
+		Visibility:  cr.Status.AtProvider.Visibility,
+		Description: repo.Description,
+		Permissions: perms,
+	}
+	if diff := cmp.Diff(desired, actual, cmpopts.EquateEmpty()); diff != "" {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: false,
+			Diff:             "Observed difference in repository\n" + diff,
+		}, nil
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// setPermissions grants the desired permissions that differ from the supplied
+// observed ones, and revokes the observed permissions of grantees that are no
+// longer desired.
+func (c *repositoryExternal) setPermissions(ctx context.Context, org, name string, desired, observed []v1alpha1.RepositoryPermission) error {
+	roles := map[permissionKey]v1alpha1.RepositoryRole{}
+	for _, p := range observed {
+		roles[keyOf(p)] = p.Role
+	}
+	keep := map[permissionKey]bool{}
+	for _, p := range desired {
+		k := keyOf(p)
+		keep[k] = true
+		if role, ok := roles[k]; ok && role == p.Role {
+			continue
+		}
+		if err := c.client.SetRepositoryPermission(ctx, org, name, quay.PermissionKind(k.kind), k.name, string(p.Role)); err != nil {
+			return errors.Wrap(err, errSetPermission)
+		}
+	}
+	for _, p := range observed {
+		k := keyOf(p)
+		if keep[k] {
+			continue
+		}
+		err := c.client.DeleteRepositoryPermission(ctx, org, name, quay.PermissionKind(k.kind), k.name)
+		if err != nil && !quay.IsNotFound(err) {
+			return errors.Wrap(err, errDeletePermission)
+		}
+	}
+	return nil
+}
+
+func (c *repositoryExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Repository)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRepository)
+	}
+	p := cr.Spec.ForProvider
+	if p.Organization == "" {
+		return managed.ExternalCreation{}, errors.New(errRepositoryOrganization)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	if err := c.client.CreateRepository(ctx, &quay.Repository{
+		Namespace:   p.Organization,
+		Name:        p.Name,
+		Description: p.Description,
+		IsPublic:    p.Visibility == v1alpha1.VisibilityPublic,
+	}); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRepository)
+	}
+	meta.SetExternalName(cr, p.Name)
+	return managed.ExternalCreation{}, c.setPermissions(ctx, p.Organization, p.Name, p.Permissions, nil)
+}
+
+// Update updates the description and visibility of the repository, and
+// grants and revokes permissions on it as observed before.
+func (c *repositoryExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Repository)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRepository)
+	}
+	p := cr.Spec.ForProvider
+	name := meta.GetExternalName(cr)
+
+	if err := c.client.UpdateRepository(ctx, &quay.Repository{
+		Namespace:   p.Organization,
+		Name:        name,
+		Description: p.Description,
+	}); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRepository)
+	}
+	visibility := p.Visibility
+	if visibility == "" {
+		visibility = v1alpha1.VisibilityPrivate
+	}
+	if visibility != cr.Status.AtProvider.Visibility {
+		if err := c.client.ChangeRepositoryVisibility(ctx, p.Organization, name, string(visibility)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeVisibility)
+		}
+		cr.Status.AtProvider.Visibility = visibility
+	}
+	if err := c.setPermissions(ctx, p.Organization, name, p.Permissions, cr.Status.AtProvider.Permissions); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	cr.Status.AtProvider.Permissions = normalizePermissions(p.Permissions)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *repositoryExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Repository)
+	if !ok {
+		return errors.New(errNotRepository)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteRepository(ctx, cr.Spec.ForProvider.Organization, meta.GetExternalName(cr))
+	if quay.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteRepository)
+}
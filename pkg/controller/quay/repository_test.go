@@ -0,0 +1,284 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/quay/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/quay"
+)
+
+var _ managed.ExternalClient = &repositoryExternal{}
+
+var repoName = "app"
+
+var (
+	deployerWrite = v1alpha1.RepositoryPermission{Kind: v1alpha1.GranteeKindUser, Name: robotUsername, Role: "write"}
+	ownersAdmin   = v1alpha1.RepositoryPermission{Kind: v1alpha1.GranteeKindTeam, Name: "owners", Role: "admin"}
+)
+
+type repositoryModifier func(*v1alpha1.Repository)
+
+func withRepoConditions(c ...xpv1.Condition) repositoryModifier {
+	return func(r *v1alpha1.Repository) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRepoExternalName(name string) repositoryModifier {
+	return func(r *v1alpha1.Repository) { meta.SetExternalName(r, name) }
+}
+
+func withRepoPermissions(p ...v1alpha1.RepositoryPermission) repositoryModifier {
+	return func(r *v1alpha1.Repository) { r.Spec.ForProvider.Permissions = p }
+}
+
+func withRepoObservation(o v1alpha1.RepositoryObservation) repositoryModifier {
+	return func(r *v1alpha1.Repository) { r.Status.AtProvider = o }
+}
+
+func repository(mod ...repositoryModifier) *v1alpha1.Repository {
+	r := &v1alpha1.Repository{
+		ObjectMeta: metav1.ObjectMeta{Name: repoName},
+		Spec: v1alpha1.RepositorySpec{
+			ForProvider: v1alpha1.RepositoryParameters{
+				Organization: orgName,
+				Name:         repoName,
+				Visibility:   v1alpha1.VisibilityPublic,
+				Description:  "The app.",
+			},
+		},
+	}
+	for _, m := range mod {
+		m(r)
+	}
+	return r
+}
+
+func TestRepositoryObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name  string
+		mg    *v1alpha1.Repository
+		repo  *quay.Repository
+		perms map[quay.PermissionKind]map[string]string
+		err   error
+		want  want
+	}{
+		{
+			name: "no external name",
+			mg:   repository(),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  repository(),
+			},
+		},
+		{
+			name: "repository up to date",
+			mg:   repository(withRepoExternalName(repoName), withRepoPermissions(ownersAdmin, deployerWrite)),
+			repo: &quay.Repository{Namespace: orgName, Name: repoName, Description: "The app.", IsPublic: true},
+			perms: map[quay.PermissionKind]map[string]string{
+				quay.PermissionKindUser: {robotUsername: "write", "creator": "admin"},
+				quay.PermissionKindTeam: {"owners": "admin"},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: repository(withRepoExternalName(repoName), withRepoPermissions(ownersAdmin, deployerWrite),
+					withRepoConditions(xpv1.Available()),
+					withRepoObservation(v1alpha1.RepositoryObservation{
+						Visibility:  v1alpha1.VisibilityPublic,
+						Permissions: []v1alpha1.RepositoryPermission{ownersAdmin, deployerWrite},
+					})),
+			},
+		},
+		{
+			name: "visibility changed",
+			mg:   repository(withRepoExternalName(repoName)),
+			repo: &quay.Repository{Namespace: orgName, Name: repoName, Description: "The app."},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg: repository(withRepoExternalName(repoName), withRepoConditions(xpv1.Available()),
+					withRepoObservation(v1alpha1.RepositoryObservation{Visibility: v1alpha1.VisibilityPrivate})),
+			},
+		},
+		{
+			name: "permission removed",
+			mg: repository(withRepoExternalName(repoName), withRepoObservation(v1alpha1.RepositoryObservation{
+				Visibility:  v1alpha1.VisibilityPublic,
+				Permissions: []v1alpha1.RepositoryPermission{deployerWrite},
+			})),
+			repo: &quay.Repository{Namespace: orgName, Name: repoName, Description: "The app.", IsPublic: true},
+			perms: map[quay.PermissionKind]map[string]string{
+				quay.PermissionKindUser: {robotUsername: "write"},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg: repository(withRepoExternalName(repoName), withRepoConditions(xpv1.Available()),
+					withRepoObservation(v1alpha1.RepositoryObservation{
+						Visibility:  v1alpha1.VisibilityPublic,
+						Permissions: []v1alpha1.RepositoryPermission{deployerWrite},
+					})),
+			},
+		},
+		{
+			name: "repository not found",
+			mg:   repository(withRepoExternalName(repoName)),
+			err:  &quay.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  repository(withRepoExternalName(repoName)),
+			},
+		},
+		{
+			name: "get error",
+			mg:   repository(withRepoExternalName(repoName)),
+			err:  errors.New("boom"),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  repository(withRepoExternalName(repoName)),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := repositoryExternal{client: &quay.RepositoryAPIMock{
+				GetRepositoryFunc: func(ctx context.Context, namespace, name string) (*quay.Repository, error) {
+					return tc.repo, tc.err
+				},
+				ListRepositoryPermissionsFunc: func(ctx context.Context, namespace, name string, kind quay.PermissionKind) (map[string]string, error) {
+					return tc.perms[kind], nil
+				},
+			}}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	var visibility string
+	set := map[string]string{}
+	var deleted []string
+	e := repositoryExternal{client: &quay.RepositoryAPIMock{
+		UpdateRepositoryFunc: func(ctx context.Context, r *quay.Repository) error {
+			want := &quay.Repository{Namespace: orgName, Name: repoName, Description: "The app."}
+			if diff := cmp.Diff(want, r); diff != "" {
+				t.Errorf("\ne.Update(...): -want request, +got request:\n%s\n", diff)
+			}
+			return nil
+		},
+		ChangeRepositoryVisibilityFunc: func(ctx context.Context, namespace, name, v string) error {
+			visibility = v
+			return nil
+		},
+		SetRepositoryPermissionFunc: func(ctx context.Context, namespace, name string, kind quay.PermissionKind, grantee, role string) error {
+			set[string(kind)+"/"+grantee] = role
+			return nil
+		},
+		DeleteRepositoryPermissionFunc: func(ctx context.Context, namespace, name string, kind quay.PermissionKind, grantee string) error {
+			deleted = append(deleted, string(kind)+"/"+grantee)
+			return &quay.APIError{StatusCode: http.StatusNotFound}
+		},
+	}}
+
+	mg := repository(withRepoExternalName(repoName), withRepoPermissions(ownersAdmin, deployerWrite),
+		withRepoObservation(v1alpha1.RepositoryObservation{
+			Visibility: v1alpha1.VisibilityPrivate,
+			Permissions: []v1alpha1.RepositoryPermission{
+				{Kind: v1alpha1.GranteeKindUser, Name: robotUsername, Role: "read"},
+				{Kind: v1alpha1.GranteeKindUser, Name: "someone", Role: "admin"},
+				ownersAdmin,
+			},
+		}))
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Update(...): unexpected error: %s\n", err)
+	}
+
+	if visibility != quay.VisibilityPublic {
+		t.Errorf("\ne.Update(...): changed visibility to %q, want %q\n", visibility, quay.VisibilityPublic)
+	}
+	if diff := cmp.Diff(map[string]string{"user/" + robotUsername: "write"}, set); diff != "" {
+		t.Errorf("\ne.Update(...): -want set permissions, +got set permissions:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{"user/someone"}, deleted); diff != "" {
+		t.Errorf("\ne.Update(...): -want deleted permissions, +got deleted permissions:\n%s\n", diff)
+	}
+	want := repository(withRepoExternalName(repoName), withRepoPermissions(ownersAdmin, deployerWrite),
+		withRepoObservation(v1alpha1.RepositoryObservation{
+			Visibility:  v1alpha1.VisibilityPublic,
+			Permissions: []v1alpha1.RepositoryPermission{ownersAdmin, deployerWrite},
+		}))
+	if diff := cmp.Diff(want, mg); diff != "" {
+		t.Errorf("\ne.Update(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "deleted"},
+		{name: "already gone", err: &quay.APIError{StatusCode: http.StatusNotFound}},
+		{name: "delete error", err: errors.New("boom"), want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := repositoryExternal{client: &quay.RepositoryAPIMock{
+				DeleteRepositoryFunc: func(ctx context.Context, namespace, name string) error {
+					if namespace != orgName || name != repoName {
+						t.Errorf("\ne.Delete(...): deleted %s/%s, want %s/%s\n", namespace, name, orgName, repoName)
+					}
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), repository(withRepoExternalName(repoName)))
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
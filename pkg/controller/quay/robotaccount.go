@@ -0,0 +1,176 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/quay/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/quay"
+)
+
+const (
+	errNotRobotAccount          = "managed resource is not a RobotAccount custom resource"
+	errObserveRobotAccount      = "cannot observe robot account"
+	errCreateRobotAccount       = "cannot create robot account"
+	errDeleteRobotAccount       = "cannot delete robot account"
+	errRobotAccountOrganization = "organization of robot account is not resolved"
+	errDockerConfig             = "cannot marshal docker config of robot account"
+)
+
+// setupRobotAccount adds a controller that reconciles RobotAccount managed
+// resources.
+func setupRobotAccount(mgr ctrl.Manager, o controller.Options) error {
+	return setupQuayResource(mgr, o, v1alpha1.RobotAccountGroupVersionKind, &v1alpha1.RobotAccount{},
+		func(c quay.Client) managed.ExternalClient {
+			return &robotAccountExternal{client: c, registry: c.Registry()}
+		})
+}
+
+// A robotAccountExternal creates and deletes robot accounts, and publishes
+// the docker config that logs in to the registry with them. The external
+// name of a robot account is its name within its organization.
+type robotAccountExternal struct {
+	client   quay.RobotAccountAPI
+	registry string
+}
+
+// dockerConfig is the format of the .dockerconfigjson of image pull secrets.
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+// getRobotAccountConnectionDetails returns the credentials of the supplied
+// robot account, including a docker config that logs in to the registry with
+// them.
+func (c *robotAccountExternal) getRobotAccountConnectionDetails(r *quay.RobotAccount) (managed.ConnectionDetails, error) {
+	cfg, err := json.Marshal(dockerConfig{Auths: map[string]dockerAuth{
+		c.registry: {
+			Username: r.Name,
+			Password: r.Token,
+			Auth:     base64.StdEncoding.EncodeToString([]byte(r.Name + ":" + r.Token)),
+		},
+	}})
+	if err != nil {
+		return nil, errors.Wrap(err, errDockerConfig)
+	}
+	return managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyDockerConfigJSON: cfg,
+		v1alpha1.ConnectionKeyUsername:         []byte(r.Name),
+		v1alpha1.ConnectionKeyPassword:         []byte(r.Token),
+	}, nil
+}
+
+// Observe observes the robot account. The API returns the token of a robot
+// account along with it, so its credentials are published on every
+// observation.
+func (c *robotAccountExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RobotAccount)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRobotAccount)
+	}
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	org := cr.Spec.ForProvider.Organization
+	if org == "" {
+		return managed.ExternalObservation{}, errors.New(errRobotAccountOrganization)
+	}
+
+	robot, err := c.client.GetRobotAccount(ctx, org, name)
+	if quay.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveRobotAccount)
+	}
+
+	cr.Status.AtProvider.Username = robot.Name
+	cr.SetConditions(xpv1.Available())
+
+	cd, err := c.getRobotAccountConnectionDetails(robot)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: cd,
+	}, nil
+}
+
+func (c *robotAccountExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RobotAccount)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRobotAccount)
+	}
+	p := cr.Spec.ForProvider
+	if p.Organization == "" {
+		return managed.ExternalCreation{}, errors.New(errRobotAccountOrganization)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	robot, err := c.client.CreateRobotAccount(ctx, p.Organization, p.Name, p.Description)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRobotAccount)
+	}
+	meta.SetExternalName(cr, p.Name)
+
+	cd, err := c.getRobotAccountConnectionDetails(robot)
+	return managed.ExternalCreation{ConnectionDetails: cd}, err
+}
+
+// Update does nothing, since robot accounts cannot be changed once they are
+// created.
+func (c *robotAccountExternal) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.RobotAccount); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRobotAccount)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *robotAccountExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RobotAccount)
+	if !ok {
+		return errors.New(errNotRobotAccount)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteRobotAccount(ctx, cr.Spec.ForProvider.Organization, meta.GetExternalName(cr))
+	if quay.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteRobotAccount)
+}
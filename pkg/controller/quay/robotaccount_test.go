@@ -0,0 +1,221 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/quay/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/quay"
+)
+
+var _ managed.ExternalClient = &robotAccountExternal{}
+
+var (
+	robotName     = "deployer"
+	robotUsername = orgName + "+" + robotName
+	robotToken    = "t0k3n"
+)
+
+type robotAccountModifier func(*v1alpha1.RobotAccount)
+
+func withRobotConditions(c ...xpv1.Condition) robotAccountModifier {
+	return func(r *v1alpha1.RobotAccount) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRobotExternalName(name string) robotAccountModifier {
+	return func(r *v1alpha1.RobotAccount) { meta.SetExternalName(r, name) }
+}
+
+func withRobotUsername(u string) robotAccountModifier {
+	return func(r *v1alpha1.RobotAccount) { r.Status.AtProvider.Username = u }
+}
+
+func robotAccount(mod ...robotAccountModifier) *v1alpha1.RobotAccount {
+	r := &v1alpha1.RobotAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: robotName},
+		Spec: v1alpha1.RobotAccountSpec{
+			ForProvider: v1alpha1.RobotAccountParameters{
+				Organization: orgName,
+				Name:         robotName,
+				Description:  "deploys images",
+			},
+		},
+	}
+	for _, m := range mod {
+		m(r)
+	}
+	return r
+}
+
+func robotConnectionDetails() managed.ConnectionDetails {
+	auth := base64.StdEncoding.EncodeToString([]byte(robotUsername + ":" + robotToken))
+	return managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyDockerConfigJSON: []byte(`{"auths":{"quay.io":{"username":"` + robotUsername +
+			`","password":"` + robotToken + `","auth":"` + auth + `"}}}`),
+		v1alpha1.ConnectionKeyUsername: []byte(robotUsername),
+		v1alpha1.ConnectionKeyPassword: []byte(robotToken),
+	}
+}
+
+func TestRobotAccountObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name  string
+		mg    *v1alpha1.RobotAccount
+		robot *quay.RobotAccount
+		err   error
+		want  want
+	}{
+		{
+			name: "no external name",
+			mg:   robotAccount(),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  robotAccount(),
+			},
+		},
+		{
+			name:  "robot account exists",
+			mg:    robotAccount(withRobotExternalName(robotName)),
+			robot: &quay.RobotAccount{Name: robotUsername, Description: "deploys images", Token: robotToken},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: robotConnectionDetails()},
+				mg: robotAccount(withRobotExternalName(robotName), withRobotUsername(robotUsername),
+					withRobotConditions(xpv1.Available())),
+			},
+		},
+		{
+			name: "robot account not found",
+			mg:   robotAccount(withRobotExternalName(robotName)),
+			err:  &quay.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  robotAccount(withRobotExternalName(robotName)),
+			},
+		},
+		{
+			name: "get error",
+			mg:   robotAccount(withRobotExternalName(robotName)),
+			err:  errors.New("boom"),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  robotAccount(withRobotExternalName(robotName)),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := robotAccountExternal{
+				client: &quay.RobotAccountAPIMock{
+					GetRobotAccountFunc: func(ctx context.Context, org, name string) (*quay.RobotAccount, error) {
+						if org != orgName || name != robotName {
+							t.Errorf("\ne.Observe(...): got robot account %s/%s, want %s/%s\n", org, name, orgName, robotName)
+						}
+						return tc.robot, tc.err
+					},
+				},
+				registry: "quay.io",
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestRobotAccountCreate(t *testing.T) {
+	e := robotAccountExternal{
+		client: &quay.RobotAccountAPIMock{
+			CreateRobotAccountFunc: func(ctx context.Context, org, name, description string) (*quay.RobotAccount, error) {
+				if org != orgName || name != robotName || description != "deploys images" {
+					t.Errorf("\ne.Create(...): unexpected request %s/%s %q\n", org, name, description)
+				}
+				return &quay.RobotAccount{Name: robotUsername, Description: description, Token: robotToken}, nil
+			},
+		},
+		registry: "quay.io",
+	}
+	mg := robotAccount()
+	got, err := e.Create(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %s\n", err)
+	}
+
+	if diff := cmp.Diff(managed.ExternalCreation{ConnectionDetails: robotConnectionDetails()}, got); diff != "" {
+		t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(robotAccount(withRobotConditions(xpv1.Creating()), withRobotExternalName(robotName)), mg); diff != "" {
+		t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestRobotAccountDelete(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "deleted"},
+		{name: "already gone", err: &quay.APIError{StatusCode: http.StatusNotFound}},
+		{name: "delete error", err: errors.New("boom"), want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := robotAccountExternal{client: &quay.RobotAccountAPIMock{
+				DeleteRobotAccountFunc: func(ctx context.Context, org, name string) error {
+					if org != orgName || name != robotName {
+						t.Errorf("\ne.Delete(...): deleted %s/%s, want %s/%s\n", org, name, orgName, robotName)
+					}
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), robotAccount(withRobotExternalName(robotName)))
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
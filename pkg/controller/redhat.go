@@ -21,15 +21,35 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/stehessel/provider-redhat/pkg/controller/config"
+	"github.com/stehessel/provider-redhat/pkg/controller/iam"
+	"github.com/stehessel/provider-redhat/pkg/controller/kafka"
+	"github.com/stehessel/provider-redhat/pkg/controller/ocm"
+	"github.com/stehessel/provider-redhat/pkg/controller/quay"
 	"github.com/stehessel/provider-redhat/pkg/controller/rhacs"
 )
 
-// Setup creates all RedHat controllers with the supplied logger and adds them to
-// the supplied manager.
-func Setup(mgr ctrl.Manager, o controller.Options) error {
-	for _, setup := range []func(ctrl.Manager, controller.Options) error{
-		config.Setup,
-		rhacs.Setup,
+// Options configure the RedHat controllers. Every controller is configured by
+// the common controller options, and the controllers of an API group
+// additionally by the options of their group, if any.
+type Options struct {
+	controller.Options
+
+	// RHACS configures the controllers of the rhacs API group.
+	RHACS rhacs.Options
+}
+
+// Setup creates all RedHat controllers with the supplied options and adds them
+// to the supplied manager.
+func Setup(mgr ctrl.Manager, o Options) error {
+	for _, setup := range []func(ctrl.Manager, Options) error{
+		groupSetup(config.Setup),
+		func(mgr ctrl.Manager, o Options) error {
+			return rhacs.Setup(mgr, o.Options, o.RHACS)
+		},
+		groupSetup(ocm.Setup),
+		groupSetup(iam.Setup),
+		groupSetup(kafka.Setup),
+		groupSetup(quay.Setup),
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -37,3 +57,11 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 	return nil
 }
+
+// groupSetup adapts the setup of an API group that is only configured by the
+// common controller options.
+func groupSetup(setup func(ctrl.Manager, controller.Options) error) func(ctrl.Manager, Options) error {
+	return func(mgr ctrl.Manager, o Options) error {
+		return setup(mgr, o.Options)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+const (
+	errNotAccessScope     = "managed resource is not an AccessScope custom resource"
+	errObserveAccessScope = "cannot observe access scope"
+	errCreateAccessScope  = "cannot create access scope"
+	errUpdateAccessScope  = "cannot update access scope"
+	errDeleteAccessScope  = "cannot delete access scope"
+)
+
+// setupAccessScope adds a controller that reconciles AccessScope managed
+// resources.
+func setupAccessScope(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	return setupCentralResource(mgr, o, cf, v1alpha1.AccessScopeGroupVersionKind, &v1alpha1.AccessScope{},
+		func(c central.Client) managed.ExternalClient { return &accessScopeExternal{client: c} })
+}
+
+// An accessScopeExternal observes, then either creates, updates, or deletes an
+// access scope of Central.
+type accessScopeExternal struct {
+	client central.AccessScopeAPI
+}
+
+func generateLabelSelectors(in []v1alpha1.LabelSelector) []central.LabelSelector {
+	var out []central.LabelSelector
+	for _, s := range in {
+		sel := central.LabelSelector{}
+		for _, r := range s.Requirements {
+			sel.Requirements = append(sel.Requirements, central.LabelRequirement{Key: r.Key, Op: string(r.Op), Values: r.Values})
+		}
+		out = append(out, sel)
+	}
+	return out
+}
+
+func generateAccessScope(id string, in *v1alpha1.AccessScopeParameters) *central.AccessScope {
+	s := &central.AccessScope{
+		ID:          id,
+		Name:        in.Name,
+		Description: in.Description,
+		Rules: &central.AccessScopeRules{
+			IncludedClusters:        in.IncludedClusters,
+			ClusterLabelSelectors:   generateLabelSelectors(in.ClusterLabelSelectors),
+			NamespaceLabelSelectors: generateLabelSelectors(in.NamespaceLabelSelectors),
+		},
+	}
+	for _, ns := range in.IncludedNamespaces {
+		s.Rules.IncludedNamespaces = append(s.Rules.IncludedNamespaces,
+			central.IncludedNamespace{ClusterName: ns.ClusterName, NamespaceName: ns.NamespaceName})
+	}
+	return s
+}
+
+func isAccessScopeUpToDate(in *v1alpha1.AccessScope, observed *central.AccessScope) (bool, string) {
+	desired := generateAccessScope(observed.ID, &in.Spec.ForProvider)
+	if observed.Rules == nil {
+		observed.Rules = &central.AccessScopeRules{}
+	}
+	if diff := cmp.Diff(desired, observed, cmpopts.EquateEmpty()); diff != "" {
+		diff = "Observed difference in access scope\n" + diff
+		return false, diff
+	}
+	return true, ""
+}
+
+func (c *accessScopeExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AccessScope)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAccessScope)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	scope, err := c.client.GetAccessScope(ctx, id)
+	if central.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveAccessScope)
+	}
+
+	cr.Status.AtProvider = v1alpha1.AccessScopeObservation{ID: scope.ID}
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isAccessScopeUpToDate(cr, scope)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *accessScopeExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AccessScope)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAccessScope)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	scope, err := c.client.CreateAccessScope(ctx, generateAccessScope("", &cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAccessScope)
+	}
+	meta.SetExternalName(cr, scope.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *accessScopeExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AccessScope)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAccessScope)
+	}
+
+	err := c.client.UpdateAccessScope(ctx, generateAccessScope(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAccessScope)
+}
+
+func (c *accessScopeExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AccessScope)
+	if !ok {
+		return errors.New(errNotAccessScope)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteAccessScope(ctx, meta.GetExternalName(cr))
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteAccessScope)
+}
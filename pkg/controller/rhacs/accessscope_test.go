@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+var _ managed.ExternalClient = &accessScopeExternal{}
+
+var accessScopeID = "access-scope-id"
+
+func accessScope() *v1alpha1.AccessScope {
+	s := &v1alpha1.AccessScope{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-namespaces"},
+		Spec: v1alpha1.AccessScopeSpec{
+			ForProvider: v1alpha1.AccessScopeParameters{
+				Name:               "Team namespaces",
+				IncludedNamespaces: []v1alpha1.IncludedNamespace{{ClusterName: "prod", NamespaceName: "team"}},
+				ClusterLabelSelectors: []v1alpha1.LabelSelector{{
+					Requirements: []v1alpha1.LabelRequirement{{Key: "env", Op: "IN", Values: []string{"dev", "stage"}}},
+				}},
+				CentralURL: "https://central.example.com",
+			},
+		},
+	}
+	meta.SetExternalName(s, accessScopeID)
+	return s
+}
+
+func centralAccessScope(mod ...func(*central.AccessScope)) *central.AccessScope {
+	s := &central.AccessScope{
+		ID:   accessScopeID,
+		Name: "Team namespaces",
+		Rules: &central.AccessScopeRules{
+			IncludedNamespaces: []central.IncludedNamespace{{ClusterName: "prod", NamespaceName: "team"}},
+			ClusterLabelSelectors: []central.LabelSelector{{
+				Requirements: []central.LabelRequirement{{Key: "env", Op: "IN", Values: []string{"dev", "stage"}}},
+			}},
+		},
+	}
+	for _, m := range mod {
+		m(s)
+	}
+	return s
+}
+
+func TestAccessScopeObserve(t *testing.T) {
+	cases := []struct {
+		name  string
+		scope *central.AccessScope
+		want  managed.ExternalObservation
+	}{
+		{
+			name:  "access scope up to date",
+			scope: centralAccessScope(),
+			want:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		{
+			name:  "rules changed",
+			scope: centralAccessScope(func(s *central.AccessScope) { s.Rules.IncludedClusters = []string{"prod"} }),
+			want:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+		{
+			name:  "missing rules",
+			scope: centralAccessScope(func(s *central.AccessScope) { s.Rules = nil }),
+			want:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := accessScopeExternal{client: &central.AccessScopeAPIMock{
+				GetAccessScopeFunc: func(ctx context.Context, id string) (*central.AccessScope, error) {
+					return tc.scope, nil
+				},
+			}}
+			got, err := e.Observe(context.Background(), accessScope())
+			if err != nil {
+				t.Fatalf("\ne.Observe(...): unexpected error: %s\n", err)
+			}
+			if diff := cmp.Diff(tc.want, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
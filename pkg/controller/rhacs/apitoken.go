@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+const (
+	errNotAPIToken       = "managed resource is not an APIToken custom resource"
+	errObserveAPIToken   = "cannot observe API token"
+	errCreateAPIToken    = "cannot create API token"
+	errRotateAPIToken    = "cannot rotate API token"
+	errRevokeAPIToken    = "cannot revoke API token"
+	errPersistExternName = "cannot persist external name"
+)
+
+// setupAPIToken adds a controller that reconciles APIToken managed resources.
+func setupAPIToken(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	annotations := managed.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())
+	return setupCentralResource(mgr, o, cf, v1alpha1.APITokenGroupVersionKind, &v1alpha1.APIToken{},
+		func(c central.Client) managed.ExternalClient {
+			return &apiTokenExternal{client: c, annotations: annotations, now: time.Now}
+		})
+}
+
+// An apiTokenExternal generates, rotates and revokes API tokens. A token is
+// rotated by generating a new one in its place, which becomes the external
+// resource of the APIToken.
+type apiTokenExternal struct {
+	client      central.APITokenAPI
+	annotations managed.CriticalAnnotationUpdater
+	now         func() time.Time
+}
+
+func generateAPITokenObservation(in *central.APITokenMeta) v1alpha1.APITokenObservation {
+	return v1alpha1.APITokenObservation{
+		ID:         in.ID,
+		Name:       in.Name,
+		Roles:      in.Roles,
+		IssuedAt:   toMetaTime(in.IssuedAt),
+		Expiration: toMetaTime(in.Expiration),
+	}
+}
+
+// isAPITokenUpToDate returns false if the observed token does not match the
+// desired name and role, or if it expires within the rotation period.
+func (c *apiTokenExternal) isAPITokenUpToDate(in *v1alpha1.APIToken, observed *central.APITokenMeta) (bool, string) {
+	desired := []string{in.Spec.ForProvider.Name, in.Spec.ForProvider.Role}
+	actual := append([]string{observed.Name}, observed.Roles...)
+	if diff := cmp.Diff(desired, actual, cmpopts.EquateEmpty()); diff != "" {
+		return false, "Observed difference in API token\n" + diff
+	}
+	if observed.Expiration == nil {
+		return true, ""
+	}
+	rotateAt := *observed.Expiration
+	if rb := in.Spec.ForProvider.RotateBefore; rb != nil {
+		rotateAt = rotateAt.Add(-rb.Duration)
+	}
+	if !c.now().Before(rotateAt) {
+		return false, fmt.Sprintf("API token expires at %s and is due for rotation", observed.Expiration.Format(time.RFC3339))
+	}
+	return true, ""
+}
+
+func (c *apiTokenExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.APIToken)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAPIToken)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	token, err := c.client.GetAPIToken(ctx, id)
+	if central.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveAPIToken)
+	}
+	if token.Revoked {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = generateAPITokenObservation(token)
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := c.isAPITokenUpToDate(cr, token)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+// generate generates a new API token for the supplied APIToken, and returns
+// the connection details to publish it with.
+func (c *apiTokenExternal) generate(ctx context.Context, cr *v1alpha1.APIToken) (*central.APIToken, managed.ConnectionDetails, error) {
+	req := central.GenerateAPITokenRequest{
+		Name:  cr.Spec.ForProvider.Name,
+		Roles: []string{cr.Spec.ForProvider.Role},
+	}
+	if ea := cr.Spec.ForProvider.ExpiresAfter; ea != nil {
+		exp := c.now().Add(ea.Duration).UTC()
+		req.Expiration = &exp
+	}
+	token, err := c.client.GenerateAPIToken(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return token, managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyToken:    []byte(token.Token),
+		v1alpha1.ConnectionKeyEndpoint: []byte(cr.Spec.ForProvider.CentralURL),
+	}, nil
+}
+
+func (c *apiTokenExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.APIToken)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAPIToken)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	token, cd, err := c.generate(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAPIToken)
+	}
+	meta.SetExternalName(cr, token.Metadata.ID)
+	return managed.ExternalCreation{ConnectionDetails: cd}, nil
+}
+
+// Update rotates the API token. If the old token cannot be revoked or the new
+// token cannot be tracked, the new token is revoked again. Either the old token
+// then remains in use, or it is revoked and a new token is created once the
+// APIToken is observed again.
+func (c *apiTokenExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.APIToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAPIToken)
+	}
+	old := meta.GetExternalName(cr)
+
+	token, cd, err := c.generate(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotateAPIToken)
+	}
+	if err := c.client.RevokeAPIToken(ctx, old); err != nil && !central.IsNotFound(err) {
+		_ = c.client.RevokeAPIToken(ctx, token.Metadata.ID)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRevokeAPIToken)
+	}
+
+	// The managed reconciler does not persist the external name of updated
+	// resources.
+	meta.SetExternalName(cr, token.Metadata.ID)
+	if err := c.annotations.UpdateCriticalAnnotations(ctx, cr); err != nil {
+		_ = c.client.RevokeAPIToken(ctx, token.Metadata.ID)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPersistExternName)
+	}
+	cr.Status.AtProvider = generateAPITokenObservation(&token.Metadata)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalUpdate{ConnectionDetails: cd}, nil
+}
+
+func (c *apiTokenExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.APIToken)
+	if !ok {
+		return errors.New(errNotAPIToken)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.RevokeAPIToken(ctx, meta.GetExternalName(cr))
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errRevokeAPIToken)
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+var (
+	_ managed.ExternalClient    = &apiTokenExternal{}
+	_ managed.ExternalConnecter = &centralConnector{}
+)
+
+var (
+	tokenID   = "token-id"
+	tokenName = "test-token"
+	tokenRole = "Continuous Integration"
+	tokenNow  = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type apiTokenModifier func(*v1alpha1.APIToken)
+
+func withTokenConditions(c ...xpv1.Condition) apiTokenModifier {
+	return func(t *v1alpha1.APIToken) { t.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTokenExternalName(id string) apiTokenModifier {
+	return func(t *v1alpha1.APIToken) { meta.SetExternalName(t, id) }
+}
+
+func withTokenObservation(o v1alpha1.APITokenObservation) apiTokenModifier {
+	return func(t *v1alpha1.APIToken) { t.Status.AtProvider = o }
+}
+
+func apiToken(mod ...apiTokenModifier) *v1alpha1.APIToken {
+	t := &v1alpha1.APIToken{
+		ObjectMeta: metav1.ObjectMeta{Name: tokenName},
+		Spec: v1alpha1.APITokenSpec{
+			ForProvider: v1alpha1.APITokenParameters{
+				Name:         tokenName,
+				Role:         tokenRole,
+				ExpiresAfter: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+				RotateBefore: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+				CentralURL:   "https://central.example.com",
+			},
+		},
+	}
+	for _, m := range mod {
+		m(t)
+	}
+	return t
+}
+
+func tokenMeta(id string, expiration time.Time) *central.APITokenMeta {
+	return &central.APITokenMeta{ID: id, Name: tokenName, Roles: []string{tokenRole}, Expiration: &expiration}
+}
+
+func tokenObservation(id string, expiration time.Time) v1alpha1.APITokenObservation {
+	exp := metav1.NewTime(expiration)
+	return v1alpha1.APITokenObservation{ID: id, Name: tokenName, Roles: []string{tokenRole}, Expiration: &exp}
+}
+
+func TestAPITokenObserve(t *testing.T) {
+	valid := tokenNow.Add(10 * 24 * time.Hour)
+	dueForRotation := tokenNow.Add(5 * 24 * time.Hour)
+
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name  string
+		token *central.APITokenMeta
+		err   error
+		want  want
+	}{
+		{
+			name:  "token up to date",
+			token: tokenMeta(tokenID, valid),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: apiToken(withTokenExternalName(tokenID), withTokenConditions(xpv1.Available()),
+					withTokenObservation(tokenObservation(tokenID, valid))),
+			},
+		},
+		{
+			name:  "token due for rotation",
+			token: tokenMeta(tokenID, dueForRotation),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg: apiToken(withTokenExternalName(tokenID), withTokenConditions(xpv1.Available()),
+					withTokenObservation(tokenObservation(tokenID, dueForRotation))),
+			},
+		},
+		{
+			name: "token role changed",
+			token: func() *central.APITokenMeta {
+				m := tokenMeta(tokenID, valid)
+				m.Roles = []string{"Admin"}
+				return m
+			}(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg: apiToken(withTokenExternalName(tokenID), withTokenConditions(xpv1.Available()),
+					withTokenObservation(func() v1alpha1.APITokenObservation {
+						o := tokenObservation(tokenID, valid)
+						o.Roles = []string{"Admin"}
+						return o
+					}())),
+			},
+		},
+		{
+			name: "token revoked",
+			token: func() *central.APITokenMeta {
+				m := tokenMeta(tokenID, valid)
+				m.Revoked = true
+				return m
+			}(),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  apiToken(withTokenExternalName(tokenID)),
+			},
+		},
+		{
+			name: "token not found",
+			err:  &central.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  apiToken(withTokenExternalName(tokenID)),
+			},
+		},
+		{
+			name: "get error",
+			err:  errors.New("boom"),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  apiToken(withTokenExternalName(tokenID)),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := apiTokenExternal{
+				client: &central.APITokenAPIMock{
+					GetAPITokenFunc: func(ctx context.Context, id string) (*central.APITokenMeta, error) {
+						return tc.token, tc.err
+					},
+				},
+				now: func() time.Time { return tokenNow },
+			}
+			mg := apiToken(withTokenExternalName(tokenID))
+			got, err := e.Observe(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAPITokenCreate(t *testing.T) {
+	var req central.GenerateAPITokenRequest
+	e := apiTokenExternal{
+		client: &central.APITokenAPIMock{
+			GenerateAPITokenFunc: func(ctx context.Context, r central.GenerateAPITokenRequest) (*central.APIToken, error) {
+				req = r
+				return &central.APIToken{Token: "secret", Metadata: *tokenMeta(tokenID, *r.Expiration)}, nil
+			},
+		},
+		now: func() time.Time { return tokenNow },
+	}
+	mg := apiToken()
+	got, err := e.Create(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %s\n", err)
+	}
+
+	exp := tokenNow.Add(30 * 24 * time.Hour)
+	wantReq := central.GenerateAPITokenRequest{Name: tokenName, Roles: []string{tokenRole}, Expiration: &exp}
+	if diff := cmp.Diff(wantReq, req); diff != "" {
+		t.Errorf("\ne.Create(...): -want request, +got request:\n%s\n", diff)
+	}
+	want := managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyToken:    []byte("secret"),
+		v1alpha1.ConnectionKeyEndpoint: []byte("https://central.example.com"),
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(apiToken(withTokenConditions(xpv1.Creating()), withTokenExternalName(tokenID)), mg); diff != "" {
+		t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestAPITokenUpdate(t *testing.T) {
+	newID := "new-token-id"
+
+	type want struct {
+		upd     managed.ExternalUpdate
+		name    string
+		revoked []string
+		err     error
+	}
+
+	cases := []struct {
+		name      string
+		revokeErr error
+		updateErr error
+		want      want
+	}{
+		{
+			name: "rotation revokes old token",
+			want: want{
+				upd: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					v1alpha1.ConnectionKeyToken:    []byte("secret"),
+					v1alpha1.ConnectionKeyEndpoint: []byte("https://central.example.com"),
+				}},
+				name:    newID,
+				revoked: []string{tokenID},
+			},
+		},
+		{
+			name:      "old token cannot be revoked",
+			revokeErr: errors.New("boom"),
+			want: want{
+				name:    tokenID,
+				revoked: []string{tokenID, newID},
+				err:     cmpopts.AnyError,
+			},
+		},
+		{
+			name:      "new token cannot be tracked",
+			updateErr: errors.New("boom"),
+			want: want{
+				name:    newID,
+				revoked: []string{tokenID, newID},
+				err:     cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			revoked := []string{}
+			e := apiTokenExternal{
+				client: &central.APITokenAPIMock{
+					GenerateAPITokenFunc: func(ctx context.Context, r central.GenerateAPITokenRequest) (*central.APIToken, error) {
+						return &central.APIToken{Token: "secret", Metadata: *tokenMeta(newID, *r.Expiration)}, nil
+					},
+					RevokeAPITokenFunc: func(ctx context.Context, id string) error {
+						revoked = append(revoked, id)
+						if id == tokenID {
+							return tc.revokeErr
+						}
+						return nil
+					},
+				},
+				annotations: managed.CriticalAnnotationUpdateFn(func(ctx context.Context, o client.Object) error {
+					return tc.updateErr
+				}),
+				now: func() time.Time { return tokenNow },
+			}
+			mg := apiToken(withTokenExternalName(tokenID))
+			got, err := e.Update(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.upd, got); diff != "" {
+				t.Errorf("\ne.Update(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.name, meta.GetExternalName(mg)); diff != "" {
+				t.Errorf("\ne.Update(...): -want external name, +got external name:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.revoked, revoked); diff != "" {
+				t.Errorf("\ne.Update(...): -want revoked, +got revoked:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAPITokenDelete(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "revoked"},
+		{name: "already gone", err: &central.APIError{StatusCode: http.StatusNotFound}},
+		{name: "revoke error", err: errors.New("boom"), want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := apiTokenExternal{client: &central.APITokenAPIMock{
+				RevokeAPITokenFunc: func(ctx context.Context, id string) error {
+					if id != tokenID {
+						t.Errorf("\ne.Delete(...): revoked %q, want %q\n", id, tokenID)
+					}
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), apiToken(withTokenExternalName(tokenID)))
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net"
+	"net/url"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+	"github.com/stehessel/provider-redhat/pkg/secrethash"
+)
+
+const (
+	errNotAuthProvider     = "managed resource is not an AuthProvider custom resource"
+	errAuthProviderType    = "exactly one of oidc and saml must be set"
+	errObserveAuthProvider = "cannot observe auth provider"
+	errCreateAuthProvider  = "cannot create auth provider"
+	errUpdateAuthProvider  = "cannot update auth provider"
+	errDeleteAuthProvider  = "cannot delete auth provider"
+	errAuthProviderSecrets = "cannot get auth provider secrets"
+	errParseCentralURL     = "cannot parse central URL"
+)
+
+// Keys of the config of auth providers.
+const (
+	configOIDCIssuer             = "issuer"
+	configOIDCClientID           = "client_id"
+	configOIDCClientSecret       = "client_secret"
+	configOIDCMode               = "mode"
+	configOIDCNoClientSecret     = "do_not_use_client_secret"
+	configSAMLSPIssuer           = "sp_issuer"
+	configSAMLIDPMetadataURL     = "idp_metadata_url"
+	configSAMLIDPIssuer          = "idp_issuer"
+	configSAMLIDPSSOURL          = "idp_sso_url"
+	configSAMLIDPCertPEM         = "idp_cert_pem"
+	configSAMLIDPNameIDFormat    = "idp_nameid_format"
+	defaultAuthProviderHTTPSPort = "443"
+)
+
+// authProviderConfigKeys are the keys of the config of auth providers that
+// are set from the parameters of an AuthProvider. The client secret is not
+// among them, because Central omits it.
+var authProviderConfigKeys = []string{
+	configOIDCIssuer,
+	configOIDCClientID,
+	configOIDCMode,
+	configOIDCNoClientSecret,
+	configSAMLSPIssuer,
+	configSAMLIDPMetadataURL,
+	configSAMLIDPIssuer,
+	configSAMLIDPSSOURL,
+	configSAMLIDPCertPEM,
+	configSAMLIDPNameIDFormat,
+}
+
+// setupAuthProvider adds a controller that reconciles AuthProvider managed
+// resources.
+func setupAuthProvider(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	kube := mgr.GetClient()
+	return setupCentralResource(mgr, o, cf, v1alpha1.AuthProviderGroupVersionKind, &v1alpha1.AuthProvider{},
+		func(c central.Client) managed.ExternalClient { return &authProviderExternal{client: c, kube: kube} })
+}
+
+// An authProviderExternal observes, then either creates, updates, or deletes
+// an auth provider of Central. The OIDC client secret is read from a secret.
+type authProviderExternal struct {
+	client central.AuthProviderAPI
+	kube   client.Client
+}
+
+// defaultUIEndpoint returns the host and port of the supplied Central URL.
+func defaultUIEndpoint(centralURL string) (string, error) {
+	u, err := url.Parse(centralURL)
+	if err != nil {
+		return "", errors.Wrap(err, errParseCentralURL)
+	}
+	if u.Port() != "" {
+		return u.Host, nil
+	}
+	return net.JoinHostPort(u.Hostname(), defaultAuthProviderHTTPSPort), nil
+}
+
+// generateAuthProvider returns the Central auth provider described by the
+// supplied AuthProvider, together with a hash of its client secret.
+func (c *authProviderExternal) generateAuthProvider(ctx context.Context, cr *v1alpha1.AuthProvider) (*central.AuthProvider, string, error) {
+	p := cr.Spec.ForProvider
+	ap := &central.AuthProvider{
+		ID:            meta.GetExternalName(cr),
+		Name:          p.Name,
+		UIEndpoint:    p.UIEndpoint,
+		Enabled:       p.Enabled,
+		Config:        map[string]string{},
+		ClaimMappings: p.ClaimMappings,
+	}
+	if ap.UIEndpoint == "" {
+		ep, err := defaultUIEndpoint(p.CentralURL)
+		if err != nil {
+			return nil, "", err
+		}
+		ap.UIEndpoint = ep
+	}
+	for _, a := range p.RequiredAttributes {
+		ap.RequiredAttributes = append(ap.RequiredAttributes,
+			central.RequiredAttribute{AttributeKey: a.AttributeKey, AttributeValue: a.AttributeValue})
+	}
+
+	if (p.OIDC == nil) == (p.SAML == nil) {
+		return nil, "", errors.New(errAuthProviderType)
+	}
+	secret := ""
+	if o := p.OIDC; o != nil {
+		ap.Type = central.AuthProviderTypeOIDC
+		ap.Config[configOIDCIssuer] = o.Issuer
+		ap.Config[configOIDCClientID] = o.ClientID
+		ap.Config[configOIDCMode] = string(o.Mode)
+		if o.ClientSecretSecretRef == nil {
+			ap.Config[configOIDCNoClientSecret] = "true"
+		} else {
+			var err error
+			if secret, err = secrethash.GetSecretValue(ctx, c.kube, *o.ClientSecretSecretRef); err != nil {
+				return nil, "", errors.Wrap(err, errAuthProviderSecrets)
+			}
+			ap.Config[configOIDCClientSecret] = secret
+		}
+	}
+	if s := p.SAML; s != nil {
+		ap.Type = central.AuthProviderTypeSAML
+		for k, v := range map[string]string{
+			configSAMLSPIssuer:        s.SPIssuer,
+			configSAMLIDPMetadataURL:  s.IDPMetadataURL,
+			configSAMLIDPIssuer:       s.IDPIssuer,
+			configSAMLIDPSSOURL:       s.IDPSSOURL,
+			configSAMLIDPCertPEM:      s.IDPCertPEM,
+			configSAMLIDPNameIDFormat: s.IDPNameIDFormat,
+		} {
+			if v != "" {
+				ap.Config[k] = v
+			}
+		}
+	}
+	return ap, secrethash.Hash([]string{secret}), nil
+}
+
+func isAuthProviderUpToDate(in *v1alpha1.AuthProvider, desired *central.AuthProvider, hash string, observed *central.AuthProvider) (bool, string) {
+	// Central omits the client secret and adds defaults to the config, so
+	// only the keys set from the parameters are compared. Keys that are no
+	// longer set must be absent, empty or false.
+	d, o := *desired, *observed
+	d.Config, o.Config = map[string]string{}, map[string]string{}
+	for _, k := range authProviderConfigKeys {
+		if v, ok := desired.Config[k]; ok {
+			d.Config[k] = v
+			o.Config[k] = observed.Config[k]
+			continue
+		}
+		if v := observed.Config[k]; v != "" && v != "false" {
+			o.Config[k] = v
+		}
+	}
+	ignore := cmpopts.IgnoreFields(central.AuthProvider{}, "LoginURL", "Validated", "Active")
+	if diff := cmp.Diff(&d, &o, cmpopts.EquateEmpty(), ignore); diff != "" {
+		diff = "Observed difference in auth provider\n" + diff
+		return false, diff
+	}
+	if secrethash.Changed(&in.Status.AtProvider.SecretObservation, hash) {
+		return false, "Referenced secrets of auth provider changed"
+	}
+	return true, ""
+}
+
+func (c *authProviderExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AuthProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAuthProvider)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	ap, err := c.client.GetAuthProvider(ctx, id)
+	if central.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveAuthProvider)
+	}
+	desired, hash, err := c.generateAuthProvider(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveAuthProvider)
+	}
+
+	cr.Status.AtProvider.ID = ap.ID
+	cr.Status.AtProvider.Type = ap.Type
+	cr.Status.AtProvider.LoginURL = ap.LoginURL
+	cr.Status.AtProvider.Validated = ap.Validated
+	cr.Status.AtProvider.Active = ap.Active
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isAuthProviderUpToDate(cr, desired, hash, ap)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *authProviderExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AuthProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAuthProvider)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	desired, _, err := c.generateAuthProvider(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAuthProvider)
+	}
+	ap, err := c.client.CreateAuthProvider(ctx, desired)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAuthProvider)
+	}
+	meta.SetExternalName(cr, ap.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *authProviderExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AuthProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAuthProvider)
+	}
+
+	desired, hash, err := c.generateAuthProvider(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAuthProvider)
+	}
+	if err := c.client.UpdateAuthProvider(ctx, desired); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAuthProvider)
+	}
+	cr.Status.AtProvider.SecretHash = hash
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *authProviderExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AuthProvider)
+	if !ok {
+		return errors.New(errNotAuthProvider)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteAuthProvider(ctx, meta.GetExternalName(cr))
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteAuthProvider)
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+	"github.com/stehessel/provider-redhat/pkg/secrethash"
+)
+
+var _ managed.ExternalClient = &authProviderExternal{}
+
+var authProviderID = "auth-provider-id"
+
+type authProviderModifier func(*v1alpha1.AuthProvider)
+
+func withAuthProviderSecretHash(h string) authProviderModifier {
+	return func(a *v1alpha1.AuthProvider) { a.Status.AtProvider.SecretHash = h }
+}
+
+func withAuthProviderParameters(f func(*v1alpha1.AuthProviderParameters)) authProviderModifier {
+	return func(a *v1alpha1.AuthProvider) { f(&a.Spec.ForProvider) }
+}
+
+func authProvider(mod ...authProviderModifier) *v1alpha1.AuthProvider {
+	a := &v1alpha1.AuthProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "sso"},
+		Spec: v1alpha1.AuthProviderSpec{
+			ForProvider: v1alpha1.AuthProviderParameters{
+				Name:    "SSO",
+				Enabled: true,
+				OIDC: &v1alpha1.OIDCConfig{
+					Issuer:   "https://sso.example.com",
+					ClientID: "central",
+					ClientSecretSecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "sso", Namespace: "crossplane-system"},
+						Key:             "clientSecret",
+					},
+					Mode: "auto",
+				},
+				ClaimMappings: map[string]string{"groups": "groups"},
+				CentralURL:    "https://central.example.com",
+			},
+		},
+	}
+	meta.SetExternalName(a, authProviderID)
+	for _, m := range mod {
+		m(a)
+	}
+	return a
+}
+
+func centralAuthProvider(mod ...func(*central.AuthProvider)) *central.AuthProvider {
+	a := &central.AuthProvider{
+		ID:         authProviderID,
+		Name:       "SSO",
+		Type:       central.AuthProviderTypeOIDC,
+		UIEndpoint: "central.example.com:443",
+		Enabled:    true,
+		Config: map[string]string{
+			"issuer":    "https://sso.example.com",
+			"client_id": "central",
+			"mode":      "auto",
+		},
+		LoginURL:      "/sso/login/" + authProviderID,
+		ClaimMappings: map[string]string{"groups": "groups"},
+	}
+	for _, m := range mod {
+		m(a)
+	}
+	return a
+}
+
+func TestDefaultUIEndpoint(t *testing.T) {
+	cases := []struct {
+		name       string
+		centralURL string
+		want       string
+	}{
+		{
+			name:       "default port",
+			centralURL: "https://central.example.com",
+			want:       "central.example.com:443",
+		},
+		{
+			name:       "explicit port",
+			centralURL: "https://central.example.com:8443",
+			want:       "central.example.com:8443",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := defaultUIEndpoint(tc.centralURL)
+			if err != nil {
+				t.Fatalf("defaultUIEndpoint(%q): unexpected error: %s", tc.centralURL, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\ndefaultUIEndpoint(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAuthProvider(t *testing.T) {
+	cases := []struct {
+		name string
+		mg   *v1alpha1.AuthProvider
+		want *central.AuthProvider
+		err  error
+	}{
+		{
+			name: "oidc with client secret",
+			mg:   authProvider(),
+			want: centralAuthProvider(func(a *central.AuthProvider) {
+				a.LoginURL = ""
+				a.Config["client_secret"] = "s3cr3t"
+			}),
+		},
+		{
+			name: "public oidc client",
+			mg: authProvider(withAuthProviderParameters(func(p *v1alpha1.AuthProviderParameters) {
+				p.OIDC.ClientSecretSecretRef = nil
+			})),
+			want: centralAuthProvider(func(a *central.AuthProvider) {
+				a.LoginURL = ""
+				a.Config["do_not_use_client_secret"] = "true"
+			}),
+		},
+		{
+			name: "saml",
+			mg: authProvider(withAuthProviderParameters(func(p *v1alpha1.AuthProviderParameters) {
+				p.OIDC = nil
+				p.SAML = &v1alpha1.SAMLConfig{SPIssuer: "central", IDPMetadataURL: "https://sso.example.com/metadata"}
+			})),
+			want: centralAuthProvider(func(a *central.AuthProvider) {
+				a.LoginURL = ""
+				a.Type = central.AuthProviderTypeSAML
+				a.Config = map[string]string{"sp_issuer": "central", "idp_metadata_url": "https://sso.example.com/metadata"}
+			}),
+		},
+		{
+			name: "no type",
+			mg: authProvider(withAuthProviderParameters(func(p *v1alpha1.AuthProviderParameters) {
+				p.OIDC = nil
+			})),
+			err: cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &authProviderExternal{kube: secretClient(map[string][]byte{"clientSecret": []byte("s3cr3t")})}
+			got, _, err := e.generateAuthProvider(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.generateAuthProvider(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\ne.generateAuthProvider(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAuthProviderObserve(t *testing.T) {
+	e := &authProviderExternal{kube: secretClient(map[string][]byte{"clientSecret": []byte("s3cr3t")})}
+	_, hash, err := e.generateAuthProvider(context.Background(), authProvider())
+	if err != nil {
+		t.Fatalf("generateAuthProvider(...): unexpected error: %s", err)
+	}
+	samlHash := secrethash.Hash([]string{""})
+	withSAML := func(p *v1alpha1.AuthProviderParameters) {
+		p.OIDC = nil
+		p.SAML = &v1alpha1.SAMLConfig{SPIssuer: "central", IDPMetadataURL: "https://sso.example.com/metadata"}
+	}
+	withCentralSAML := func(a *central.AuthProvider) {
+		a.Type = central.AuthProviderTypeSAML
+		a.Config = map[string]string{"sp_issuer": "central", "idp_metadata_url": "https://sso.example.com/metadata"}
+	}
+
+	cases := []struct {
+		name     string
+		mg       *v1alpha1.AuthProvider
+		provider *central.AuthProvider
+		want     managed.ExternalObservation
+	}{
+		{
+			name:     "auth provider up to date",
+			mg:       authProvider(withAuthProviderSecretHash(hash)),
+			provider: centralAuthProvider(func(a *central.AuthProvider) { a.Config["disable_offline_access_scope"] = "false" }),
+			want:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		{
+			name:     "issuer changed",
+			mg:       authProvider(withAuthProviderSecretHash(hash)),
+			provider: centralAuthProvider(func(a *central.AuthProvider) { a.Config["issuer"] = "https://other.example.com" }),
+			want:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+		{
+			name:     "secret hash recorded on first observation",
+			mg:       authProvider(),
+			provider: centralAuthProvider(func(a *central.AuthProvider) { a.Config["disable_offline_access_scope"] = "false" }),
+			want:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		{
+			name:     "client secret changed",
+			mg:       authProvider(withAuthProviderSecretHash("outdated")),
+			provider: centralAuthProvider(),
+			want:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+		{
+			name:     "client secret no longer omitted",
+			mg:       authProvider(withAuthProviderSecretHash(hash)),
+			provider: centralAuthProvider(func(a *central.AuthProvider) { a.Config["do_not_use_client_secret"] = "true" }),
+			want:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+		{
+			name:     "saml up to date",
+			mg:       authProvider(withAuthProviderSecretHash(samlHash), withAuthProviderParameters(withSAML)),
+			provider: centralAuthProvider(withCentralSAML),
+			want:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		{
+			name: "saml key removed",
+			mg:   authProvider(withAuthProviderSecretHash(samlHash), withAuthProviderParameters(withSAML)),
+			provider: centralAuthProvider(withCentralSAML, func(a *central.AuthProvider) {
+				a.Config["idp_cert_pem"] = "-----BEGIN CERTIFICATE-----"
+			}),
+			want: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e.client = &central.AuthProviderAPIMock{
+				GetAuthProviderFunc: func(ctx context.Context, id string) (*central.AuthProvider, error) {
+					return tc.provider, nil
+				},
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("\ne.Observe(...): unexpected error: %s\n", err)
+			}
+			if diff := cmp.Diff(tc.want, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if _, want, _ := e.generateAuthProvider(context.Background(), tc.mg); tc.mg.Status.AtProvider.SecretHash != want && tc.want.ResourceUpToDate {
+				t.Errorf("\ne.Observe(...): recorded secret hash %q, want %q\n", tc.mg.Status.AtProvider.SecretHash, want)
+			}
+			if tc.mg.Status.AtProvider.LoginURL != tc.provider.LoginURL {
+				t.Errorf("\ne.Observe(...): want login URL %q, got %q\n", tc.provider.LoginURL, tc.mg.Status.AtProvider.LoginURL)
+			}
+		})
+	}
+}
+
+func TestAuthProviderUpdate(t *testing.T) {
+	var got *central.AuthProvider
+	e := &authProviderExternal{
+		client: &central.AuthProviderAPIMock{
+			UpdateAuthProviderFunc: func(ctx context.Context, p *central.AuthProvider) error {
+				got = p
+				return nil
+			},
+		},
+		kube: secretClient(map[string][]byte{"clientSecret": []byte("rotated")}),
+	}
+	mg := authProvider(withAuthProviderSecretHash("outdated"))
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Update(...): unexpected error: %s\n", err)
+	}
+	if got.Config["client_secret"] != "rotated" {
+		t.Errorf("\ne.Update(...): want rotated client secret, got %q\n", got.Config["client_secret"])
+	}
+	if mg.Status.AtProvider.SecretHash == "outdated" {
+		t.Errorf("\ne.Update(...): secret hash was not updated\n")
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+	"github.com/stehessel/provider-redhat/pkg/features"
+	"github.com/stehessel/provider-redhat/pkg/tracing"
+)
+
+const (
+	errNotCentralResource = "managed resource is not a resource of the Central API"
+	errNoCentralURL       = "central URL is not set or not yet resolved"
+)
+
+// A centralResource is a managed resource that lives in the API of a Central.
+type centralResource interface {
+	resource.Managed
+
+	// GetCentralURL returns the UI URL of the resource's Central.
+	GetCentralURL() string
+}
+
+// setupCentralResource adds a controller that reconciles managed resources of
+// the supplied kind through the API of their Central. Their external name is
+// the identifier Central assigns to them, so it does not default to the
+// resource's name.
+func setupCentralResource(mgr ctrl.Manager, o controller.Options, cf *clientFactory, gvk schema.GroupVersionKind, obj client.Object, external func(central.Client) managed.ExternalClient) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(gvk),
+		managed.WithExternalConnecter(&centralConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			clients:  cf,
+			external: external,
+		}),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r, otel.GetTracerProvider()), o.GlobalRateLimiter))
+}
+
+// A centralConnector produces a client for the API of the Central a managed
+// resource belongs to.
+type centralConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clients  *clientFactory
+	external func(central.Client) managed.ExternalClient
+}
+
+func (c *centralConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(centralResource)
+	if !ok {
+		return nil, errors.New(errNotCentralResource)
+	}
+	if cr.GetCentralURL() == "" {
+		return nil, errors.New(errNoCentralURL)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+	tracing.SetAttributes(ctx, tracing.AttributeProviderConfig.String(cr.GetProviderConfigReference().Name))
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	client, err := c.clients.newCentralClient(ctx, pc, cr.GetCentralURL())
+	if err != nil {
+		return nil, err
+	}
+	return c.external(client), nil
+}
+
+// A process runs a long call to the API of a Central in the background, such
+// as one that has Central write or restore a backup before it responds.
+type process struct {
+	key  string
+	done chan struct{}
+	err  error
+}
+
+// finished returns true once the process finished. Its error may be read
+// afterwards.
+func (p *process) finished() bool {
+	select {
+	case <-p.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// processes tracks the processes of managed resources across reconciles,
+// keyed by the UID of the resource. Processes are lost when the provider
+// restarts, in which case they are run again.
+type processes struct {
+	mu        sync.Mutex
+	timeout   time.Duration
+	processes map[types.UID]*process
+}
+
+// newProcesses returns a tracker of processes bounded by the supplied
+// timeout.
+func newProcesses(timeout time.Duration) *processes {
+	return &processes{timeout: timeout, processes: map[types.UID]*process{}}
+}
+
+// start runs the supplied function in the background as the process of the
+// resource with the supplied UID. The key identifies what the process works
+// on, e.g. the backup it restores.
+func (r *processes) start(uid types.UID, key string, run func(ctx context.Context) error) {
+	p := &process{key: key, done: make(chan struct{})}
+	r.mu.Lock()
+	r.processes[uid] = p
+	r.mu.Unlock()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+		defer cancel()
+		p.err = run(ctx)
+		close(p.done)
+	}()
+}
+
+func (r *processes) get(uid types.UID) *process {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.processes[uid]
+}
+
+func (r *processes) forget(uid types.UID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.processes, uid)
+}
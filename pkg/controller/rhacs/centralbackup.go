@@ -0,0 +1,315 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+	"github.com/stehessel/provider-redhat/pkg/clients/s3"
+	"github.com/stehessel/provider-redhat/pkg/secrethash"
+)
+
+const (
+	errNotCentralBackup     = "managed resource is not a CentralBackup custom resource"
+	errObserveCentralBackup = "cannot observe central backup"
+	errCreateCentralBackup  = "cannot create central backup"
+	errUpdateCentralBackup  = "cannot update central backup"
+	errDeleteCentralBackup  = "cannot delete central backup"
+	errCentralBackupSecrets = "cannot get central backup secrets"
+	errTriggerCentralBackup = "cannot have central write a backup"
+	errTriggerLost          = "the provider restarted while central wrote a backup"
+)
+
+// backupTimeout bounds the backups the provider has Central write. Central
+// writes a backup before it responds to the request to trigger it, so
+// backups are triggered in the background.
+const backupTimeout = 15 * time.Minute
+
+// backupKeyPrefix is the prefix of the keys of the backups Central writes
+// below the object prefix of an external backup.
+const backupKeyPrefix = "backup"
+
+// setupCentralBackup adds a controller that reconciles CentralBackup managed
+// resources.
+func setupCentralBackup(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	kube := mgr.GetClient()
+	triggers := newProcesses(backupTimeout)
+	return setupCentralResource(mgr, o, cf, v1alpha1.CentralBackupGroupVersionKind, &v1alpha1.CentralBackup{},
+		func(c central.Client) managed.ExternalClient {
+			return &centralBackupExternal{client: c, kube: kube, objectStore: s3.NewClient, triggers: triggers, now: time.Now}
+		})
+}
+
+// A centralBackupExternal observes, then either creates, updates, or deletes
+// an external backup integration of Central. Once the integration exists,
+// it has Central write a backup and tracks the latest backup in the object
+// store.
+type centralBackupExternal struct {
+	client      central.ExternalBackupAPI
+	kube        client.Client
+	objectStore func(s3.Config) (s3.Client, error)
+	// triggers tracks the backups Central writes in the background.
+	triggers *processes
+	now      func() time.Time
+}
+
+// objectStoreConfig returns the configuration of a client for the object
+// store of the supplied CentralBackup.
+func objectStoreConfig(ctx context.Context, kube client.Client, p v1alpha1.CentralBackupParameters) (s3.Config, error) {
+	id, err := secrethash.GetSecretValue(ctx, kube, p.S3.AccessKeyIDSecretRef)
+	if err != nil {
+		return s3.Config{}, errors.Wrap(err, errCentralBackupSecrets)
+	}
+	key, err := secrethash.GetSecretValue(ctx, kube, p.S3.SecretAccessKeySecretRef)
+	if err != nil {
+		return s3.Config{}, errors.Wrap(err, errCentralBackupSecrets)
+	}
+	return s3.Config{
+		Endpoint:        p.S3.Endpoint,
+		Region:          p.S3.Region,
+		AccessKeyID:     id,
+		SecretAccessKey: key,
+	}, nil
+}
+
+// generateExternalBackup returns the Central external backup described by the
+// supplied CentralBackup, together with a hash of its credentials.
+func generateExternalBackup(id string, p v1alpha1.CentralBackupParameters, cfg s3.Config) (*central.ExternalBackup, string) {
+	s := p.Schedule
+	b := &central.ExternalBackup{
+		ID:            id,
+		Name:          p.Name,
+		Type:          central.ExternalBackupTypeS3,
+		BackupsToKeep: p.BackupsToKeep,
+		Schedule: &central.Schedule{
+			IntervalType: string(s.IntervalType),
+			Hour:         s.Hour,
+			Minute:       s.Minute,
+		},
+		S3: &central.S3Config{
+			Bucket:          p.S3.Bucket,
+			AccessKeyID:     cfg.AccessKeyID,
+			SecretAccessKey: cfg.SecretAccessKey,
+			Region:          p.S3.Region,
+			ObjectPrefix:    p.S3.ObjectPrefix,
+			Endpoint:        p.S3.Endpoint,
+		},
+	}
+	if len(s.DaysOfWeek) > 0 {
+		b.Schedule.DaysOfWeek = &central.ScheduleDays{Days: s.DaysOfWeek}
+	}
+	if len(s.DaysOfMonth) > 0 {
+		b.Schedule.DaysOfMonth = &central.ScheduleDays{Days: s.DaysOfMonth}
+	}
+	return b, secrethash.Hash([]string{cfg.AccessKeyID, cfg.SecretAccessKey})
+}
+
+// scrubExternalBackup returns a copy of the supplied external backup without
+// its credentials, which Central masks.
+func scrubExternalBackup(in *central.ExternalBackup) *central.ExternalBackup {
+	b := *in
+	if b.S3 != nil {
+		s := *b.S3
+		s.AccessKeyID, s.SecretAccessKey = "", ""
+		b.S3 = &s
+	}
+	return &b
+}
+
+func isCentralBackupUpToDate(in *v1alpha1.CentralBackup, desired *central.ExternalBackup, hash string, observed *central.ExternalBackup) (bool, string) {
+	if diff := cmp.Diff(scrubExternalBackup(desired), scrubExternalBackup(observed), cmpopts.EquateEmpty()); diff != "" {
+		diff = "Observed difference in central backup\n" + diff
+		return false, diff
+	}
+	if secrethash.Changed(&in.Status.AtProvider.SecretObservation, hash) {
+		return false, "Referenced secrets of central backup changed"
+	}
+	switch in.Status.AtProvider.TriggerState {
+	case v1alpha1.TriggerStateRunning, v1alpha1.TriggerStateSucceeded:
+		return true, ""
+	case v1alpha1.TriggerStateFailed:
+		return false, "Central failed to write a backup"
+	default:
+		return false, "Central has not yet written a backup"
+	}
+}
+
+// collectTrigger records the outcome of the backup the supplied CentralBackup
+// last had Central write once it finished.
+func (c *centralBackupExternal) collectTrigger(cr *v1alpha1.CentralBackup) {
+	o := &cr.Status.AtProvider
+	if o.TriggerState != v1alpha1.TriggerStateRunning {
+		return
+	}
+	p := c.triggers.get(cr.GetUID())
+	switch {
+	case p == nil:
+		o.TriggerState, o.TriggerMessage = v1alpha1.TriggerStateFailed, errTriggerLost
+	case !p.finished():
+		return
+	case p.err != nil:
+		o.TriggerState, o.TriggerMessage = v1alpha1.TriggerStateFailed, errors.Wrap(p.err, errTriggerCentralBackup).Error()
+	default:
+		o.TriggerState, o.TriggerMessage = v1alpha1.TriggerStateSucceeded, ""
+	}
+	c.triggers.forget(cr.GetUID())
+}
+
+func (c *centralBackupExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CentralBackup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCentralBackup)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	backup, err := c.client.GetExternalBackup(ctx, id)
+	if central.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveCentralBackup)
+	}
+	p := cr.Spec.ForProvider
+	cfg, err := objectStoreConfig(ctx, c.kube, p)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveCentralBackup)
+	}
+	desired, hash := generateExternalBackup(id, p, cfg)
+
+	store, err := c.objectStore(cfg)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveCentralBackup)
+	}
+	latest, err := store.LatestObject(ctx, p.S3.Bucket, path.Join(p.S3.ObjectPrefix, backupKeyPrefix))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveCentralBackup)
+	}
+
+	cr.Status.AtProvider.ID = backup.ID
+	if latest != nil {
+		t := metav1.NewTime(latest.LastModified)
+		cr.Status.AtProvider.ObjectKey = latest.Key
+		cr.Status.AtProvider.Size = latest.Size
+		cr.Status.AtProvider.LastBackupTime = &t
+	}
+	c.collectTrigger(cr)
+	switch o := cr.Status.AtProvider; {
+	case o.TriggerState == v1alpha1.TriggerStateFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	case o.TriggerState != "" && o.ObjectKey != "":
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Creating())
+	}
+	upToDate, diff := isCentralBackupUpToDate(cr, desired, hash, backup)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *centralBackupExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CentralBackup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCentralBackup)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	cfg, err := objectStoreConfig(ctx, c.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCentralBackup)
+	}
+	desired, _ := generateExternalBackup("", cr.Spec.ForProvider, cfg)
+	backup, err := c.client.CreateExternalBackup(ctx, desired)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCentralBackup)
+	}
+	meta.SetExternalName(cr, backup.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update writes the integration to Central and has Central write a backup
+// with it in the background, so that the latest backup reflects the current
+// configuration. Central writes one backup at a time, so no backup is
+// triggered while another one is written.
+func (c *centralBackupExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CentralBackup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCentralBackup)
+	}
+
+	id := meta.GetExternalName(cr)
+	cfg, err := objectStoreConfig(ctx, c.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCentralBackup)
+	}
+	desired, hash := generateExternalBackup(id, cr.Spec.ForProvider, cfg)
+	if err := c.client.UpdateExternalBackup(ctx, desired); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCentralBackup)
+	}
+	cr.Status.AtProvider.SecretHash = hash
+
+	if p := c.triggers.get(cr.GetUID()); p != nil && !p.finished() {
+		return managed.ExternalUpdate{}, nil
+	}
+	client := c.client
+	c.triggers.start(cr.GetUID(), id, func(ctx context.Context) error {
+		return client.TriggerExternalBackup(ctx, id)
+	})
+	t := metav1.NewTime(c.now())
+	cr.Status.AtProvider.LastTriggerTime = &t
+	cr.Status.AtProvider.TriggerState, cr.Status.AtProvider.TriggerMessage = v1alpha1.TriggerStateRunning, ""
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete removes the integration from Central. The backups it wrote remain in
+// the object store, so that they can be restored after the Central is gone.
+func (c *centralBackupExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CentralBackup)
+	if !ok {
+		return errors.New(errNotCentralBackup)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteExternalBackup(ctx, meta.GetExternalName(cr))
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteCentralBackup)
+}
@@ -0,0 +1,454 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+	"github.com/stehessel/provider-redhat/pkg/clients/s3"
+	"github.com/stehessel/provider-redhat/pkg/secrethash"
+)
+
+var _ managed.ExternalClient = &centralBackupExternal{}
+
+var centralBackupID = "backup-id"
+
+var backupCredentials = map[string][]byte{"id": []byte("minio"), "key": []byte("minio123")}
+
+type centralBackupModifier func(*v1alpha1.CentralBackup)
+
+func withCentralBackupStatus(f func(*v1alpha1.CentralBackupObservation)) centralBackupModifier {
+	return func(b *v1alpha1.CentralBackup) { f(&b.Status.AtProvider) }
+}
+
+func centralBackup(mod ...centralBackupModifier) *v1alpha1.CentralBackup {
+	secret := xpv1.SecretReference{Name: "minio", Namespace: "crossplane-system"}
+	b := &v1alpha1.CentralBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "old-central"},
+		Spec: v1alpha1.CentralBackupSpec{
+			ForProvider: v1alpha1.CentralBackupParameters{
+				Name:          "minio",
+				Schedule:      v1alpha1.Schedule{IntervalType: "DAILY", Hour: 2},
+				BackupsToKeep: 1,
+				S3: v1alpha1.S3BackupStore{
+					Bucket:                   "backups",
+					Endpoint:                 "https://minio.example.com:9000",
+					ObjectPrefix:             "central",
+					AccessKeyIDSecretRef:     xpv1.SecretKeySelector{SecretReference: secret, Key: "id"},
+					SecretAccessKeySecretRef: xpv1.SecretKeySelector{SecretReference: secret, Key: "key"},
+				},
+				CentralURL: "https://central.example.com",
+			},
+		},
+	}
+	meta.SetExternalName(b, centralBackupID)
+	for _, m := range mod {
+		m(b)
+	}
+	return b
+}
+
+func centralExternalBackup(mod ...func(*central.ExternalBackup)) *central.ExternalBackup {
+	b := &central.ExternalBackup{
+		ID:            centralBackupID,
+		Name:          "minio",
+		Type:          central.ExternalBackupTypeS3,
+		BackupsToKeep: 1,
+		Schedule:      &central.Schedule{IntervalType: "DAILY", Hour: 2},
+		S3: &central.S3Config{
+			Bucket:          "backups",
+			AccessKeyID:     "******",
+			SecretAccessKey: "******",
+			Endpoint:        "https://minio.example.com:9000",
+			ObjectPrefix:    "central",
+		},
+	}
+	for _, m := range mod {
+		m(b)
+	}
+	return b
+}
+
+func TestCentralBackupObserve(t *testing.T) {
+	hash := secrethash.Hash([]string{"minio", "minio123"})
+	triggered := metav1.NewTime(time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC))
+	written := time.Date(2023, 3, 1, 0, 1, 0, 0, time.UTC)
+	latest := &s3.ObjectInfo{Key: "central/backup_2023-03-01T00:00:00.zip", Size: 42, LastModified: written}
+
+	withHash := withCentralBackupStatus(func(o *v1alpha1.CentralBackupObservation) { o.SecretHash = hash })
+	withOutdatedHash := withCentralBackupStatus(func(o *v1alpha1.CentralBackupObservation) { o.SecretHash = "outdated" })
+	withTriggered := withCentralBackupStatus(func(o *v1alpha1.CentralBackupObservation) {
+		o.LastTriggerTime = &triggered
+		o.TriggerState = v1alpha1.TriggerStateSucceeded
+	})
+	withRunning := withCentralBackupStatus(func(o *v1alpha1.CentralBackupObservation) {
+		o.LastTriggerTime = &triggered
+		o.TriggerState = v1alpha1.TriggerStateRunning
+	})
+	withFailed := withCentralBackupStatus(func(o *v1alpha1.CentralBackupObservation) {
+		o.LastTriggerTime = &triggered
+		o.TriggerState = v1alpha1.TriggerStateFailed
+	})
+
+	type want struct {
+		obs          managed.ExternalObservation
+		objectKey    string
+		hash         string
+		triggerState string
+		condition    xpv1.Condition
+		err          error
+	}
+
+	cases := []struct {
+		name    string
+		mg      *v1alpha1.CentralBackup
+		backup  *central.ExternalBackup
+		err     error
+		latest  *s3.ObjectInfo
+		trigger *process
+		want    want
+	}{
+		{
+			name:   "backup written",
+			mg:     centralBackup(withHash, withTriggered),
+			backup: centralExternalBackup(),
+			latest: latest,
+			want: want{
+				obs:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				objectKey:    latest.Key,
+				triggerState: v1alpha1.TriggerStateSucceeded,
+				condition:    xpv1.Available(),
+			},
+		},
+		{
+			name:    "backup being written",
+			mg:      centralBackup(withHash, withRunning),
+			backup:  centralExternalBackup(),
+			trigger: &process{key: centralBackupID, done: make(chan struct{})},
+			want: want{
+				obs:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				triggerState: v1alpha1.TriggerStateRunning,
+				condition:    xpv1.Creating(),
+			},
+		},
+		{
+			name:    "backup finished",
+			mg:      centralBackup(withHash, withRunning),
+			backup:  centralExternalBackup(),
+			latest:  latest,
+			trigger: finishedProcess(centralBackupID, nil),
+			want: want{
+				obs:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				objectKey:    latest.Key,
+				triggerState: v1alpha1.TriggerStateSucceeded,
+				condition:    xpv1.Available(),
+			},
+		},
+		{
+			name:    "backup failed",
+			mg:      centralBackup(withHash, withRunning),
+			backup:  centralExternalBackup(),
+			latest:  latest,
+			trigger: finishedProcess(centralBackupID, errors.New("boom")),
+			want: want{
+				obs:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				objectKey:    latest.Key,
+				triggerState: v1alpha1.TriggerStateFailed,
+				condition:    xpv1.Unavailable(),
+			},
+		},
+		{
+			name:   "backup lost on restart",
+			mg:     centralBackup(withHash, withRunning),
+			backup: centralExternalBackup(),
+			latest: latest,
+			want: want{
+				obs:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				objectKey:    latest.Key,
+				triggerState: v1alpha1.TriggerStateFailed,
+				condition:    xpv1.Unavailable(),
+			},
+		},
+		{
+			name:   "backup failed before",
+			mg:     centralBackup(withHash, withFailed),
+			backup: centralExternalBackup(),
+			latest: latest,
+			want: want{
+				obs:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				objectKey:    latest.Key,
+				triggerState: v1alpha1.TriggerStateFailed,
+				condition:    xpv1.Unavailable(),
+			},
+		},
+		{
+			name:   "backup not yet triggered",
+			mg:     centralBackup(withHash),
+			backup: centralExternalBackup(),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				condition: xpv1.Creating(),
+			},
+		},
+		{
+			name:   "integration drifted",
+			mg:     centralBackup(withHash, withTriggered),
+			backup: centralExternalBackup(func(b *central.ExternalBackup) { b.S3.Bucket = "other" }),
+			latest: latest,
+			want: want{
+				obs:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				objectKey:    latest.Key,
+				triggerState: v1alpha1.TriggerStateSucceeded,
+				condition:    xpv1.Available(),
+			},
+		},
+		{
+			name:   "secret hash recorded on first observation",
+			mg:     centralBackup(withTriggered),
+			backup: centralExternalBackup(),
+			latest: latest,
+			want: want{
+				obs:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				objectKey:    latest.Key,
+				hash:         hash,
+				triggerState: v1alpha1.TriggerStateSucceeded,
+				condition:    xpv1.Available(),
+			},
+		},
+		{
+			name:   "secret changed",
+			mg:     centralBackup(withOutdatedHash, withTriggered),
+			backup: centralExternalBackup(),
+			latest: latest,
+			want: want{
+				obs:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				objectKey:    latest.Key,
+				triggerState: v1alpha1.TriggerStateSucceeded,
+				condition:    xpv1.Available(),
+			},
+		},
+		{
+			name: "integration not found",
+			mg:   centralBackup(),
+			err:  &central.APIError{StatusCode: http.StatusNotFound},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &centralBackupExternal{
+				kube:     secretClient(backupCredentials),
+				triggers: newProcesses(backupTimeout),
+				client: &central.ExternalBackupAPIMock{
+					GetExternalBackupFunc: func(ctx context.Context, id string) (*central.ExternalBackup, error) {
+						return tc.backup, tc.err
+					},
+				},
+				objectStore: func(cfg s3.Config) (s3.Client, error) {
+					if cfg.Endpoint != "https://minio.example.com:9000" || cfg.AccessKeyID != "minio" {
+						t.Errorf("\ne.Observe(...): unexpected object store config %+v\n", cfg)
+					}
+					return &s3.ClientMock{
+						LatestObjectFunc: func(ctx context.Context, bucket, prefix string) (*s3.ObjectInfo, error) {
+							if prefix != "central/backup" {
+								t.Errorf("\ne.Observe(...): want prefix %q, got %q\n", "central/backup", prefix)
+							}
+							return tc.latest, nil
+						},
+					}, nil
+				},
+			}
+			if tc.trigger != nil {
+				e.triggers.processes[tc.mg.GetUID()] = tc.trigger
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.objectKey, tc.mg.Status.AtProvider.ObjectKey); diff != "" {
+				t.Errorf("\ne.Observe(...): -want object key, +got object key:\n%s\n", diff)
+			}
+			if tc.want.hash != "" && tc.mg.Status.AtProvider.SecretHash != tc.want.hash {
+				t.Errorf("\ne.Observe(...): recorded secret hash %q, want %q\n", tc.mg.Status.AtProvider.SecretHash, tc.want.hash)
+			}
+			if diff := cmp.Diff(tc.want.triggerState, tc.mg.Status.AtProvider.TriggerState); diff != "" {
+				t.Errorf("\ne.Observe(...): -want trigger state, +got trigger state:\n%s\n", diff)
+			}
+			if tc.want.condition.Type != "" {
+				if diff := cmp.Diff(tc.want.condition, tc.mg.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+					t.Errorf("\ne.Observe(...): -want condition, +got condition:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCentralBackupCreate(t *testing.T) {
+	var got *central.ExternalBackup
+	e := &centralBackupExternal{
+		kube: secretClient(backupCredentials),
+		client: &central.ExternalBackupAPIMock{
+			CreateExternalBackupFunc: func(ctx context.Context, b *central.ExternalBackup) (*central.ExternalBackup, error) {
+				got = b
+				created := *b
+				created.ID = centralBackupID
+				return &created, nil
+			},
+		},
+	}
+	mg := centralBackup()
+	meta.SetExternalName(mg, "")
+
+	if _, err := e.Create(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %s\n", err)
+	}
+	want := centralExternalBackup(func(b *central.ExternalBackup) {
+		b.ID = ""
+		b.S3.AccessKeyID, b.S3.SecretAccessKey = "minio", "minio123"
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\ne.Create(...): -want backup, +got backup:\n%s\n", diff)
+	}
+	if meta.GetExternalName(mg) != centralBackupID {
+		t.Errorf("\ne.Create(...): want external name %q, got %q\n", centralBackupID, meta.GetExternalName(mg))
+	}
+}
+
+func TestCentralBackupUpdate(t *testing.T) {
+	now := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	type want struct {
+		triggerState string
+		triggered    bool
+		err          error
+	}
+
+	cases := []struct {
+		name       string
+		updateErr  error
+		triggerErr error
+		running    *process
+		want       want
+	}{
+		{
+			name: "updated and triggered",
+			want: want{triggerState: v1alpha1.TriggerStateRunning, triggered: true},
+		},
+		{
+			name:       "trigger error reported on observation",
+			triggerErr: errors.New("boom"),
+			want:       want{triggerState: v1alpha1.TriggerStateRunning, triggered: true},
+		},
+		{
+			name:    "backup still being written",
+			running: &process{key: centralBackupID, done: make(chan struct{})},
+		},
+		{
+			name:      "update error",
+			updateErr: errors.New("boom"),
+			want:      want{err: cmpopts.AnyError},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &centralBackupExternal{
+				kube:     secretClient(backupCredentials),
+				triggers: newProcesses(backupTimeout),
+				now:      func() time.Time { return now },
+				client: &central.ExternalBackupAPIMock{
+					UpdateExternalBackupFunc: func(ctx context.Context, b *central.ExternalBackup) error {
+						if b.ID != centralBackupID || b.S3.SecretAccessKey != "minio123" {
+							t.Errorf("\ne.Update(...): unexpected backup %+v\n", b)
+						}
+						return tc.updateErr
+					},
+					TriggerExternalBackupFunc: func(ctx context.Context, id string) error {
+						return tc.triggerErr
+					},
+				},
+			}
+			mg := centralBackup()
+			if tc.running != nil {
+				e.triggers.processes[mg.GetUID()] = tc.running
+			}
+			_, err := e.Update(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.triggerState, mg.Status.AtProvider.TriggerState); diff != "" {
+				t.Errorf("\ne.Update(...): -want trigger state, +got trigger state:\n%s\n", diff)
+			}
+			if triggered := mg.Status.AtProvider.LastTriggerTime != nil; triggered != tc.want.triggered {
+				t.Errorf("\ne.Update(...): want triggered %t, got %t\n", tc.want.triggered, triggered)
+			}
+			if !tc.want.triggered {
+				return
+			}
+			p := e.triggers.get(mg.GetUID())
+			if p == nil {
+				t.Fatalf("\ne.Update(...): want trigger tracked\n")
+			}
+			<-p.done
+			if diff := cmp.Diff(tc.triggerErr, p.err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want trigger error, +got trigger error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCentralBackupDelete(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "deleted"},
+		{name: "already gone", err: &central.APIError{StatusCode: http.StatusNotFound}},
+		{name: "delete error", err: errors.New("boom"), want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := centralBackupExternal{client: &central.ExternalBackupAPIMock{
+				DeleteExternalBackupFunc: func(ctx context.Context, id string) error { return tc.err },
+			}}
+			err := e.Delete(context.Background(), centralBackup())
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
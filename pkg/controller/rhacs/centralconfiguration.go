@@ -0,0 +1,672 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+	"github.com/stehessel/provider-redhat/pkg/secrethash"
+)
+
+const (
+	errNotCentralConfiguration     = "managed resource is not a CentralConfiguration custom resource"
+	errObserveCentralConfiguration = "cannot observe central configuration"
+	errUpdateCentralConfiguration  = "cannot update central configuration"
+	errDeleteCentralConfiguration  = "cannot delete central configuration"
+	errGetConfigMap                = "cannot get config map"
+	errDuplicateConfigItem         = "duplicate declarative configuration item"
+	errDeclarativeNotifierType     = "exactly one of generic and splunk must be set"
+	errDeclarativeAuthProviderType = "exactly one of oidc and saml must be set"
+	errUnknownPermissionSet        = "unknown permission set"
+	errUnknownAccessScope          = "unknown access scope"
+	errConfigItemNotFound          = "item not found in central"
+)
+
+// declarativeConfigOrder is the order items of declarative configuration are
+// pushed in, so that the items they reference exist. They are removed in
+// reverse order.
+var declarativeConfigOrder = map[string]int{
+	central.DeclarativeConfigTypePermissionSet: 0,
+	central.DeclarativeConfigTypeAccessScope:   1,
+	central.DeclarativeConfigTypeRole:          2,
+	central.DeclarativeConfigTypeNotifier:      3,
+	central.DeclarativeConfigTypeAuthProvider:  4,
+}
+
+// setupCentralConfiguration adds a controller that reconciles
+// CentralConfiguration managed resources.
+func setupCentralConfiguration(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	kube := mgr.GetClient()
+	return setupCentralResource(mgr, o, cf, v1alpha1.CentralConfigurationGroupVersionKind, &v1alpha1.CentralConfiguration{},
+		func(c central.Client) managed.ExternalClient {
+			return &centralConfigurationExternal{client: c, kube: kube}
+		})
+}
+
+// centralConfigurationAPI is the part of the API of a central used to push
+// declarative configuration to it.
+type centralConfigurationAPI interface {
+	central.PermissionSetAPI
+	central.AccessScopeAPI
+	central.RoleAPI
+	central.NotifierAPI
+	central.AuthProviderAPI
+	central.GroupAPI
+	central.DeclarativeConfigHealthAPI
+}
+
+// A centralConfigurationExternal pushes the declarative configuration in the
+// config maps of a CentralConfiguration to its Central. Central only reads
+// declarative configuration from mounted config maps, so the items are
+// pushed through its API instead. They are pushed again whenever the bundle
+// changes or an item is missing from Central.
+type centralConfigurationExternal struct {
+	client centralConfigurationAPI
+	kube   client.Client
+}
+
+// A declarativeConfigItem is an item of declarative configuration together
+// with the config map key it was read from.
+type declarativeConfigItem struct {
+	central.DeclarativeConfig
+	source string
+}
+
+// loadDeclarativeConfig returns the items of declarative configuration of the
+// referenced config maps in the order they are pushed in, together with a
+// hash of the bundle.
+func loadDeclarativeConfig(ctx context.Context, kube client.Client, refs []v1alpha1.ConfigMapReference) ([]declarativeConfigItem, string, error) {
+	var items []declarativeConfigItem
+	var data []string
+	seen := map[string]string{}
+	for _, ref := range refs {
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return nil, "", errors.Wrapf(err, "%s %s/%s", errGetConfigMap, ref.Namespace, ref.Name)
+		}
+		keys := make([]string, 0, len(cm.Data))
+		for k := range cm.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			source := fmt.Sprintf("%s/%s[%s]", ref.Namespace, ref.Name, k)
+			parsed, err := central.ParseDeclarativeConfig([]byte(cm.Data[k]))
+			if err != nil {
+				return nil, "", errors.Wrap(err, source)
+			}
+			for _, p := range parsed {
+				key := p.Type() + "/" + p.Name()
+				if s, ok := seen[key]; ok {
+					return nil, "", errors.Errorf("%s %s in %s and %s", errDuplicateConfigItem, key, s, source)
+				}
+				seen[key] = source
+				items = append(items, declarativeConfigItem{DeclarativeConfig: p, source: source})
+			}
+			data = append(data, source, cm.Data[k])
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return declarativeConfigOrder[items[i].Type()] < declarativeConfigOrder[items[j].Type()]
+	})
+	// The bundle may contain secrets, so only its hash is kept.
+	return items, secrethash.Hash(data), nil
+}
+
+func generatePermissionSetFromConfig(id string, in *central.DeclarativePermissionSet) *central.PermissionSet {
+	p := &central.PermissionSet{
+		ID:               id,
+		Name:             in.Name,
+		Description:      in.Description,
+		ResourceToAccess: map[string]string{},
+	}
+	for _, r := range in.Resources {
+		p.ResourceToAccess[r.Resource] = r.Access
+	}
+	return p
+}
+
+func generateLabelSelectorsFromConfig(in []central.DeclarativeLabelSelector) []central.LabelSelector {
+	var out []central.LabelSelector
+	for _, s := range in {
+		sel := central.LabelSelector{}
+		for _, r := range s.Requirements {
+			sel.Requirements = append(sel.Requirements, central.LabelRequirement{Key: r.Key, Op: r.Operator, Values: r.Values})
+		}
+		out = append(out, sel)
+	}
+	return out
+}
+
+func generateAccessScopeFromConfig(id string, in *central.DeclarativeAccessScope) *central.AccessScope {
+	s := &central.AccessScope{
+		ID:          id,
+		Name:        in.Name,
+		Description: in.Description,
+		Rules: &central.AccessScopeRules{
+			ClusterLabelSelectors:   generateLabelSelectorsFromConfig(in.Rules.ClusterLabelSelectors),
+			NamespaceLabelSelectors: generateLabelSelectorsFromConfig(in.Rules.NamespaceLabelSelectors),
+		},
+	}
+	for _, o := range in.Rules.Included {
+		if len(o.Namespaces) == 0 {
+			s.Rules.IncludedClusters = append(s.Rules.IncludedClusters, o.Cluster)
+			continue
+		}
+		for _, ns := range o.Namespaces {
+			s.Rules.IncludedNamespaces = append(s.Rules.IncludedNamespaces,
+				central.IncludedNamespace{ClusterName: o.Cluster, NamespaceName: ns})
+		}
+	}
+	return s
+}
+
+func generateNotifierFromConfig(id, centralURL string, in *central.DeclarativeNotifier) (*central.Notifier, error) {
+	if (in.Generic == nil) == (in.Splunk == nil) {
+		return nil, errors.New(errDeclarativeNotifierType)
+	}
+	n := &central.Notifier{ID: id, Name: in.Name, UIEndpoint: centralURL}
+	if g := in.Generic; g != nil {
+		n.Type = central.NotifierTypeGeneric
+		n.Generic = &central.GenericNotifier{
+			Endpoint:            g.Endpoint,
+			SkipTLSVerify:       g.SkipTLSVerify,
+			CACert:              g.CACertPEM,
+			Username:            g.Username,
+			Password:            g.Password,
+			Headers:             g.Headers,
+			ExtraFields:         g.ExtraFields,
+			AuditLoggingEnabled: g.AuditLoggingEnabled,
+		}
+	}
+	if s := in.Splunk; s != nil {
+		n.Type = central.NotifierTypeSplunk
+		n.Splunk = &central.SplunkNotifier{
+			HTTPToken:           s.Token,
+			HTTPEndpoint:        s.Endpoint,
+			Insecure:            s.Insecure,
+			Truncate:            s.Truncate,
+			AuditLoggingEnabled: s.AuditLoggingEnabled,
+		}
+	}
+	return n, nil
+}
+
+func generateAuthProviderFromConfig(id, centralURL string, in *central.DeclarativeAuthProvider) (*central.AuthProvider, error) {
+	if (in.OIDC == nil) == (in.SAML == nil) {
+		return nil, errors.New(errDeclarativeAuthProviderType)
+	}
+	ap := &central.AuthProvider{
+		ID:         id,
+		Name:       in.Name,
+		UIEndpoint: in.UIEndpoint,
+		Enabled:    true,
+		Config:     map[string]string{},
+	}
+	if ap.UIEndpoint == "" {
+		ep, err := defaultUIEndpoint(centralURL)
+		if err != nil {
+			return nil, err
+		}
+		ap.UIEndpoint = ep
+	}
+	for _, a := range in.RequiredAttributes {
+		ap.RequiredAttributes = append(ap.RequiredAttributes,
+			central.RequiredAttribute{AttributeKey: a.Key, AttributeValue: a.Value})
+	}
+	for _, m := range in.ClaimMappings {
+		if ap.ClaimMappings == nil {
+			ap.ClaimMappings = map[string]string{}
+		}
+		ap.ClaimMappings[m.Path] = m.Name
+	}
+
+	if o := in.OIDC; o != nil {
+		ap.Type = central.AuthProviderTypeOIDC
+		ap.Config[configOIDCIssuer] = o.Issuer
+		ap.Config[configOIDCClientID] = o.ClientID
+		ap.Config[configOIDCMode] = o.Mode
+		if o.ClientSecret == "" {
+			ap.Config[configOIDCNoClientSecret] = "true"
+		} else {
+			ap.Config[configOIDCClientSecret] = o.ClientSecret
+		}
+	}
+	if s := in.SAML; s != nil {
+		ap.Type = central.AuthProviderTypeSAML
+		for k, v := range map[string]string{
+			configSAMLSPIssuer:        s.SPIssuer,
+			configSAMLIDPMetadataURL:  s.MetadataURL,
+			configSAMLIDPIssuer:       s.IDPIssuer,
+			configSAMLIDPSSOURL:       s.SSOURL,
+			configSAMLIDPCertPEM:      s.Cert,
+			configSAMLIDPNameIDFormat: s.NameIDFormat,
+		} {
+			if v != "" {
+				ap.Config[k] = v
+			}
+		}
+	}
+	return ap, nil
+}
+
+// generateGroupsFromConfig returns the groups that grant the roles of the
+// supplied auth provider.
+func generateGroupsFromConfig(id string, in *central.DeclarativeAuthProvider) []central.Group {
+	var groups []central.Group
+	if in.MinimumRole != "" {
+		groups = append(groups, central.Group{Props: central.GroupProperties{AuthProviderID: id}, RoleName: in.MinimumRole})
+	}
+	for _, g := range in.Groups {
+		groups = append(groups, central.Group{
+			Props:    central.GroupProperties{AuthProviderID: id, Key: g.Key, Value: g.Value},
+			RoleName: g.Role,
+		})
+	}
+	return groups
+}
+
+// itemKey identifies an item of declarative configuration by type and name.
+func itemKey(typ, name string) string {
+	return typ + "/" + name
+}
+
+func (c *centralConfigurationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CentralConfiguration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCentralConfiguration)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	_, hash, err := loadDeclarativeConfig(ctx, c.kube, cr.Spec.ForProvider.ConfigMapRefs)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveCentralConfiguration)
+	}
+
+	missing := map[int]bool{}
+	for i := range cr.Status.AtProvider.Items {
+		it := &cr.Status.AtProvider.Items[i]
+		if it.ID == "" {
+			continue
+		}
+		err := c.getItem(ctx, it.Type, it.ID)
+		if central.IsNotFound(err) {
+			missing[i] = true
+			it.Health, it.Message = central.DeclarativeConfigUnhealthy, errConfigItemNotFound
+			continue
+		}
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errObserveCentralConfiguration)
+		}
+	}
+
+	// Central only reports the health of items it tracks, and not at all if
+	// it does not support declarative configuration.
+	healths, err := c.client.GetDeclarativeConfigHealths(ctx)
+	if err != nil && !central.IsNotFound(err) {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveCentralConfiguration)
+	}
+	byKey := map[string]central.DeclarativeConfigHealth{}
+	for _, h := range healths {
+		byKey[itemKey(h.ResourceType, h.ResourceName)] = h
+	}
+	// The bundle is only available once it was pushed and all of its items
+	// are healthy.
+	healthy := cr.Status.AtProvider.ConfigHash != ""
+	for i := range cr.Status.AtProvider.Items {
+		it := &cr.Status.AtProvider.Items[i]
+		if h, ok := byKey[itemKey(it.Type, it.Name)]; ok && !missing[i] {
+			it.Health, it.Message = h.Status, h.ErrorMessage
+		}
+		healthy = healthy && it.Health == central.DeclarativeConfigHealthy
+	}
+	switch {
+	case healthy:
+		cr.SetConditions(xpv1.Available())
+	case cr.Status.AtProvider.ConfigHash == "":
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate, diff := true, ""
+	switch {
+	case cr.Status.AtProvider.ConfigHash != hash:
+		upToDate, diff = false, "Declarative configuration changed"
+	case len(missing) > 0:
+		upToDate, diff = false, "Items of declarative configuration are missing from central"
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+// getItem returns an error if the supplied item cannot be read from Central.
+func (c *centralConfigurationExternal) getItem(ctx context.Context, typ, id string) error {
+	var err error
+	switch typ {
+	case central.DeclarativeConfigTypePermissionSet:
+		_, err = c.client.GetPermissionSet(ctx, id)
+	case central.DeclarativeConfigTypeAccessScope:
+		_, err = c.client.GetAccessScope(ctx, id)
+	case central.DeclarativeConfigTypeRole:
+		_, err = c.client.GetRole(ctx, id)
+	case central.DeclarativeConfigTypeNotifier:
+		_, err = c.client.GetNotifier(ctx, id)
+	case central.DeclarativeConfigTypeAuthProvider:
+		_, err = c.client.GetAuthProvider(ctx, id)
+	}
+	return err
+}
+
+// Create only records that the bundle belongs to this resource. Its items are
+// pushed by Update, whose status is persisted even if pushing some of them
+// fails, so that the IDs of the others are not lost.
+func (c *centralConfigurationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CentralConfiguration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCentralConfiguration)
+	}
+	cr.SetConditions(xpv1.Creating())
+	meta.SetExternalName(cr, cr.GetName())
+	return managed.ExternalCreation{}, nil
+}
+
+// Update pushes all items of the bundle and removes items that were removed
+// from it. The bundle is only recorded as pushed if all items were, so that
+// failed items are retried.
+func (c *centralConfigurationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CentralConfiguration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCentralConfiguration)
+	}
+	items, hash, err := loadDeclarativeConfig(ctx, c.kube, cr.Spec.ForProvider.ConfigMapRefs)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCentralConfiguration)
+	}
+
+	previous := map[string]v1alpha1.DeclarativeConfigItem{}
+	for _, it := range cr.Status.AtProvider.Items {
+		previous[itemKey(it.Type, it.Name)] = it
+	}
+
+	p := &pusher{client: c.client, centralURL: cr.Spec.ForProvider.CentralURL, ids: map[string]string{}}
+	var errs []error
+	pushed := make([]v1alpha1.DeclarativeConfigItem, 0, len(items))
+	for _, it := range items {
+		key := itemKey(it.Type(), it.Name())
+		prev := previous[key]
+		delete(previous, key)
+
+		out := v1alpha1.DeclarativeConfigItem{Type: it.Type(), Name: it.Name(), Source: it.source, Health: central.DeclarativeConfigHealthy}
+		out.ID, err = p.push(ctx, prev.ID, it.DeclarativeConfig)
+		if err != nil {
+			err = errors.Wrapf(err, "%s from %s", key, it.source)
+			errs = append(errs, err)
+			out.Health, out.Message = central.DeclarativeConfigUnhealthy, err.Error()
+		}
+		if out.ID == "" {
+			// Keep the ID of an item that failed to push, so that it is
+			// updated or removed later.
+			out.ID = prev.ID
+		}
+		p.ids[key] = out.ID
+		pushed = append(pushed, out)
+	}
+
+	removed := make([]v1alpha1.DeclarativeConfigItem, 0, len(previous))
+	for _, it := range previous {
+		removed = append(removed, it)
+	}
+	for _, it := range c.remove(ctx, removed) {
+		errs = append(errs, errors.New(it.Message))
+		pushed = append(pushed, it)
+	}
+
+	cr.Status.AtProvider.Items = pushed
+	if len(errs) > 0 {
+		return managed.ExternalUpdate{}, errors.Wrap(kerrors.NewAggregate(errs), errUpdateCentralConfiguration)
+	}
+	cr.Status.AtProvider.ConfigHash = hash
+	return managed.ExternalUpdate{}, nil
+}
+
+// remove deletes the supplied items from Central in reverse push order. It
+// returns the items that could not be deleted, with the error as message.
+func (c *centralConfigurationExternal) remove(ctx context.Context, items []v1alpha1.DeclarativeConfigItem) []v1alpha1.DeclarativeConfigItem {
+	sort.SliceStable(items, func(i, j int) bool {
+		return declarativeConfigOrder[items[i].Type] > declarativeConfigOrder[items[j].Type]
+	})
+	var failed []v1alpha1.DeclarativeConfigItem
+	for _, it := range items {
+		if it.ID == "" {
+			continue
+		}
+		var err error
+		switch it.Type {
+		case central.DeclarativeConfigTypePermissionSet:
+			err = c.client.DeletePermissionSet(ctx, it.ID)
+		case central.DeclarativeConfigTypeAccessScope:
+			err = c.client.DeleteAccessScope(ctx, it.ID)
+		case central.DeclarativeConfigTypeRole:
+			err = c.client.DeleteRole(ctx, it.ID)
+		case central.DeclarativeConfigTypeNotifier:
+			err = c.client.DeleteNotifier(ctx, it.ID)
+		case central.DeclarativeConfigTypeAuthProvider:
+			err = c.client.DeleteAuthProvider(ctx, it.ID)
+		}
+		if err != nil && !central.IsNotFound(err) {
+			it.Health, it.Message = central.DeclarativeConfigUnhealthy, errors.Wrapf(err, "cannot remove %s", itemKey(it.Type, it.Name)).Error()
+			failed = append(failed, it)
+		}
+	}
+	return failed
+}
+
+func (c *centralConfigurationExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CentralConfiguration)
+	if !ok {
+		return errors.New(errNotCentralConfiguration)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	failed := c.remove(ctx, cr.Status.AtProvider.Items)
+	if len(failed) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(failed))
+	for _, it := range failed {
+		errs = append(errs, errors.New(it.Message))
+	}
+	return errors.Wrap(kerrors.NewAggregate(errs), errDeleteCentralConfiguration)
+}
+
+// A pusher pushes items of declarative configuration to Central. Roles
+// reference permission sets and access scopes by name, which are resolved
+// to the IDs of the pushed items, or else to the ones of existing items.
+type pusher struct {
+	client     centralConfigurationAPI
+	centralURL string
+
+	// ids of the pushed items by item key.
+	ids map[string]string
+
+	permissionSets []central.PermissionSet
+	accessScopes   []central.AccessScope
+}
+
+// push creates the supplied item, or updates it if it was pushed before with
+// the supplied ID. It returns the ID of the item.
+func (p *pusher) push(ctx context.Context, id string, in central.DeclarativeConfig) (string, error) {
+	switch {
+	case in.PermissionSet != nil:
+		ps := generatePermissionSetFromConfig(id, in.PermissionSet)
+		if id != "" {
+			if err := p.client.UpdatePermissionSet(ctx, ps); !central.IsNotFound(err) {
+				return id, err
+			}
+			ps.ID = ""
+		}
+		out, err := p.client.CreatePermissionSet(ctx, ps)
+		if err != nil {
+			return "", err
+		}
+		return out.ID, nil
+
+	case in.AccessScope != nil:
+		s := generateAccessScopeFromConfig(id, in.AccessScope)
+		if id != "" {
+			if err := p.client.UpdateAccessScope(ctx, s); !central.IsNotFound(err) {
+				return id, err
+			}
+			s.ID = ""
+		}
+		out, err := p.client.CreateAccessScope(ctx, s)
+		if err != nil {
+			return "", err
+		}
+		return out.ID, nil
+
+	case in.Role != nil:
+		r, err := p.generateRole(ctx, in.Role)
+		if err != nil {
+			return "", err
+		}
+		if id != "" {
+			if err := p.client.UpdateRole(ctx, r); !central.IsNotFound(err) {
+				return r.Name, err
+			}
+		}
+		return r.Name, p.client.CreateRole(ctx, r)
+
+	case in.Notifier != nil:
+		n, err := generateNotifierFromConfig(id, p.centralURL, in.Notifier)
+		if err != nil {
+			return "", err
+		}
+		if id != "" {
+			if err := p.client.UpdateNotifier(ctx, n); !central.IsNotFound(err) {
+				return id, err
+			}
+			n.ID = ""
+		}
+		out, err := p.client.CreateNotifier(ctx, n)
+		if err != nil {
+			return "", err
+		}
+		return out.ID, nil
+
+	case in.AuthProvider != nil:
+		return p.pushAuthProvider(ctx, id, in.AuthProvider)
+	}
+	return "", errors.New("empty declarative configuration item")
+}
+
+func (p *pusher) pushAuthProvider(ctx context.Context, id string, in *central.DeclarativeAuthProvider) (string, error) {
+	ap, err := generateAuthProviderFromConfig(id, p.centralURL, in)
+	if err != nil {
+		return "", err
+	}
+	created := false
+	if id != "" {
+		err = p.client.UpdateAuthProvider(ctx, ap)
+	}
+	if id == "" || central.IsNotFound(err) {
+		ap.ID = ""
+		out, cerr := p.client.CreateAuthProvider(ctx, ap)
+		if cerr != nil {
+			return "", cerr
+		}
+		id, err, created = out.ID, nil, true
+	}
+	if err != nil {
+		return id, err
+	}
+
+	var previous []central.Group
+	if !created {
+		if previous, err = p.client.ListGroups(ctx, id); err != nil {
+			return id, err
+		}
+	}
+	return id, p.client.UpdateGroups(ctx, previous, generateGroupsFromConfig(id, in))
+}
+
+// generateRole returns the Central role described by the supplied item.
+func (p *pusher) generateRole(ctx context.Context, in *central.DeclarativeRole) (*central.Role, error) {
+	r := &central.Role{Name: in.Name, Description: in.Description}
+
+	r.PermissionSetID = p.ids[itemKey(central.DeclarativeConfigTypePermissionSet, in.PermissionSet)]
+	if r.PermissionSetID == "" {
+		if p.permissionSets == nil {
+			sets, err := p.client.ListPermissionSets(ctx)
+			if err != nil {
+				return nil, err
+			}
+			p.permissionSets = sets
+		}
+		for _, s := range p.permissionSets {
+			if s.Name == in.PermissionSet {
+				r.PermissionSetID = s.ID
+			}
+		}
+	}
+	if r.PermissionSetID == "" {
+		return nil, errors.Errorf("%s %q", errUnknownPermissionSet, in.PermissionSet)
+	}
+
+	r.AccessScopeID = p.ids[itemKey(central.DeclarativeConfigTypeAccessScope, in.AccessScope)]
+	if r.AccessScopeID == "" {
+		if p.accessScopes == nil {
+			scopes, err := p.client.ListAccessScopes(ctx)
+			if err != nil {
+				return nil, err
+			}
+			p.accessScopes = scopes
+		}
+		for _, s := range p.accessScopes {
+			if s.Name == in.AccessScope {
+				r.AccessScopeID = s.ID
+			}
+		}
+	}
+	if r.AccessScopeID == "" {
+		return nil, errors.Errorf("%s %q", errUnknownAccessScope, in.AccessScope)
+	}
+	return r, nil
+}
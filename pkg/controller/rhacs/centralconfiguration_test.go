@@ -0,0 +1,419 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+var _ managed.ExternalClient = &centralConfigurationExternal{}
+
+var declarativeConfig = map[string]string{
+	"roles.yaml": `
+name: team-reader
+permissionSet: readers
+accessScope: Unrestricted
+---
+name: readers
+resources:
+- resource: Deployment
+  access: READ_ACCESS
+`,
+	"sso.yaml": `
+name: sso
+minimumRole: None
+groups:
+- key: groups
+  value: team
+  role: team-reader
+oidc:
+  issuer: https://sso.example.com
+  mode: auto
+  clientID: acs
+  clientSecret: s3cr3t
+`,
+}
+
+// A centralConfigurationMock mocks the parts of the central API used by
+// CentralConfigurations.
+type centralConfigurationMock struct {
+	*central.PermissionSetAPIMock
+	*central.AccessScopeAPIMock
+	*central.RoleAPIMock
+	*central.NotifierAPIMock
+	*central.AuthProviderAPIMock
+	*central.GroupAPIMock
+	*central.DeclarativeConfigHealthAPIMock
+}
+
+func configMapClient(data map[string]string) client.Client {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.(*corev1.ConfigMap).Data = data
+			return nil
+		}),
+	}
+}
+
+type centralConfigurationModifier func(*v1alpha1.CentralConfiguration)
+
+func withCentralConfigurationExternalName(name string) centralConfigurationModifier {
+	return func(c *v1alpha1.CentralConfiguration) { meta.SetExternalName(c, name) }
+}
+
+func withConfigHash(h string) centralConfigurationModifier {
+	return func(c *v1alpha1.CentralConfiguration) { c.Status.AtProvider.ConfigHash = h }
+}
+
+func withConfigItems(items ...v1alpha1.DeclarativeConfigItem) centralConfigurationModifier {
+	// Observe and Update modify the items in place, so each resource gets its
+	// own copy.
+	return func(c *v1alpha1.CentralConfiguration) {
+		c.Status.AtProvider.Items = append([]v1alpha1.DeclarativeConfigItem(nil), items...)
+	}
+}
+
+func centralConfiguration(mod ...centralConfigurationModifier) *v1alpha1.CentralConfiguration {
+	c := &v1alpha1.CentralConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "team"},
+		Spec: v1alpha1.CentralConfigurationSpec{
+			ForProvider: v1alpha1.CentralConfigurationParameters{
+				ConfigMapRefs: []v1alpha1.ConfigMapReference{{Name: "acs-config", Namespace: "crossplane-system"}},
+				CentralURL:    "https://central.example.com",
+			},
+		},
+	}
+	meta.SetExternalName(c, "team")
+	for _, m := range mod {
+		m(c)
+	}
+	return c
+}
+
+func TestLoadDeclarativeConfig(t *testing.T) {
+	refs := []v1alpha1.ConfigMapReference{{Name: "acs-config", Namespace: "crossplane-system"}}
+
+	items, hash, err := loadDeclarativeConfig(context.Background(), configMapClient(declarativeConfig), refs)
+	if err != nil {
+		t.Fatalf("loadDeclarativeConfig(...): unexpected error: %s", err)
+	}
+	var got []string
+	for _, it := range items {
+		got = append(got, itemKey(it.Type(), it.Name())+" "+it.source)
+	}
+	want := []string{
+		"PERMISSION_SET/readers crossplane-system/acs-config[roles.yaml]",
+		"ROLE/team-reader crossplane-system/acs-config[roles.yaml]",
+		"AUTH_PROVIDER/sso crossplane-system/acs-config[sso.yaml]",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\nloadDeclarativeConfig(...): -want items, +got items:\n%s\n", diff)
+	}
+
+	_, changed, err := loadDeclarativeConfig(context.Background(), configMapClient(map[string]string{"sso.yaml": declarativeConfig["sso.yaml"]}), refs)
+	if err != nil {
+		t.Fatalf("loadDeclarativeConfig(...): unexpected error: %s", err)
+	}
+	if hash == changed {
+		t.Errorf("\nloadDeclarativeConfig(...): want hash to change with the bundle\n")
+	}
+
+	twice := append(refs, v1alpha1.ConfigMapReference{Name: "more-config", Namespace: "crossplane-system"})
+	if _, _, err := loadDeclarativeConfig(context.Background(), configMapClient(declarativeConfig), twice); err == nil {
+		t.Errorf("\nloadDeclarativeConfig(...): want error for duplicate items\n")
+	}
+}
+
+func TestCentralConfigurationObserve(t *testing.T) {
+	_, hash, err := loadDeclarativeConfig(context.Background(), configMapClient(declarativeConfig),
+		centralConfiguration().Spec.ForProvider.ConfigMapRefs)
+	if err != nil {
+		t.Fatalf("loadDeclarativeConfig(...): unexpected error: %s", err)
+	}
+	pushed := []centralConfigurationModifier{
+		withConfigHash(hash),
+		withConfigItems(
+			v1alpha1.DeclarativeConfigItem{Type: central.DeclarativeConfigTypePermissionSet, Name: "readers", ID: "ps-1", Health: central.DeclarativeConfigHealthy},
+			v1alpha1.DeclarativeConfigItem{Type: central.DeclarativeConfigTypeRole, Name: "team-reader", ID: "team-reader", Health: central.DeclarativeConfigHealthy},
+			v1alpha1.DeclarativeConfigItem{Type: central.DeclarativeConfigTypeAuthProvider, Name: "sso", ID: "ap-1", Health: central.DeclarativeConfigHealthy},
+		),
+	}
+	notFound := &central.APIError{StatusCode: http.StatusNotFound}
+
+	type want struct {
+		obs       managed.ExternalObservation
+		condition xpv1.Condition
+		err       error
+	}
+
+	cases := []struct {
+		name             string
+		cr               *v1alpha1.CentralConfiguration
+		permissionSetErr error
+		healths          []central.DeclarativeConfigHealth
+		healthErr        error
+		want             want
+	}{
+		{
+			name: "not created",
+			cr:   centralConfiguration(withCentralConfigurationExternalName("")),
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+		{
+			name:      "not pushed",
+			cr:        centralConfiguration(),
+			healthErr: notFound,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, Diff: "Declarative configuration changed"},
+				condition: xpv1.Creating(),
+			},
+		},
+		{
+			name:      "up to date",
+			cr:        centralConfiguration(pushed...),
+			healthErr: notFound,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Available(),
+			},
+		},
+		{
+			name:             "item missing",
+			cr:               centralConfiguration(pushed...),
+			permissionSetErr: notFound,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, Diff: "Items of declarative configuration are missing from central"},
+				condition: xpv1.Unavailable(),
+			},
+		},
+		{
+			name: "unhealthy",
+			cr:   centralConfiguration(pushed...),
+			healths: []central.DeclarativeConfigHealth{{
+				ResourceType: central.DeclarativeConfigTypeRole,
+				ResourceName: "team-reader",
+				Status:       central.DeclarativeConfigUnhealthy,
+				ErrorMessage: "unknown permission set",
+			}},
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Unavailable(),
+			},
+		},
+		{
+			name:      "health error",
+			cr:        centralConfiguration(pushed...),
+			healthErr: errors.New("boom"),
+			want:      want{err: cmpopts.AnyError},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &centralConfigurationExternal{
+				kube: configMapClient(declarativeConfig),
+				client: centralConfigurationMock{
+					PermissionSetAPIMock: &central.PermissionSetAPIMock{
+						GetPermissionSetFunc: func(ctx context.Context, id string) (*central.PermissionSet, error) {
+							return &central.PermissionSet{ID: id}, tc.permissionSetErr
+						},
+					},
+					RoleAPIMock: &central.RoleAPIMock{
+						GetRoleFunc: func(ctx context.Context, name string) (*central.Role, error) {
+							return &central.Role{Name: name}, nil
+						},
+					},
+					AuthProviderAPIMock: &central.AuthProviderAPIMock{
+						GetAuthProviderFunc: func(ctx context.Context, id string) (*central.AuthProvider, error) {
+							return &central.AuthProvider{ID: id}, nil
+						},
+					},
+					DeclarativeConfigHealthAPIMock: &central.DeclarativeConfigHealthAPIMock{
+						GetDeclarativeConfigHealthsFunc: func(ctx context.Context) ([]central.DeclarativeConfigHealth, error) {
+							return tc.healths, tc.healthErr
+						},
+					},
+				},
+			}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if tc.want.condition.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want condition, +got condition:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCentralConfigurationUpdate(t *testing.T) {
+	cases := []struct {
+		name      string
+		cr        *v1alpha1.CentralConfiguration
+		groupsErr error
+		wantCalls []string
+		wantItems []v1alpha1.DeclarativeConfigItem
+		wantHash  bool
+		err       error
+	}{
+		{
+			name:      "created",
+			cr:        centralConfiguration(),
+			wantCalls: []string{"create permission set readers", "create role team-reader ps-1/io.stackrox.authz.accessscope.unrestricted", "create auth provider sso", "update groups ap-1 2"},
+			wantItems: []v1alpha1.DeclarativeConfigItem{
+				{Type: central.DeclarativeConfigTypePermissionSet, Name: "readers", ID: "ps-1", Source: "crossplane-system/acs-config[roles.yaml]", Health: central.DeclarativeConfigHealthy},
+				{Type: central.DeclarativeConfigTypeRole, Name: "team-reader", ID: "team-reader", Source: "crossplane-system/acs-config[roles.yaml]", Health: central.DeclarativeConfigHealthy},
+				{Type: central.DeclarativeConfigTypeAuthProvider, Name: "sso", ID: "ap-1", Source: "crossplane-system/acs-config[sso.yaml]", Health: central.DeclarativeConfigHealthy},
+			},
+			wantHash: true,
+		},
+		{
+			name: "updated and removed",
+			cr: centralConfiguration(withConfigItems(
+				v1alpha1.DeclarativeConfigItem{Type: central.DeclarativeConfigTypePermissionSet, Name: "readers", ID: "ps-1"},
+				v1alpha1.DeclarativeConfigItem{Type: central.DeclarativeConfigTypeAccessScope, Name: "old", ID: "as-1"},
+				v1alpha1.DeclarativeConfigItem{Type: central.DeclarativeConfigTypeRole, Name: "team-reader", ID: "team-reader"},
+				v1alpha1.DeclarativeConfigItem{Type: central.DeclarativeConfigTypeRole, Name: "old", ID: "old"},
+				v1alpha1.DeclarativeConfigItem{Type: central.DeclarativeConfigTypeAuthProvider, Name: "sso", ID: "ap-1"},
+			)),
+			wantCalls: []string{"update permission set ps-1", "update role team-reader ps-1/io.stackrox.authz.accessscope.unrestricted", "update auth provider ap-1", "update groups ap-1 2", "delete role old", "delete access scope as-1"},
+			wantItems: []v1alpha1.DeclarativeConfigItem{
+				{Type: central.DeclarativeConfigTypePermissionSet, Name: "readers", ID: "ps-1", Source: "crossplane-system/acs-config[roles.yaml]", Health: central.DeclarativeConfigHealthy},
+				{Type: central.DeclarativeConfigTypeRole, Name: "team-reader", ID: "team-reader", Source: "crossplane-system/acs-config[roles.yaml]", Health: central.DeclarativeConfigHealthy},
+				{Type: central.DeclarativeConfigTypeAuthProvider, Name: "sso", ID: "ap-1", Source: "crossplane-system/acs-config[sso.yaml]", Health: central.DeclarativeConfigHealthy},
+			},
+			wantHash: true,
+		},
+		{
+			name:      "push error",
+			cr:        centralConfiguration(),
+			groupsErr: errors.New("boom"),
+			wantCalls: []string{"create permission set readers", "create role team-reader ps-1/io.stackrox.authz.accessscope.unrestricted", "create auth provider sso", "update groups ap-1 2"},
+			wantItems: []v1alpha1.DeclarativeConfigItem{
+				{Type: central.DeclarativeConfigTypePermissionSet, Name: "readers", ID: "ps-1", Source: "crossplane-system/acs-config[roles.yaml]", Health: central.DeclarativeConfigHealthy},
+				{Type: central.DeclarativeConfigTypeRole, Name: "team-reader", ID: "team-reader", Source: "crossplane-system/acs-config[roles.yaml]", Health: central.DeclarativeConfigHealthy},
+				{Type: central.DeclarativeConfigTypeAuthProvider, Name: "sso", ID: "ap-1", Source: "crossplane-system/acs-config[sso.yaml]", Health: central.DeclarativeConfigUnhealthy},
+			},
+			err: cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls []string
+			call := func(format string, args ...interface{}) {
+				calls = append(calls, errors.Errorf(format, args...).Error())
+			}
+			e := &centralConfigurationExternal{
+				kube: configMapClient(declarativeConfig),
+				client: centralConfigurationMock{
+					PermissionSetAPIMock: &central.PermissionSetAPIMock{
+						CreatePermissionSetFunc: func(ctx context.Context, p *central.PermissionSet) (*central.PermissionSet, error) {
+							call("create permission set %s", p.Name)
+							return &central.PermissionSet{ID: "ps-1"}, nil
+						},
+						UpdatePermissionSetFunc: func(ctx context.Context, p *central.PermissionSet) error {
+							call("update permission set %s", p.ID)
+							return nil
+						},
+					},
+					AccessScopeAPIMock: &central.AccessScopeAPIMock{
+						ListAccessScopesFunc: func(ctx context.Context) ([]central.AccessScope, error) {
+							return []central.AccessScope{{ID: "io.stackrox.authz.accessscope.unrestricted", Name: "Unrestricted"}}, nil
+						},
+						DeleteAccessScopeFunc: func(ctx context.Context, id string) error {
+							call("delete access scope %s", id)
+							return nil
+						},
+					},
+					RoleAPIMock: &central.RoleAPIMock{
+						CreateRoleFunc: func(ctx context.Context, r *central.Role) error {
+							call("create role %s %s/%s", r.Name, r.PermissionSetID, r.AccessScopeID)
+							return nil
+						},
+						UpdateRoleFunc: func(ctx context.Context, r *central.Role) error {
+							call("update role %s %s/%s", r.Name, r.PermissionSetID, r.AccessScopeID)
+							return nil
+						},
+						DeleteRoleFunc: func(ctx context.Context, name string) error {
+							call("delete role %s", name)
+							return &central.APIError{StatusCode: http.StatusNotFound}
+						},
+					},
+					AuthProviderAPIMock: &central.AuthProviderAPIMock{
+						CreateAuthProviderFunc: func(ctx context.Context, p *central.AuthProvider) (*central.AuthProvider, error) {
+							call("create auth provider %s", p.Name)
+							if p.Config[configOIDCClientSecret] != "s3cr3t" {
+								t.Errorf("\ne.Update(...): unexpected auth provider config %v\n", p.Config)
+							}
+							return &central.AuthProvider{ID: "ap-1"}, nil
+						},
+						UpdateAuthProviderFunc: func(ctx context.Context, p *central.AuthProvider) error {
+							call("update auth provider %s", p.ID)
+							return nil
+						},
+					},
+					GroupAPIMock: &central.GroupAPIMock{
+						ListGroupsFunc: func(ctx context.Context, authProviderID string) ([]central.Group, error) {
+							return nil, nil
+						},
+						UpdateGroupsFunc: func(ctx context.Context, previous, required []central.Group) error {
+							call("update groups %s %d", required[0].Props.AuthProviderID, len(required))
+							return tc.groupsErr
+						},
+					},
+				},
+			}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.wantCalls, calls); diff != "" {
+				t.Errorf("\ne.Update(...): -want calls, +got calls:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.wantItems, tc.cr.Status.AtProvider.Items, cmpopts.IgnoreFields(v1alpha1.DeclarativeConfigItem{}, "Message")); diff != "" {
+				t.Errorf("\ne.Update(...): -want items, +got items:\n%s\n", diff)
+			}
+			if gotHash := tc.cr.Status.AtProvider.ConfigHash != ""; gotHash != tc.wantHash {
+				t.Errorf("\ne.Update(...): want config hash %t, got %t\n", tc.wantHash, gotHash)
+			}
+		})
+	}
+}
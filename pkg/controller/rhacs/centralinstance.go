@@ -18,16 +18,19 @@ package rhacs
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
 	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	"go.opentelemetry.io/otel"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -40,24 +43,62 @@ import (
 
 	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
 	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+	centralclient "github.com/stehessel/provider-redhat/pkg/clients/central"
 	"github.com/stehessel/provider-redhat/pkg/clients/rhacs"
+	"github.com/stehessel/provider-redhat/pkg/clients/s3"
 	"github.com/stehessel/provider-redhat/pkg/features"
+	"github.com/stehessel/provider-redhat/pkg/tracing"
 )
 
 const (
 	errNotCentralInstance = "managed resource is not a CentralInstance custom resource"
 	errTrackPCUsage       = "cannot track ProviderConfig usage"
 	errGetPC              = "cannot get ProviderConfig"
-	errGetCreds           = "cannot get credentials"
 	errGetFailed          = "cannot get central instance"
 	errObserveFailed      = "cannot observe central instance"
 	errCreateFailed       = "cannot create central instance"
 	errUpdateFailed       = "cannot update central instance"
 	errDeleteFailed       = "cannot delete central instance"
+	errBootstrapFailed    = "cannot issue bootstrap credentials of central instance"
+	errRevokeBootstrap    = "cannot revoke bootstrap token of central instance"
+	errRecordBootstrap    = "cannot record bootstrap token of central instance"
+	errRestoreFailed      = "cannot restore central instance from backup"
+	errGetCentralBackup   = "cannot get central backup"
+	errNoBackup           = "central backup has not yet written a backup"
 )
 
-// Setup adds a controller that reconciles CentralInstance managed resources.
-func Setup(mgr ctrl.Manager, o controller.Options) error {
+// bootstrapTokenRole is the role of the API token issued to bootstrap access
+// to a ready central.
+const bootstrapTokenRole = "Admin"
+
+// The API token issued to bootstrap access to a ready central expires after
+// bootstrapTokenTTL, and is rotated bootstrapTokenRotateBefore its expiry.
+const (
+	bootstrapTokenTTL          = 90 * 24 * time.Hour
+	bootstrapTokenRotateBefore = 30 * 24 * time.Hour
+)
+
+// restoreTimeout bounds restores of backups to centrals. Central restores a
+// backup before it responds to the request to restore it, so restores run in
+// the background.
+const restoreTimeout = 15 * time.Minute
+
+// PollIntervals configure how often CentralInstances are checked for drift from
+// the desired state, depending on the lifecycle phase of their central. Phases
+// without a dedicated interval are polled at the interval of the controller
+// options.
+type PollIntervals struct {
+	// Transitional is used while a central is accepted, preparing,
+	// provisioning or being deleted.
+	Transitional time.Duration
+
+	// Ready is used once a central is ready.
+	Ready time.Duration
+}
+
+// setupCentralInstance adds a controller that reconciles CentralInstance
+// managed resources.
+func setupCentralInstance(mgr ctrl.Manager, o controller.Options, ro Options, cf *clientFactory) error {
 	name := managed.ControllerName(v1alpha1.CentralInstanceGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -68,9 +109,12 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.CentralInstanceGroupVersionKind),
 		managed.WithExternalConnecter(&connector{
-			kube:  mgr.GetClient(),
-			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			clients:  cf,
+			restores: newProcesses(restoreTimeout),
 		}),
+		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...))
@@ -79,14 +123,22 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.CentralInstance{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(ratelimiter.NewReconciler(name, &throttler{
+			inner: &poller{
+				kube:      mgr.GetClient(),
+				intervals: ro.PollIntervals,
+				inner:     tracing.NewReconciler(name, r, otel.GetTracerProvider()),
+			},
+		}, o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
-	kube  client.Client
-	usage resource.Tracker
+	kube     client.Client
+	usage    resource.Tracker
+	clients  *clientFactory
+	restores *processes
 }
 
 // Connect typically produces an ExternalClient by:
@@ -103,30 +155,129 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
+	tracing.SetAttributes(ctx, tracing.AttributeProviderConfig.String(cr.GetProviderConfigReference().Name))
 
 	pc := &apisv1alpha1.ProviderConfig{}
 	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, errGetPC)
 	}
 
-	cd := pc.Spec.Credentials
-	token, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	client, err := c.clients.newClient(ctx, pc)
 	if err != nil {
-		return nil, errors.Wrap(err, errGetCreds)
+		return nil, err
+	}
+	centralClient := func(ctx context.Context, url string) (centralAPI, error) {
+		return c.clients.newCentralClient(ctx, pc, url)
 	}
-	stringToken := string(token)
+	return &external{
+		client:        client,
+		centralClient: centralClient,
+		kube:          c.kube,
+		objectStore:   s3.NewClient,
+		restores:      c.restores,
+		annotations:   managed.NewRetryingCriticalAnnotationUpdater(c.kube),
+		now:           time.Now,
+	}, nil
+}
+
+// A throttler requeues reconciles of CentralInstances once a token of the
+// rate limit of their ProviderConfig is available again if one of their
+// fleet-manager calls was throttled, rather than failing and backing off.
+type throttler struct {
+	inner reconcile.Reconciler
+}
 
-	client, err := rhacs.NewClient(stringToken, pc.Spec.Gateway)
+func (t *throttler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	th := &rhacs.Throttle{}
+	res, err := t.inner.Reconcile(rhacs.WithThrottle(ctx, th), req)
+	if d := th.RetryAfter(); d > 0 {
+		return reconcile.Result{RequeueAfter: d}, nil
+	}
+	return res, err
+}
+
+// A poller requeues CentralInstances after the poll interval matching the
+// lifecycle phase of their central.
+type poller struct {
+	kube      client.Client
+	intervals PollIntervals
+	inner     reconcile.Reconciler
+}
+
+func (p *poller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := p.inner.Reconcile(ctx, req)
 	if err != nil {
-		return nil, errors.Wrap(err, rhacs.ErrNewClient)
+		return res, err
+	}
+	cr := &v1alpha1.CentralInstance{}
+	if err := p.kube.Get(ctx, req.NamespacedName, cr); err != nil {
+		return res, nil
+	}
+
+	// The managed reconciler requeues after its poll interval once a central
+	// has been observed, and immediately while waiting for it to be deleted.
+	deleting := res.Requeue && meta.WasDeleted(cr) &&
+		(cr.Status.AtProvider.Status == rhacs.CentralRequestStatusDeprovision ||
+			cr.Status.AtProvider.Status == rhacs.CentralRequestStatusDeleting)
+	if res.RequeueAfter == 0 && !deleting {
+		return res, nil
+	}
+	if d := p.intervals.forCentral(cr); d > 0 {
+		return reconcile.Result{RequeueAfter: d}, nil
+	}
+	return res, nil
+}
+
+// forCentral returns the poll interval matching the lifecycle phase of the
+// supplied CentralInstance, or zero if the phase has no dedicated interval.
+func (pi PollIntervals) forCentral(cr *v1alpha1.CentralInstance) time.Duration {
+	switch cr.Status.AtProvider.Status {
+	case "",
+		rhacs.CentralRequestStatusAccepted,
+		rhacs.CentralRequestStatusPreparing,
+		rhacs.CentralRequestStatusProvisioning,
+		rhacs.CentralRequestStatusDeprovision,
+		rhacs.CentralRequestStatusDeleting:
+		return annotatedInterval(cr, v1alpha1.AnnotationPollIntervalTransitional, pi.Transitional)
+	case rhacs.CentralRequestStatusReady:
+		return annotatedInterval(cr, v1alpha1.AnnotationPollIntervalReady, pi.Ready)
+	default:
+		return 0
 	}
-	return &external{client: client}, nil
+}
+
+// annotatedInterval returns the poll interval set by the supplied annotation,
+// falling back to the supplied default if it is absent or invalid.
+func annotatedInterval(cr *v1alpha1.CentralInstance, key string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(cr.GetAnnotations()[key]); err == nil && d > 0 {
+		return d
+	}
+	return def
+}
+
+// centralAPI is the part of the API of a central used to bootstrap and
+// restore it.
+type centralAPI interface {
+	centralclient.APITokenAPI
+	centralclient.RestoreAPI
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
 	client fleetmanager.PublicAPI
+	// centralClient returns a client for the API of the central served at
+	// the supplied URL.
+	centralClient func(ctx context.Context, url string) (centralAPI, error)
+	// kube reads the CentralBackups centrals are restored from, and
+	// objectStore reads their backups.
+	kube        client.Client
+	objectStore func(s3.Config) (s3.Client, error)
+	restores    *processes
+	// annotations records the bootstrap token and restored backup of a
+	// central.
+	annotations managed.CriticalAnnotationUpdater
+	now         func() time.Time
 }
 
 func generateObservation(in *public.CentralRequest) v1alpha1.CentralInstanceObservation {
@@ -167,14 +318,18 @@ func getCondition(status string) xpv1.Condition {
 	}
 }
 
-func isUpToDate(in *v1alpha1.CentralInstance, observed *public.CentralRequest) (bool, string) {
+// isUpToDate compares the parameters of a CentralInstance with those of its
+// observed central.
+func isUpToDate(in *v1alpha1.CentralInstance) (bool, string) {
+	observed := in.Status.AtProvider
 	observedParams := v1alpha1.CentralInstanceParameters{
 		Name:          observed.Name,
-		CloudProvider: v1alpha1.CloudProvider(observed.CloudProvider),
-		Region:        v1alpha1.Region(observed.Region),
-		MultiAZ:       observed.MultiAz,
+		CloudProvider: observed.CloudProvider,
+		Region:        observed.Region,
+		MultiAZ:       observed.MultiAZ,
 	}
-	if diff := cmp.Diff(in.Spec.ForProvider, observedParams, cmpopts.EquateEmpty()); diff != "" {
+	ignore := cmpopts.IgnoreFields(v1alpha1.CentralInstanceParameters{}, "RestoreFrom")
+	if diff := cmp.Diff(in.Spec.ForProvider, observedParams, cmpopts.EquateEmpty(), ignore); diff != "" {
 		diff = "Observed difference in central instance\n" + diff
 		return false, diff
 	}
@@ -213,19 +368,221 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
+	tracing.SetAttributes(ctx,
+		tracing.AttributeCentralID.String(central.Id),
+		tracing.AttributeCentralName.String(central.Name),
+		tracing.AttributeCentralRegion.String(central.Region))
+
 	cr.Status.AtProvider = generateObservation(central)
 	condition := getCondition(cr.Status.AtProvider.Status)
 	cr.SetConditions(condition)
 	meta.SetExternalName(cr, central.Name)
-	upToDate, diff := isUpToDate(cr, central)
+	upToDate, diff := isUpToDate(cr)
 
-	return managed.ExternalObservation{
+	obs := managed.ExternalObservation{
 		ResourceExists:   true,
 		ResourceUpToDate: upToDate,
 		Diff:             diff,
+	}
+	if publishesCredentials(cr) {
+		obs.ConnectionDetails = managed.ConnectionDetails{
+			v1alpha1.ConnectionKeyEndpoint: []byte(cr.Status.AtProvider.CentralUIURL),
+		}
+	}
+	if !upToDate {
+		return obs, nil
+	}
+
+	// The restored database replaces the API tokens of the central, so it is
+	// restored before bootstrap credentials are issued.
+	switch pending, running := c.restoring(cr); {
+	case running:
+		return obs, nil
+	case pending:
+		obs.ResourceUpToDate, obs.Diff = false, "Backup has not been restored"
+		return obs, nil
+	}
+	if obs.ConnectionDetails != nil {
+		pending, reason, err := c.bootstrapPending(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errBootstrapFailed)
+		}
+		obs.ResourceUpToDate, obs.Diff = !pending, reason
+	}
+	return obs, nil
+}
+
+// publishesCredentials returns true if bootstrap credentials are published
+// for a CentralInstance. Credentials are only issued once its central is
+// ready, and only if they can be published.
+func publishesCredentials(cr *v1alpha1.CentralInstance) bool {
+	return cr.Status.AtProvider.Status == rhacs.CentralRequestStatusReady &&
+		(cr.GetWriteConnectionSecretToReference() != nil || cr.GetPublishConnectionDetailsTo() != nil)
+}
+
+// bootstrapPending returns true if the bootstrap token of a central has yet
+// to be issued, no longer exists, e.g. because a backup was restored, was
+// revoked, or is due for rotation.
+func (c *external) bootstrapPending(ctx context.Context, cr *v1alpha1.CentralInstance) (bool, string, error) {
+	id := cr.GetAnnotations()[v1alpha1.AnnotationBootstrapToken]
+	if id == "" {
+		return true, "Bootstrap token has not been issued", nil
+	}
+	client, err := c.centralClient(ctx, cr.Status.AtProvider.CentralUIURL)
+	if err != nil {
+		return false, "", err
+	}
+	token, err := client.GetAPIToken(ctx, id)
+	if centralclient.IsNotFound(err) {
+		return true, "Bootstrap token no longer exists", nil
+	}
+	if err != nil {
+		return false, "", err
+	}
+	if token.Revoked {
+		return true, "Bootstrap token was revoked", nil
+	}
+	if token.Expiration == nil || !c.now().Before(token.Expiration.Add(-bootstrapTokenRotateBefore)) {
+		return true, "Bootstrap token is due for rotation", nil
+	}
+	return false, "", nil
+}
+
+// bootstrap issues an admin API token to access a ready central through the
+// central API, authenticated with the SSO token of the ProviderConfig, and
+// returns it together with the endpoint of the central. Central returns the
+// token only when issuing it, so its ID is recorded in an annotation to
+// rotate and revoke it later. The token it replaces is revoked first; if
+// that fails or the new token cannot be recorded, the new token is revoked
+// again.
+func (c *external) bootstrap(ctx context.Context, cr *v1alpha1.CentralInstance) (managed.ConnectionDetails, error) {
+	url := cr.Status.AtProvider.CentralUIURL
+	client, err := c.centralClient(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, errBootstrapFailed)
+	}
+	exp := c.now().Add(bootstrapTokenTTL).UTC()
+	token, err := client.GenerateAPIToken(ctx, centralclient.GenerateAPITokenRequest{
+		Name:       "crossplane-bootstrap-" + cr.Spec.ForProvider.Name,
+		Roles:      []string{bootstrapTokenRole},
+		Expiration: &exp,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errBootstrapFailed)
+	}
+	if old := cr.GetAnnotations()[v1alpha1.AnnotationBootstrapToken]; old != "" {
+		if err := client.RevokeAPIToken(ctx, old); err != nil && !centralclient.IsNotFound(err) {
+			_ = client.RevokeAPIToken(ctx, token.Metadata.ID)
+			return nil, errors.Wrap(err, errRevokeBootstrap)
+		}
+	}
+
+	if err := c.recordAnnotations(ctx, cr, map[string]string{v1alpha1.AnnotationBootstrapToken: token.Metadata.ID}); err != nil {
+		_ = client.RevokeAPIToken(ctx, token.Metadata.ID)
+		return nil, errors.Wrap(err, errRecordBootstrap)
+	}
+	return managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyEndpoint: []byte(url),
+		v1alpha1.ConnectionKeyToken:    []byte(token.Token),
 	}, nil
 }
 
+// revokeBootstrap revokes the bootstrap token of a ready central.
+func (c *external) revokeBootstrap(ctx context.Context, cr *v1alpha1.CentralInstance) error {
+	id := cr.GetAnnotations()[v1alpha1.AnnotationBootstrapToken]
+	if id == "" || cr.Status.AtProvider.Status != rhacs.CentralRequestStatusReady {
+		return nil
+	}
+	client, err := c.centralClient(ctx, cr.Status.AtProvider.CentralUIURL)
+	if err != nil {
+		return errors.Wrap(err, errRevokeBootstrap)
+	}
+	if err := client.RevokeAPIToken(ctx, id); err != nil && !centralclient.IsNotFound(err) {
+		return errors.Wrap(err, errRevokeBootstrap)
+	}
+	return nil
+}
+
+// recordAnnotations persists the supplied annotations of a CentralInstance.
+// The managed reconciler does not persist annotations of updated resources.
+// Recording them resets the status observed before, so it is kept.
+func (c *external) recordAnnotations(ctx context.Context, cr *v1alpha1.CentralInstance, a map[string]string) error {
+	status := cr.Status.DeepCopy()
+	meta.AddAnnotations(cr, a)
+	if err := c.annotations.UpdateCriticalAnnotations(ctx, cr); err != nil {
+		return err
+	}
+	cr.Status = *status
+	return nil
+}
+
+// restoring returns true if the backup referenced by a ready central has yet
+// to be restored, and whether its restore is running.
+func (c *external) restoring(cr *v1alpha1.CentralInstance) (pending, running bool) {
+	if cr.Spec.ForProvider.RestoreFrom == nil ||
+		cr.Status.AtProvider.Status != rhacs.CentralRequestStatusReady ||
+		cr.GetAnnotations()[v1alpha1.AnnotationRestoredBackup] != "" {
+		return false, false
+	}
+	p := c.restores.get(cr.GetUID())
+	return true, p != nil && !p.finished()
+}
+
+// restore starts to restore the latest backup of the CentralBackup referenced
+// by a ready central in the background, and returns true once it finished.
+// The restored backup is then recorded in an annotation, so that it is
+// restored only once. The bootstrap token issued before is lost with the
+// replaced database, so a new one is issued afterwards.
+func (c *external) restore(ctx context.Context, cr *v1alpha1.CentralInstance) (bool, error) {
+	if p := c.restores.get(cr.GetUID()); p != nil {
+		if !p.finished() {
+			return false, nil
+		}
+		if p.err != nil {
+			c.restores.forget(cr.GetUID())
+			return false, p.err
+		}
+		if err := c.recordAnnotations(ctx, cr, map[string]string{v1alpha1.AnnotationRestoredBackup: p.key}); err != nil {
+			return false, err
+		}
+		c.restores.forget(cr.GetUID())
+		return true, nil
+	}
+
+	ref := cr.Spec.ForProvider.RestoreFrom
+	backup := &v1alpha1.CentralBackup{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, backup); err != nil {
+		return false, errors.Wrap(err, errGetCentralBackup)
+	}
+	key := backup.Status.AtProvider.ObjectKey
+	if key == "" {
+		return false, errors.New(errNoBackup)
+	}
+	cfg, err := objectStoreConfig(ctx, c.kube, backup.Spec.ForProvider)
+	if err != nil {
+		return false, err
+	}
+	store, err := c.objectStore(cfg)
+	if err != nil {
+		return false, err
+	}
+	client, err := c.centralClient(ctx, cr.Status.AtProvider.CentralUIURL)
+	if err != nil {
+		return false, err
+	}
+
+	bucket, size := backup.Spec.ForProvider.S3.Bucket, backup.Status.AtProvider.Size
+	c.restores.start(cr.GetUID(), key, func(ctx context.Context) error {
+		obj, err := store.GetObject(ctx, bucket, key)
+		if err != nil {
+			return err
+		}
+		defer obj.Close() //nolint:errcheck // The object is only read.
+		return client.RestoreDatabase(ctx, obj, size)
+	})
+	return false, nil
+}
+
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.CentralInstance)
 	if !ok {
@@ -262,8 +619,22 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, nil
 	}
 
-	err := c.Delete(ctx, mg)
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	// The parameters of a central cannot be changed, so it is replaced.
+	if upToDate, _ := isUpToDate(cr); !upToDate {
+		err := c.Delete(ctx, mg)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	if pending, _ := c.restoring(cr); pending {
+		restored, err := c.restore(ctx, cr)
+		if err != nil || !restored {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRestoreFailed)
+		}
+	}
+	if !publishesCredentials(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+	cd, err := c.bootstrap(ctx, cr)
+	return managed.ExternalUpdate{ConnectionDetails: cd}, err
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -276,6 +647,9 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		cr.Status.AtProvider.Status == rhacs.CentralRequestStatusDeleting {
 		return nil
 	}
+	if err := c.revokeBootstrap(ctx, cr); err != nil {
+		return err
+	}
 
 	resp, err := c.client.DeleteCentralById(ctx, cr.Status.AtProvider.ID, true)
 	if resp != nil {
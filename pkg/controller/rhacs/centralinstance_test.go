@@ -18,29 +18,41 @@ package rhacs
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
 	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+	centralclient "github.com/stehessel/provider-redhat/pkg/clients/central"
 	"github.com/stehessel/provider-redhat/pkg/clients/rhacs"
+	"github.com/stehessel/provider-redhat/pkg/clients/s3"
 )
 
 // Test that our Reconciler implementation satisfies the Reconciler interface.
 var (
 	_ managed.ExternalClient    = &external{}
 	_ managed.ExternalConnecter = &connector{}
+	_ reconcile.Reconciler      = &throttler{}
+	_ reconcile.Reconciler      = &poller{}
 )
 
 var (
@@ -184,6 +196,51 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		{
+			name: "observation bootstrap pending",
+			client: &fleetmanager.PublicAPIMock{
+				GetCentralsFunc: func(ctx context.Context, localVarOptionals *public.GetCentralsOpts) (public.CentralRequestList, *http.Response, error) {
+					central := centralRequest(func(c *public.CentralRequest) { c.CentralUIURL = "https://central.example.com" })
+					return public.CentralRequestList{Items: []public.CentralRequest{central}}, nil, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  centralInstance(withConditions(xpv1.Available()), withConnectionSecret),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{v1alpha1.ConnectionKeyEndpoint: []byte("https://central.example.com")},
+				},
+				mg: centralInstance(withConditions(xpv1.Available()), withConnectionSecret, func(c *v1alpha1.CentralInstance) {
+					c.Status.AtProvider.CentralUIURL = "https://central.example.com"
+				}),
+				err: nil,
+			},
+		},
+		{
+			name: "observation restore pending",
+			client: &fleetmanager.PublicAPIMock{
+				GetCentralsFunc: func(ctx context.Context, localVarOptionals *public.GetCentralsOpts) (public.CentralRequestList, *http.Response, error) {
+					central := centralRequest()
+					return public.CentralRequestList{Items: []public.CentralRequest{central}}, nil, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  centralInstance(withConditions(xpv1.Available()), withRestoreFrom),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				mg:  centralInstance(withConditions(xpv1.Available()), withRestoreFrom),
+				err: nil,
+			},
+		},
 		{
 			name: "observation while creating",
 			client: &fleetmanager.PublicAPIMock{
@@ -285,7 +342,7 @@ func TestObserve(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			e := external{client: tc.client}
+			e := external{client: tc.client, restores: newProcesses(restoreTimeout)}
 			got, err := e.Observe(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
 				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
@@ -399,10 +456,10 @@ func TestUpdate(t *testing.T) {
 			},
 			args: args{
 				ctx: context.Background(),
-				mg:  centralInstance(withStatus(rhacs.CentralRequestStatusReady)),
+				mg:  centralInstance(withStatus(rhacs.CentralRequestStatusReady), withRegion("new-region")),
 			},
 			want: want{
-				mg:  centralInstance(withStatus(rhacs.CentralRequestStatusReady), withConditions(xpv1.Deleting())),
+				mg:  centralInstance(withStatus(rhacs.CentralRequestStatusReady), withRegion("new-region"), withConditions(xpv1.Deleting())),
 				err: nil,
 			},
 		},
@@ -415,13 +472,32 @@ func TestUpdate(t *testing.T) {
 			},
 			args: args{
 				ctx: context.Background(),
-				mg:  centralInstance(withStatus(rhacs.CentralRequestStatusDeprovision)),
+				mg:  centralInstance(withStatus(rhacs.CentralRequestStatusDeprovision), withRegion("new-region")),
 			},
 			want: want{
-				mg:  centralInstance(withConditions(xpv1.Deleting()), withStatus(rhacs.CentralRequestStatusDeprovision)),
+				mg:  centralInstance(withConditions(xpv1.Deleting()), withStatus(rhacs.CentralRequestStatusDeprovision), withRegion("new-region")),
 				err: nil,
 			},
 		},
+		{
+			name: "update issues bootstrap token",
+			client: &fleetmanager.PublicAPIMock{
+				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool) (*http.Response, error) {
+					return nil, errors.New("should never reach this error")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  centralInstance(withConnectionSecret),
+			},
+			want: want{
+				obs: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					v1alpha1.ConnectionKeyEndpoint: []byte(""),
+					v1alpha1.ConnectionKeyToken:    []byte("s3cr3t"),
+				}},
+				mg: centralInstance(withConnectionSecret, withBootstrapToken("token-id")),
+			},
+		},
 		{
 			name: "update error",
 			client: &fleetmanager.PublicAPIMock{
@@ -431,10 +507,10 @@ func TestUpdate(t *testing.T) {
 			},
 			args: args{
 				ctx: context.Background(),
-				mg:  centralInstance(withStatus(rhacs.CentralRequestStatusReady)),
+				mg:  centralInstance(withStatus(rhacs.CentralRequestStatusReady), withRegion("new-region")),
 			},
 			want: want{
-				mg:  centralInstance(withStatus(rhacs.CentralRequestStatusReady), withConditions(xpv1.Deleting())),
+				mg:  centralInstance(withStatus(rhacs.CentralRequestStatusReady), withRegion("new-region"), withConditions(xpv1.Deleting())),
 				err: cmpopts.AnyError,
 			},
 		},
@@ -442,7 +518,18 @@ func TestUpdate(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			e := external{client: tc.client}
+			e := external{
+				client: tc.client,
+				now:    time.Now,
+				centralClient: func(ctx context.Context, url string) (centralAPI, error) {
+					return centralMock{APITokenAPIMock: &centralclient.APITokenAPIMock{
+						GenerateAPITokenFunc: func(ctx context.Context, req centralclient.GenerateAPITokenRequest) (*centralclient.APIToken, error) {
+							return &centralclient.APIToken{Token: "s3cr3t", Metadata: centralclient.APITokenMeta{ID: "token-id"}}, nil
+						},
+					}}, nil
+				},
+				annotations: managed.CriticalAnnotationUpdateFn(func(ctx context.Context, o client.Object) error { return nil }),
+			}
 			got, err := e.Update(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
 				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
@@ -469,10 +556,11 @@ func TestDelete(t *testing.T) {
 	}
 
 	cases := []struct {
-		name   string
-		client fleetmanager.PublicAPI
-		args   args
-		want   want
+		name      string
+		client    fleetmanager.PublicAPI
+		revokeErr error
+		args      args
+		want      want
 	}{
 		{
 			name: "delete success",
@@ -490,6 +578,40 @@ func TestDelete(t *testing.T) {
 				err: nil,
 			},
 		},
+		{
+			name: "delete revokes bootstrap token",
+			client: &fleetmanager.PublicAPIMock{
+				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool) (*http.Response, error) {
+					return nil, nil
+				},
+			},
+			revokeErr: &centralclient.APIError{StatusCode: http.StatusNotFound},
+			args: args{
+				ctx: context.Background(),
+				mg:  centralInstance(withBootstrapToken("token-id")),
+			},
+			want: want{
+				mg:  centralInstance(withBootstrapToken("token-id"), withConditions(xpv1.Deleting())),
+				err: nil,
+			},
+		},
+		{
+			name: "delete revoke error",
+			client: &fleetmanager.PublicAPIMock{
+				DeleteCentralByIdFunc: func(ctx context.Context, id string, async bool) (*http.Response, error) {
+					return nil, errors.New("should never reach this error")
+				},
+			},
+			revokeErr: errors.New("boom"),
+			args: args{
+				ctx: context.Background(),
+				mg:  centralInstance(withBootstrapToken("token-id")),
+			},
+			want: want{
+				mg:  centralInstance(withBootstrapToken("token-id"), withConditions(xpv1.Deleting())),
+				err: cmpopts.AnyError,
+			},
+		},
 		{
 			name: "delete already in progress",
 			client: &fleetmanager.PublicAPIMock{
@@ -526,7 +648,14 @@ func TestDelete(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			e := external{client: tc.client}
+			e := external{
+				client: tc.client,
+				centralClient: func(ctx context.Context, url string) (centralAPI, error) {
+					return centralMock{APITokenAPIMock: &centralclient.APITokenAPIMock{
+						RevokeAPITokenFunc: func(ctx context.Context, id string) error { return tc.revokeErr },
+					}}, nil
+				},
+			}
 			err := e.Delete(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
 				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
@@ -537,3 +666,599 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func withConnectionSecret(c *v1alpha1.CentralInstance) {
+	c.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "central", Namespace: "crossplane-system"})
+}
+
+func withBootstrapToken(id string) centralInstanceModifier {
+	return func(c *v1alpha1.CentralInstance) {
+		meta.AddAnnotations(c, map[string]string{v1alpha1.AnnotationBootstrapToken: id})
+	}
+}
+
+func TestBootstrapPending(t *testing.T) {
+	valid := testTime.Add(bootstrapTokenRotateBefore + time.Hour)
+	expiring := testTime.Add(bootstrapTokenRotateBefore - time.Hour)
+
+	type want struct {
+		pending bool
+		err     error
+	}
+
+	cases := []struct {
+		name  string
+		mg    *v1alpha1.CentralInstance
+		token *centralclient.APITokenMeta
+		err   error
+		want  want
+	}{
+		{
+			name: "token not yet issued",
+			mg:   centralInstance(withConnectionSecret),
+			want: want{pending: true},
+		},
+		{
+			name:  "token valid",
+			mg:    centralInstance(withConnectionSecret, withBootstrapToken("token-id")),
+			token: &centralclient.APITokenMeta{ID: "token-id", Expiration: &valid},
+		},
+		{
+			name: "token lost",
+			mg:   centralInstance(withConnectionSecret, withBootstrapToken("token-id")),
+			err:  &centralclient.APIError{StatusCode: http.StatusNotFound},
+			want: want{pending: true},
+		},
+		{
+			name:  "token revoked",
+			mg:    centralInstance(withConnectionSecret, withBootstrapToken("token-id")),
+			token: &centralclient.APITokenMeta{ID: "token-id", Expiration: &valid, Revoked: true},
+			want:  want{pending: true},
+		},
+		{
+			name:  "token due for rotation",
+			mg:    centralInstance(withConnectionSecret, withBootstrapToken("token-id")),
+			token: &centralclient.APITokenMeta{ID: "token-id", Expiration: &expiring},
+			want:  want{pending: true},
+		},
+		{
+			name:  "token without expiry",
+			mg:    centralInstance(withConnectionSecret, withBootstrapToken("token-id")),
+			token: &centralclient.APITokenMeta{ID: "token-id"},
+			want:  want{pending: true},
+		},
+		{
+			name: "get error",
+			mg:   centralInstance(withConnectionSecret, withBootstrapToken("token-id")),
+			err:  errors.New("boom"),
+			want: want{err: cmpopts.AnyError},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := external{
+				now: func() time.Time { return testTime },
+				centralClient: func(ctx context.Context, url string) (centralAPI, error) {
+					return centralMock{APITokenAPIMock: &centralclient.APITokenAPIMock{
+						GetAPITokenFunc: func(ctx context.Context, id string) (*centralclient.APITokenMeta, error) {
+							return tc.token, tc.err
+						},
+					}}, nil
+				},
+			}
+			pending, _, err := e.bootstrapPending(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.bootstrapPending(...): -want error, +got error:\n%s\n", diff)
+			}
+			if pending != tc.want.pending {
+				t.Errorf("\ne.bootstrapPending(...): want pending %t, got %t\n", tc.want.pending, pending)
+			}
+		})
+	}
+}
+
+func TestBootstrap(t *testing.T) {
+	uiURL := "https://central.example.com"
+	withUIURL := func(c *v1alpha1.CentralInstance) { c.Status.AtProvider.CentralUIURL = uiURL }
+
+	type want struct {
+		cd      managed.ConnectionDetails
+		tokenID string
+		revoked []string
+		err     error
+	}
+
+	cases := []struct {
+		name      string
+		mg        *v1alpha1.CentralInstance
+		issueErr  error
+		revokeErr error
+		recordErr error
+		want      want
+	}{
+		{
+			name: "token issued",
+			mg:   centralInstance(withConnectionSecret, withUIURL),
+			want: want{
+				cd: managed.ConnectionDetails{
+					v1alpha1.ConnectionKeyEndpoint: []byte(uiURL),
+					v1alpha1.ConnectionKeyToken:    []byte("s3cr3t"),
+				},
+				tokenID: "new-token-id",
+			},
+		},
+		{
+			name: "token rotated",
+			mg:   centralInstance(withConnectionSecret, withUIURL, withBootstrapToken("token-id")),
+			want: want{
+				cd: managed.ConnectionDetails{
+					v1alpha1.ConnectionKeyEndpoint: []byte(uiURL),
+					v1alpha1.ConnectionKeyToken:    []byte("s3cr3t"),
+				},
+				tokenID: "new-token-id",
+				revoked: []string{"token-id"},
+			},
+		},
+		{
+			name:      "replaced token already gone",
+			mg:        centralInstance(withConnectionSecret, withUIURL, withBootstrapToken("token-id")),
+			revokeErr: &centralclient.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				cd: managed.ConnectionDetails{
+					v1alpha1.ConnectionKeyEndpoint: []byte(uiURL),
+					v1alpha1.ConnectionKeyToken:    []byte("s3cr3t"),
+				},
+				tokenID: "new-token-id",
+				revoked: []string{"token-id"},
+			},
+		},
+		{
+			name:      "revoke error",
+			mg:        centralInstance(withConnectionSecret, withUIURL, withBootstrapToken("token-id")),
+			revokeErr: errors.New("boom"),
+			want: want{
+				tokenID: "token-id",
+				revoked: []string{"token-id", "new-token-id"},
+				err:     cmpopts.AnyError,
+			},
+		},
+		{
+			name:      "record error",
+			mg:        centralInstance(withConnectionSecret, withUIURL),
+			recordErr: errors.New("boom"),
+			want: want{
+				tokenID: "new-token-id",
+				revoked: []string{"new-token-id"},
+				err:     cmpopts.AnyError,
+			},
+		},
+		{
+			name:     "issue error",
+			mg:       centralInstance(withConnectionSecret, withUIURL),
+			issueErr: errors.New("boom"),
+			want:     want{err: cmpopts.AnyError},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var revoked []string
+			e := external{
+				now: func() time.Time { return testTime },
+				centralClient: func(ctx context.Context, url string) (centralAPI, error) {
+					if url != uiURL {
+						t.Errorf("\ne.bootstrap(...): want client for %q, got %q\n", uiURL, url)
+					}
+					return centralMock{APITokenAPIMock: &centralclient.APITokenAPIMock{
+						GenerateAPITokenFunc: func(ctx context.Context, req centralclient.GenerateAPITokenRequest) (*centralclient.APIToken, error) {
+							if diff := cmp.Diff([]string{bootstrapTokenRole}, req.Roles); diff != "" {
+								t.Errorf("\ne.bootstrap(...): -want roles, +got roles:\n%s\n", diff)
+							}
+							if req.Expiration == nil || !req.Expiration.Equal(testTime.Add(bootstrapTokenTTL)) {
+								t.Errorf("\ne.bootstrap(...): want expiration %s, got %v\n", testTime.Add(bootstrapTokenTTL), req.Expiration)
+							}
+							if tc.issueErr != nil {
+								return nil, tc.issueErr
+							}
+							return &centralclient.APIToken{Token: "s3cr3t", Metadata: centralclient.APITokenMeta{ID: "new-token-id"}}, nil
+						},
+						RevokeAPITokenFunc: func(ctx context.Context, id string) error {
+							revoked = append(revoked, id)
+							if id == "new-token-id" {
+								return nil
+							}
+							return tc.revokeErr
+						},
+					}}, nil
+				},
+				annotations: managed.CriticalAnnotationUpdateFn(func(ctx context.Context, o client.Object) error {
+					// Recording annotations resets the status.
+					o.(*v1alpha1.CentralInstance).Status = v1alpha1.CentralInstanceStatus{}
+					return tc.recordErr
+				}),
+			}
+			cd, err := e.bootstrap(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.bootstrap(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cd, cd); diff != "" {
+				t.Errorf("\ne.bootstrap(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.tokenID, tc.mg.GetAnnotations()[v1alpha1.AnnotationBootstrapToken]); diff != "" {
+				t.Errorf("\ne.bootstrap(...): -want token ID, +got token ID:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.revoked, revoked); diff != "" {
+				t.Errorf("\ne.bootstrap(...): -want revoked, +got revoked:\n%s\n", diff)
+			}
+			if err == nil && tc.mg.Status.AtProvider.CentralUIURL != uiURL {
+				t.Errorf("\ne.bootstrap(...): want observed status to be kept\n")
+			}
+		})
+	}
+}
+
+// A centralMock mocks the parts of the central API used by CentralInstances.
+type centralMock struct {
+	*centralclient.APITokenAPIMock
+	*centralclient.RestoreAPIMock
+}
+
+func withRestoreFrom(c *v1alpha1.CentralInstance) {
+	c.Spec.ForProvider.RestoreFrom = &xpv1.Reference{Name: "old-central"}
+}
+
+// finishedProcess returns a process working on the supplied key that
+// finished with the supplied error.
+func finishedProcess(key string, err error) *process {
+	p := &process{key: key, done: make(chan struct{}), err: err}
+	close(p.done)
+	return p
+}
+
+func TestRestoring(t *testing.T) {
+	key := "backups/backup_2023-03-01T00:00:00.zip"
+	withRestored := func(c *v1alpha1.CentralInstance) {
+		meta.AddAnnotations(c, map[string]string{v1alpha1.AnnotationRestoredBackup: key})
+	}
+
+	type want struct {
+		pending bool
+		running bool
+	}
+
+	cases := []struct {
+		name    string
+		mg      *v1alpha1.CentralInstance
+		process *process
+		want    want
+	}{
+		{
+			name: "no backup referenced",
+			mg:   centralInstance(),
+		},
+		{
+			name: "central not ready",
+			mg:   centralInstance(withRestoreFrom, withStatus(rhacs.CentralRequestStatusProvisioning)),
+		},
+		{
+			name: "already restored",
+			mg:   centralInstance(withRestoreFrom, withRestored),
+		},
+		{
+			name: "not yet started",
+			mg:   centralInstance(withRestoreFrom),
+			want: want{pending: true},
+		},
+		{
+			name:    "running",
+			mg:      centralInstance(withRestoreFrom),
+			process: &process{key: key, done: make(chan struct{})},
+			want:    want{pending: true, running: true},
+		},
+		{
+			name:    "finished",
+			mg:      centralInstance(withRestoreFrom),
+			process: finishedProcess(key, nil),
+			want:    want{pending: true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := external{restores: newProcesses(restoreTimeout)}
+			if tc.process != nil {
+				e.restores.processes[tc.mg.GetUID()] = tc.process
+			}
+			pending, running := e.restoring(tc.mg)
+			if diff := cmp.Diff(tc.want, want{pending: pending, running: running}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\ne.restoring(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	uiURL := "https://central.example.com"
+	key := "backups/backup_2023-03-01T00:00:00.zip"
+	withUIURL := func(c *v1alpha1.CentralInstance) { c.Status.AtProvider.CentralUIURL = uiURL }
+
+	backup := func(key string) *v1alpha1.CentralBackup {
+		b := centralBackup()
+		b.Status.AtProvider.ObjectKey = key
+		b.Status.AtProvider.Size = 42
+		return b
+	}
+
+	type want struct {
+		restored   bool
+		annotation string
+		started    bool
+		tracked    bool
+		err        error
+	}
+
+	cases := []struct {
+		name      string
+		mg        *v1alpha1.CentralInstance
+		backup    *v1alpha1.CentralBackup
+		process   *process
+		recordErr error
+		want      want
+	}{
+		{
+			name:   "backup not yet written",
+			mg:     centralInstance(withUIURL, withRestoreFrom),
+			backup: backup(""),
+			want:   want{err: cmpopts.AnyError},
+		},
+		{
+			name:   "restore started",
+			mg:     centralInstance(withUIURL, withRestoreFrom),
+			backup: backup(key),
+			want:   want{started: true, tracked: true},
+		},
+		{
+			name:    "restore running",
+			mg:      centralInstance(withUIURL, withRestoreFrom),
+			process: &process{key: key, done: make(chan struct{})},
+			want:    want{tracked: true},
+		},
+		{
+			name:    "restore finished",
+			mg:      centralInstance(withUIURL, withRestoreFrom),
+			process: finishedProcess(key, nil),
+			want:    want{restored: true, annotation: key},
+		},
+		{
+			name:    "restore failed",
+			mg:      centralInstance(withUIURL, withRestoreFrom),
+			process: finishedProcess(key, errors.New("boom")),
+			want:    want{err: cmpopts.AnyError},
+		},
+		{
+			name:      "record error",
+			mg:        centralInstance(withUIURL, withRestoreFrom),
+			process:   finishedProcess(key, nil),
+			recordErr: errors.New("boom"),
+			want:      want{annotation: key, tracked: true, err: cmpopts.AnyError},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			restored := make(chan int64, 1)
+			e := external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						switch o := obj.(type) {
+						case *v1alpha1.CentralBackup:
+							tc.backup.DeepCopyInto(o)
+						case *corev1.Secret:
+							o.Data = map[string][]byte{"id": []byte("minio"), "key": []byte("minio123")}
+						}
+						return nil
+					}),
+				},
+				objectStore: func(cfg s3.Config) (s3.Client, error) {
+					return &s3.ClientMock{
+						GetObjectFunc: func(ctx context.Context, bucket, k string) (s3.Object, error) {
+							if bucket != "backups" || k != key {
+								t.Errorf("\ne.restore(...): want object %q of bucket %q, got %q of %q\n", key, "backups", k, bucket)
+							}
+							return &s3.ObjectMock{CloseFunc: func() error { return nil }}, nil
+						},
+					}, nil
+				},
+				centralClient: func(ctx context.Context, url string) (centralAPI, error) {
+					return centralMock{RestoreAPIMock: &centralclient.RestoreAPIMock{
+						RestoreDatabaseFunc: func(ctx context.Context, archive io.ReaderAt, size int64) error {
+							restored <- size
+							return nil
+						},
+					}}, nil
+				},
+				restores: newProcesses(restoreTimeout),
+				annotations: managed.CriticalAnnotationUpdateFn(func(ctx context.Context, o client.Object) error {
+					return tc.recordErr
+				}),
+			}
+			if tc.process != nil {
+				e.restores.processes[tc.mg.GetUID()] = tc.process
+			}
+			got, err := e.restore(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.restore(...): -want error, +got error:\n%s\n", diff)
+			}
+			if got != tc.want.restored {
+				t.Errorf("\ne.restore(...): want restored %t, got %t\n", tc.want.restored, got)
+			}
+			if diff := cmp.Diff(tc.want.annotation, tc.mg.GetAnnotations()[v1alpha1.AnnotationRestoredBackup]); diff != "" {
+				t.Errorf("\ne.restore(...): -want restored backup, +got restored backup:\n%s\n", diff)
+			}
+			p := e.restores.get(tc.mg.GetUID())
+			if (p != nil) != tc.want.tracked {
+				t.Errorf("\ne.restore(...): want restore tracked %t, got %t\n", tc.want.tracked, p != nil)
+			}
+			if !tc.want.started {
+				return
+			}
+			if size := <-restored; size != 42 {
+				t.Errorf("\ne.restore(...): want size 42, got %d\n", size)
+			}
+			<-p.done
+			if p.err != nil || p.key != key {
+				t.Errorf("\ne.restore(...): want restore of %q to succeed, got %q and %v\n", key, p.key, p.err)
+			}
+		})
+	}
+}
+
+func TestThrottler(t *testing.T) {
+	cases := []struct {
+		name    string
+		limited bool
+		calls   int
+		want    bool
+	}{
+		{
+			name:    "rate limit exhausted",
+			limited: true,
+			calls:   1,
+			want:    true,
+		},
+		{
+			name:  "throttled call requeues reconcile",
+			calls: 2,
+			want:  true,
+		},
+		{
+			name:  "calls within rate limit",
+			calls: 1,
+		},
+		{
+			name:    "reconcile without calls",
+			limited: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			lim := rhacs.NewRateLimiters().Get("default", &apisv1alpha1.RateLimit{RequestsPerSecond: 1, Burst: 1})
+			if tc.limited {
+				lim.Allow()
+			}
+			fm := rhacs.NewRateLimitedClient(&fleetmanager.PublicAPIMock{
+				GetCentralByIdFunc: func(ctx context.Context, id string) (public.CentralRequest, *http.Response, error) {
+					return public.CentralRequest{}, nil, nil
+				},
+			}, lim, "default")
+			th := &throttler{
+				inner: reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+					ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+					defer cancel()
+					for i := 0; i < tc.calls; i++ {
+						if _, _, err := fm.GetCentralById(ctx, "test-id"); err != nil {
+							return reconcile.Result{}, err
+						}
+					}
+					return reconcile.Result{}, nil
+				}),
+			}
+			got, err := th.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+			if err != nil {
+				t.Errorf("\nth.Reconcile(...): unexpected error: %s\n", err)
+			}
+			if diff := cmp.Diff(tc.want, got.RequeueAfter > 0); diff != "" {
+				t.Errorf("\nth.Reconcile(...): -want requeue, +got requeue:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPoller(t *testing.T) {
+	pi := PollIntervals{Transitional: 10 * time.Second, Ready: 5 * time.Minute}
+	polled := reconcile.Result{RequeueAfter: time.Minute}
+
+	withAnnotation := func(k, v string) centralInstanceModifier {
+		return func(c *v1alpha1.CentralInstance) { meta.AddAnnotations(c, map[string]string{k: v}) }
+	}
+	withDeletionTimestamp := func(c *v1alpha1.CentralInstance) {
+		now := metav1.Now()
+		c.SetDeletionTimestamp(&now)
+	}
+
+	cases := []struct {
+		name   string
+		cr     *v1alpha1.CentralInstance
+		result reconcile.Result
+		want   reconcile.Result
+	}{
+		{
+			name:   "ready",
+			cr:     centralInstance(withStatus(rhacs.CentralRequestStatusReady)),
+			result: polled,
+			want:   reconcile.Result{RequeueAfter: pi.Ready},
+		},
+		{
+			name:   "provisioning",
+			cr:     centralInstance(withStatus(rhacs.CentralRequestStatusProvisioning)),
+			result: polled,
+			want:   reconcile.Result{RequeueAfter: pi.Transitional},
+		},
+		{
+			name:   "annotation override",
+			cr:     centralInstance(withStatus(rhacs.CentralRequestStatusReady), withAnnotation(v1alpha1.AnnotationPollIntervalReady, "30m")),
+			result: polled,
+			want:   reconcile.Result{RequeueAfter: 30 * time.Minute},
+		},
+		{
+			name:   "invalid annotation",
+			cr:     centralInstance(withStatus(rhacs.CentralRequestStatusAccepted), withAnnotation(v1alpha1.AnnotationPollIntervalTransitional, "soon")),
+			result: polled,
+			want:   reconcile.Result{RequeueAfter: pi.Transitional},
+		},
+		{
+			name:   "failed",
+			cr:     centralInstance(withStatus(rhacs.CentralRequestStatusFailed)),
+			result: polled,
+			want:   polled,
+		},
+		{
+			name:   "waiting for deletion",
+			cr:     centralInstance(withStatus(rhacs.CentralRequestStatusDeleting), withDeletionTimestamp),
+			result: reconcile.Result{Requeue: true},
+			want:   reconcile.Result{RequeueAfter: pi.Transitional},
+		},
+		{
+			name:   "requeue after error",
+			cr:     centralInstance(withStatus(rhacs.CentralRequestStatusReady)),
+			result: reconcile.Result{Requeue: true},
+			want:   reconcile.Result{Requeue: true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &poller{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						tc.cr.DeepCopyInto(obj.(*v1alpha1.CentralInstance))
+						return nil
+					}),
+				},
+				intervals: pi,
+				inner: reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+					return tc.result, nil
+				}),
+			}
+			got, err := p.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+			if err != nil {
+				t.Errorf("\np.Reconcile(...): unexpected error: %s\n", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\np.Reconcile(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+const (
+	errNotCollection     = "managed resource is not a Collection custom resource"
+	errObserveCollection = "cannot observe collection"
+	errCreateCollection  = "cannot create collection"
+	errUpdateCollection  = "cannot update collection"
+	errDeleteCollection  = "cannot delete collection"
+)
+
+// setupCollection adds a controller that reconciles Collection managed
+// resources.
+func setupCollection(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	return setupCentralResource(mgr, o, cf, v1alpha1.CollectionGroupVersionKind, &v1alpha1.Collection{},
+		func(c central.Client) managed.ExternalClient { return &collectionExternal{client: c} })
+}
+
+// A collectionExternal observes, then either creates, updates, or deletes a
+// collection of Central.
+type collectionExternal struct {
+	client central.CollectionAPI
+}
+
+func generateCollection(id string, in *v1alpha1.CollectionParameters) *central.Collection {
+	c := &central.Collection{
+		ID:                    id,
+		Name:                  in.Name,
+		Description:           in.Description,
+		EmbeddedCollectionIDs: in.EmbeddedCollectionIDs,
+	}
+	for _, s := range in.ResourceSelectors {
+		sel := central.ResourceSelector{}
+		for _, r := range s.Rules {
+			rule := central.SelectorRule{FieldName: string(r.FieldName), Operator: string(r.Operator)}
+			for _, v := range r.Values {
+				rule.Values = append(rule.Values, central.RuleValue{Value: v.Value, MatchType: string(v.MatchType)})
+			}
+			sel.Rules = append(sel.Rules, rule)
+		}
+		c.ResourceSelectors = append(c.ResourceSelectors, sel)
+	}
+	return c
+}
+
+func isCollectionUpToDate(in *v1alpha1.Collection, observed *central.Collection) (bool, string) {
+	desired := generateCollection(observed.ID, &in.Spec.ForProvider)
+	sortIDs := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if diff := cmp.Diff(desired, observed, cmpopts.EquateEmpty(), sortIDs); diff != "" {
+		diff = "Observed difference in collection\n" + diff
+		return false, diff
+	}
+	return true, ""
+}
+
+func (c *collectionExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Collection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCollection)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	col, err := c.client.GetCollection(ctx, id)
+	if central.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveCollection)
+	}
+
+	cr.Status.AtProvider = v1alpha1.CollectionObservation{ID: col.ID}
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isCollectionUpToDate(cr, col)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *collectionExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Collection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCollection)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	col, err := c.client.CreateCollection(ctx, generateCollection("", &cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCollection)
+	}
+	meta.SetExternalName(cr, col.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *collectionExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Collection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCollection)
+	}
+
+	err := c.client.UpdateCollection(ctx, generateCollection(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCollection)
+}
+
+func (c *collectionExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Collection)
+	if !ok {
+		return errors.New(errNotCollection)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteCollection(ctx, meta.GetExternalName(cr))
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteCollection)
+}
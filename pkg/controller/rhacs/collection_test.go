@@ -0,0 +1,195 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+var _ managed.ExternalClient = &collectionExternal{}
+
+var collectionID = "collection-id"
+
+type collectionModifier func(*v1alpha1.Collection)
+
+func withCollectionConditions(c ...xpv1.Condition) collectionModifier {
+	return func(col *v1alpha1.Collection) { col.Status.ConditionedStatus.Conditions = c }
+}
+
+func withCollectionExternalName(id string) collectionModifier {
+	return func(col *v1alpha1.Collection) { meta.SetExternalName(col, id) }
+}
+
+func withCollectionObservation(o v1alpha1.CollectionObservation) collectionModifier {
+	return func(col *v1alpha1.Collection) { col.Status.AtProvider = o }
+}
+
+func collection(mod ...collectionModifier) *v1alpha1.Collection {
+	c := &v1alpha1.Collection{
+		ObjectMeta: metav1.ObjectMeta{Name: "team"},
+		Spec: v1alpha1.CollectionSpec{
+			ForProvider: v1alpha1.CollectionParameters{
+				Name: "team",
+				ResourceSelectors: []v1alpha1.ResourceSelector{{
+					Rules: []v1alpha1.SelectorRule{{
+						FieldName: "Namespace Label",
+						Operator:  "OR",
+						Values:    []v1alpha1.RuleValue{{Value: "team=payments", MatchType: "EXACT"}},
+					}},
+				}},
+				EmbeddedCollectionIDs: []string{"b", "a"},
+				CentralURL:            "https://central.example.com",
+			},
+		},
+	}
+	for _, m := range mod {
+		m(c)
+	}
+	return c
+}
+
+func centralCollection(mod ...func(*central.Collection)) *central.Collection {
+	c := &central.Collection{
+		ID:   collectionID,
+		Name: "team",
+		ResourceSelectors: []central.ResourceSelector{{
+			Rules: []central.SelectorRule{{
+				FieldName: "Namespace Label",
+				Operator:  "OR",
+				Values:    []central.RuleValue{{Value: "team=payments", MatchType: "EXACT"}},
+			}},
+		}},
+		EmbeddedCollectionIDs: []string{"a", "b"},
+	}
+	for _, m := range mod {
+		m(c)
+	}
+	return c
+}
+
+func TestCollectionObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name       string
+		collection *central.Collection
+		err        error
+		want       want
+	}{
+		{
+			name:       "collection up to date",
+			collection: centralCollection(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: collection(withCollectionExternalName(collectionID), withCollectionConditions(xpv1.Available()),
+					withCollectionObservation(v1alpha1.CollectionObservation{ID: collectionID})),
+			},
+		},
+		{
+			name: "rule changed",
+			collection: centralCollection(func(c *central.Collection) {
+				c.ResourceSelectors[0].Rules[0].Values[0].MatchType = "REGEX"
+			}),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg: collection(withCollectionExternalName(collectionID), withCollectionConditions(xpv1.Available()),
+					withCollectionObservation(v1alpha1.CollectionObservation{ID: collectionID})),
+			},
+		},
+		{
+			name: "collection not found",
+			err:  &central.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  collection(withCollectionExternalName(collectionID)),
+			},
+		},
+		{
+			name: "get error",
+			err:  errors.New("boom"),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  collection(withCollectionExternalName(collectionID)),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := collectionExternal{client: &central.CollectionAPIMock{
+				GetCollectionFunc: func(ctx context.Context, id string) (*central.Collection, error) {
+					return tc.collection, tc.err
+				},
+			}}
+			mg := collection(withCollectionExternalName(collectionID))
+			got, err := e.Observe(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCollectionCreate(t *testing.T) {
+	e := collectionExternal{client: &central.CollectionAPIMock{
+		CreateCollectionFunc: func(ctx context.Context, c *central.Collection) (*central.Collection, error) {
+			want := centralCollection(func(c *central.Collection) {
+				c.ID = ""
+				c.EmbeddedCollectionIDs = []string{"b", "a"}
+			})
+			if diff := cmp.Diff(want, c); diff != "" {
+				t.Errorf("\ne.Create(...): -want collection, +got collection:\n%s\n", diff)
+			}
+			return centralCollection(), nil
+		},
+	}}
+	mg := collection()
+	if _, err := e.Create(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %s\n", err)
+	}
+	want := collection(withCollectionConditions(xpv1.Creating()), withCollectionExternalName(collectionID))
+	if diff := cmp.Diff(want, mg); diff != "" {
+		t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+	"github.com/stehessel/provider-redhat/pkg/secrethash"
+)
+
+const (
+	errNotImageIntegration     = "managed resource is not an ImageIntegration custom resource"
+	errImageIntegrationType    = "exactly one of docker, quay, ecr and google must be set"
+	errObserveImageIntegration = "cannot observe image integration"
+	errCreateImageIntegration  = "cannot create image integration"
+	errUpdateImageIntegration  = "cannot update image integration"
+	errDeleteImageIntegration  = "cannot delete image integration"
+	errImageIntegrationSecrets = "cannot get image integration secrets"
+)
+
+// imageIntegrationTestInterval is the interval at which image integrations
+// are tested through Central, in addition to after each change.
+const imageIntegrationTestInterval = time.Hour
+
+// setupImageIntegration adds a controller that reconciles ImageIntegration
+// managed resources.
+func setupImageIntegration(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	kube := mgr.GetClient()
+	return setupCentralResource(mgr, o, cf, v1alpha1.ImageIntegrationGroupVersionKind, &v1alpha1.ImageIntegration{},
+		func(c central.Client) managed.ExternalClient {
+			return &imageIntegrationExternal{client: c, kube: kube, now: time.Now}
+		})
+}
+
+// An imageIntegrationExternal observes, then either creates, updates, or
+// deletes an image integration of Central. Credentials are read from
+// secrets. Integrations are tested through Central after each change and
+// periodically.
+type imageIntegrationExternal struct {
+	client central.ImageIntegrationAPI
+	kube   client.Client
+	now    func() time.Time
+}
+
+// generateImageIntegration returns the Central image integration described by
+// the supplied ImageIntegration, together with a hash of its credentials.
+func (c *imageIntegrationExternal) generateImageIntegration(ctx context.Context, cr *v1alpha1.ImageIntegration) (*central.ImageIntegration, string, error) {
+	p := cr.Spec.ForProvider
+	ii := &central.ImageIntegration{
+		ID:                  meta.GetExternalName(cr),
+		Name:                p.Name,
+		SkipTestIntegration: p.SkipTestIntegration,
+	}
+	for _, cat := range p.Categories {
+		ii.Categories = append(ii.Categories, string(cat))
+	}
+	if len(ii.Categories) == 0 {
+		ii.Categories = []string{"REGISTRY"}
+	}
+
+	types := 0
+	secrets := []string{}
+	secret := func(sel *xpv1.SecretKeySelector) (string, error) {
+		if sel == nil {
+			secrets = append(secrets, "")
+			return "", nil
+		}
+		v, err := secrethash.GetSecretValue(ctx, c.kube, *sel)
+		secrets = append(secrets, v)
+		return v, errors.Wrap(err, errImageIntegrationSecrets)
+	}
+
+	var err error
+	if d := p.Docker; d != nil {
+		types++
+		ii.Type = central.ImageIntegrationTypeDocker
+		ii.Docker = &central.DockerImageIntegration{Endpoint: d.Endpoint, Username: d.Username, Insecure: d.Insecure}
+		if ii.Docker.Password, err = secret(d.PasswordSecretRef); err != nil {
+			return nil, "", err
+		}
+	}
+	if q := p.Quay; q != nil {
+		types++
+		ii.Type = central.ImageIntegrationTypeQuay
+		ii.Quay = &central.QuayImageIntegration{Endpoint: q.Endpoint, Insecure: q.Insecure}
+		if ii.Quay.OAuthToken, err = secret(q.OAuthTokenSecretRef); err != nil {
+			return nil, "", err
+		}
+		if r := q.RobotAccount; r != nil {
+			ii.Quay.RegistryRobotCredentials = &central.QuayRobotAccountCredentials{Username: r.Username}
+			if ii.Quay.RegistryRobotCredentials.Password, err = secret(&r.PasswordSecretRef); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+	if e := p.ECR; e != nil {
+		types++
+		ii.Type = central.ImageIntegrationTypeECR
+		ii.ECR = &central.ECRImageIntegration{
+			RegistryID: e.RegistryID,
+			Region:     e.Region,
+			Endpoint:   e.Endpoint,
+			UseIAM:     e.UseIAM,
+		}
+		if a := e.AssumeRole; a != nil {
+			ii.ECR.UseAssumeRole = true
+			ii.ECR.AssumeRoleID = a.RoleID
+			ii.ECR.AssumeRoleExternalID = a.ExternalID
+		}
+		if ii.ECR.AccessKeyID, err = secret(e.AccessKeyIDSecretRef); err != nil {
+			return nil, "", err
+		}
+		if ii.ECR.SecretAccessKey, err = secret(e.SecretAccessKeySecretRef); err != nil {
+			return nil, "", err
+		}
+	}
+	if g := p.Google; g != nil {
+		types++
+		ii.Type = central.ImageIntegrationTypeGoogle
+		ii.Google = &central.GoogleImageIntegration{Endpoint: g.Endpoint, Project: g.Project}
+		if ii.Google.ServiceAccount, err = secret(&g.ServiceAccountSecretRef); err != nil {
+			return nil, "", err
+		}
+	}
+	if types != 1 {
+		return nil, "", errors.New(errImageIntegrationType)
+	}
+	return ii, secrethash.Hash(secrets), nil
+}
+
+// scrubImageIntegration returns a copy of the supplied image integration
+// without its sensitive fields, which Central masks.
+func scrubImageIntegration(in *central.ImageIntegration) *central.ImageIntegration {
+	ii := *in
+	if ii.Docker != nil {
+		d := *ii.Docker
+		d.Password = ""
+		ii.Docker = &d
+	}
+	if ii.Quay != nil {
+		q := *ii.Quay
+		q.OAuthToken = ""
+		if q.RegistryRobotCredentials != nil {
+			r := *q.RegistryRobotCredentials
+			r.Password = ""
+			q.RegistryRobotCredentials = &r
+		}
+		ii.Quay = &q
+	}
+	if ii.ECR != nil {
+		e := *ii.ECR
+		e.SecretAccessKey = ""
+		ii.ECR = &e
+	}
+	if ii.Google != nil {
+		g := *ii.Google
+		g.ServiceAccount = ""
+		ii.Google = &g
+	}
+	return &ii
+}
+
+func isImageIntegrationUpToDate(in *v1alpha1.ImageIntegration, desired *central.ImageIntegration, hash string, observed *central.ImageIntegration) (bool, string) {
+	d, o := scrubImageIntegration(desired), scrubImageIntegration(observed)
+	// Central derives the endpoint of ECR registries if none is given.
+	if d.ECR != nil && o.ECR != nil && d.ECR.Endpoint == "" {
+		d.ECR.Endpoint = o.ECR.Endpoint
+	}
+	ignore := cmpopts.IgnoreFields(central.ImageIntegration{}, "SkipTestIntegration")
+	if diff := cmp.Diff(d, o, cmpopts.EquateEmpty(), ignore); diff != "" {
+		diff = "Observed difference in image integration\n" + diff
+		return false, diff
+	}
+	if secrethash.Changed(&in.Status.AtProvider.SecretObservation, hash) {
+		return false, "Referenced secrets of image integration changed"
+	}
+	return true, ""
+}
+
+// needsTest returns true if the supplied ImageIntegration changed since its
+// last test, or if the last test is older than the test interval.
+func (c *imageIntegrationExternal) needsTest(cr *v1alpha1.ImageIntegration) bool {
+	t := cr.Status.AtProvider.Test
+	return t == nil || t.ObservedGeneration != cr.GetGeneration() ||
+		!c.now().Before(t.LastTestTime.Add(imageIntegrationTestInterval))
+}
+
+// test tests the supplied image integration through Central, and records the
+// result in the status of the supplied ImageIntegration.
+func (c *imageIntegrationExternal) test(ctx context.Context, cr *v1alpha1.ImageIntegration, ii *central.ImageIntegration) {
+	result := &v1alpha1.ImageIntegrationTest{
+		Succeeded:          true,
+		LastTestTime:       metav1.NewTime(c.now()),
+		ObservedGeneration: cr.GetGeneration(),
+	}
+	if err := c.client.TestImageIntegration(ctx, ii); err != nil {
+		result.Succeeded = false
+		result.Message = err.Error()
+	}
+	cr.Status.AtProvider.Test = result
+}
+
+func (c *imageIntegrationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ImageIntegration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotImageIntegration)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	ii, err := c.client.GetImageIntegration(ctx, id)
+	if central.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveImageIntegration)
+	}
+	desired, hash, err := c.generateImageIntegration(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveImageIntegration)
+	}
+
+	cr.Status.AtProvider.ID = ii.ID
+	cr.Status.AtProvider.Type = ii.Type
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isImageIntegrationUpToDate(cr, desired, hash, ii)
+	// Outdated integrations are tested once they have been updated.
+	if upToDate && c.needsTest(cr) {
+		c.test(ctx, cr, desired)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *imageIntegrationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ImageIntegration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotImageIntegration)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	desired, _, err := c.generateImageIntegration(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateImageIntegration)
+	}
+	ii, err := c.client.CreateImageIntegration(ctx, desired)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateImageIntegration)
+	}
+	meta.SetExternalName(cr, ii.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *imageIntegrationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ImageIntegration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotImageIntegration)
+	}
+
+	desired, hash, err := c.generateImageIntegration(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateImageIntegration)
+	}
+	if err := c.client.UpdateImageIntegration(ctx, desired); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateImageIntegration)
+	}
+	cr.Status.AtProvider.SecretHash = hash
+	// The updated integration is tested by the next observation.
+	cr.Status.AtProvider.Test = nil
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *imageIntegrationExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ImageIntegration)
+	if !ok {
+		return errors.New(errNotImageIntegration)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteImageIntegration(ctx, meta.GetExternalName(cr))
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteImageIntegration)
+}
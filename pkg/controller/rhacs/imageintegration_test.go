@@ -0,0 +1,311 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+var _ managed.ExternalClient = &imageIntegrationExternal{}
+
+var (
+	imageIntegrationID = "image-integration-id"
+	testTime           = time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+)
+
+type imageIntegrationModifier func(*v1alpha1.ImageIntegration)
+
+func withImageIntegrationSecretHash(h string) imageIntegrationModifier {
+	return func(i *v1alpha1.ImageIntegration) { i.Status.AtProvider.SecretHash = h }
+}
+
+func withImageIntegrationTest(t *v1alpha1.ImageIntegrationTest) imageIntegrationModifier {
+	return func(i *v1alpha1.ImageIntegration) { i.Status.AtProvider.Test = t }
+}
+
+func withImageIntegrationParameters(f func(*v1alpha1.ImageIntegrationParameters)) imageIntegrationModifier {
+	return func(i *v1alpha1.ImageIntegration) { f(&i.Spec.ForProvider) }
+}
+
+func imageIntegration(mod ...imageIntegrationModifier) *v1alpha1.ImageIntegration {
+	i := &v1alpha1.ImageIntegration{
+		ObjectMeta: metav1.ObjectMeta{Name: "quay", Generation: 2},
+		Spec: v1alpha1.ImageIntegrationSpec{
+			ForProvider: v1alpha1.ImageIntegrationParameters{
+				Name:       "quay",
+				Categories: []v1alpha1.ImageIntegrationCategory{"REGISTRY", "SCANNER"},
+				Quay: &v1alpha1.QuayImageIntegration{
+					Endpoint: "quay.io",
+					OAuthTokenSecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "quay", Namespace: "crossplane-system"},
+						Key:             "token",
+					},
+					RobotAccount: &v1alpha1.QuayRobotAccount{
+						Username: "org+robot",
+						PasswordSecretRef: xpv1.SecretKeySelector{
+							SecretReference: xpv1.SecretReference{Name: "quay", Namespace: "crossplane-system"},
+							Key:             "token",
+						},
+					},
+				},
+				CentralURL: "https://central.example.com",
+			},
+		},
+	}
+	meta.SetExternalName(i, imageIntegrationID)
+	for _, m := range mod {
+		m(i)
+	}
+	return i
+}
+
+func centralImageIntegration(mod ...func(*central.ImageIntegration)) *central.ImageIntegration {
+	i := &central.ImageIntegration{
+		ID:         imageIntegrationID,
+		Name:       "quay",
+		Type:       central.ImageIntegrationTypeQuay,
+		Categories: []string{"REGISTRY", "SCANNER"},
+		Quay: &central.QuayImageIntegration{
+			Endpoint:                 "quay.io",
+			RegistryRobotCredentials: &central.QuayRobotAccountCredentials{Username: "org+robot"},
+		},
+	}
+	for _, m := range mod {
+		m(i)
+	}
+	return i
+}
+
+func TestGenerateImageIntegration(t *testing.T) {
+	cases := []struct {
+		name string
+		mg   *v1alpha1.ImageIntegration
+		want *central.ImageIntegration
+		err  error
+	}{
+		{
+			name: "quay with robot account",
+			mg:   imageIntegration(),
+			want: centralImageIntegration(func(i *central.ImageIntegration) {
+				i.Quay.OAuthToken = "s3cr3t"
+				i.Quay.RegistryRobotCredentials.Password = "s3cr3t"
+			}),
+		},
+		{
+			name: "ecr with assumed role",
+			mg: imageIntegration(withImageIntegrationParameters(func(p *v1alpha1.ImageIntegrationParameters) {
+				p.Categories = nil
+				p.Quay = nil
+				p.ECR = &v1alpha1.ECRImageIntegration{
+					RegistryID: "123456789012",
+					Region:     "us-east-1",
+					UseIAM:     true,
+					AssumeRole: &v1alpha1.ECRAssumeRole{RoleID: "arn:aws:iam::123456789012:role/central"},
+				}
+			})),
+			want: &central.ImageIntegration{
+				ID:         imageIntegrationID,
+				Name:       "quay",
+				Type:       central.ImageIntegrationTypeECR,
+				Categories: []string{"REGISTRY"},
+				ECR: &central.ECRImageIntegration{
+					RegistryID:    "123456789012",
+					Region:        "us-east-1",
+					UseIAM:        true,
+					UseAssumeRole: true,
+					AssumeRoleID:  "arn:aws:iam::123456789012:role/central",
+				},
+			},
+		},
+		{
+			name: "several types",
+			mg: imageIntegration(withImageIntegrationParameters(func(p *v1alpha1.ImageIntegrationParameters) {
+				p.Docker = &v1alpha1.DockerImageIntegration{Endpoint: "registry.example.com"}
+			})),
+			err: cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &imageIntegrationExternal{kube: secretClient(map[string][]byte{"token": []byte("s3cr3t")})}
+			got, _, err := e.generateImageIntegration(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.generateImageIntegration(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\ne.generateImageIntegration(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestImageIntegrationObserve(t *testing.T) {
+	e := &imageIntegrationExternal{
+		kube: secretClient(map[string][]byte{"token": []byte("s3cr3t")}),
+		now:  func() time.Time { return testTime },
+	}
+	_, hash, err := e.generateImageIntegration(context.Background(), imageIntegration())
+	if err != nil {
+		t.Fatalf("generateImageIntegration(...): unexpected error: %s", err)
+	}
+	recent := &v1alpha1.ImageIntegrationTest{
+		Succeeded:          true,
+		LastTestTime:       metav1.NewTime(testTime.Add(-time.Minute)),
+		ObservedGeneration: 2,
+	}
+
+	type want struct {
+		obs    managed.ExternalObservation
+		hash   string
+		tested bool
+		test   *v1alpha1.ImageIntegrationTest
+	}
+
+	cases := []struct {
+		name    string
+		mg      *v1alpha1.ImageIntegration
+		ii      *central.ImageIntegration
+		testErr error
+		want    want
+	}{
+		{
+			name: "untested integration succeeds",
+			mg:   imageIntegration(withImageIntegrationSecretHash(hash)),
+			ii:   centralImageIntegration(),
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				tested: true,
+				test:   &v1alpha1.ImageIntegrationTest{Succeeded: true, LastTestTime: metav1.NewTime(testTime), ObservedGeneration: 2},
+			},
+		},
+		{
+			name:    "untested integration fails",
+			mg:      imageIntegration(withImageIntegrationSecretHash(hash)),
+			ii:      centralImageIntegration(),
+			testErr: &central.APIError{API: "central", StatusCode: http.StatusBadRequest, Message: "unauthorized"},
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				tested: true,
+				test: &v1alpha1.ImageIntegrationTest{
+					Message:            "central API responded with 400: unauthorized",
+					LastTestTime:       metav1.NewTime(testTime),
+					ObservedGeneration: 2,
+				},
+			},
+		},
+		{
+			name: "recently tested integration",
+			mg:   imageIntegration(withImageIntegrationSecretHash(hash), withImageIntegrationTest(recent)),
+			ii:   centralImageIntegration(),
+			want: want{
+				obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				test: recent,
+			},
+		},
+		{
+			name: "secret hash recorded on first observation",
+			mg:   imageIntegration(withImageIntegrationTest(recent)),
+			ii:   centralImageIntegration(),
+			want: want{
+				obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				hash: hash,
+				test: recent,
+			},
+		},
+		{
+			name: "outdated integration is not tested",
+			mg:   imageIntegration(withImageIntegrationSecretHash("outdated")),
+			ii:   centralImageIntegration(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tested := false
+			e.client = &central.ImageIntegrationAPIMock{
+				GetImageIntegrationFunc: func(ctx context.Context, id string) (*central.ImageIntegration, error) {
+					return tc.ii, nil
+				},
+				TestImageIntegrationFunc: func(ctx context.Context, i *central.ImageIntegration) error {
+					tested = true
+					return tc.testErr
+				},
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("\ne.Observe(...): unexpected error: %s\n", err)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if tested != tc.want.tested {
+				t.Errorf("\ne.Observe(...): want tested %t, got %t\n", tc.want.tested, tested)
+			}
+			if tc.want.hash != "" && tc.mg.Status.AtProvider.SecretHash != tc.want.hash {
+				t.Errorf("\ne.Observe(...): recorded secret hash %q, want %q\n", tc.mg.Status.AtProvider.SecretHash, tc.want.hash)
+			}
+			if diff := cmp.Diff(tc.want.test, tc.mg.Status.AtProvider.Test); diff != "" {
+				t.Errorf("\ne.Observe(...): -want test, +got test:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestImageIntegrationUpdate(t *testing.T) {
+	var got *central.ImageIntegration
+	e := &imageIntegrationExternal{
+		client: &central.ImageIntegrationAPIMock{
+			UpdateImageIntegrationFunc: func(ctx context.Context, i *central.ImageIntegration) error {
+				got = i
+				return nil
+			},
+		},
+		kube: secretClient(map[string][]byte{"token": []byte("rotated")}),
+	}
+	mg := imageIntegration(withImageIntegrationSecretHash("outdated"), withImageIntegrationTest(&v1alpha1.ImageIntegrationTest{Succeeded: true}))
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Update(...): unexpected error: %s\n", err)
+	}
+	if got.Quay.OAuthToken != "rotated" {
+		t.Errorf("\ne.Update(...): want rotated token, got %q\n", got.Quay.OAuthToken)
+	}
+	if mg.Status.AtProvider.SecretHash == "outdated" {
+		t.Errorf("\ne.Update(...): secret hash was not updated\n")
+	}
+	if mg.Status.AtProvider.Test != nil {
+		t.Errorf("\ne.Update(...): test result was not reset\n")
+	}
+}
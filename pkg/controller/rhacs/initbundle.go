@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+const (
+	errNotInitBundle       = "managed resource is not an InitBundle custom resource"
+	errObserveInitBundle   = "cannot observe init bundle"
+	errCreateInitBundle    = "cannot create init bundle"
+	errInitBundleDetails   = "cannot extract init bundle connection details"
+	errRevokeInitBundle    = "cannot revoke init bundle"
+	errInitBundleNotExists = "init bundle is not created yet"
+)
+
+// setupInitBundle adds a controller that reconciles InitBundle managed
+// resources.
+func setupInitBundle(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	return setupCentralResource(mgr, o, cf, v1alpha1.InitBundleGroupVersionKind, &v1alpha1.InitBundle{},
+		func(c central.Client) managed.ExternalClient { return &initBundleExternal{client: c} })
+}
+
+// An initBundleExternal generates, observes and revokes init bundles.
+type initBundleExternal struct {
+	client central.InitBundleAPI
+}
+
+func generateInitBundleObservation(in *central.InitBundleMeta) v1alpha1.InitBundleObservation {
+	o := v1alpha1.InitBundleObservation{
+		ID:        in.ID,
+		Name:      in.Name,
+		CreatedAt: toMetaTime(in.CreatedAt),
+		ExpiresAt: toMetaTime(in.ExpiresAt),
+	}
+	for _, c := range in.ImpactedClusters {
+		o.ImpactedClusters = append(o.ImpactedClusters, v1alpha1.ImpactedCluster{ID: c.ID, Name: c.Name})
+	}
+	return o
+}
+
+func toMetaTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	mt := metav1.NewTime(*t)
+	return &mt
+}
+
+// getInitBundleConnectionDetails returns the init bundle in both of its forms,
+// together with the TLS material of the secured cluster services.
+func getInitBundleConnectionDetails(b *central.InitBundle) (managed.ConnectionDetails, error) {
+	tls, err := b.TLS()
+	if err != nil {
+		return nil, errors.Wrap(err, errInitBundleDetails)
+	}
+	return managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyHelmValuesBundle:     b.HelmValuesBundle,
+		v1alpha1.ConnectionKeyKubectlBundle:        b.KubectlBundle,
+		v1alpha1.ConnectionKeyCACert:               []byte(tls.CA.Cert),
+		v1alpha1.ConnectionKeySensorCert:           []byte(tls.Sensor.ServiceTLS.Cert),
+		v1alpha1.ConnectionKeySensorKey:            []byte(tls.Sensor.ServiceTLS.Key),
+		v1alpha1.ConnectionKeyCollectorCert:        []byte(tls.Collector.ServiceTLS.Cert),
+		v1alpha1.ConnectionKeyCollectorKey:         []byte(tls.Collector.ServiceTLS.Key),
+		v1alpha1.ConnectionKeyAdmissionControlCert: []byte(tls.AdmissionControl.ServiceTLS.Cert),
+		v1alpha1.ConnectionKeyAdmissionControlKey:  []byte(tls.AdmissionControl.ServiceTLS.Key),
+	}, nil
+}
+
+func (c *initBundleExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.InitBundle)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInitBundle)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	bundles, err := c.client.ListInitBundles(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveInitBundle)
+	}
+	for i := range bundles {
+		if bundles[i].ID != id {
+			continue
+		}
+		cr.Status.AtProvider = generateInitBundleObservation(&bundles[i])
+		cr.SetConditions(xpv1.Available())
+		// The content of an init bundle cannot be changed once generated.
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	return managed.ExternalObservation{ResourceExists: false}, nil
+}
+
+func (c *initBundleExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.InitBundle)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInitBundle)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	bundle, err := c.client.GenerateInitBundle(ctx, cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInitBundle)
+	}
+	meta.SetExternalName(cr, bundle.Meta.ID)
+
+	// Central returns the content of an init bundle only once, so it is
+	// published right away.
+	cd, err := getInitBundleConnectionDetails(bundle)
+	return managed.ExternalCreation{ConnectionDetails: cd}, err
+}
+
+func (c *initBundleExternal) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.InitBundle); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInitBundle)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *initBundleExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.InitBundle)
+	if !ok {
+		return errors.New(errNotInitBundle)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return errors.New(errInitBundleNotExists)
+	}
+	// Revoking a bundle that secured clusters use must be confirmed by
+	// listing these clusters.
+	impacted := make([]string, 0, len(cr.Status.AtProvider.ImpactedClusters))
+	for _, c := range cr.Status.AtProvider.ImpactedClusters {
+		impacted = append(impacted, c.ID)
+	}
+	err := c.client.RevokeInitBundles(ctx, []string{id}, impacted)
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errRevokeInitBundle)
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+var _ managed.ExternalClient = &initBundleExternal{}
+
+var (
+	bundleID   = "bundle-id"
+	bundleName = "test-bundle"
+	helmValues = `ca:
+  cert: ca-cert
+sensor:
+  serviceTLS:
+    cert: sensor-cert
+    key: sensor-key
+collector:
+  serviceTLS:
+    cert: collector-cert
+    key: collector-key
+admissionControl:
+  serviceTLS:
+    cert: admission-control-cert
+    key: admission-control-key
+`
+)
+
+type initBundleModifier func(*v1alpha1.InitBundle)
+
+func withBundleConditions(c ...xpv1.Condition) initBundleModifier {
+	return func(b *v1alpha1.InitBundle) { b.Status.ConditionedStatus.Conditions = c }
+}
+
+func withBundleExternalName(id string) initBundleModifier {
+	return func(b *v1alpha1.InitBundle) { meta.SetExternalName(b, id) }
+}
+
+func withBundleObservation(o v1alpha1.InitBundleObservation) initBundleModifier {
+	return func(b *v1alpha1.InitBundle) { b.Status.AtProvider = o }
+}
+
+func initBundle(mod ...initBundleModifier) *v1alpha1.InitBundle {
+	b := &v1alpha1.InitBundle{
+		ObjectMeta: metav1.ObjectMeta{Name: bundleName},
+		Spec: v1alpha1.InitBundleSpec{
+			ForProvider: v1alpha1.InitBundleParameters{
+				Name:       bundleName,
+				CentralURL: "https://central.example.com",
+			},
+		},
+	}
+	for _, m := range mod {
+		m(b)
+	}
+	return b
+}
+
+func impactedObservation() v1alpha1.InitBundleObservation {
+	return v1alpha1.InitBundleObservation{
+		ID:               bundleID,
+		Name:             bundleName,
+		ImpactedClusters: []v1alpha1.ImpactedCluster{{ID: "cluster-id", Name: "cluster"}},
+	}
+}
+
+func TestInitBundleObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name   string
+		client central.InitBundleAPI
+		mg     resource.Managed
+		want   want
+	}{
+		{
+			name:   "no external name",
+			client: &central.InitBundleAPIMock{},
+			mg:     initBundle(),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  initBundle(),
+			},
+		},
+		{
+			name: "bundle exists",
+			client: &central.InitBundleAPIMock{
+				ListInitBundlesFunc: func(ctx context.Context) ([]central.InitBundleMeta, error) {
+					return []central.InitBundleMeta{
+						{ID: "other-id", Name: "other"},
+						{ID: bundleID, Name: bundleName, ImpactedClusters: []central.ImpactedCluster{{ID: "cluster-id", Name: "cluster"}}},
+					}, nil
+				},
+			},
+			mg: initBundle(withBundleExternalName(bundleID)),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: initBundle(withBundleExternalName(bundleID),
+					withBundleConditions(xpv1.Available()),
+					withBundleObservation(impactedObservation())),
+			},
+		},
+		{
+			name: "bundle revoked",
+			client: &central.InitBundleAPIMock{
+				ListInitBundlesFunc: func(ctx context.Context) ([]central.InitBundleMeta, error) {
+					return nil, nil
+				},
+			},
+			mg: initBundle(withBundleExternalName(bundleID)),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  initBundle(withBundleExternalName(bundleID)),
+			},
+		},
+		{
+			name: "list error",
+			client: &central.InitBundleAPIMock{
+				ListInitBundlesFunc: func(ctx context.Context) ([]central.InitBundleMeta, error) {
+					return nil, errors.New("boom")
+				},
+			},
+			mg: initBundle(withBundleExternalName(bundleID)),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  initBundle(withBundleExternalName(bundleID)),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := initBundleExternal{client: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestInitBundleCreate(t *testing.T) {
+	type want struct {
+		cre managed.ExternalCreation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name   string
+		client central.InitBundleAPI
+		want   want
+	}{
+		{
+			name: "creation publishes bundle",
+			client: &central.InitBundleAPIMock{
+				GenerateInitBundleFunc: func(ctx context.Context, name string) (*central.InitBundle, error) {
+					return &central.InitBundle{
+						Meta:             central.InitBundleMeta{ID: bundleID, Name: name},
+						HelmValuesBundle: []byte(helmValues),
+						KubectlBundle:    []byte("kubectl-bundle"),
+					}, nil
+				},
+			},
+			want: want{
+				cre: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					v1alpha1.ConnectionKeyHelmValuesBundle:     []byte(helmValues),
+					v1alpha1.ConnectionKeyKubectlBundle:        []byte("kubectl-bundle"),
+					v1alpha1.ConnectionKeyCACert:               []byte("ca-cert"),
+					v1alpha1.ConnectionKeySensorCert:           []byte("sensor-cert"),
+					v1alpha1.ConnectionKeySensorKey:            []byte("sensor-key"),
+					v1alpha1.ConnectionKeyCollectorCert:        []byte("collector-cert"),
+					v1alpha1.ConnectionKeyCollectorKey:         []byte("collector-key"),
+					v1alpha1.ConnectionKeyAdmissionControlCert: []byte("admission-control-cert"),
+					v1alpha1.ConnectionKeyAdmissionControlKey:  []byte("admission-control-key"),
+				}},
+				mg: initBundle(withBundleConditions(xpv1.Creating()), withBundleExternalName(bundleID)),
+			},
+		},
+		{
+			name: "creation error",
+			client: &central.InitBundleAPIMock{
+				GenerateInitBundleFunc: func(ctx context.Context, name string) (*central.InitBundle, error) {
+					return nil, errors.New("boom")
+				},
+			},
+			want: want{
+				mg:  initBundle(withBundleConditions(xpv1.Creating())),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mg := initBundle()
+			e := initBundleExternal{client: tc.client}
+			got, err := e.Create(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, got); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestInitBundleDelete(t *testing.T) {
+	cases := []struct {
+		name    string
+		err     error
+		want    error
+		wantIDs []string
+	}{
+		{
+			name:    "revoke confirms impacted clusters",
+			wantIDs: []string{"cluster-id"},
+		},
+		{
+			name:    "already revoked",
+			err:     &central.APIError{StatusCode: http.StatusNotFound},
+			wantIDs: []string{"cluster-id"},
+		},
+		{
+			name:    "revoke error",
+			err:     errors.New("boom"),
+			want:    cmpopts.AnyError,
+			wantIDs: []string{"cluster-id"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotIDs []string
+			e := initBundleExternal{client: &central.InitBundleAPIMock{
+				RevokeInitBundlesFunc: func(ctx context.Context, ids []string, confirmImpactedClusterIDs []string) error {
+					if diff := cmp.Diff([]string{bundleID}, ids); diff != "" {
+						t.Errorf("\ne.Delete(...): -want revoked, +got revoked:\n%s\n", diff)
+					}
+					gotIDs = confirmImpactedClusterIDs
+					return tc.err
+				},
+			}}
+			mg := initBundle(withBundleExternalName(bundleID), withBundleObservation(impactedObservation()))
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.wantIDs, gotIDs); diff != "" {
+				t.Errorf("\ne.Delete(...): -want confirmed clusters, +got confirmed clusters:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+	"github.com/stehessel/provider-redhat/pkg/secrethash"
+)
+
+const (
+	errNotNotifier     = "managed resource is not a Notifier custom resource"
+	errNotifierType    = "exactly one of slack, webhook, email and splunk must be set"
+	errObserveNotifier = "cannot observe notifier"
+	errCreateNotifier  = "cannot create notifier"
+	errUpdateNotifier  = "cannot update notifier"
+	errDeleteNotifier  = "cannot delete notifier"
+	errNotifierSecrets = "cannot get notifier secrets"
+)
+
+// setupNotifier adds a controller that reconciles Notifier managed resources.
+func setupNotifier(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	kube := mgr.GetClient()
+	return setupCentralResource(mgr, o, cf, v1alpha1.NotifierGroupVersionKind, &v1alpha1.Notifier{},
+		func(c central.Client) managed.ExternalClient { return &notifierExternal{client: c, kube: kube} })
+}
+
+// A notifierExternal observes, then either creates, updates, or deletes a
+// notifier integration of Central. Sensitive fields are read from secrets.
+type notifierExternal struct {
+	client central.NotifierAPI
+	kube   client.Client
+}
+
+// generateNotifier returns the Central notifier described by the supplied
+// Notifier, together with a hash of its sensitive fields.
+func (c *notifierExternal) generateNotifier(ctx context.Context, cr *v1alpha1.Notifier) (*central.Notifier, string, error) {
+	p := cr.Spec.ForProvider
+	n := &central.Notifier{
+		ID:         meta.GetExternalName(cr),
+		Name:       p.Name,
+		UIEndpoint: p.UIEndpoint,
+		LabelKey:   p.LabelKey,
+	}
+	if n.UIEndpoint == "" {
+		n.UIEndpoint = p.CentralURL
+	}
+
+	types := 0
+	secrets := []string{}
+	secret := func(sel *xpv1.SecretKeySelector) (string, error) {
+		if sel == nil {
+			secrets = append(secrets, "")
+			return "", nil
+		}
+		v, err := secrethash.GetSecretValue(ctx, c.kube, *sel)
+		secrets = append(secrets, v)
+		return v, errors.Wrap(err, errNotifierSecrets)
+	}
+
+	var err error
+	if s := p.Slack; s != nil {
+		types++
+		n.Type = central.NotifierTypeSlack
+		// Slack notifiers post to the webhook URL in their default label.
+		if n.LabelDefault, err = secret(&s.WebhookURLSecretRef); err != nil {
+			return nil, "", err
+		}
+	}
+	if w := p.Webhook; w != nil {
+		types++
+		n.Type = central.NotifierTypeGeneric
+		n.Generic = &central.GenericNotifier{
+			Endpoint:            w.Endpoint,
+			SkipTLSVerify:       w.SkipTLSVerify,
+			CACert:              w.CACert,
+			Username:            w.Username,
+			AuditLoggingEnabled: w.AuditLoggingEnabled,
+		}
+		for _, h := range w.Headers {
+			n.Generic.Headers = append(n.Generic.Headers, central.KeyValue{Key: h.Key, Value: h.Value})
+		}
+		for _, f := range w.ExtraFields {
+			n.Generic.ExtraFields = append(n.Generic.ExtraFields, central.KeyValue{Key: f.Key, Value: f.Value})
+		}
+		if n.Generic.Password, err = secret(w.PasswordSecretRef); err != nil {
+			return nil, "", err
+		}
+	}
+	if e := p.Email; e != nil {
+		types++
+		n.Type = central.NotifierTypeEmail
+		n.Email = &central.EmailNotifier{
+			Server:                   e.Server,
+			Sender:                   e.Sender,
+			From:                     e.From,
+			Username:                 e.Username,
+			DisableTLS:               e.DisableTLS,
+			StartTLSAuthMethod:       string(e.StartTLSAuthMethod),
+			AllowUnauthenticatedSMTP: e.AllowUnauthenticatedSMTP,
+		}
+		if n.Email.Password, err = secret(e.PasswordSecretRef); err != nil {
+			return nil, "", err
+		}
+	}
+	if s := p.Splunk; s != nil {
+		types++
+		n.Type = central.NotifierTypeSplunk
+		n.Splunk = &central.SplunkNotifier{
+			HTTPEndpoint:        s.HTTPEndpoint,
+			Insecure:            s.Insecure,
+			Truncate:            s.Truncate,
+			AuditLoggingEnabled: s.AuditLoggingEnabled,
+		}
+		if n.Splunk.HTTPToken, err = secret(&s.HTTPTokenSecretRef); err != nil {
+			return nil, "", err
+		}
+	}
+	if types != 1 {
+		return nil, "", errors.New(errNotifierType)
+	}
+
+	return n, secrethash.Hash(secrets), nil
+}
+
+// scrubNotifier returns a copy of the supplied notifier without its sensitive
+// fields, which Central masks.
+func scrubNotifier(in *central.Notifier) *central.Notifier {
+	n := *in
+	if n.Type == central.NotifierTypeSlack {
+		n.LabelDefault = ""
+	}
+	if n.Generic != nil {
+		g := *n.Generic
+		g.Password = ""
+		n.Generic = &g
+	}
+	if n.Email != nil {
+		e := *n.Email
+		e.Password = ""
+		n.Email = &e
+	}
+	if n.Splunk != nil {
+		s := *n.Splunk
+		s.HTTPToken = ""
+		n.Splunk = &s
+	}
+	return &n
+}
+
+func isNotifierUpToDate(in *v1alpha1.Notifier, desired *central.Notifier, hash string, observed *central.Notifier) (bool, string) {
+	if diff := cmp.Diff(scrubNotifier(desired), scrubNotifier(observed), cmpopts.EquateEmpty()); diff != "" {
+		diff = "Observed difference in notifier\n" + diff
+		return false, diff
+	}
+	if secrethash.Changed(&in.Status.AtProvider.SecretObservation, hash) {
+		return false, "Referenced secrets of notifier changed"
+	}
+	return true, ""
+}
+
+func (c *notifierExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Notifier)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNotifier)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	notifier, err := c.client.GetNotifier(ctx, id)
+	if central.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveNotifier)
+	}
+	desired, hash, err := c.generateNotifier(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveNotifier)
+	}
+
+	cr.Status.AtProvider.ID = notifier.ID
+	cr.Status.AtProvider.Type = notifier.Type
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isNotifierUpToDate(cr, desired, hash, notifier)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *notifierExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Notifier)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNotifier)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	desired, _, err := c.generateNotifier(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNotifier)
+	}
+	notifier, err := c.client.CreateNotifier(ctx, desired)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNotifier)
+	}
+	meta.SetExternalName(cr, notifier.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *notifierExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Notifier)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNotifier)
+	}
+
+	desired, hash, err := c.generateNotifier(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNotifier)
+	}
+	if err := c.client.UpdateNotifier(ctx, desired); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNotifier)
+	}
+	cr.Status.AtProvider.SecretHash = hash
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *notifierExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Notifier)
+	if !ok {
+		return errors.New(errNotNotifier)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteNotifier(ctx, meta.GetExternalName(cr))
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteNotifier)
+}
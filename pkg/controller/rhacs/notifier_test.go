@@ -0,0 +1,294 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+var _ managed.ExternalClient = &notifierExternal{}
+
+var notifierID = "notifier-id"
+
+type notifierModifier func(*v1alpha1.Notifier)
+
+func withNotifierSecretHash(h string) notifierModifier {
+	return func(n *v1alpha1.Notifier) { n.Status.AtProvider.SecretHash = h }
+}
+
+func withNotifierParameters(f func(*v1alpha1.NotifierParameters)) notifierModifier {
+	return func(n *v1alpha1.Notifier) { f(&n.Spec.ForProvider) }
+}
+
+func notifier(mod ...notifierModifier) *v1alpha1.Notifier {
+	n := &v1alpha1.Notifier{
+		ObjectMeta: metav1.ObjectMeta{Name: "slack"},
+		Spec: v1alpha1.NotifierSpec{
+			ForProvider: v1alpha1.NotifierParameters{
+				Name: "slack",
+				Slack: &v1alpha1.SlackNotifier{WebhookURLSecretRef: xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "crossplane-system"},
+					Key:             "url",
+				}},
+				CentralURL: "https://central.example.com",
+			},
+		},
+	}
+	meta.SetExternalName(n, notifierID)
+	for _, m := range mod {
+		m(n)
+	}
+	return n
+}
+
+func centralNotifier(mod ...func(*central.Notifier)) *central.Notifier {
+	n := &central.Notifier{
+		ID:           notifierID,
+		Name:         "slack",
+		Type:         central.NotifierTypeSlack,
+		UIEndpoint:   "https://central.example.com",
+		LabelDefault: "******",
+	}
+	for _, m := range mod {
+		m(n)
+	}
+	return n
+}
+
+func secretClient(data map[string][]byte) client.Client {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.(*corev1.Secret).Data = data
+			return nil
+		}),
+	}
+}
+
+func TestNotifierObserve(t *testing.T) {
+	e := &notifierExternal{kube: secretClient(map[string][]byte{"url": []byte("https://hooks.slack.com/1")})}
+	_, hash, err := e.generateNotifier(context.Background(), notifier())
+	if err != nil {
+		t.Fatalf("generateNotifier(...): unexpected error: %s", err)
+	}
+
+	type want struct {
+		obs  managed.ExternalObservation
+		hash string
+		err  error
+	}
+
+	cases := []struct {
+		name     string
+		mg       *v1alpha1.Notifier
+		notifier *central.Notifier
+		err      error
+		want     want
+	}{
+		{
+			name:     "notifier up to date",
+			mg:       notifier(withNotifierSecretHash(hash)),
+			notifier: centralNotifier(),
+			want:     want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		{
+			name:     "notifier drifted",
+			mg:       notifier(withNotifierSecretHash(hash)),
+			notifier: centralNotifier(func(n *central.Notifier) { n.UIEndpoint = "https://other.example.com" }),
+			want:     want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		{
+			name:     "secret hash recorded on first observation",
+			mg:       notifier(),
+			notifier: centralNotifier(),
+			want:     want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, hash: hash},
+		},
+		{
+			name:     "secret changed",
+			mg:       notifier(withNotifierSecretHash("old")),
+			notifier: centralNotifier(),
+			want:     want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		{
+			name: "multiple types",
+			mg: notifier(withNotifierParameters(func(p *v1alpha1.NotifierParameters) {
+				p.Webhook = &v1alpha1.WebhookNotifier{Endpoint: "https://example.com"}
+			})),
+			notifier: centralNotifier(),
+			want:     want{err: cmpopts.AnyError},
+		},
+		{
+			name: "notifier not found",
+			mg:   notifier(),
+			err:  &central.APIError{StatusCode: http.StatusNotFound},
+			want: want{obs: managed.ExternalObservation{}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e.client = &central.NotifierAPIMock{
+				GetNotifierFunc: func(ctx context.Context, id string) (*central.Notifier, error) {
+					return tc.notifier, tc.err
+				},
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if tc.want.hash != "" && tc.mg.Status.AtProvider.SecretHash != tc.want.hash {
+				t.Errorf("\ne.Observe(...): recorded secret hash %q, want %q\n", tc.mg.Status.AtProvider.SecretHash, tc.want.hash)
+			}
+		})
+	}
+}
+
+func TestNotifierCreate(t *testing.T) {
+	var got *central.Notifier
+	e := &notifierExternal{
+		kube: secretClient(map[string][]byte{"token": []byte("splunk-token")}),
+		client: &central.NotifierAPIMock{
+			CreateNotifierFunc: func(ctx context.Context, n *central.Notifier) (*central.Notifier, error) {
+				got = n
+				created := *n
+				created.ID = notifierID
+				return &created, nil
+			},
+		},
+	}
+	mg := notifier(withNotifierParameters(func(p *v1alpha1.NotifierParameters) {
+		p.Slack = nil
+		p.Splunk = &v1alpha1.SplunkNotifier{
+			HTTPEndpoint: "https://splunk.example.com:8088",
+			HTTPTokenSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "splunk", Namespace: "crossplane-system"},
+				Key:             "token",
+			},
+			Truncate: 10000,
+		}
+	}))
+	meta.SetExternalName(mg, "")
+
+	if _, err := e.Create(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %s\n", err)
+	}
+	want := &central.Notifier{
+		Name:       "slack",
+		Type:       central.NotifierTypeSplunk,
+		UIEndpoint: "https://central.example.com",
+		Splunk: &central.SplunkNotifier{
+			HTTPToken:    "splunk-token",
+			HTTPEndpoint: "https://splunk.example.com:8088",
+			Truncate:     10000,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\ne.Create(...): -want notifier, +got notifier:\n%s\n", diff)
+	}
+	if meta.GetExternalName(mg) != notifierID {
+		t.Errorf("\ne.Create(...): want external name %q, got %q\n", notifierID, meta.GetExternalName(mg))
+	}
+}
+
+func TestNotifierUpdate(t *testing.T) {
+	cases := []struct {
+		name string
+		kube client.Client
+		err  error
+		want error
+	}{
+		{
+			name: "update with secrets",
+			kube: secretClient(map[string][]byte{"url": []byte("https://hooks.slack.com/2")}),
+		},
+		{
+			name: "secret key missing",
+			kube: secretClient(map[string][]byte{}),
+			want: cmpopts.AnyError,
+		},
+		{
+			name: "update error",
+			kube: secretClient(map[string][]byte{"url": []byte("https://hooks.slack.com/2")}),
+			err:  errors.New("boom"),
+			want: cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &notifierExternal{
+				kube: tc.kube,
+				client: &central.NotifierAPIMock{
+					UpdateNotifierFunc: func(ctx context.Context, n *central.Notifier) error {
+						if n.ID != notifierID || n.LabelDefault != "https://hooks.slack.com/2" {
+							t.Errorf("\ne.Update(...): unexpected notifier %+v\n", n)
+						}
+						return tc.err
+					},
+				},
+			}
+			_, err := e.Update(context.Background(), notifier())
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestNotifierDelete(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "deleted"},
+		{name: "already gone", err: &central.APIError{StatusCode: http.StatusNotFound}},
+		{name: "delete error", err: errors.New("boom"), want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := notifierExternal{client: &central.NotifierAPIMock{
+				DeleteNotifierFunc: func(ctx context.Context, id string) error { return tc.err },
+			}}
+			err := e.Delete(context.Background(), notifier())
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
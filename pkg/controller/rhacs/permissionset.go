@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+const (
+	errNotPermissionSet     = "managed resource is not a PermissionSet custom resource"
+	errObservePermissionSet = "cannot observe permission set"
+	errCreatePermissionSet  = "cannot create permission set"
+	errUpdatePermissionSet  = "cannot update permission set"
+	errDeletePermissionSet  = "cannot delete permission set"
+)
+
+// setupPermissionSet adds a controller that reconciles PermissionSet managed
+// resources.
+func setupPermissionSet(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	return setupCentralResource(mgr, o, cf, v1alpha1.PermissionSetGroupVersionKind, &v1alpha1.PermissionSet{},
+		func(c central.Client) managed.ExternalClient { return &permissionSetExternal{client: c} })
+}
+
+// A permissionSetExternal observes, then either creates, updates, or deletes
+// a permission set of Central.
+type permissionSetExternal struct {
+	client central.PermissionSetAPI
+}
+
+func generatePermissionSet(id string, in *v1alpha1.PermissionSetParameters) *central.PermissionSet {
+	p := &central.PermissionSet{ID: id, Name: in.Name, Description: in.Description}
+	if len(in.ResourceToAccess) > 0 {
+		p.ResourceToAccess = make(map[string]string, len(in.ResourceToAccess))
+		for r, a := range in.ResourceToAccess {
+			p.ResourceToAccess[r] = string(a)
+		}
+	}
+	return p
+}
+
+func isPermissionSetUpToDate(in *v1alpha1.PermissionSet, observed *central.PermissionSet) (bool, string) {
+	desired := generatePermissionSet(observed.ID, &in.Spec.ForProvider)
+	// Central lists resource types without access explicitly.
+	observedAccess := map[string]string{}
+	for r, a := range observed.ResourceToAccess {
+		if a != "NO_ACCESS" {
+			observedAccess[r] = a
+		}
+	}
+	desiredAccess := map[string]string{}
+	for r, a := range desired.ResourceToAccess {
+		if a != "NO_ACCESS" {
+			desiredAccess[r] = a
+		}
+	}
+	d := *desired
+	d.ResourceToAccess = desiredAccess
+	o := *observed
+	o.ResourceToAccess = observedAccess
+	if diff := cmp.Diff(&d, &o, cmpopts.EquateEmpty()); diff != "" {
+		diff = "Observed difference in permission set\n" + diff
+		return false, diff
+	}
+	return true, ""
+}
+
+func (c *permissionSetExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PermissionSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPermissionSet)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	ps, err := c.client.GetPermissionSet(ctx, id)
+	if central.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObservePermissionSet)
+	}
+
+	cr.Status.AtProvider = v1alpha1.PermissionSetObservation{ID: ps.ID}
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isPermissionSetUpToDate(cr, ps)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *permissionSetExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PermissionSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPermissionSet)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	ps, err := c.client.CreatePermissionSet(ctx, generatePermissionSet("", &cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePermissionSet)
+	}
+	meta.SetExternalName(cr, ps.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *permissionSetExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PermissionSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPermissionSet)
+	}
+
+	err := c.client.UpdatePermissionSet(ctx, generatePermissionSet(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePermissionSet)
+}
+
+func (c *permissionSetExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PermissionSet)
+	if !ok {
+		return errors.New(errNotPermissionSet)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeletePermissionSet(ctx, meta.GetExternalName(cr))
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeletePermissionSet)
+}
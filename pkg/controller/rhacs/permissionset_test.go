@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+var _ managed.ExternalClient = &permissionSetExternal{}
+
+var permissionSetID = "permission-set-id"
+
+func permissionSet(mod ...func(*v1alpha1.PermissionSet)) *v1alpha1.PermissionSet {
+	p := &v1alpha1.PermissionSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "developer"},
+		Spec: v1alpha1.PermissionSetSpec{
+			ForProvider: v1alpha1.PermissionSetParameters{
+				Name: "Developer",
+				ResourceToAccess: map[string]v1alpha1.Access{
+					"Deployment": "READ_ACCESS",
+					"Image":      "READ_WRITE_ACCESS",
+				},
+				CentralURL: "https://central.example.com",
+			},
+		},
+	}
+	for _, m := range mod {
+		m(p)
+	}
+	return p
+}
+
+func TestPermissionSetObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := []struct {
+		name string
+		ps   *central.PermissionSet
+		err  error
+		want want
+	}{
+		{
+			name: "resources without access are ignored",
+			ps: &central.PermissionSet{ID: permissionSetID, Name: "Developer", ResourceToAccess: map[string]string{
+				"Deployment": "READ_ACCESS",
+				"Image":      "READ_WRITE_ACCESS",
+				"Secret":     "NO_ACCESS",
+			}},
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		{
+			name: "access changed",
+			ps: &central.PermissionSet{ID: permissionSetID, Name: "Developer", ResourceToAccess: map[string]string{
+				"Deployment": "READ_ACCESS",
+			}},
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		{
+			name: "get error",
+			err:  errors.New("boom"),
+			want: want{err: cmpopts.AnyError},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := permissionSetExternal{client: &central.PermissionSetAPIMock{
+				GetPermissionSetFunc: func(ctx context.Context, id string) (*central.PermissionSet, error) {
+					return tc.ps, tc.err
+				},
+			}}
+			mg := permissionSet(func(p *v1alpha1.PermissionSet) { meta.SetExternalName(p, permissionSetID) })
+			got, err := e.Observe(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPermissionSetCreate(t *testing.T) {
+	e := permissionSetExternal{client: &central.PermissionSetAPIMock{
+		CreatePermissionSetFunc: func(ctx context.Context, p *central.PermissionSet) (*central.PermissionSet, error) {
+			out := *p
+			out.ID = permissionSetID
+			return &out, nil
+		},
+	}}
+	mg := permissionSet()
+	if _, err := e.Create(context.Background(), mg); err != nil {
+		t.Fatalf("\ne.Create(...): unexpected error: %s\n", err)
+	}
+	want := permissionSet(func(p *v1alpha1.PermissionSet) {
+		p.SetConditions(xpv1.Creating())
+		meta.SetExternalName(p, permissionSetID)
+	})
+	if diff := cmp.Diff(want, mg); diff != "" {
+		t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+	}
+}
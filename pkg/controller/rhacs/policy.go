@@ -0,0 +1,275 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+const (
+	errNotPolicy     = "managed resource is not a Policy custom resource"
+	errObservePolicy = "cannot observe policy"
+	errCreatePolicy  = "cannot create policy"
+	errUpdatePolicy  = "cannot update policy"
+	errDeletePolicy  = "cannot delete policy"
+
+	// policyVersion is the version of the policy schema Policies are
+	// written in.
+	policyVersion = "1.1"
+)
+
+// setupPolicy adds a controller that reconciles Policy managed resources.
+func setupPolicy(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	return setupCentralResource(mgr, o, cf, v1alpha1.PolicyGroupVersionKind, &v1alpha1.Policy{},
+		func(c central.Client) managed.ExternalClient { return &policyExternal{client: c} })
+}
+
+// A policyExternal observes, then either creates, updates, or deletes a
+// security policy of Central.
+type policyExternal struct {
+	client central.PolicyAPI
+}
+
+func fromMetaTime(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
+
+func generateScope(in *v1alpha1.Scope) *central.Scope {
+	if in == nil {
+		return nil
+	}
+	s := &central.Scope{Cluster: in.Cluster, Namespace: in.Namespace}
+	if in.Label != nil {
+		s.Label = &central.ScopeLabel{Key: in.Label.Key, Value: in.Label.Value}
+	}
+	return s
+}
+
+func generateScopeParameters(in *central.Scope) *v1alpha1.Scope {
+	if in == nil {
+		return nil
+	}
+	s := &v1alpha1.Scope{Cluster: in.Cluster, Namespace: in.Namespace}
+	if in.Label != nil {
+		s.Label = &v1alpha1.ScopeLabel{Key: in.Label.Key, Value: in.Label.Value}
+	}
+	return s
+}
+
+// generatePolicy returns the Central policy described by the supplied
+// parameters.
+func generatePolicy(id string, in *v1alpha1.PolicyParameters) *central.Policy {
+	p := &central.Policy{
+		ID:              id,
+		Name:            in.Name,
+		Description:     in.Description,
+		Rationale:       in.Rationale,
+		Remediation:     in.Remediation,
+		Disabled:        in.Disabled,
+		Categories:      in.Categories,
+		EventSource:     string(in.EventSource),
+		Severity:        string(in.Severity),
+		Notifiers:       in.Notifiers,
+		PolicyVersion:   policyVersion,
+		LifecycleStages: make([]string, 0, len(in.LifecycleStages)),
+	}
+	for _, s := range in.LifecycleStages {
+		p.LifecycleStages = append(p.LifecycleStages, string(s))
+	}
+	for _, a := range in.EnforcementActions {
+		p.EnforcementActions = append(p.EnforcementActions, string(a))
+	}
+	for i := range in.Scope {
+		p.Scope = append(p.Scope, *generateScope(&in.Scope[i]))
+	}
+	for _, e := range in.Exclusions {
+		ex := central.PolicyExclusion{Name: e.Name, Expiration: fromMetaTime(e.Expiration)}
+		if e.Deployment != nil {
+			ex.Deployment = &central.PolicyExclusionDeployment{Name: e.Deployment.Name, Scope: generateScope(e.Deployment.Scope)}
+		}
+		if e.Image != nil {
+			ex.Image = &central.PolicyExclusionImage{Name: e.Image.Name}
+		}
+		p.Exclusions = append(p.Exclusions, ex)
+	}
+	for _, s := range in.PolicySections {
+		sec := central.PolicySection{SectionName: s.SectionName}
+		for _, g := range s.PolicyGroups {
+			grp := central.PolicyGroup{FieldName: g.FieldName, BooleanOperator: string(g.BooleanOperator), Negate: g.Negate}
+			for _, v := range g.Values {
+				grp.Values = append(grp.Values, central.PolicyValue{Value: v})
+			}
+			sec.PolicyGroups = append(sec.PolicyGroups, grp)
+		}
+		p.PolicySections = append(p.PolicySections, sec)
+	}
+	for _, v := range in.MitreAttackVectors {
+		p.MitreAttackVectors = append(p.MitreAttackVectors, central.MitreAttackVector{Tactic: v.Tactic, Techniques: v.Techniques})
+	}
+	return p
+}
+
+// generatePolicyParameters returns the parameters describing the supplied
+// Central policy.
+func generatePolicyParameters(in *central.Policy) v1alpha1.PolicyParameters {
+	p := v1alpha1.PolicyParameters{
+		Name:        in.Name,
+		Description: in.Description,
+		Rationale:   in.Rationale,
+		Remediation: in.Remediation,
+		Disabled:    in.Disabled,
+		Categories:  in.Categories,
+		EventSource: v1alpha1.EventSource(in.EventSource),
+		Severity:    v1alpha1.Severity(in.Severity),
+		Notifiers:   in.Notifiers,
+	}
+	for _, s := range in.LifecycleStages {
+		p.LifecycleStages = append(p.LifecycleStages, v1alpha1.LifecycleStage(s))
+	}
+	for _, a := range in.EnforcementActions {
+		p.EnforcementActions = append(p.EnforcementActions, v1alpha1.EnforcementAction(a))
+	}
+	for i := range in.Scope {
+		p.Scope = append(p.Scope, *generateScopeParameters(&in.Scope[i]))
+	}
+	for _, e := range in.Exclusions {
+		ex := v1alpha1.PolicyExclusion{Name: e.Name, Expiration: toMetaTime(e.Expiration)}
+		if e.Deployment != nil {
+			ex.Deployment = &v1alpha1.PolicyExclusionDeployment{Name: e.Deployment.Name, Scope: generateScopeParameters(e.Deployment.Scope)}
+		}
+		if e.Image != nil {
+			ex.Image = &v1alpha1.PolicyExclusionImage{Name: e.Image.Name}
+		}
+		p.Exclusions = append(p.Exclusions, ex)
+	}
+	for _, s := range in.PolicySections {
+		sec := v1alpha1.PolicySection{SectionName: s.SectionName}
+		for _, g := range s.PolicyGroups {
+			grp := v1alpha1.PolicyGroup{FieldName: g.FieldName, BooleanOperator: v1alpha1.BooleanOperator(g.BooleanOperator), Negate: g.Negate}
+			for _, v := range g.Values {
+				grp.Values = append(grp.Values, v.Value)
+			}
+			sec.PolicyGroups = append(sec.PolicyGroups, grp)
+		}
+		p.PolicySections = append(p.PolicySections, sec)
+	}
+	for _, v := range in.MitreAttackVectors {
+		p.MitreAttackVectors = append(p.MitreAttackVectors, v1alpha1.MitreAttackVector{Tactic: v.Tactic, Techniques: v.Techniques})
+	}
+	return p
+}
+
+func isPolicyUpToDate(in *v1alpha1.Policy, observed *central.Policy) (bool, string) {
+	observedParams := generatePolicyParameters(observed)
+	if diff := cmp.Diff(in.Spec.ForProvider, observedParams, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.PolicyParameters{}, "CentralURL", "CentralURLRef", "CentralURLSelector")); diff != "" {
+		diff = "Observed difference in policy\n" + diff
+		return false, diff
+	}
+	return true, ""
+}
+
+func (c *policyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicy)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	policy, err := c.client.GetPolicy(ctx, id)
+	if central.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObservePolicy)
+	}
+
+	cr.Status.AtProvider = v1alpha1.PolicyObservation{
+		ID:          policy.ID,
+		IsDefault:   policy.IsDefault,
+		LastUpdated: toMetaTime(policy.LastUpdated),
+	}
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isPolicyUpToDate(cr, policy)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *policyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	policy, err := c.client.CreatePolicy(ctx, generatePolicy("", &cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePolicy)
+	}
+	meta.SetExternalName(cr, policy.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *policyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPolicy)
+	}
+
+	err := c.client.UpdatePolicy(ctx, generatePolicy(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePolicy)
+}
+
+func (c *policyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return errors.New(errNotPolicy)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeletePolicy(ctx, meta.GetExternalName(cr))
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeletePolicy)
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+var _ managed.ExternalClient = &policyExternal{}
+
+var policyID = "policy-id"
+
+type policyModifier func(*v1alpha1.Policy)
+
+func withPolicyConditions(c ...xpv1.Condition) policyModifier {
+	return func(p *v1alpha1.Policy) { p.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPolicyExternalName(id string) policyModifier {
+	return func(p *v1alpha1.Policy) { meta.SetExternalName(p, id) }
+}
+
+func withPolicyObservation(o v1alpha1.PolicyObservation) policyModifier {
+	return func(p *v1alpha1.Policy) { p.Status.AtProvider = o }
+}
+
+func policy(mod ...policyModifier) *v1alpha1.Policy {
+	p := &v1alpha1.Policy{
+		ObjectMeta: metav1.ObjectMeta{Name: "latest-tag"},
+		Spec: v1alpha1.PolicySpec{
+			ForProvider: v1alpha1.PolicyParameters{
+				Name:               "Latest tag",
+				Categories:         []string{"DevOps Best Practices"},
+				LifecycleStages:    []v1alpha1.LifecycleStage{"BUILD", "DEPLOY"},
+				Severity:           "LOW_SEVERITY",
+				EnforcementActions: []v1alpha1.EnforcementAction{"FAIL_BUILD_ENFORCEMENT"},
+				Scope:              []v1alpha1.Scope{{Namespace: "prod", Label: &v1alpha1.ScopeLabel{Key: "app", Value: "web"}}},
+				Exclusions: []v1alpha1.PolicyExclusion{{
+					Name:       "kube-system",
+					Deployment: &v1alpha1.PolicyExclusionDeployment{Scope: &v1alpha1.Scope{Namespace: "kube-system"}},
+				}},
+				PolicySections: []v1alpha1.PolicySection{{
+					PolicyGroups: []v1alpha1.PolicyGroup{{FieldName: "Image Tag", BooleanOperator: "OR", Values: []string{"latest"}}},
+				}},
+				CentralURL: "https://central.example.com",
+			},
+		},
+	}
+	for _, m := range mod {
+		m(p)
+	}
+	return p
+}
+
+func centralPolicy(mod ...func(*central.Policy)) *central.Policy {
+	p := &central.Policy{
+		ID:                 policyID,
+		Name:               "Latest tag",
+		Categories:         []string{"DevOps Best Practices"},
+		LifecycleStages:    []string{"BUILD", "DEPLOY"},
+		Severity:           "LOW_SEVERITY",
+		EnforcementActions: []string{"FAIL_BUILD_ENFORCEMENT"},
+		Notifiers:          []string{},
+		PolicyVersion:      policyVersion,
+		Scope:              []central.Scope{{Namespace: "prod", Label: &central.ScopeLabel{Key: "app", Value: "web"}}},
+		Exclusions: []central.PolicyExclusion{{
+			Name:       "kube-system",
+			Deployment: &central.PolicyExclusionDeployment{Scope: &central.Scope{Namespace: "kube-system"}},
+		}},
+		PolicySections: []central.PolicySection{{
+			PolicyGroups: []central.PolicyGroup{{FieldName: "Image Tag", BooleanOperator: "OR", Values: []central.PolicyValue{{Value: "latest"}}}},
+		}},
+	}
+	for _, m := range mod {
+		m(p)
+	}
+	return p
+}
+
+func TestPolicyObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name   string
+		policy *central.Policy
+		err    error
+		want   want
+	}{
+		{
+			name:   "policy up to date",
+			policy: centralPolicy(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: policy(withPolicyExternalName(policyID), withPolicyConditions(xpv1.Available()),
+					withPolicyObservation(v1alpha1.PolicyObservation{ID: policyID})),
+			},
+		},
+		{
+			name:   "policy drifted",
+			policy: centralPolicy(func(p *central.Policy) { p.Severity = "HIGH_SEVERITY" }),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg: policy(withPolicyExternalName(policyID), withPolicyConditions(xpv1.Available()),
+					withPolicyObservation(v1alpha1.PolicyObservation{ID: policyID})),
+			},
+		},
+		{
+			name: "policy not found",
+			err:  &central.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  policy(withPolicyExternalName(policyID)),
+			},
+		},
+		{
+			name: "get error",
+			err:  errors.New("boom"),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  policy(withPolicyExternalName(policyID)),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := policyExternal{client: &central.PolicyAPIMock{
+				GetPolicyFunc: func(ctx context.Context, id string) (*central.Policy, error) {
+					return tc.policy, tc.err
+				},
+			}}
+			mg := policy(withPolicyExternalName(policyID))
+			got, err := e.Observe(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyCreate(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want resource.Managed
+		werr error
+	}{
+		{
+			name: "creation success",
+			want: policy(withPolicyConditions(xpv1.Creating()), withPolicyExternalName(policyID)),
+		},
+		{
+			name: "creation error",
+			err:  errors.New("boom"),
+			want: policy(withPolicyConditions(xpv1.Creating())),
+			werr: cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := policyExternal{client: &central.PolicyAPIMock{
+				CreatePolicyFunc: func(ctx context.Context, p *central.Policy) (*central.Policy, error) {
+					if diff := cmp.Diff(centralPolicy(func(p *central.Policy) { p.ID = "" }), p, cmpopts.EquateEmpty()); diff != "" {
+						t.Errorf("\ne.Create(...): -want policy, +got policy:\n%s\n", diff)
+					}
+					return centralPolicy(), tc.err
+				},
+			}}
+			mg := policy()
+			_, err := e.Create(context.Background(), mg)
+			if diff := cmp.Diff(tc.werr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyUpdate(t *testing.T) {
+	var got *central.Policy
+	e := policyExternal{client: &central.PolicyAPIMock{
+		UpdatePolicyFunc: func(ctx context.Context, p *central.Policy) error {
+			got = p
+			return nil
+		},
+	}}
+	if _, err := e.Update(context.Background(), policy(withPolicyExternalName(policyID))); err != nil {
+		t.Fatalf("\ne.Update(...): unexpected error: %s\n", err)
+	}
+	if diff := cmp.Diff(centralPolicy(), got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("\ne.Update(...): -want policy, +got policy:\n%s\n", diff)
+	}
+}
+
+func TestPolicyDelete(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "deleted"},
+		{name: "already gone", err: &central.APIError{StatusCode: http.StatusNotFound}},
+		{name: "delete error", err: errors.New("boom"), want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := policyExternal{client: &central.PolicyAPIMock{
+				DeletePolicyFunc: func(ctx context.Context, id string) error { return tc.err },
+			}}
+			err := e.Delete(context.Background(), policy(withPolicyExternalName(policyID)))
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
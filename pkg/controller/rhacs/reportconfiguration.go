@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+const (
+	errNotReportConfiguration     = "managed resource is not a ReportConfiguration custom resource"
+	errObserveReportConfiguration = "cannot observe report configuration"
+	errCreateReportConfiguration  = "cannot create report configuration"
+	errUpdateReportConfiguration  = "cannot update report configuration"
+	errDeleteReportConfiguration  = "cannot delete report configuration"
+)
+
+// setupReportConfiguration adds a controller that reconciles
+// ReportConfiguration managed resources.
+func setupReportConfiguration(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	return setupCentralResource(mgr, o, cf, v1alpha1.ReportConfigurationGroupVersionKind, &v1alpha1.ReportConfiguration{},
+		func(c central.Client) managed.ExternalClient { return &reportConfigurationExternal{client: c} })
+}
+
+// A reportConfigurationExternal observes, then either creates, updates, or
+// deletes a vulnerability report configuration of Central.
+type reportConfigurationExternal struct {
+	client central.ReportConfigurationAPI
+}
+
+func generateReportConfiguration(id string, in *v1alpha1.ReportConfigurationParameters) *central.ReportConfiguration {
+	r := &central.ReportConfiguration{
+		ID:          id,
+		Name:        in.Name,
+		Description: in.Description,
+		Type:        central.ReportTypeVulnerability,
+		VulnReportFilters: &central.VulnReportFilters{
+			Fixability:      string(in.Filters.Fixability),
+			SinceLastReport: in.Filters.SinceLastReport,
+		},
+		ScopeID: in.CollectionID,
+		EmailConfig: &central.EmailNotifierConfig{
+			NotifierID:   in.Email.NotifierID,
+			MailingLists: in.Email.MailingLists,
+		},
+		Schedule: &central.Schedule{
+			IntervalType: string(in.Schedule.IntervalType),
+			Hour:         in.Schedule.Hour,
+			Minute:       in.Schedule.Minute,
+		},
+	}
+	for _, s := range in.Filters.Severities {
+		r.VulnReportFilters.Severities = append(r.VulnReportFilters.Severities, string(s))
+	}
+	for _, t := range in.Filters.ImageTypes {
+		r.VulnReportFilters.ImageTypes = append(r.VulnReportFilters.ImageTypes, string(t))
+	}
+	if len(in.Schedule.DaysOfWeek) > 0 {
+		r.Schedule.DaysOfWeek = &central.ScheduleDays{Days: in.Schedule.DaysOfWeek}
+	}
+	if len(in.Schedule.DaysOfMonth) > 0 {
+		r.Schedule.DaysOfMonth = &central.ScheduleDays{Days: in.Schedule.DaysOfMonth}
+	}
+	return r
+}
+
+func generateReportConfigurationObservation(in *central.ReportConfiguration) v1alpha1.ReportConfigurationObservation {
+	o := v1alpha1.ReportConfigurationObservation{
+		ID:                    in.ID,
+		LastSuccessfulRunTime: toMetaTime(in.LastSuccessfulRunTime),
+	}
+	if s := in.LastRunStatus; s != nil {
+		o.LastRunStatus = s.ReportStatus
+		o.LastRunTime = toMetaTime(s.LastRunTime)
+		o.LastRunError = s.ErrorMsg
+	}
+	return o
+}
+
+func isReportConfigurationUpToDate(in *v1alpha1.ReportConfiguration, observed *central.ReportConfiguration) (bool, string) {
+	desired := generateReportConfiguration(observed.ID, &in.Spec.ForProvider)
+	ignore := cmpopts.IgnoreFields(central.ReportConfiguration{}, "LastRunStatus", "LastSuccessfulRunTime")
+	if diff := cmp.Diff(desired, observed, cmpopts.EquateEmpty(), ignore); diff != "" {
+		diff = "Observed difference in report configuration\n" + diff
+		return false, diff
+	}
+	return true, ""
+}
+
+func (c *reportConfigurationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ReportConfiguration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotReportConfiguration)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rc, err := c.client.GetReportConfiguration(ctx, id)
+	if central.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveReportConfiguration)
+	}
+
+	cr.Status.AtProvider = generateReportConfigurationObservation(rc)
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isReportConfigurationUpToDate(cr, rc)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *reportConfigurationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ReportConfiguration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotReportConfiguration)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	rc, err := c.client.CreateReportConfiguration(ctx, generateReportConfiguration("", &cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateReportConfiguration)
+	}
+	meta.SetExternalName(cr, rc.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *reportConfigurationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ReportConfiguration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotReportConfiguration)
+	}
+
+	err := c.client.UpdateReportConfiguration(ctx, generateReportConfiguration(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateReportConfiguration)
+}
+
+func (c *reportConfigurationExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ReportConfiguration)
+	if !ok {
+		return errors.New(errNotReportConfiguration)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteReportConfiguration(ctx, meta.GetExternalName(cr))
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteReportConfiguration)
+}
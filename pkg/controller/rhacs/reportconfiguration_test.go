@@ -0,0 +1,176 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+var _ managed.ExternalClient = &reportConfigurationExternal{}
+
+var reportConfigurationID = "report-id"
+
+func reportConfiguration() *v1alpha1.ReportConfiguration {
+	r := &v1alpha1.ReportConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "weekly"},
+		Spec: v1alpha1.ReportConfigurationSpec{
+			ForProvider: v1alpha1.ReportConfigurationParameters{
+				Name: "weekly",
+				Filters: v1alpha1.VulnReportFilters{
+					Fixability: "FIXABLE",
+					Severities: []v1alpha1.VulnerabilitySeverity{"CRITICAL_VULNERABILITY_SEVERITY"},
+					ImageTypes: []v1alpha1.ImageType{"DEPLOYED"},
+				},
+				CollectionID: collectionID,
+				Email:        v1alpha1.ReportEmail{NotifierID: notifierID, MailingLists: []string{"team@example.com"}},
+				Schedule:     v1alpha1.Schedule{IntervalType: "WEEKLY", Hour: 8, DaysOfWeek: []int32{1}},
+				CentralURL:   "https://central.example.com",
+			},
+		},
+	}
+	meta.SetExternalName(r, reportConfigurationID)
+	return r
+}
+
+func centralReportConfiguration(mod ...func(*central.ReportConfiguration)) *central.ReportConfiguration {
+	r := &central.ReportConfiguration{
+		ID:   reportConfigurationID,
+		Name: "weekly",
+		Type: central.ReportTypeVulnerability,
+		VulnReportFilters: &central.VulnReportFilters{
+			Fixability: "FIXABLE",
+			Severities: []string{"CRITICAL_VULNERABILITY_SEVERITY"},
+			ImageTypes: []string{"DEPLOYED"},
+		},
+		ScopeID:     collectionID,
+		EmailConfig: &central.EmailNotifierConfig{NotifierID: notifierID, MailingLists: []string{"team@example.com"}},
+		Schedule: &central.Schedule{
+			IntervalType: "WEEKLY",
+			Hour:         8,
+			DaysOfWeek:   &central.ScheduleDays{Days: []int32{1}},
+		},
+	}
+	for _, m := range mod {
+		m(r)
+	}
+	return r
+}
+
+func TestReportConfigurationObserve(t *testing.T) {
+	lastRun := time.Date(2022, 10, 3, 8, 0, 0, 0, time.UTC)
+
+	type want struct {
+		obs managed.ExternalObservation
+		at  v1alpha1.ReportConfigurationObservation
+		err error
+	}
+
+	cases := []struct {
+		name   string
+		report *central.ReportConfiguration
+		err    error
+		want   want
+	}{
+		{
+			name: "report up to date",
+			report: centralReportConfiguration(func(r *central.ReportConfiguration) {
+				r.LastRunStatus = &central.ReportLastRunStatus{ReportStatus: "FAILURE", LastRunTime: &lastRun, ErrorMsg: "no notifier"}
+			}),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				at: v1alpha1.ReportConfigurationObservation{
+					ID:            reportConfigurationID,
+					LastRunStatus: "FAILURE",
+					LastRunTime:   toMetaTime(&lastRun),
+					LastRunError:  "no notifier",
+				},
+			},
+		},
+		{
+			name: "schedule changed",
+			report: centralReportConfiguration(func(r *central.ReportConfiguration) {
+				r.Schedule.DaysOfWeek.Days = []int32{5}
+			}),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				at:  v1alpha1.ReportConfigurationObservation{ID: reportConfigurationID},
+			},
+		},
+		{
+			name: "report not found",
+			err:  &central.APIError{StatusCode: http.StatusNotFound},
+			want: want{obs: managed.ExternalObservation{}},
+		},
+		{
+			name: "get error",
+			err:  errors.New("boom"),
+			want: want{err: cmpopts.AnyError},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := reportConfigurationExternal{client: &central.ReportConfigurationAPIMock{
+				GetReportConfigurationFunc: func(ctx context.Context, id string) (*central.ReportConfiguration, error) {
+					return tc.report, tc.err
+				},
+			}}
+			mg := reportConfiguration()
+			got, err := e.Observe(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.at, mg.Status.AtProvider); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestReportConfigurationUpdate(t *testing.T) {
+	var got *central.ReportConfiguration
+	e := reportConfigurationExternal{client: &central.ReportConfigurationAPIMock{
+		UpdateReportConfigurationFunc: func(ctx context.Context, r *central.ReportConfiguration) error {
+			got = r
+			return nil
+		},
+	}}
+	if _, err := e.Update(context.Background(), reportConfiguration()); err != nil {
+		t.Fatalf("\ne.Update(...): unexpected error: %s\n", err)
+	}
+	if diff := cmp.Diff(centralReportConfiguration(), got); diff != "" {
+		t.Errorf("\ne.Update(...): -want report, +got report:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+	"github.com/stehessel/provider-redhat/pkg/clients/rhacs"
+)
+
+const errGetCreds = "cannot get credentials"
+
+// Options configure the rhacs controllers beyond the common controller
+// options.
+type Options struct {
+	// PollIntervals by lifecycle phase of a central.
+	PollIntervals PollIntervals
+
+	// CentralCacheTTL is how long the centrals listed for a ProviderConfig
+	// are reused to observe all CentralInstances using it. Zero disables
+	// caching.
+	CentralCacheTTL time.Duration
+
+	// UnmanagedCentralsInterval is how often the centrals of each
+	// ProviderConfig are checked for ones not managed by a CentralInstance.
+	// Zero disables the check.
+	UnmanagedCentralsInterval time.Duration
+}
+
+// Setup adds the controllers of the rhacs API group to the supplied manager.
+func Setup(mgr ctrl.Manager, o controller.Options, ro Options) error {
+	cf := &clientFactory{
+		kube:     mgr.GetClient(),
+		limiters: rhacs.NewRateLimiters(),
+		cache:    rhacs.NewCentralCache(ro.CentralCacheTTL),
+	}
+	for _, setup := range []func(ctrl.Manager, controller.Options, *clientFactory) error{
+		func(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+			return setupCentralInstance(mgr, o, ro, cf)
+		},
+		setupInitBundle,
+		setupAPIToken,
+		setupPolicy,
+		setupNotifier,
+		setupAuthProvider,
+		setupPermissionSet,
+		setupAccessScope,
+		setupRole,
+		setupImageIntegration,
+		setupCollection,
+		setupReportConfiguration,
+		setupCentralBackup,
+		setupCentralConfiguration,
+	} {
+		if err := setup(mgr, o, cf); err != nil {
+			return err
+		}
+	}
+	if ro.UnmanagedCentralsInterval > 0 {
+		return setupUnmanagedCentrals(mgr, o, ro, cf)
+	}
+	return nil
+}
+
+// A clientFactory produces fleet-manager clients for ProviderConfigs. All
+// clients of a ProviderConfig share its rate limiter and central cache.
+type clientFactory struct {
+	kube     client.Client
+	limiters *rhacs.RateLimiters
+	cache    *rhacs.CentralCache
+}
+
+func (f *clientFactory) token(ctx context.Context, pc *apisv1alpha1.ProviderConfig) (string, error) {
+	cd := pc.Spec.Credentials
+	token, err := resource.CommonCredentialExtractor(ctx, cd.Source, f.kube, cd.CommonCredentialSelectors)
+	return string(token), errors.Wrap(err, errGetCreds)
+}
+
+func (f *clientFactory) newClient(ctx context.Context, pc *apisv1alpha1.ProviderConfig) (fleetmanager.PublicAPI, error) {
+	token, err := f.token(ctx, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := rhacs.NewClient(token, pc.Spec.Gateway)
+	if err != nil {
+		return nil, errors.Wrap(err, rhacs.ErrNewClient)
+	}
+	if lim := f.limiters.Get(pc.Name, pc.Spec.RateLimit); lim != nil {
+		client = rhacs.NewRateLimitedClient(client, lim, pc.Name)
+	}
+	return rhacs.NewCachedClient(client, f.cache, pc.Name), nil
+}
+
+// newCentralClient returns a client for the API of the Central served at the
+// supplied URL, authenticated with the credentials of the ProviderConfig.
+func (f *clientFactory) newCentralClient(ctx context.Context, pc *apisv1alpha1.ProviderConfig, url string) (central.Client, error) {
+	token, err := f.token(ctx, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := central.NewClient(token, url)
+	return client, errors.Wrap(err, central.ErrNewClient)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+const (
+	errNotRole     = "managed resource is not a Role custom resource"
+	errObserveRole = "cannot observe role"
+	errCreateRole  = "cannot create role"
+	errUpdateRole  = "cannot update role"
+	errDeleteRole  = "cannot delete role"
+)
+
+// setupRole adds a controller that reconciles Role managed resources.
+func setupRole(mgr ctrl.Manager, o controller.Options, cf *clientFactory) error {
+	return setupCentralResource(mgr, o, cf, v1alpha1.RoleGroupVersionKind, &v1alpha1.Role{},
+		func(c central.Client) managed.ExternalClient { return &roleExternal{client: c} })
+}
+
+// A roleExternal observes, then either creates, updates, or deletes a role of
+// Central. Roles are identified by their name.
+type roleExternal struct {
+	client central.RoleAPI
+}
+
+func generateRole(name string, in *v1alpha1.RoleParameters) *central.Role {
+	return &central.Role{
+		Name:            name,
+		Description:     in.Description,
+		PermissionSetID: in.PermissionSetID,
+		AccessScopeID:   in.AccessScopeID,
+	}
+}
+
+func isRoleUpToDate(in *v1alpha1.Role, observed *central.Role) (bool, string) {
+	desired := generateRole(observed.Name, &in.Spec.ForProvider)
+	if diff := cmp.Diff(desired, observed); diff != "" {
+		diff = "Observed difference in role\n" + diff
+		return false, diff
+	}
+	return true, ""
+}
+
+func (c *roleExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Role)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRole)
+	}
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	role, err := c.client.GetRole(ctx, name)
+	if central.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveRole)
+	}
+
+	cr.Status.AtProvider = v1alpha1.RoleObservation{Name: role.Name}
+	cr.SetConditions(xpv1.Available())
+	upToDate, diff := isRoleUpToDate(cr, role)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+func (c *roleExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Role)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRole)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	name := cr.Spec.ForProvider.Name
+	if err := c.client.CreateRole(ctx, generateRole(name, &cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRole)
+	}
+	meta.SetExternalName(cr, name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *roleExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Role)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRole)
+	}
+
+	err := c.client.UpdateRole(ctx, generateRole(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRole)
+}
+
+func (c *roleExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Role)
+	if !ok {
+		return errors.New(errNotRole)
+	}
+	mg.SetConditions(xpv1.Deleting())
+
+	err := c.client.DeleteRole(ctx, meta.GetExternalName(cr))
+	if central.IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteRole)
+}
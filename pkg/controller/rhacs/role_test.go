@@ -0,0 +1,224 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/central"
+)
+
+var _ managed.ExternalClient = &roleExternal{}
+
+var roleName = "Team Developer"
+
+type roleModifier func(*v1alpha1.Role)
+
+func withRoleConditions(c ...xpv1.Condition) roleModifier {
+	return func(r *v1alpha1.Role) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRoleExternalName(name string) roleModifier {
+	return func(r *v1alpha1.Role) { meta.SetExternalName(r, name) }
+}
+
+func withRoleObservation(o v1alpha1.RoleObservation) roleModifier {
+	return func(r *v1alpha1.Role) { r.Status.AtProvider = o }
+}
+
+func role(mod ...roleModifier) *v1alpha1.Role {
+	r := &v1alpha1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-developer"},
+		Spec: v1alpha1.RoleSpec{
+			ForProvider: v1alpha1.RoleParameters{
+				Name:            roleName,
+				Description:     "Developers of the team",
+				PermissionSetID: permissionSetID,
+				AccessScopeID:   accessScopeID,
+				CentralURL:      "https://central.example.com",
+			},
+		},
+	}
+	for _, m := range mod {
+		m(r)
+	}
+	return r
+}
+
+func centralRole(mod ...func(*central.Role)) *central.Role {
+	r := &central.Role{
+		Name:            roleName,
+		Description:     "Developers of the team",
+		PermissionSetID: permissionSetID,
+		AccessScopeID:   accessScopeID,
+	}
+	for _, m := range mod {
+		m(r)
+	}
+	return r
+}
+
+func TestRoleObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	cases := []struct {
+		name string
+		role *central.Role
+		err  error
+		want want
+	}{
+		{
+			name: "role up to date",
+			role: centralRole(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: role(withRoleExternalName(roleName), withRoleConditions(xpv1.Available()),
+					withRoleObservation(v1alpha1.RoleObservation{Name: roleName})),
+			},
+		},
+		{
+			name: "permission set changed",
+			role: centralRole(func(r *central.Role) { r.PermissionSetID = "other-id" }),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg: role(withRoleExternalName(roleName), withRoleConditions(xpv1.Available()),
+					withRoleObservation(v1alpha1.RoleObservation{Name: roleName})),
+			},
+		},
+		{
+			name: "role not found",
+			err:  &central.APIError{StatusCode: http.StatusNotFound},
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  role(withRoleExternalName(roleName)),
+			},
+		},
+		{
+			name: "get error",
+			err:  errors.New("boom"),
+			want: want{
+				obs: managed.ExternalObservation{},
+				mg:  role(withRoleExternalName(roleName)),
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := roleExternal{client: &central.RoleAPIMock{
+				GetRoleFunc: func(ctx context.Context, name string) (*central.Role, error) {
+					return tc.role, tc.err
+				},
+			}}
+			mg := role(withRoleExternalName(roleName))
+			got, err := e.Observe(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got,
+				cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, mg); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestRoleCreate(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want resource.Managed
+		werr error
+	}{
+		{
+			name: "external name is role name",
+			want: role(withRoleConditions(xpv1.Creating()), withRoleExternalName(roleName)),
+		},
+		{
+			name: "creation error",
+			err:  errors.New("boom"),
+			want: role(withRoleConditions(xpv1.Creating())),
+			werr: cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := roleExternal{client: &central.RoleAPIMock{
+				CreateRoleFunc: func(ctx context.Context, r *central.Role) error {
+					if diff := cmp.Diff(centralRole(), r); diff != "" {
+						t.Errorf("\ne.Create(...): -want role, +got role:\n%s\n", diff)
+					}
+					return tc.err
+				},
+			}}
+			mg := role()
+			_, err := e.Create(context.Background(), mg)
+			if diff := cmp.Diff(tc.werr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestRoleDelete(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "deleted"},
+		{name: "already gone", err: &central.APIError{StatusCode: http.StatusNotFound}},
+		{name: "delete error", err: errors.New("boom"), want: cmpopts.AnyError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := roleExternal{client: &central.RoleAPIMock{
+				DeleteRoleFunc: func(ctx context.Context, name string) error { return tc.err },
+			}}
+			err := e.Delete(context.Background(), role(withRoleExternalName(roleName)))
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/rhacs"
+)
+
+const (
+	reconcileTimeout = 1 * time.Minute
+
+	errListCentrals         = "cannot list centrals"
+	errListCentralInstances = "cannot list CentralInstances"
+	errAdoptCentral         = "cannot adopt central"
+	errUpdatePCStatus       = "cannot update ProviderConfig status"
+)
+
+// Event reasons of the unmanaged centrals controller.
+const (
+	reasonUnmanagedCentral   event.Reason = "UnmanagedCentral"
+	reasonAdoptedCentral     event.Reason = "AdoptedCentral"
+	reasonCannotAdopt        event.Reason = "CannotAdoptCentral"
+	reasonCannotListCentrals event.Reason = "CannotListCentrals"
+)
+
+// setupUnmanagedCentrals adds a controller that periodically compares the
+// centrals of each ProviderConfig's organisation with all CentralInstances,
+// and reports centrals that are not managed by any of them.
+func setupUnmanagedCentrals(mgr ctrl.Manager, o controller.Options, ro Options, cf *clientFactory) error {
+	name := "unmanagedcentrals/" + strings.ToLower(apisv1alpha1.ProviderConfigGroupKind)
+
+	r := &unmanagedCentralsReconciler{
+		kube:      mgr.GetClient(),
+		newClient: cf.newClient,
+		interval:  ro.UnmanagedCentralsInterval,
+		log:       o.Logger.WithValues("controller", name),
+		record:    event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&apisv1alpha1.ProviderConfig{}, builder.WithPredicates(predicate.Or(
+			predicate.GenerationChangedPredicate{},
+			predicate.LabelChangedPredicate{},
+		))).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// An unmanagedCentralsReconciler reports the centrals of a ProviderConfig's
+// organisation that are not managed by a CentralInstance, and adopts them if
+// the ProviderConfig opts in to adoption.
+type unmanagedCentralsReconciler struct {
+	kube      client.Client
+	newClient func(ctx context.Context, pc *apisv1alpha1.ProviderConfig) (fleetmanager.PublicAPI, error)
+	interval  time.Duration
+	log       logging.Logger
+	record    event.Recorder
+}
+
+func (r *unmanagedCentralsReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		// There's no need to requeue if the ProviderConfig no longer exists.
+		log.Debug(errGetPC, "error", err)
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+
+	unmanaged, taken, err := r.findUnmanaged(ctx, pc)
+	if err != nil {
+		log.Debug("Cannot find unmanaged centrals", "error", err)
+		r.record.Event(pc, event.Warning(reasonCannotListCentrals, err))
+		return reconcile.Result{RequeueAfter: r.interval}, nil
+	}
+	if pc.GetLabels()[v1alpha1.LabelAdoptUnmanagedCentrals] == "true" {
+		unmanaged = r.adopt(ctx, pc, unmanaged, taken)
+	}
+
+	reported := map[string]bool{}
+	for _, c := range pc.Status.UnmanagedCentrals {
+		reported[c.ID] = true
+	}
+	status := make([]apisv1alpha1.UnmanagedCentral, 0, len(unmanaged))
+	for _, c := range unmanaged {
+		if !reported[c.Id] {
+			r.record.Event(pc, event.Warning(reasonUnmanagedCentral, errors.Errorf("central %q (%s) is not managed by any CentralInstance", c.Name, c.Id)))
+		}
+		status = append(status, apisv1alpha1.UnmanagedCentral{
+			ID:            c.Id,
+			Name:          c.Name,
+			CloudProvider: c.CloudProvider,
+			Region:        c.Region,
+			Owner:         c.Owner,
+			Status:        c.Status,
+		})
+	}
+
+	if cmp.Equal(pc.Status.UnmanagedCentrals, status, cmpopts.EquateEmpty()) {
+		return reconcile.Result{RequeueAfter: r.interval}, nil
+	}
+	pc.Status.UnmanagedCentrals = status
+	return reconcile.Result{RequeueAfter: r.interval}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdatePCStatus)
+}
+
+// findUnmanaged returns the centrals of the ProviderConfig's organisation that
+// are neither managed by any CentralInstance nor being deleted, sorted by
+// name, and the names of all CentralInstances. Several ProviderConfigs may
+// share an organisation, so CentralInstances using another ProviderConfig
+// count as managing their central, too.
+func (r *unmanagedCentralsReconciler) findUnmanaged(ctx context.Context, pc *apisv1alpha1.ProviderConfig) ([]public.CentralRequest, map[string]bool, error) {
+	fm, err := r.newClient(ctx, pc)
+	if err != nil {
+		return nil, nil, err
+	}
+	centrals, resp, err := fm.GetCentrals(ctx, nil)
+	if resp != nil {
+		if err := resp.Body.Close(); err != nil {
+			return nil, nil, errors.Wrap(err, errListCentrals)
+		}
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errListCentrals)
+	}
+
+	l := &v1alpha1.CentralInstanceList{}
+	if err := r.kube.List(ctx, l); err != nil {
+		return nil, nil, errors.Wrap(err, errListCentralInstances)
+	}
+	taken := map[string]bool{}
+	managedBy := map[string]bool{}
+	for i := range l.Items {
+		ci := &l.Items[i]
+		taken[ci.GetName()] = true
+		managedBy[meta.GetExternalName(ci)] = true
+		managedBy[ci.Spec.ForProvider.Name] = true
+		managedBy[ci.Status.AtProvider.ID] = true
+	}
+
+	unmanaged := []public.CentralRequest{}
+	for _, c := range centrals.Items {
+		if managedBy[c.Id] || managedBy[c.Name] ||
+			c.Status == rhacs.CentralRequestStatusDeprovision ||
+			c.Status == rhacs.CentralRequestStatusDeleting {
+			continue
+		}
+		unmanaged = append(unmanaged, c)
+	}
+	sort.Slice(unmanaged, func(i, j int) bool { return unmanaged[i].Name < unmanaged[j].Name })
+	return unmanaged, taken, nil
+}
+
+// adopt creates a CentralInstance for each of the supplied centrals and
+// returns the centrals that could not be adopted. The supplied names of
+// existing CentralInstances are not used for adopted ones.
+func (r *unmanagedCentralsReconciler) adopt(ctx context.Context, pc *apisv1alpha1.ProviderConfig, centrals []public.CentralRequest, taken map[string]bool) []public.CentralRequest {
+	remaining := []public.CentralRequest{}
+	for _, c := range centrals {
+		if err := checkAdoptable(c); err != nil {
+			r.record.Event(pc, event.Warning(reasonCannotAdopt, errors.Wrapf(err, "%s %q", errAdoptCentral, c.Name)))
+			remaining = append(remaining, c)
+			continue
+		}
+		ci := adoptedCentralInstance(pc, c, adoptedName(c, taken))
+		if err := r.kube.Create(ctx, ci); err != nil {
+			r.record.Event(pc, event.Warning(reasonCannotAdopt, errors.Wrapf(err, "%s %q", errAdoptCentral, c.Name)))
+			remaining = append(remaining, c)
+			continue
+		}
+		r.record.Event(pc, event.Normal(reasonAdoptedCentral, fmt.Sprintf("Adopted central %q as CentralInstance %q", c.Name, ci.GetName())))
+	}
+	return remaining
+}
+
+// checkAdoptable returns an error if the supplied central is deployed to a
+// cloud provider or region a CentralInstance cannot specify.
+func checkAdoptable(c public.CentralRequest) error {
+	if v1alpha1.CloudProvider(c.CloudProvider) != v1alpha1.CloudProviderAWS {
+		return errors.Errorf("cloud provider %q is not supported", c.CloudProvider)
+	}
+	if v1alpha1.Region(c.Region) != v1alpha1.RegionUSEast1 {
+		return errors.Errorf("region %q is not supported", c.Region)
+	}
+	return nil
+}
+
+// adoptedName returns the name of the CentralInstance adopting the supplied
+// central. It is the name of the central, suffixed with its ID if a
+// CentralInstance of that name exists already.
+func adoptedName(c public.CentralRequest, taken map[string]bool) string {
+	if !taken[c.Name] {
+		return c.Name
+	}
+	return c.Name + "-" + strings.ToLower(c.Id)
+}
+
+func adoptedCentralInstance(pc *apisv1alpha1.ProviderConfig, c public.CentralRequest, name string) *v1alpha1.CentralInstance {
+	ci := &v1alpha1.CentralInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{v1alpha1.LabelAdopted: "true"},
+		},
+		Spec: v1alpha1.CentralInstanceSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: pc.Name},
+				// Deleting an adopted CentralInstance must not delete a
+				// central that was not created through it.
+				DeletionPolicy: xpv1.DeletionOrphan,
+			},
+			ForProvider: v1alpha1.CentralInstanceParameters{
+				CloudProvider: v1alpha1.CloudProvider(c.CloudProvider),
+				MultiAZ:       c.MultiAz,
+				Name:          c.Name,
+				Region:        v1alpha1.Region(c.Region),
+			},
+		},
+	}
+	meta.SetExternalName(ci, c.Name)
+	return ci
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rhacs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/stehessel/provider-redhat/apis/rhacs/v1alpha1"
+	apisv1alpha1 "github.com/stehessel/provider-redhat/apis/v1alpha1"
+	"github.com/stehessel/provider-redhat/pkg/clients/rhacs"
+)
+
+var _ reconcile.Reconciler = &unmanagedCentralsReconciler{}
+
+type providerConfigModifier func(*apisv1alpha1.ProviderConfig)
+
+func withAdoption() providerConfigModifier {
+	return func(pc *apisv1alpha1.ProviderConfig) {
+		meta.AddLabels(pc, map[string]string{v1alpha1.LabelAdoptUnmanagedCentrals: "true"})
+	}
+}
+
+func withUnmanagedCentrals(c ...apisv1alpha1.UnmanagedCentral) providerConfigModifier {
+	return func(pc *apisv1alpha1.ProviderConfig) { pc.Status.UnmanagedCentrals = c }
+}
+
+func providerConfig(mod ...providerConfigModifier) *apisv1alpha1.ProviderConfig {
+	pc := &apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	for _, m := range mod {
+		m(pc)
+	}
+	return pc
+}
+
+func withProviderConfigRef(name string) centralInstanceModifier {
+	return func(c *v1alpha1.CentralInstance) { c.SetProviderConfigReference(&xpv1.Reference{Name: name}) }
+}
+
+func unmanagedCentral() apisv1alpha1.UnmanagedCentral {
+	return apisv1alpha1.UnmanagedCentral{
+		ID:            "other-id",
+		Name:          "other-central",
+		CloudProvider: string(cloudProvider),
+		Region:        string(region),
+		Status:        rhacs.CentralRequestStatusReady,
+	}
+}
+
+func TestUnmanagedCentralsReconcile(t *testing.T) {
+	interval := 10 * time.Minute
+
+	managedCentral := centralRequest()
+	otherCentral := centralRequest(func(c *public.CentralRequest) {
+		c.Id = "other-id"
+		c.Name = "other-central"
+	})
+	gcpCentral := centralRequest(func(c *public.CentralRequest) {
+		c.Id = "other-id"
+		c.Name = "other-central"
+		c.CloudProvider = "gcp"
+	})
+	deletingCentral := centralRequest(func(c *public.CentralRequest) {
+		c.Id = "deleting-id"
+		c.Name = "deleting-central"
+		c.Status = rhacs.CentralRequestStatusDeleting
+	})
+
+	type args struct {
+		pc       *apisv1alpha1.ProviderConfig
+		cis      []v1alpha1.CentralInstance
+		centrals []public.CentralRequest
+		listErr  error
+	}
+
+	type want struct {
+		result  reconcile.Result
+		status  []apisv1alpha1.UnmanagedCentral
+		updated bool
+		created []string
+	}
+
+	cases := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "report unmanaged central",
+			args: args{
+				pc:       providerConfig(),
+				cis:      []v1alpha1.CentralInstance{*centralInstance(withProviderConfigRef("default"))},
+				centrals: []public.CentralRequest{managedCentral, otherCentral, deletingCentral},
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: interval},
+				status:  []apisv1alpha1.UnmanagedCentral{unmanagedCentral()},
+				updated: true,
+			},
+		},
+		{
+			name: "centrals managed through two ProviderConfigs",
+			args: args{
+				pc: providerConfig(withAdoption()),
+				cis: []v1alpha1.CentralInstance{
+					*centralInstance(withProviderConfigRef("default")),
+					*centralInstance(withProviderConfigRef("other"), func(c *v1alpha1.CentralInstance) {
+						c.SetName("other-central")
+						c.Spec.ForProvider.Name = "other-central"
+						meta.SetExternalName(c, "other-id")
+					}),
+				},
+				centrals: []public.CentralRequest{managedCentral, otherCentral},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+			},
+		},
+		{
+			name: "already reported",
+			args: args{
+				pc:       providerConfig(withUnmanagedCentrals(unmanagedCentral())),
+				cis:      []v1alpha1.CentralInstance{*centralInstance(withProviderConfigRef("default"))},
+				centrals: []public.CentralRequest{managedCentral, otherCentral},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+				status: []apisv1alpha1.UnmanagedCentral{unmanagedCentral()},
+			},
+		},
+		{
+			name: "adopt unmanaged central",
+			args: args{
+				pc:       providerConfig(withAdoption(), withUnmanagedCentrals(unmanagedCentral())),
+				centrals: []public.CentralRequest{otherCentral},
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: interval},
+				updated: true,
+				created: []string{"other-central"},
+			},
+		},
+		{
+			name: "adopt unmanaged central whose name is taken",
+			args: args{
+				pc: providerConfig(withAdoption(), withUnmanagedCentrals(unmanagedCentral())),
+				cis: []v1alpha1.CentralInstance{*centralInstance(withProviderConfigRef("other"), func(c *v1alpha1.CentralInstance) {
+					c.SetName("other-central")
+				})},
+				centrals: []public.CentralRequest{otherCentral},
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: interval},
+				updated: true,
+				created: []string{"other-central-other-id"},
+			},
+		},
+		{
+			name: "unsupported cloud provider not adopted",
+			args: args{
+				pc:       providerConfig(withAdoption()),
+				centrals: []public.CentralRequest{gcpCentral},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+				status: []apisv1alpha1.UnmanagedCentral{func() apisv1alpha1.UnmanagedCentral {
+					c := unmanagedCentral()
+					c.CloudProvider = "gcp"
+					return c
+				}()},
+				updated: true,
+			},
+		},
+		{
+			name: "list centrals error",
+			args: args{
+				pc:      providerConfig(),
+				listErr: errors.New("boom"),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := false
+			created := []string{}
+			kube := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					tc.args.pc.DeepCopyInto(obj.(*apisv1alpha1.ProviderConfig))
+					return nil
+				}),
+				MockList: test.NewMockListFn(nil, func(obj client.ObjectList) error {
+					obj.(*v1alpha1.CentralInstanceList).Items = tc.args.cis
+					return nil
+				}),
+				MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					ci := obj.(*v1alpha1.CentralInstance)
+					if ci.Spec.DeletionPolicy != xpv1.DeletionOrphan || meta.GetExternalName(ci) != ci.Spec.ForProvider.Name {
+						t.Errorf("adopted CentralInstance %q must orphan its central and use its name as external name", ci.GetName())
+					}
+					created = append(created, ci.GetName())
+					return nil
+				},
+				MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					updated = true
+					tc.args.pc = obj.(*apisv1alpha1.ProviderConfig)
+					return nil
+				},
+			}
+			r := &unmanagedCentralsReconciler{
+				kube: kube,
+				newClient: func(_ context.Context, _ *apisv1alpha1.ProviderConfig) (fleetmanager.PublicAPI, error) {
+					return &fleetmanager.PublicAPIMock{
+						GetCentralsFunc: func(ctx context.Context, localVarOptionals *public.GetCentralsOpts) (public.CentralRequestList, *http.Response, error) {
+							return public.CentralRequestList{Items: tc.args.centrals}, nil, tc.args.listErr
+						},
+					}, nil
+				},
+				interval: interval,
+				log:      logging.NewNopLogger(),
+				record:   event.NewNopRecorder(),
+			}
+
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}})
+			if err != nil {
+				t.Errorf("\nr.Reconcile(...): unexpected error: %s\n", err)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\nr.Reconcile(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("\nr.Reconcile(...): -want status update, +got status update:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, tc.args.pc.Status.UnmanagedCentrals, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\nr.Reconcile(...): -want unmanaged centrals, +got unmanaged centrals:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\nr.Reconcile(...): -want adopted, +got adopted:\n%s\n", diff)
+			}
+		})
+	}
+}
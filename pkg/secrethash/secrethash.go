@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secrethash reads the secrets of managed resources, and detects
+// changes to them that external APIs do not return, e.g. because they mask
+// them.
+package secrethash
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/stehessel/provider-redhat/apis/v1alpha1"
+)
+
+const (
+	errGetSecret         = "cannot get secret"
+	errSecretKeyNotFound = "secret key not found"
+)
+
+// GetSecretValue returns the value of the secret key selected by the supplied
+// selector.
+func GetSecretValue(ctx context.Context, kube client.Client, sel xpv1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: sel.Namespace, Name: sel.Name}, s); err != nil {
+		return "", errors.Wrapf(err, "%s %s/%s", errGetSecret, sel.Namespace, sel.Name)
+	}
+	v, ok := s.Data[sel.Key]
+	if !ok {
+		return "", errors.Errorf("%s: %s/%s[%s]", errSecretKeyNotFound, sel.Namespace, sel.Name, sel.Key)
+	}
+	return string(v), nil
+}
+
+// Hash returns a hash of the supplied secret values.
+func Hash(values []string) string {
+	h := sha256.New()
+	for _, v := range values {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Changed returns true if the supplied hash of the current secrets of a
+// managed resource differs from the hash recorded in its observation.
+//
+// The managed reconciler does not persist the status set when an external
+// resource is created, so the hash of the secrets it was created with is
+// recorded by its first observation instead, for which Changed returns false.
+// The hash of secrets written by an update is recorded by the update.
+func Changed(o *v1alpha1.SecretObservation, hash string) bool {
+	if o.SecretHash == "" {
+		o.SecretHash = hash
+		return false
+	}
+	return o.SecretHash != hash
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrethash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/stehessel/provider-redhat/apis/v1alpha1"
+)
+
+func TestGetSecretValue(t *testing.T) {
+	sel := xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "creds", Namespace: "crossplane-system"}, Key: "password"}
+
+	type want struct {
+		value string
+		err   error
+	}
+
+	cases := []struct {
+		name   string
+		data   map[string][]byte
+		getErr error
+		want   want
+	}{
+		{
+			name: "value of key",
+			data: map[string][]byte{"password": []byte("s3cr3t")},
+			want: want{value: "s3cr3t"},
+		},
+		{
+			name: "key not found",
+			data: map[string][]byte{"username": []byte("admin")},
+			want: want{err: cmpopts.AnyError},
+		},
+		{
+			name:   "get error",
+			getErr: errors.New("boom"),
+			want:   want{err: cmpopts.AnyError},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			kube := &test.MockClient{
+				MockGet: test.NewMockGetFn(tc.getErr, func(obj client.Object) error {
+					obj.(*corev1.Secret).Data = tc.data
+					return nil
+				}),
+			}
+			got, err := GetSecretValue(context.Background(), kube, sel)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\nGetSecretValue(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.value, got); diff != "" {
+				t.Errorf("\nGetSecretValue(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestChanged(t *testing.T) {
+	hash := Hash([]string{"s3cr3t"})
+
+	cases := []struct {
+		name     string
+		recorded string
+		want     bool
+	}{
+		{name: "first observation records hash"},
+		{name: "unchanged", recorded: hash},
+		{name: "changed", recorded: Hash([]string{"old"}), want: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &v1alpha1.SecretObservation{SecretHash: tc.recorded}
+			if diff := cmp.Diff(tc.want, Changed(o, hash)); diff != "" {
+				t.Errorf("\nChanged(...): -want, +got:\n%s\n", diff)
+			}
+			if !tc.want && o.SecretHash != hash {
+				t.Errorf("\nChanged(...): recorded hash %q, want %q\n", o.SecretHash, hash)
+			}
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	if Hash([]string{"ab", "c"}) == Hash([]string{"a", "bc"}) {
+		t.Errorf("\nHash(...): values with different boundaries must not collide\n")
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing provides OpenTelemetry instrumentation for reconcilers and
+// the HTTP clients they use to talk to Red Hat APIs.
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ServiceName is the service name reported with every exported span.
+	ServiceName = "provider-redhat"
+
+	// TracerName is the instrumentation name of the provider's tracer.
+	TracerName = "github.com/stehessel/provider-redhat"
+
+	errNewExporter = "cannot create OTLP trace exporter"
+)
+
+// Span attributes recorded by the provider's controllers.
+const (
+	AttributeController        = attribute.Key("crossplane.controller")
+	AttributeResourceName      = attribute.Key("crossplane.resource.name")
+	AttributeResourceNamespace = attribute.Key("crossplane.resource.namespace")
+	AttributeProviderConfig    = attribute.Key("crossplane.providerconfig")
+	AttributeCentralID         = attribute.Key("rhacs.central.id")
+	AttributeCentralName       = attribute.Key("rhacs.central.name")
+	AttributeCentralRegion     = attribute.Key("rhacs.central.region")
+)
+
+// Setup configures the global TracerProvider to export spans via OTLP/HTTP to
+// the supplied endpoint. The returned function flushes and stops the exporter.
+func Setup(ctx context.Context, endpoint string, insecure bool) (func(context.Context) error, error) {
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint)}
+	if insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exp, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExporter)
+	}
+
+	tp := NewTracerProvider(sdktrace.WithBatcher(exp))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return tp.Shutdown, nil
+}
+
+// NewTracerProvider returns a TracerProvider that identifies itself as the
+// provider. Tests may pass sdktrace.WithSyncer with an in-memory exporter.
+func NewTracerProvider(o ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	res := sdkresource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(ServiceName))
+	return sdktrace.NewTracerProvider(append([]sdktrace.TracerProviderOption{sdktrace.WithResource(res)}, o...)...)
+}
+
+// NewTransport wraps the supplied RoundTripper so that each request is
+// recorded as a child span of the span found in the request's context.
+func NewTransport(rt http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(rt, otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+		return r.Method + " " + r.URL.Path
+	}))
+}
+
+// SetAttributes records the supplied attributes on the span of the current
+// reconcile, if any.
+func SetAttributes(ctx context.Context, kv ...attribute.KeyValue) {
+	trace.SpanFromContext(ctx).SetAttributes(kv...)
+}
+
+// A Reconciler starts a span for every reconcile of the wrapped Reconciler.
+type Reconciler struct {
+	name   string
+	inner  reconcile.Reconciler
+	tracer trace.Tracer
+}
+
+// NewReconciler wraps the supplied Reconciler, recording each reconcile as a
+// span created by the supplied TracerProvider.
+func NewReconciler(name string, r reconcile.Reconciler, tp trace.TracerProvider) *Reconciler {
+	return &Reconciler{name: name, inner: r, tracer: tp.Tracer(TracerName)}
+}
+
+// Reconcile the supplied request within a new span. Spans are named after the
+// controller, so that their name does not grow with the number of resources,
+// and record the reconciled resource as attributes.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	attrs := []attribute.KeyValue{
+		AttributeController.String(r.name),
+		AttributeResourceName.String(req.Name),
+	}
+	if req.Namespace != "" {
+		attrs = append(attrs, AttributeResourceNamespace.String(req.Namespace))
+	}
+	ctx, span := r.tracer.Start(ctx, "Reconcile "+r.name, trace.WithAttributes(attrs...))
+	defer span.End()
+
+	res, err := r.inner.Reconcile(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return res, err
+}
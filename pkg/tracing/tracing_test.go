@@ -0,0 +1,179 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ reconcile.Reconciler = &Reconciler{}
+
+type span struct {
+	Name       string
+	Parent     string
+	Status     codes.Code
+	Attributes map[attribute.Key]string
+}
+
+func spans(stubs tracetest.SpanStubs) []span {
+	names := map[string]string{}
+	for _, s := range stubs {
+		names[s.SpanContext.SpanID().String()] = s.Name
+	}
+	out := make([]span, 0, len(stubs))
+	for _, s := range stubs {
+		attrs := map[attribute.Key]string{}
+		for _, kv := range s.Attributes {
+			attrs[kv.Key] = kv.Value.Emit()
+		}
+		out = append(out, span{
+			Name:       s.Name,
+			Parent:     names[s.Parent.SpanID().String()],
+			Status:     s.Status.Code,
+			Attributes: attrs,
+		})
+	}
+	return out
+}
+
+func TestReconciler(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	errBoom := errors.New("boom")
+
+	type want struct {
+		spans []span
+		err   error
+	}
+
+	cases := []struct {
+		name      string
+		namespace string
+		inner     reconcile.Func
+		want      want
+	}{
+		{
+			name: "reconcile with fleet manager call",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				SetAttributes(ctx, AttributeCentralID.String("test-id"), AttributeProviderConfig.String("default"))
+				req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/api/rhacs/v1/centrals", nil)
+				resp, err := (&http.Client{Transport: NewTransport(http.DefaultTransport)}).Do(req)
+				if err != nil {
+					return reconcile.Result{}, err
+				}
+				return reconcile.Result{}, resp.Body.Close()
+			},
+			want: want{
+				spans: []span{
+					{
+						Name:   "GET /api/rhacs/v1/centrals",
+						Parent: "Reconcile test",
+					},
+					{
+						Name: "Reconcile test",
+						Attributes: map[attribute.Key]string{
+							AttributeController:     "test",
+							AttributeResourceName:   "test-central",
+							AttributeCentralID:      "test-id",
+							AttributeProviderConfig: "default",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "reconcile of namespaced resource",
+			namespace: "crossplane-system",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{}, nil
+			},
+			want: want{
+				spans: []span{
+					{
+						Name: "Reconcile test",
+						Attributes: map[attribute.Key]string{
+							AttributeController:        "test",
+							AttributeResourceName:      "test-central",
+							AttributeResourceNamespace: "crossplane-system",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reconcile error",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{}, errBoom
+			},
+			want: want{
+				spans: []span{
+					{
+						Name:   "Reconcile test",
+						Status: codes.Error,
+						Attributes: map[attribute.Key]string{
+							AttributeController:   "test",
+							AttributeResourceName: "test-central",
+						},
+					},
+				},
+				err: errBoom,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			exp := tracetest.NewInMemoryExporter()
+			tp := NewTracerProvider(sdktrace.WithSyncer(exp))
+			otel.SetTracerProvider(tp)
+
+			r := NewReconciler("test", tc.inner, tp)
+			_, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-central", Namespace: tc.namespace}})
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\nr.Reconcile(...): -want error, +got error:\n%s\n", diff)
+			}
+			// Only compare the attributes set by the provider, not the ones
+			// added by the HTTP instrumentation.
+			got := spans(exp.GetSpans())
+			for i := range got {
+				if got[i].Parent != "" {
+					got[i].Attributes = nil
+				}
+			}
+			if diff := cmp.Diff(tc.want.spans, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\nr.Reconcile(...): -want spans, +got spans:\n%s\n", diff)
+			}
+		})
+	}
+}